@@ -51,6 +51,13 @@ func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"success: with ORDERED_ALLOW_TIMEOUT ordering",
+			func() {
+				msg.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			true,
+		},
 		{
 			"connection id is invalid",
 			func() {
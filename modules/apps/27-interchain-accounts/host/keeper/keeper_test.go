@@ -220,6 +220,8 @@ func (suite *KeeperTestSuite) TestNewModuleQuerySafeAllowList() {
 	suite.Require().Contains(allowList, "/cosmos.auth.v1beta1.Query/Accounts")
 	suite.Require().Contains(allowList, "/cosmos.auth.v1beta1.Query/ModuleAccountByName")
 	suite.Require().Contains(allowList, "/ibc.core.client.v1.Query/VerifyMembership")
+	suite.Require().Contains(allowList, "/ibc.core.client.v1.Query/VerifyNonMembership")
+	suite.Require().Contains(allowList, "/ibc.core.client.v1.Query/VerifyMembershipBatch")
 	suite.Require().NotContains(allowList, "/cosmos.gov.v1beta1.Query/Proposals")
 	suite.Require().NotContains(allowList, "/cosmos.gov.v1.Query/Proposals")
 	suite.Require().NotContains(allowList, "/cosmos.distribution.v1beta1.Query/Params")
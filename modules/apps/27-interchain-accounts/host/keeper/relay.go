@@ -16,6 +16,9 @@ import (
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
+// The transaction response is a proto encoded sdk.TxMsgData containing the responses of the executed
+// messages. It is included in the result acknowledgement and is therefore available to destination
+// callbacks when the host is wrapped by the callbacks middleware.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 	err := data.UnmarshalJSON(packet.GetData())
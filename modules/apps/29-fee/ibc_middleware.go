@@ -17,9 +17,10 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware               = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler    = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule         = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradeCancellableModule = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the fee middleware given the
@@ -441,6 +442,14 @@ func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID str
 	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, versionMetadata.AppVersion)
 }
 
+// OnChanUpgradeCancel implements the UpgradeCancellableModule interface. The callback is passed through
+// to the underlying application if it implements the UpgradeCancellableModule interface.
+func (im IBCMiddleware) OnChanUpgradeCancel(ctx sdk.Context, portID, channelID string) {
+	if cbs, ok := im.app.(porttypes.UpgradeCancellableModule); ok {
+		cbs.OnChanUpgradeCancel(ctx, portID, channelID)
+	}
+}
+
 // SendPacket implements the ICS4 Wrapper interface
 func (im IBCMiddleware) SendPacket(
 	ctx sdk.Context,
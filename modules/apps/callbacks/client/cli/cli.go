@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for the ibc callbacks middleware
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-callbacks",
+		Short:                      "IBC callbacks query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdParams(),
+		GetCmdPendingCallback(),
+		GetCmdPendingCallbacks(),
+		GetCmdChannelCallback(),
+		GetCmdCallbackAddressStatus(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the ibc callbacks middleware
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-callbacks",
+		Short:                      "IBC callbacks transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewExecuteCallbackCmd(),
+		NewRegisterChannelCallbackCmd(),
+		NewDeregisterChannelCallbackCmd(),
+	)
+
+	return txCmd
+}
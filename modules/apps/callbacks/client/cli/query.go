@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+// GetCmdParams returns the command handler for the callbacks middleware parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc callbacks parameters",
+		Long:    "Query the current ibc callbacks parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-callbacks params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPendingCallback returns the command handler for the Query/PendingCallback rpc.
+func GetCmdPendingCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-callback [callback-type] [port-id] [channel-id] [sequence]",
+		Short:   "Query a callback awaiting retry",
+		Long:    "Query a callback awaiting retry by callback type, port-id, channel-id and packet sequence.",
+		Args:    cobra.ExactArgs(4),
+		Example: fmt.Sprintf("%s query ibc-callbacks pending-callback %s transfer channel-0 100", version.AppName, types.CallbackTypeAcknowledgementPacket),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingCallbackRequest{
+				CallbackType: args[0],
+				PortId:       args[1],
+				ChannelId:    args[2],
+				Sequence:     seq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingCallback(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPendingCallbacks returns the command handler for the Query/PendingCallbacks rpc.
+func GetCmdPendingCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-callbacks",
+		Short:   "Query all callbacks awaiting retry",
+		Long:    "Query all callbacks awaiting retry",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-callbacks pending-callbacks", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingCallbacksRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingCallbacks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending callbacks")
+
+	return cmd
+}
+
+// GetCmdChannelCallback returns the command handler for the Query/ChannelCallback rpc.
+func GetCmdChannelCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-callback [port-id] [channel-id]",
+		Short:   "Query the callback address registered for the channel lifecycle callbacks of a channel",
+		Long:    "Query the callback address registered for the channel lifecycle callbacks of a channel by port-id and channel-id.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-callbacks channel-callback transfer channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelCallbackRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelCallback(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCallbackAddressStatus returns the command handler for the Query/CallbackAddressStatus rpc.
+func GetCmdCallbackAddressStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "callback-address-status [callback-address]",
+		Short:   "Query whether callbacks to a callback address are permitted and its gas usage in the current block",
+		Long:    "Query whether callbacks to a callback address are permitted by the allowlist and denylist, and the gas consumed by callbacks to the callback address in the current block.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-callbacks callback-address-status cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCallbackAddressStatusRequest{
+				CallbackAddress: args[0],
+			}
+
+			res, err := queryClient.CallbackAddressStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+// NewExecuteCallbackCmd returns the command to create a MsgExecuteCallback
+func NewExecuteCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-callback [callback-type] [port-id] [channel-id] [sequence]",
+		Short: "Retry a callback which ran out of gas",
+		Long: `Retry a callback which ran out of gas during packet relaying. The transaction must provide
+enough gas for the callback to execute up to its commit gas limit, otherwise the callback remains pending.`,
+		Example: fmt.Sprintf("%s tx ibc-callbacks execute-callback %s transfer channel-0 100 --gas 2000000", version.AppName, types.CallbackTypeAcknowledgementPacket),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteCallback(types.CallbackType(args[0]), args[1], args[2], seq, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterChannelCallbackCmd returns the command to create a MsgRegisterChannelCallback
+func NewRegisterChannelCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-channel-callback [port-id] [channel-id] [callback-address]",
+		Short: "Register a callback address for the channel lifecycle callbacks of a channel",
+		Long: `Register a callback address to be notified when the channel opens, closes, completes an upgrade
+or cancels an upgrade. The transaction must be signed by the callback address.`,
+		Example: fmt.Sprintf("%s tx ibc-callbacks register-channel-callback transfer channel-0 cosmos1...", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterChannelCallback(args[0], args[1], args[2], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeregisterChannelCallbackCmd returns the command to create a MsgDeregisterChannelCallback
+func NewDeregisterChannelCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deregister-channel-callback [port-id] [channel-id]",
+		Short:   "Remove the callback address registered for the channel lifecycle callbacks of a channel",
+		Long:    "Remove the callback address registered for the channel lifecycle callbacks of a channel. The transaction must be signed by the registered callback address.",
+		Example: fmt.Sprintf("%s tx ibc-callbacks deregister-channel-callback transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeregisterChannelCallback(args[0], args[1], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/keeper"
 	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -18,9 +19,10 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware               = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler    = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule         = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradeCancellableModule = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the ibc-callbacks middleware given
@@ -31,6 +33,12 @@ type IBCMiddleware struct {
 
 	contractKeeper types.ContractKeeper
 
+	// keeper is optional. When set, acknowledgement, timeout and receive packet callbacks which
+	// run out of gas and may be retried are stored for later execution using MsgExecuteCallback,
+	// instead of reverting the packet relaying transaction. The keeper is also required to execute
+	// channel lifecycle callbacks for the callback addresses registered using MsgRegisterChannelCallback.
+	keeper *keeper.Keeper
+
 	// maxCallbackGas defines the maximum amount of gas that a callback actor can ask the
 	// relayer to pay for. If a callback fails due to insufficient gas, the entire tx
 	// is reverted if the relayer hadn't provided the minimum(userDefinedGas, maxCallbackGas).
@@ -81,10 +89,17 @@ func (im *IBCMiddleware) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return im.ics4Wrapper
 }
 
+// WithKeeper sets the callbacks keeper. This function may be used after the middleware's
+// creation to enable storing out of gas callbacks for later retry and channel lifecycle callbacks.
+func (im *IBCMiddleware) WithKeeper(k *keeper.Keeper) {
+	im.keeper = k
+}
+
 // SendPacket implements source callbacks for sending packets.
 // It defers to the underlying application and then calls the contract callback.
 // If the contract callback returns an error, panics, or runs out of gas, then
-// the packet send is rejected.
+// the packet send is rejected. The packet send is also rejected if the callback
+// is not permitted by the callbacks middleware parameters.
 func (im IBCMiddleware) SendPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -138,7 +153,7 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	}
 
 	callbackData, err := types.GetSourceCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), im.callbackGasRemaining(ctx, types.CallbackTypeAcknowledgementPacket), im.maxCallbackGas,
 	)
 	// OnAcknowledgementPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -153,6 +168,7 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
 	err = im.processCallback(ctx, types.CallbackTypeAcknowledgementPacket, callbackData, callbackExecutor)
+	im.scheduleCallbackRetry(ctx, types.CallbackTypeAcknowledgementPacket, packet, acknowledgement, false, relayer, callbackData, err)
 	types.EmitCallbackEvent(
 		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
 		types.CallbackTypeAcknowledgementPacket, callbackData, err,
@@ -162,7 +178,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 }
 
 // OnTimeoutPacket implements timeout source callbacks for the ibc-callbacks middleware.
-// It defers to the underlying application and then calls the contract callback.
+// It defers to the underlying application and then calls the contract callback. If the timeout
+// closed the channel, the channel close callback is executed as well.
 // If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
 // reverted via a panic.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
@@ -171,8 +188,13 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 		return err
 	}
 
+	// ordered channels are closed once a packet has timed out
+	if im.keeper != nil && im.keeper.IsChannelClosed(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		im.processChannelCloseCallback(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
 	callbackData, err := types.GetSourceCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), im.callbackGasRemaining(ctx, types.CallbackTypeTimeoutPacket), im.maxCallbackGas,
 	)
 	// OnTimeoutPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -185,6 +207,7 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
 	err = im.processCallback(ctx, types.CallbackTypeTimeoutPacket, callbackData, callbackExecutor)
+	im.scheduleCallbackRetry(ctx, types.CallbackTypeTimeoutPacket, packet, nil, false, relayer, callbackData, err)
 	types.EmitCallbackEvent(
 		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
 		types.CallbackTypeTimeoutPacket, callbackData, err,
@@ -208,7 +231,7 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet
 	}
 
 	callbackData, err := types.GetDestCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), im.callbackGasRemaining(ctx, types.CallbackTypeReceivePacket), im.maxCallbackGas,
 	)
 	// OnRecvPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -221,6 +244,7 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
 	err = im.processCallback(ctx, types.CallbackTypeReceivePacket, callbackData, callbackExecutor)
+	im.scheduleCallbackRetry(ctx, types.CallbackTypeReceivePacket, packet, ack.Acknowledgement(), ack.Success(), relayer, callbackData, err)
 	types.EmitCallbackEvent(
 		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		types.CallbackTypeReceivePacket, callbackData, err,
@@ -246,7 +270,7 @@ func (im IBCMiddleware) WriteAcknowledgement(
 	}
 
 	callbackData, err := types.GetDestCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), im.callbackGasRemaining(ctx, types.CallbackTypeReceivePacket), im.maxCallbackGas,
 	)
 	// WriteAcknowledgement is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -259,6 +283,7 @@ func (im IBCMiddleware) WriteAcknowledgement(
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
 	err = im.processCallback(ctx, types.CallbackTypeReceivePacket, callbackData, callbackExecutor)
+	im.scheduleCallbackRetry(ctx, types.CallbackTypeReceivePacket, packet, ack.Acknowledgement(), ack.Success(), nil, callbackData, err)
 	types.EmitCallbackEvent(
 		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		types.CallbackTypeReceivePacket, callbackData, err,
@@ -274,20 +299,29 @@ func (im IBCMiddleware) WriteAcknowledgement(
 //   - panicErr: Takes the second-highest precedence. If a panic occurs and it is not propagated, an error wrapped with types.ErrCallbackPanic is returned.
 //   - callbackErr: If the callbackExecutor returns an error, it is returned as-is.
 //
+// The callback is not executed and an error is returned if the callbacks middleware parameters do not permit
+// the callback, see validateCallback. The gas consumed by the callback is added to the per block gas usage of
+// the callback address.
+//
 // panics if
 //   - the contractExecutor panics for any reason, and the callbackType is SendPacket, or
 //   - the contractExecutor runs out of gas and the relayer has not reserved gas grater than or equal to
-//     CommitGasLimit.
-func (IBCMiddleware) processCallback(
+//     CommitGasLimit, and the callback cannot be scheduled for retry.
+func (im IBCMiddleware) processCallback(
 	ctx sdk.Context, callbackType types.CallbackType,
 	callbackData types.CallbackData, callbackExecutor func(sdk.Context) error,
 ) (err error) {
+	if err := im.validateCallback(ctx, callbackType, callbackData); err != nil {
+		return err
+	}
+
 	cachedCtx, writeFn := ctx.CacheContext()
 	cachedCtx = cachedCtx.WithGasMeter(storetypes.NewGasMeter(callbackData.ExecutionGasLimit))
 
 	defer func() {
 		// consume the minimum of g.consumed and g.limit
 		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback", callbackType))
+		im.consumeCallbackGas(ctx, callbackData.CallbackAddress, cachedCtx.GasMeter().GasConsumedToLimit())
 
 		// recover from all panics except during SendPacket callbacks
 		if r := recover(); r != nil {
@@ -298,8 +332,9 @@ func (IBCMiddleware) processCallback(
 		}
 
 		// if the callback ran out of gas and the relayer has not reserved enough gas, then revert the state
+		// unless the callback can be stored for a later retry
 		if cachedCtx.GasMeter().IsPastLimit() {
-			if callbackData.AllowRetry() {
+			if callbackData.AllowRetry() && !im.canScheduleRetry(callbackType) {
 				panic(storetypes.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", callbackType, callbackData.CommitGasLimit)})
 			}
 			err = errorsmod.Wrapf(types.ErrCallbackOutOfGas, "ibc %s callback out of gas", callbackType)
@@ -316,6 +351,134 @@ func (IBCMiddleware) processCallback(
 	return err
 }
 
+// validateCallback returns an error if the callbacks middleware parameters do not permit the execution of the
+// callback. The callback address must be permitted by the allowlist and denylist, must be the packet sender for
+// source callbacks if required, and the commit gas limit of the callback must not exceed the gas remaining in the
+// per block gas budget of the callback address. The parameters are only enforced if the keeper is set.
+func (im IBCMiddleware) validateCallback(ctx sdk.Context, callbackType types.CallbackType, callbackData types.CallbackData) error {
+	if im.keeper == nil {
+		return nil
+	}
+
+	// the execution gas limit of the callback has been computed from the gas remaining in the transaction,
+	// reading the parameters is therefore not metered so that the callback may use the gas it is entitled to.
+	infiniteGasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if err := im.keeper.ValidateCallbackAddress(infiniteGasCtx, callbackType, callbackData); err != nil {
+		return err
+	}
+
+	return im.keeper.ValidateCallbackGasBudget(infiniteGasCtx, callbackData)
+}
+
+// consumeCallbackGas adds the gas consumed by a callback to the per block gas usage of the callback address.
+func (im IBCMiddleware) consumeCallbackGas(ctx sdk.Context, callbackAddress string, gas uint64) {
+	if im.keeper == nil {
+		return
+	}
+
+	// the callback may have consumed all gas remaining in the transaction, recording the gas usage is
+	// therefore not metered.
+	infiniteGasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	im.keeper.ConsumeCallbackGas(infiniteGasCtx, callbackAddress, gas)
+}
+
+// callbackGasRemaining returns the gas remaining in the transaction which may be used to execute a callback
+// of the given type. If out of gas callbacks of this type are stored for a later retry, RetryGasReserve is
+// withheld so that the retry is persisted once the callback has used up its execution gas.
+func (im IBCMiddleware) callbackGasRemaining(ctx sdk.Context, callbackType types.CallbackType) uint64 {
+	remainingGas := ctx.GasMeter().GasRemaining()
+	if !im.canScheduleRetry(callbackType) {
+		return remainingGas
+	}
+
+	if remainingGas < types.RetryGasReserve {
+		return 0
+	}
+
+	return remainingGas - types.RetryGasReserve
+}
+
+// canScheduleRetry returns true if out of gas callbacks of the given type are stored for a later retry
+// instead of reverting the transaction. Send packet and channel lifecycle callbacks are never retried.
+func (im IBCMiddleware) canScheduleRetry(callbackType types.CallbackType) bool {
+	return im.keeper != nil && types.ValidateRetryableCallbackType(callbackType) == nil
+}
+
+// scheduleCallbackRetry stores the callback for a later retry if it ran out of gas before reaching
+// the commit gas limit, or if it was not executed because the per block gas budget of the callback
+// address was exhausted.
+func (im IBCMiddleware) scheduleCallbackRetry(
+	ctx sdk.Context, callbackType types.CallbackType, packet ibcexported.PacketI,
+	acknowledgement []byte, acknowledgementSuccess bool, relayer sdk.AccAddress,
+	callbackData types.CallbackData, err error,
+) {
+	if !im.canScheduleRetry(callbackType) {
+		return
+	}
+
+	outOfGas := callbackData.AllowRetry() && errors.Is(err, types.ErrCallbackOutOfGas)
+	if !outOfGas && !errors.Is(err, types.ErrCallbackGasBudgetExceeded) {
+		return
+	}
+
+	// the callback may have consumed all gas remaining in the transaction, storing the callback is
+	// therefore not metered. The amount of callbacks stored is bounded by the packets relayed.
+	infiniteGasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	im.keeper.ScheduleCallbackRetry(infiniteGasCtx, callbackType, packet, acknowledgement, acknowledgementSuccess, relayer.String(), callbackData)
+}
+
+// processChannelCallback executes a channel lifecycle callback for the callback address registered for the
+// channel. The callback is skipped if the keeper is not set, if the contract keeper does not implement the
+// ChannelContractKeeper interface or if no callback address is registered for the channel. The callback is
+// executed with a gas limit of maxCallbackGas and its result does not affect the channel handshake or upgrade.
+func (im IBCMiddleware) processChannelCallback(
+	ctx sdk.Context, callbackType types.CallbackType, portID, channelID string,
+	callbackExecutor func(sdk.Context, types.ChannelContractKeeper, string) error,
+) {
+	if im.keeper == nil {
+		return
+	}
+
+	channelContractKeeper, ok := im.contractKeeper.(types.ChannelContractKeeper)
+	if !ok {
+		return
+	}
+
+	callbackAddress, found := im.keeper.GetChannelCallback(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	callbackData := types.CallbackData{
+		CallbackAddress:   callbackAddress,
+		ExecutionGasLimit: im.maxCallbackGas,
+		CommitGasLimit:    im.maxCallbackGas,
+	}
+	if remainingGas := ctx.GasMeter().GasRemaining(); remainingGas < callbackData.ExecutionGasLimit {
+		callbackData.ExecutionGasLimit = remainingGas
+	}
+
+	err := im.processCallback(ctx, callbackType, callbackData, func(cachedCtx sdk.Context) error {
+		return callbackExecutor(cachedCtx, channelContractKeeper, callbackAddress)
+	})
+
+	types.EmitChannelCallbackEvent(ctx, portID, channelID, callbackType, callbackData, err)
+}
+
+// processChannelCloseCallback executes the channel close callback and removes the callback address
+// registered for the closed channel.
+func (im IBCMiddleware) processChannelCloseCallback(ctx sdk.Context, portID, channelID string) {
+	im.processChannelCallback(ctx, types.CallbackTypeChannelClose, portID, channelID,
+		func(cachedCtx sdk.Context, contractKeeper types.ChannelContractKeeper, callbackAddress string) error {
+			return contractKeeper.IBCOnChanCloseCallback(cachedCtx, portID, channelID, callbackAddress)
+		},
+	)
+
+	if im.keeper != nil {
+		im.keeper.DeleteChannelCallback(ctx, portID, channelID)
+	}
+}
+
 // OnChanOpenInit defers to the underlying application
 func (im IBCMiddleware) OnChanOpenInit(
 	ctx sdk.Context,
@@ -343,7 +506,8 @@ func (im IBCMiddleware) OnChanOpenTry(
 	return im.app.OnChanOpenTry(ctx, channelOrdering, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
 }
 
-// OnChanOpenAck defers to the underlying application
+// OnChanOpenAck defers to the underlying application and then calls the channel open callback
+// of the callback address registered for the channel, if any.
 func (im IBCMiddleware) OnChanOpenAck(
 	ctx sdk.Context,
 	portID,
@@ -351,22 +515,58 @@ func (im IBCMiddleware) OnChanOpenAck(
 	counterpartyChannelID,
 	counterpartyVersion string,
 ) error {
-	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	if err := im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion); err != nil {
+		return err
+	}
+
+	im.processChannelCallback(ctx, types.CallbackTypeChannelOpen, portID, channelID,
+		func(cachedCtx sdk.Context, contractKeeper types.ChannelContractKeeper, callbackAddress string) error {
+			return contractKeeper.IBCOnChanOpenCallback(cachedCtx, portID, channelID, counterpartyVersion, callbackAddress)
+		},
+	)
+
+	return nil
 }
 
-// OnChanOpenConfirm defers to the underlying application
+// OnChanOpenConfirm defers to the underlying application and then calls the channel open callback
+// of the callback address registered for the channel, if any.
 func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
-	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+	if err := im.app.OnChanOpenConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	im.processChannelCallback(ctx, types.CallbackTypeChannelOpen, portID, channelID,
+		func(cachedCtx sdk.Context, contractKeeper types.ChannelContractKeeper, callbackAddress string) error {
+			version, _ := im.GetAppVersion(cachedCtx, portID, channelID)
+			return contractKeeper.IBCOnChanOpenCallback(cachedCtx, portID, channelID, version, callbackAddress)
+		},
+	)
+
+	return nil
 }
 
-// OnChanCloseInit defers to the underlying application
+// OnChanCloseInit defers to the underlying application and then calls the channel close callback
+// of the callback address registered for the channel, if any.
 func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
-	return im.app.OnChanCloseInit(ctx, portID, channelID)
+	if err := im.app.OnChanCloseInit(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	im.processChannelCloseCallback(ctx, portID, channelID)
+
+	return nil
 }
 
-// OnChanCloseConfirm defers to the underlying application
+// OnChanCloseConfirm defers to the underlying application and then calls the channel close callback
+// of the callback address registered for the channel, if any.
 func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
-	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+	if err := im.app.OnChanCloseConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	im.processChannelCloseCallback(ctx, portID, channelID)
+
+	return nil
 }
 
 // OnChanUpgradeInit implements the IBCModule interface
@@ -399,7 +599,8 @@ func (im IBCMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, cou
 	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
 }
 
-// OnChanUpgradeOpen implements the IBCModule interface
+// OnChanUpgradeOpen implements the IBCModule interface. The channel upgrade open callback of the
+// callback address registered for the channel is called once the underlying application has completed the upgrade.
 func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
 	cbs, ok := im.app.(porttypes.UpgradableModule)
 	if !ok {
@@ -407,6 +608,27 @@ func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID str
 	}
 
 	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+
+	im.processChannelCallback(ctx, types.CallbackTypeChannelUpgradeOpen, portID, channelID,
+		func(cachedCtx sdk.Context, contractKeeper types.ChannelContractKeeper, callbackAddress string) error {
+			return contractKeeper.IBCOnChanUpgradeOpenCallback(cachedCtx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion, callbackAddress)
+		},
+	)
+}
+
+// OnChanUpgradeCancel implements the UpgradeCancellableModule interface. The callback is passed through to
+// the underlying application if it implements the UpgradeCancellableModule interface, then the channel upgrade
+// cancel callback of the callback address registered for the channel is called, if any.
+func (im IBCMiddleware) OnChanUpgradeCancel(ctx sdk.Context, portID, channelID string) {
+	if cbs, ok := im.app.(porttypes.UpgradeCancellableModule); ok {
+		cbs.OnChanUpgradeCancel(ctx, portID, channelID)
+	}
+
+	im.processChannelCallback(ctx, types.CallbackTypeChannelUpgradeCancel, portID, channelID,
+		func(cachedCtx sdk.Context, contractKeeper types.ChannelContractKeeper, callbackAddress string) error {
+			return contractKeeper.IBCOnChanUpgradeCancelCallback(cachedCtx, portID, channelID, callbackAddress)
+		},
+	)
 }
 
 // GetAppVersion implements the ICS4Wrapper interface. Callbacks has no version,
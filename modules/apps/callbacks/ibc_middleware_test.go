@@ -18,6 +18,7 @@ import (
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -219,6 +220,7 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 		noExecution expResult = iota
 		callbackFailed
 		callbackSuccess
+		callbackRetryScheduled
 	)
 
 	var (
@@ -229,8 +231,6 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 		userGasLimit uint64
 	)
 
-	panicError := fmt.Errorf("panic error")
-
 	testCases := []struct {
 		name      string
 		malleate  func()
@@ -271,15 +271,15 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 			nil,
 		},
 		{
-			"failure: callback execution panics on insufficient gas provided by relayer",
+			"failure: callback execution is stored for retry on insufficient gas provided by relayer",
 			func() {
 				packetData.Memo = fmt.Sprintf(`{"src_callback": {"address":"%s", "gas_limit":"%d"}}`, simapp.OogPanicContract, userGasLimit)
 				packet.Data = packetData.GetBytes()
 
 				ctx = ctx.WithGasMeter(storetypes.NewGasMeter(300_000))
 			},
-			callbackFailed,
-			panicError,
+			callbackRetryScheduled,
+			nil,
 		},
 		{
 			"failure: callback execution fails",
@@ -334,13 +334,6 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 				err := onAcknowledgementPacket()
 				s.Require().Nil(err)
 
-			case panicError:
-				s.Require().PanicsWithValue(storetypes.ErrorOutOfGas{
-					Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", types.CallbackTypeAcknowledgementPacket, userGasLimit),
-				}, func() {
-					_ = onAcknowledgementPacket()
-				})
-
 			default:
 				err := onAcknowledgementPacket()
 				s.Require().ErrorIs(err, tc.expError)
@@ -359,6 +352,18 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeAcknowledgementPacket])
 				s.Require().Equal(uint8(0), sourceStatefulCounter)
 
+			case callbackRetryScheduled:
+				s.Require().Len(sourceCounters, 1)
+				s.Require().Equal(1, sourceCounters[types.CallbackTypeAcknowledgementPacket])
+				s.Require().Equal(uint8(0), sourceStatefulCounter)
+
+				pendingCallback, found := GetSimApp(s.chainA).IBCCallbacksKeeper.GetPendingCallback(
+					ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), types.CallbackTypeAcknowledgementPacket, packet.SourcePort, packet.SourceChannel, packet.Sequence,
+				)
+				s.Require().True(found)
+				s.Require().Equal(userGasLimit, pendingCallback.CommitGasLimit)
+				s.Require().Equal(ack, pendingCallback.Acknowledgement)
+
 			case callbackSuccess:
 				s.Require().Len(sourceCounters, 1)
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeAcknowledgementPacket])
@@ -381,6 +386,7 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 		noExecution expResult = iota
 		callbackFailed
 		callbackSuccess
+		callbackRetryScheduled
 	)
 
 	var (
@@ -429,17 +435,15 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 			nil,
 		},
 		{
-			"failure: callback execution panics on insufficient gas provided by relayer",
+			"failure: callback execution is stored for retry on insufficient gas provided by relayer",
 			func() {
 				packetData.Memo = fmt.Sprintf(`{"src_callback": {"address":"%s"}}`, simapp.OogPanicContract)
 				packet.Data = packetData.GetBytes()
 
 				ctx = ctx.WithGasMeter(storetypes.NewGasMeter(300_000))
 			},
-			callbackFailed,
-			storetypes.ErrorOutOfGas{
-				Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", types.CallbackTypeTimeoutPacket, maxCallbackGas),
-			},
+			callbackRetryScheduled,
+			nil,
 		},
 		{
 			"failure: callback execution fails",
@@ -520,6 +524,19 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeSendPacket])
 				s.Require().Equal(uint8(1), sourceStatefulCounter)
 
+			case callbackRetryScheduled:
+				s.Require().Len(sourceCounters, 2)
+				s.Require().Equal(1, sourceCounters[types.CallbackTypeTimeoutPacket])
+				s.Require().Equal(1, sourceCounters[types.CallbackTypeSendPacket])
+				s.Require().Equal(uint8(1), sourceStatefulCounter)
+
+				pendingCallback, found := GetSimApp(s.chainA).IBCCallbacksKeeper.GetPendingCallback(
+					ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), types.CallbackTypeTimeoutPacket, packet.SourcePort, packet.SourceChannel, packet.Sequence,
+				)
+				s.Require().True(found)
+				s.Require().Equal(maxCallbackGas, pendingCallback.CommitGasLimit)
+				s.Require().Equal(s.chainA.SenderAccount.GetAddress().String(), pendingCallback.Relayer)
+
 			case callbackSuccess:
 				s.Require().Len(sourceCounters, 2)
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeTimeoutPacket])
@@ -543,6 +560,7 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 		noExecution expResult = iota
 		callbackFailed
 		callbackSuccess
+		callbackRetryScheduled
 	)
 
 	var (
@@ -553,7 +571,6 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 	)
 
 	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
-	panicAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("panic"))
 
 	testCases := []struct {
 		name      string
@@ -595,15 +612,15 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 			successAck,
 		},
 		{
-			"failure: callback execution panics on insufficient gas provided by relayer",
+			"failure: callback execution is stored for retry on insufficient gas provided by relayer",
 			func() {
 				packetData.Memo = fmt.Sprintf(`{"dest_callback": {"address":"%s", "gas_limit":"%d"}}`, simapp.OogPanicContract, userGasLimit)
 				packet.Data = packetData.GetBytes()
 
 				ctx = ctx.WithGasMeter(storetypes.NewGasMeter(300_000))
 			},
-			callbackFailed,
-			panicAck,
+			callbackRetryScheduled,
+			successAck,
 		},
 		{
 			"failure: callback execution fails",
@@ -657,13 +674,6 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 				ack := onRecvPacket()
 				s.Require().NotNil(ack)
 
-			case panicAck:
-				s.Require().PanicsWithValue(storetypes.ErrorOutOfGas{
-					Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", types.CallbackTypeReceivePacket, userGasLimit),
-				}, func() {
-					_ = onRecvPacket()
-				})
-
 			default:
 				ack := onRecvPacket()
 				s.Require().Equal(tc.expAck, ack)
@@ -682,6 +692,18 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 				s.Require().Equal(1, destCounters[types.CallbackTypeReceivePacket])
 				s.Require().Equal(uint8(0), destStatefulCounter)
 
+			case callbackRetryScheduled:
+				s.Require().Len(destCounters, 1)
+				s.Require().Equal(1, destCounters[types.CallbackTypeReceivePacket])
+				s.Require().Equal(uint8(0), destStatefulCounter)
+
+				pendingCallback, found := GetSimApp(s.chainB).IBCCallbacksKeeper.GetPendingCallback(
+					ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), types.CallbackTypeReceivePacket, packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+				)
+				s.Require().True(found)
+				s.Require().Equal(successAck.Acknowledgement(), pendingCallback.Acknowledgement)
+				s.Require().True(pendingCallback.AcknowledgementSuccess)
+
 			case callbackSuccess:
 				s.Require().Len(destCounters, 1)
 				s.Require().Equal(1, destCounters[types.CallbackTypeReceivePacket])
@@ -698,6 +720,49 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestRecvPacketCallbackRetry tests that a receive packet callback which runs out of gas is stored for retry when
+// the packet is relayed through the core msg server with insufficient gas, and that the packet is received.
+func (s *CallbacksTestSuite) TestRecvPacketCallbackRetry() {
+	s.SetupTransferTest()
+
+	userGasLimit := uint64(600_000)
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, ibctesting.TestCoin,
+		s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 100), 0, fmt.Sprintf(`{"dest_callback": {"address":"%s", "gas_limit":"%d"}}`, simapp.OogPanicContract, userGasLimit),
+	)
+
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+
+	proof, proofHeight := s.path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, s.chainB.SenderAccount.GetAddress().String())
+
+	// the relayer provides less gas than the user defined gas limit of the callback
+	ctx := s.chainB.GetContext().WithGasMeter(storetypes.NewGasMeter(userGasLimit))
+
+	recvRes, err := GetSimApp(s.chainB).IBCKeeper.RecvPacket(ctx, recvMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypes.SUCCESS, recvRes.Result)
+	s.Require().False(ctx.GasMeter().IsOutOfGas())
+
+	s.Require().Equal(1, GetSimApp(s.chainB).MockContractKeeper.Counters[types.CallbackTypeReceivePacket])
+
+	_, found := GetSimApp(s.chainB).IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	s.Require().True(found)
+
+	pendingCallback, found := GetSimApp(s.chainB).IBCCallbacksKeeper.GetPendingCallback(
+		ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), types.CallbackTypeReceivePacket, packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+	)
+	s.Require().True(found)
+	s.Require().Equal(userGasLimit, pendingCallback.CommitGasLimit)
+}
+
 func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
 	var (
 		packetData transfertypes.FungibleTokenPacketData
@@ -867,7 +932,7 @@ func (s *CallbacksTestSuite) TestProcessCallback() {
 			"callbackExecutor panic",
 		},
 		{
-			"failure: callbackExecutor oog panic, but retry is allowed",
+			"failure: callbackExecutor oog, retry is allowed and callback may be stored for retry",
 			func() {
 				executionGas := callbackData.ExecutionGasLimit
 				callbackData.CommitGasLimit = executionGas + 1
@@ -877,8 +942,23 @@ func (s *CallbacksTestSuite) TestProcessCallback() {
 					return nil
 				}
 			},
+			false,
+			errorsmod.Wrapf(types.ErrCallbackOutOfGas, "ibc %s callback out of gas", callbackType),
+		},
+		{
+			"failure: callbackExecutor oog panic, retry is allowed and SendPacket",
+			func() {
+				callbackType = types.CallbackTypeSendPacket
+				executionGas := callbackData.ExecutionGasLimit
+				callbackData.CommitGasLimit = executionGas + 1
+				expGasConsumed = executionGas
+				callbackExecutor = func(cachedCtx sdk.Context) error { //nolint:unparam
+					cachedCtx.GasMeter().ConsumeGas(executionGas+1, "callbackExecutor oog panic")
+					return nil
+				}
+			},
 			true,
-			storetypes.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", types.CallbackTypeReceivePacket, 1000000+1)},
+			storetypes.ErrorOutOfGas{Descriptor: "callbackExecutor oog panic"},
 		},
 	}
 
@@ -911,9 +991,9 @@ func (s *CallbacksTestSuite) TestProcessCallback() {
 
 			tc.malleate()
 
-			module, _, err := s.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(s.chainA.GetContext(), ibctesting.MockFeePort)
+			module, _, err := s.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(s.chainB.GetContext(), ibctesting.MockFeePort)
 			s.Require().NoError(err)
-			cbs, ok := s.chainA.App.GetIBCKeeper().PortKeeper.Route(module)
+			cbs, ok := s.chainB.App.GetIBCKeeper().PortKeeper.Route(module)
 			s.Require().True(ok)
 			mockCallbackStack, ok := cbs.(ibccallbacks.IBCMiddleware)
 			s.Require().True(ok)
@@ -935,6 +1015,7 @@ func (s *CallbacksTestSuite) TestProcessCallback() {
 			}
 
 			s.Require().Equal(expGasConsumed, ctx.GasMeter().GasConsumed())
+			s.Require().Equal(expGasConsumed, GetSimApp(s.chainB).IBCCallbacksKeeper.GetCallbackGasUsed(ctx, callbackData.CallbackAddress))
 		})
 	}
 }
@@ -1010,6 +1091,316 @@ func (s *CallbacksTestSuite) TestOnChanCloseConfirm() {
 	s.Require().NoError(err)
 }
 
+func (s *CallbacksTestSuite) TestChannelCallbacks() {
+	var (
+		callbackAddress string
+		ctx             sdk.Context
+	)
+
+	testCases := []struct {
+		name         string
+		callbackType types.CallbackType
+		malleate     func()
+		expExecuted  bool
+		expSuccess   bool
+		expPanic     bool
+	}{
+		{
+			"success: channel open ack",
+			types.CallbackTypeChannelOpen,
+			func() {},
+			true,
+			true,
+			false,
+		},
+		{
+			"success: channel close",
+			types.CallbackTypeChannelClose,
+			func() {},
+			true,
+			true,
+			false,
+		},
+		{
+			"success: channel upgrade open",
+			types.CallbackTypeChannelUpgradeOpen,
+			func() {},
+			true,
+			true,
+			false,
+		},
+		{
+			"success: channel upgrade cancel",
+			types.CallbackTypeChannelUpgradeCancel,
+			func() {},
+			true,
+			true,
+			false,
+		},
+		{
+			"success: no callback address registered",
+			types.CallbackTypeChannelOpen,
+			func() {
+				callbackAddress = ""
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"success: callback error does not block the channel handshake",
+			types.CallbackTypeChannelClose,
+			func() {
+				callbackAddress = simapp.ErrorContract
+			},
+			true,
+			false,
+			false,
+		},
+		{
+			"failure: callback out of gas with insufficient gas provided by relayer",
+			types.CallbackTypeChannelUpgradeOpen,
+			func() {
+				callbackAddress = simapp.OogPanicContract
+				ctx = ctx.WithGasMeter(storetypes.NewGasMeter(maxCallbackGas / 2))
+			},
+			true,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupMockFeeTest()
+			s.path.Setup()
+
+			callbackAddress = simapp.SuccessContract
+			ctx = s.chainA.GetContext()
+
+			tc.malleate()
+
+			portID, channelID := s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID
+			if callbackAddress != "" {
+				GetSimApp(s.chainA).IBCCallbacksKeeper.SetChannelCallback(ctx, portID, channelID, callbackAddress)
+			}
+
+			cbs, ok := s.chainA.App.GetIBCKeeper().PortKeeper.Route(ibctesting.MockFeePort)
+			s.Require().True(ok)
+			mockCallbackStack, ok := cbs.(ibccallbacks.IBCMiddleware)
+			s.Require().True(ok)
+
+			executeCallback := func() {
+				switch tc.callbackType {
+				case types.CallbackTypeChannelOpen:
+					err := mockCallbackStack.OnChanOpenAck(ctx, portID, channelID, s.path.EndpointB.ChannelID, ibcmock.MockFeeVersion)
+					s.Require().NoError(err)
+				case types.CallbackTypeChannelClose:
+					err := mockCallbackStack.OnChanCloseConfirm(ctx, portID, channelID)
+					s.Require().NoError(err)
+				case types.CallbackTypeChannelUpgradeOpen:
+					mockCallbackStack.OnChanUpgradeOpen(ctx, portID, channelID, channeltypes.UNORDERED, []string{s.path.EndpointA.ConnectionID}, ibcmock.MockFeeVersion)
+				case types.CallbackTypeChannelUpgradeCancel:
+					mockCallbackStack.OnChanUpgradeCancel(ctx, portID, channelID)
+				default:
+					s.FailNow(fmt.Sprintf("invalid callback type %s", tc.callbackType))
+				}
+			}
+
+			if tc.expPanic {
+				s.Require().Panics(executeCallback)
+				// reset the exhausted gas meter so the resulting state can be inspected
+				ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			} else {
+				executeCallback()
+			}
+
+			expCounter := 0
+			if tc.expExecuted {
+				expCounter = 1
+			}
+			s.Require().Equal(expCounter, GetSimApp(s.chainA).MockContractKeeper.Counters[tc.callbackType])
+
+			var expStateEntries uint8
+			if tc.expSuccess {
+				expStateEntries = 1
+			}
+			s.Require().Equal(expStateEntries, GetSimApp(s.chainA).MockContractKeeper.GetStateEntryCounter(ctx))
+
+			var channelCallbackEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeChannelCallback {
+					channelCallbackEvents++
+				}
+			}
+			if tc.expExecuted && !tc.expPanic {
+				s.Require().Equal(1, channelCallbackEvents)
+			} else {
+				s.Require().Zero(channelCallbackEvents)
+			}
+
+			if tc.callbackType == types.CallbackTypeChannelClose {
+				_, found := GetSimApp(s.chainA).IBCCallbacksKeeper.GetChannelCallback(ctx, portID, channelID)
+				s.Require().False(found)
+			}
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestChannelOpenCallbackHandshake() {
+	s.SetupMockFeeTest()
+	s.path.SetupConnections()
+
+	s.Require().NoError(s.path.EndpointA.ChanOpenInit())
+	GetSimApp(s.chainA).IBCCallbacksKeeper.SetChannelCallback(s.chainA.GetContext(), s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, simapp.SuccessContract)
+
+	s.Require().NoError(s.path.EndpointB.ChanOpenTry())
+	GetSimApp(s.chainB).IBCCallbacksKeeper.SetChannelCallback(s.chainB.GetContext(), s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, simapp.SuccessContract)
+
+	s.Require().NoError(s.path.EndpointA.ChanOpenAck())
+	s.Require().NoError(s.path.EndpointB.ChanOpenConfirm())
+
+	s.Require().Equal(1, GetSimApp(s.chainA).MockContractKeeper.Counters[types.CallbackTypeChannelOpen])
+	s.Require().Equal(1, GetSimApp(s.chainB).MockContractKeeper.Counters[types.CallbackTypeChannelOpen])
+
+	s.Require().NoError(s.path.EndpointA.ChanCloseInit())
+
+	s.Require().Equal(1, GetSimApp(s.chainA).MockContractKeeper.Counters[types.CallbackTypeChannelClose])
+
+	_, found := GetSimApp(s.chainA).IBCCallbacksKeeper.GetChannelCallback(s.chainA.GetContext(), s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	s.Require().False(found)
+}
+
+// TestRecvPacketCallbackGasBudgetExceeded tests that a receive packet callback which exceeds the per block gas
+// budget of its callback address is not executed but stored for a later retry.
+func (s *CallbacksTestSuite) TestRecvPacketCallbackGasBudgetExceeded() {
+	s.SetupTransferTest()
+
+	userGasLimit := uint64(600_000)
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, ibctesting.TestCoin,
+		s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 100), 0, fmt.Sprintf(`{"dest_callback": {"address":"%s", "gas_limit":"%d"}}`, simapp.SuccessContract, userGasLimit),
+	)
+
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+
+	proof, proofHeight := s.path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, s.chainB.SenderAccount.GetAddress().String())
+
+	ctx := s.chainB.GetContext()
+	callbacksKeeper := GetSimApp(s.chainB).IBCCallbacksKeeper
+
+	params := callbacksKeeper.GetParams(ctx)
+	params.MaxBlockGasPerAddress = userGasLimit
+	callbacksKeeper.SetParams(ctx, params)
+	callbacksKeeper.ConsumeCallbackGas(ctx, simapp.SuccessContract, 1)
+
+	recvRes, err := GetSimApp(s.chainB).IBCKeeper.RecvPacket(ctx, recvMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypes.SUCCESS, recvRes.Result)
+
+	s.Require().Zero(GetSimApp(s.chainB).MockContractKeeper.Counters[types.CallbackTypeReceivePacket])
+
+	pendingCallback, found := callbacksKeeper.GetPendingCallback(ctx, types.CallbackTypeReceivePacket, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	s.Require().True(found)
+	s.Require().Equal(userGasLimit, pendingCallback.CommitGasLimit)
+}
+
+// TestChannelCallbacksOnPacketLifecycle tests that the channel lifecycle callbacks are executed when an upgrade
+// is aborted or a channel is closed while relaying packets through the core msg server.
+func (s *CallbacksTestSuite) TestChannelCallbacksOnPacketLifecycle() {
+	var (
+		packet channeltypes.Packet
+		ack    []byte
+	)
+
+	testCases := []struct {
+		name         string
+		callbackType types.CallbackType
+		order        channeltypes.Order
+		malleate     func()
+		relay        func() error
+		expState     channeltypes.State
+	}{
+		{
+			"upgrade aborted by a packet acknowledgement after the counterparty upgrade timeout elapsed",
+			types.CallbackTypeChannelUpgradeCancel,
+			channeltypes.UNORDERED,
+			func() {
+				res, err := s.path.EndpointB.RecvPacketWithResult(packet)
+				s.Require().NoError(err)
+
+				ack, err = ibctesting.ParseAckFromEvents(res.Events)
+				s.Require().NoError(err)
+
+				s.path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.FLUSHING })
+
+				upgrade := channeltypes.Upgrade{
+					Fields:  channeltypes.NewUpgradeFields(channeltypes.UNORDERED, []string{s.path.EndpointA.ConnectionID}, ibcmock.UpgradeVersion),
+					Timeout: channeltypes.NewTimeout(clienttypes.ZeroHeight(), 1),
+				}
+				s.path.EndpointA.SetChannelUpgrade(upgrade)
+				s.path.EndpointA.SetChannelCounterpartyUpgrade(upgrade)
+			},
+			func() error {
+				return s.path.EndpointA.AcknowledgePacket(packet, ack)
+			},
+			channeltypes.OPEN,
+		},
+		{
+			"ordered channel closed by a packet timeout",
+			types.CallbackTypeChannelClose,
+			channeltypes.ORDERED,
+			func() {
+				// the packet times out once the counterparty height has passed the timeout height
+				s.coordinator.CommitNBlocks(s.chainB, 2)
+				s.Require().NoError(s.path.EndpointA.UpdateClient())
+			},
+			func() error {
+				return s.path.EndpointA.TimeoutPacket(packet)
+			},
+			channeltypes.CLOSED,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupMockFeeTest()
+			s.path.EndpointA.ChannelConfig.Order = tc.order
+			s.path.EndpointB.ChannelConfig.Order = tc.order
+			s.path.Setup()
+
+			portID, channelID := s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID
+			GetSimApp(s.chainA).IBCCallbacksKeeper.SetChannelCallback(s.chainA.GetContext(), portID, channelID, simapp.SuccessContract)
+
+			timeoutHeight := s.chainB.GetTimeoutHeight()
+			if tc.order == channeltypes.ORDERED {
+				timeoutHeight = clienttypes.GetSelfHeight(s.chainB.GetContext()).Increment().(clienttypes.Height)
+			}
+			sequence, err := s.path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			s.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			tc.malleate()
+
+			s.Require().NoError(tc.relay())
+
+			s.Require().Equal(tc.expState, s.path.EndpointA.GetChannel().State)
+			s.Require().Equal(1, GetSimApp(s.chainA).MockContractKeeper.Counters[tc.callbackType])
+		})
+	}
+}
+
 func (s *CallbacksTestSuite) TestOnRecvPacketAsyncAck() {
 	s.SetupMockFeeTest()
 
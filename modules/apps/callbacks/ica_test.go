@@ -16,11 +16,12 @@ import (
 	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (s *CallbacksTestSuite) TestICACallbacks() {
-	// Destination callbacks are not supported for ICA packets
 	testCases := []struct {
 		name        string
 		icaMemo     string
@@ -36,9 +37,21 @@ func (s *CallbacksTestSuite) TestICACallbacks() {
 		{
 			"success: dest callback",
 			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
-			"none",
+			types.CallbackTypeReceivePacket,
+			true,
+		},
+		{
+			"success: dest callback with user defined gas limit",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s", "gas_limit": "%d"}}`, simapp.SuccessContract, maxCallbackGas/2),
+			types.CallbackTypeReceivePacket,
 			true,
 		},
+		{
+			"failure: dest callback with low gas (error)",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.OogErrorContract),
+			types.CallbackTypeReceivePacket,
+			false,
+		},
 		{
 			"success: source callback",
 			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
@@ -89,6 +102,38 @@ func (s *CallbacksTestSuite) TestICACallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestICAHostCallbackTxResponse() {
+	var (
+		callbackAck     ibcexported.Acknowledgement
+		callbackAddress string
+	)
+
+	icaAddr := s.SetupICATest()
+
+	GetSimApp(s.chainB).MockContractKeeper.IBCReceivePacketCallbackFn = func(
+		_ sdk.Context, _ ibcexported.PacketI, ack ibcexported.Acknowledgement, contractAddress string,
+	) error {
+		callbackAck = ack
+		callbackAddress = contractAddress
+		return nil
+	}
+
+	s.ExecuteICATx(icaAddr, fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract))
+
+	s.Require().Equal(simapp.SuccessContract, callbackAddress)
+	s.Require().NotNil(callbackAck)
+	s.Require().True(callbackAck.Success())
+
+	// the acknowledgement result contains the responses of the messages executed by the interchain account
+	ack, ok := callbackAck.(channeltypes.Acknowledgement)
+	s.Require().True(ok)
+
+	var txMsgData sdk.TxMsgData
+	s.Require().NoError(proto.Unmarshal(ack.GetResult(), &txMsgData))
+	s.Require().Len(txMsgData.MsgResponses, 1)
+	s.Require().Equal(sdk.MsgTypeURL(&stakingtypes.MsgDelegateResponse{}), txMsgData.MsgResponses[0].TypeUrl)
+}
+
 func (s *CallbacksTestSuite) TestICATimeoutCallbacks() {
 	// ICA channels are closed after a timeout packet is executed
 	testCases := []struct {
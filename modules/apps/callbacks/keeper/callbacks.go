@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+// ExecutePendingCallback retries the execution of a callback which previously ran out of gas or exceeded
+// the per block gas budget of its callback address. The callback is executed with the commit gas limit it
+// was entitled to during packet relaying. The callback address must still be permitted by the allowlist and
+// denylist of the callbacks parameters and the callback is subject to the per block gas budget.
+// If the transaction does not provide enough gas for the callback to run to completion, the
+// transaction is reverted via a panic and the callback remains pending. Otherwise, the pending
+// callback is removed from state regardless of the callback result, which is returned as an error.
+func (k Keeper) ExecutePendingCallback(ctx sdk.Context, pendingCallback types.PendingCallback) (err error) {
+	callbackType := pendingCallback.GetCallbackType()
+	callbackData := types.CallbackData{
+		CallbackAddress:   pendingCallback.CallbackAddress,
+		ExecutionGasLimit: pendingCallback.CommitGasLimit,
+		SenderAddress:     pendingCallback.SenderAddress,
+		CommitGasLimit:    pendingCallback.CommitGasLimit,
+	}
+
+	if err := k.ValidateCallbackAddress(ctx, callbackType, callbackData); err != nil {
+		return err
+	}
+
+	if err := k.ValidateCallbackGasBudget(ctx, callbackData); err != nil {
+		return err
+	}
+
+	if remainingGas := ctx.GasMeter().GasRemaining(); remainingGas < callbackData.ExecutionGasLimit {
+		callbackData.ExecutionGasLimit = remainingGas
+	}
+
+	callbackExecutor, err := k.newCallbackExecutor(pendingCallback)
+	if err != nil {
+		return err
+	}
+
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(storetypes.NewGasMeter(callbackData.ExecutionGasLimit))
+
+	defer func() {
+		// consume the minimum of g.consumed and g.limit
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback retry", callbackType))
+		k.ConsumeCallbackGas(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), callbackData.CallbackAddress, cachedCtx.GasMeter().GasConsumedToLimit())
+
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrCallbackPanic, "ibc %s callback panicked with: %v", callbackType, r)
+		}
+
+		// if the callback ran out of gas and the signer has not provided enough gas, then revert the state
+		// so that the callback remains pending
+		if cachedCtx.GasMeter().IsPastLimit() {
+			if callbackData.AllowRetry() {
+				panic(storetypes.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc %s callback retry out of gas; commitGasLimit: %d", callbackType, callbackData.CommitGasLimit)})
+			}
+			err = errorsmod.Wrapf(types.ErrCallbackOutOfGas, "ibc %s callback out of gas", callbackType)
+		}
+
+		k.DeletePendingCallback(ctx, pendingCallback)
+		types.EmitCallbackEvent(
+			ctx, pendingCallback.GetPortID(), pendingCallback.GetChannelID(), pendingCallback.Packet.GetSequence(),
+			callbackType, callbackData, err,
+		)
+	}()
+
+	err = callbackExecutor(cachedCtx)
+	if err == nil {
+		writeFn()
+	}
+
+	return err
+}
+
+// newCallbackExecutor returns a function invoking the contract keeper entry point matching the
+// callback type of the pending callback.
+func (k Keeper) newCallbackExecutor(pendingCallback types.PendingCallback) (func(sdk.Context) error, error) {
+	var relayer sdk.AccAddress
+	if pendingCallback.Relayer != "" {
+		addr, err := sdk.AccAddressFromBech32(pendingCallback.Relayer)
+		if err != nil {
+			return nil, err
+		}
+		relayer = addr
+	}
+
+	switch pendingCallback.GetCallbackType() {
+	case types.CallbackTypeAcknowledgementPacket:
+		return func(cachedCtx sdk.Context) error {
+			return k.contractKeeper.IBCOnAcknowledgementPacketCallback(
+				cachedCtx, pendingCallback.Packet, pendingCallback.Acknowledgement, relayer,
+				pendingCallback.CallbackAddress, pendingCallback.SenderAddress,
+			)
+		}, nil
+	case types.CallbackTypeTimeoutPacket:
+		return func(cachedCtx sdk.Context) error {
+			return k.contractKeeper.IBCOnTimeoutPacketCallback(
+				cachedCtx, pendingCallback.Packet, relayer, pendingCallback.CallbackAddress, pendingCallback.SenderAddress,
+			)
+		}, nil
+	case types.CallbackTypeReceivePacket:
+		return func(cachedCtx sdk.Context) error {
+			return k.contractKeeper.IBCReceivePacketCallback(
+				cachedCtx, pendingCallback.Packet, pendingCallback.GetAcknowledgement(), pendingCallback.CallbackAddress,
+			)
+		}, nil
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidCallbackType, "callback type %s cannot be retried", pendingCallback.CallbackType)
+	}
+}
@@ -0,0 +1,9 @@
+package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// PruneExpiredCallbacksWithLimit is a wrapper around pruneExpiredCallbacks
+// to allow the number of callbacks removed per block to be set in tests.
+func (k Keeper) PruneExpiredCallbacksWithLimit(ctx sdk.Context, limit int) {
+	k.pruneExpiredCallbacks(ctx, limit)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+// InitGenesis initializes the ibc callbacks middleware state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	if err := state.Params.Validate(); err != nil {
+		panic(fmt.Errorf("could not set ibc callbacks params at genesis: %v", err))
+	}
+	k.SetParams(ctx, state.Params)
+
+	for _, pendingCallback := range state.PendingCallbacks {
+		k.SetPendingCallback(ctx, pendingCallback)
+	}
+
+	for _, channelCallback := range state.ChannelCallbacks {
+		k.SetChannelCallback(ctx, channelCallback.PortId, channelCallback.ChannelId, channelCallback.CallbackAddress)
+	}
+}
+
+// ExportGenesis returns the ibc callbacks middleware exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllPendingCallbacks(ctx), k.GetAllChannelCallbacks(ctx))
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// PendingCallbacks implements the Query/PendingCallbacks gRPC method
+func (k Keeper) PendingCallbacks(goCtx context.Context, req *types.QueryPendingCallbacksRequest) (*types.QueryPendingCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pendingCallbacks []types.PendingCallback
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingCallbackKeyPrefix+"/"))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pendingCallback types.PendingCallback
+		if err := k.cdc.Unmarshal(value, &pendingCallback); err != nil {
+			return err
+		}
+
+		pendingCallbacks = append(pendingCallbacks, pendingCallback)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingCallbacksResponse{
+		PendingCallbacks: pendingCallbacks,
+		Pagination:       pagination,
+	}, nil
+}
+
+// PendingCallback implements the Query/PendingCallback gRPC method
+func (k Keeper) PendingCallback(goCtx context.Context, req *types.QueryPendingCallbackRequest) (*types.QueryPendingCallbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingCallback, found := k.GetPendingCallback(ctx, types.CallbackType(req.CallbackType), req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrPendingCallbackNotFound, "callback type: %s, port ID: %s, channel ID: %s, sequence: %d", req.CallbackType, req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryPendingCallbackResponse{
+		PendingCallback: pendingCallback,
+	}, nil
+}
+
+// ChannelCallback implements the Query/ChannelCallback gRPC method
+func (k Keeper) ChannelCallback(goCtx context.Context, req *types.QueryChannelCallbackRequest) (*types.QueryChannelCallbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	callbackAddress, found := k.GetChannelCallback(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelCallbackNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryChannelCallbackResponse{
+		CallbackAddress: callbackAddress,
+	}, nil
+}
+
+// CallbackAddressStatus implements the Query/CallbackAddressStatus gRPC method
+func (k Keeper) CallbackAddressStatus(goCtx context.Context, req *types.QueryCallbackAddressStatusRequest) (*types.QueryCallbackAddressStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.CallbackAddress) == "" {
+		return nil, status.Error(codes.InvalidArgument, "callback address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryCallbackAddressStatusResponse{
+		Allowed:      params.IsCallbackAddressAllowed(req.CallbackAddress),
+		BlockGasUsed: k.GetCallbackGasUsed(ctx, req.CallbackAddress),
+		MaxBlockGas:  params.MaxBlockGasPerAddress,
+	}, nil
+}
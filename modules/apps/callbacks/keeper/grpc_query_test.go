@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (s *KeeperTestSuite) TestQueryParams() {
+	ctx := s.chainA.GetContext()
+
+	res, err := GetSimApp(s.chainA).IBCCallbacksKeeper.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), *res.Params)
+}
+
+func (s *KeeperTestSuite) TestQueryPendingCallbacks() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	pendingCallbacks := []types.PendingCallback{
+		newPendingCallback(1, simapp.SuccessContract, expiry),
+		newPendingCallback(2, simapp.SuccessContract, expiry),
+	}
+
+	for _, pendingCallback := range pendingCallbacks {
+		callbacksKeeper.SetPendingCallback(ctx, pendingCallback)
+	}
+
+	res, err := callbacksKeeper.PendingCallbacks(ctx, &types.QueryPendingCallbacksRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(pendingCallbacks[:1], res.PendingCallbacks)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = callbacksKeeper.PendingCallbacks(ctx, nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestQueryPendingCallback() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	pendingCallback := newPendingCallback(1, simapp.SuccessContract, ctx.BlockTime().Add(time.Hour))
+	callbacksKeeper.SetPendingCallback(ctx, pendingCallback)
+
+	req := &types.QueryPendingCallbackRequest{
+		CallbackType: string(types.CallbackTypeAcknowledgementPacket),
+		PortId:       ibctesting.MockPort,
+		ChannelId:    ibctesting.FirstChannelID,
+		Sequence:     1,
+	}
+
+	res, err := callbacksKeeper.PendingCallback(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(pendingCallback, res.PendingCallback)
+
+	req.Sequence = 2
+	_, err = callbacksKeeper.PendingCallback(ctx, req)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestQueryChannelCallback() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	callbacksKeeper.SetChannelCallback(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, simapp.SuccessContract)
+
+	req := &types.QueryChannelCallbackRequest{
+		PortId:    ibctesting.MockPort,
+		ChannelId: ibctesting.FirstChannelID,
+	}
+
+	res, err := callbacksKeeper.ChannelCallback(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(simapp.SuccessContract, res.CallbackAddress)
+
+	req.ChannelId = ibctesting.InvalidID
+	_, err = callbacksKeeper.ChannelCallback(ctx, req)
+	s.Require().Error(err)
+
+	_, err = callbacksKeeper.ChannelCallback(ctx, nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestQueryCallbackAddressStatus() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	params := types.DefaultParams()
+	params.DeniedCallbackAddresses = []string{simapp.ErrorContract}
+	params.MaxBlockGasPerAddress = 1_000_000
+	callbacksKeeper.SetParams(ctx, params)
+	callbacksKeeper.ConsumeCallbackGas(ctx, simapp.SuccessContract, 100)
+
+	res, err := callbacksKeeper.CallbackAddressStatus(ctx, &types.QueryCallbackAddressStatusRequest{CallbackAddress: simapp.SuccessContract})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryCallbackAddressStatusResponse{Allowed: true, BlockGasUsed: 100, MaxBlockGas: 1_000_000}, res)
+
+	res, err = callbacksKeeper.CallbackAddressStatus(ctx, &types.QueryCallbackAddressStatusRequest{CallbackAddress: simapp.ErrorContract})
+	s.Require().NoError(err)
+	s.Require().False(res.Allowed)
+
+	_, err = callbacksKeeper.CallbackAddressStatus(ctx, &types.QueryCallbackAddressStatusRequest{})
+	s.Require().Error(err)
+
+	_, err = callbacksKeeper.CallbackAddressStatus(ctx, nil)
+	s.Require().Error(err)
+}
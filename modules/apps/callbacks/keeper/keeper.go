@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Keeper defines the IBC callbacks middleware keeper. It persists callbacks which ran out
+// of gas during packet relaying so that they may be retried with a higher gas limit.
+type Keeper struct {
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+	cdc       codec.BinaryCodec
+
+	channelKeeper  types.ChannelKeeper
+	portKeeper     types.PortKeeper
+	contractKeeper types.ContractKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new IBC callbacks Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key, tKey storetypes.StoreKey, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	contractKeeper types.ContractKeeper, authority string,
+) Keeper {
+	if channelKeeper == nil {
+		panic(errors.New("channel keeper cannot be nil"))
+	}
+
+	if portKeeper == nil {
+		panic(errors.New("port keeper cannot be nil"))
+	}
+
+	if contractKeeper == nil {
+		panic(errors.New("contract keeper cannot be nil"))
+	}
+
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		storeKey:       key,
+		tStoreKey:      tKey,
+		cdc:            cdc,
+		channelKeeper:  channelKeeper,
+		portKeeper:     portKeeper,
+		contractKeeper: contractKeeper,
+		authority:      authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", ibcexported.ModuleName, types.ModuleName))
+}
+
+// GetAuthority returns the callbacks middleware's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the total set of the callbacks middleware parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("ibc callbacks params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of the callbacks middleware parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ParamsKey), bz)
+}
+
+// GetPendingCallback returns the pending callback stored for the given callback type and packet identifiers.
+func (k Keeper) GetPendingCallback(ctx sdk.Context, callbackType types.CallbackType, portID, channelID string, sequence uint64) (types.PendingCallback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingCallbackKey(callbackType, portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PendingCallback{}, false
+	}
+
+	var pendingCallback types.PendingCallback
+	k.cdc.MustUnmarshal(bz, &pendingCallback)
+	return pendingCallback, true
+}
+
+// SetPendingCallback stores the pending callback and inserts it into the expiry queue.
+func (k Keeper) SetPendingCallback(ctx sdk.Context, pendingCallback types.PendingCallback) {
+	store := ctx.KVStore(k.storeKey)
+	key := pendingCallbackKey(pendingCallback)
+
+	store.Set(key, k.cdc.MustMarshal(&pendingCallback))
+	store.Set(types.PendingCallbackExpiryKey(pendingCallback.Expiry, key), key)
+}
+
+// DeletePendingCallback removes the pending callback and its expiry queue entry from state.
+func (k Keeper) DeletePendingCallback(ctx sdk.Context, pendingCallback types.PendingCallback) {
+	store := ctx.KVStore(k.storeKey)
+	key := pendingCallbackKey(pendingCallback)
+
+	store.Delete(key)
+	store.Delete(types.PendingCallbackExpiryKey(pendingCallback.Expiry, key))
+}
+
+// GetAllPendingCallbacks returns all callbacks awaiting retry.
+func (k Keeper) GetAllPendingCallbacks(ctx sdk.Context) []types.PendingCallback {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingCallbackKeyPrefix+"/"))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var pendingCallbacks []types.PendingCallback
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingCallback types.PendingCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingCallback)
+
+		pendingCallbacks = append(pendingCallbacks, pendingCallback)
+	}
+
+	return pendingCallbacks
+}
+
+// ScheduleCallbackRetry stores a callback which ran out of gas or exceeded the per block gas budget of
+// its callback address so that it may later be retried using MsgExecuteCallback until the retry window configured in the params has elapsed.
+func (k Keeper) ScheduleCallbackRetry(
+	ctx sdk.Context, callbackType types.CallbackType, packet ibcexported.PacketI,
+	acknowledgement []byte, acknowledgementSuccess bool, relayer string, callbackData types.CallbackData,
+) {
+	expiry := ctx.BlockTime().Add(k.GetParams(ctx).RetryWindow)
+	pendingCallback := types.NewPendingCallback(callbackType, packet, acknowledgement, acknowledgementSuccess, relayer, callbackData, expiry)
+
+	k.SetPendingCallback(ctx, pendingCallback)
+	types.EmitCallbackRetryEvent(ctx, types.EventTypeCallbackRetryScheduled, pendingCallback)
+}
+
+// MaxExpiredCallbacksPrunedPerBlock is the maximum number of expired pending callbacks removed in a single block.
+const MaxExpiredCallbacksPrunedPerBlock = 100
+
+// PruneExpiredCallbacks removes the pending callbacks whose retry window has elapsed at the current block time.
+// At most MaxExpiredCallbacksPrunedPerBlock callbacks are removed per block, in expiry order, the remaining expired
+// callbacks being removed in the following blocks.
+func (k Keeper) PruneExpiredCallbacks(ctx sdk.Context) {
+	k.pruneExpiredCallbacks(ctx, MaxExpiredCallbacksPrunedPerBlock)
+}
+
+// pruneExpiredCallbacks removes at most limit pending callbacks whose retry window has elapsed.
+func (k Keeper) pruneExpiredCallbacks(ctx sdk.Context, limit int) {
+	store := ctx.KVStore(k.storeKey)
+	start := []byte(types.PendingCallbackExpiryKeyPrefix + "/")
+	end := storetypes.PrefixEndBytes(types.PendingCallbackExpiryPrefix(ctx.BlockTime()))
+
+	iterator := store.Iterator(start, end)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var expired []types.PendingCallback
+	for ; iterator.Valid() && len(expired) < limit; iterator.Next() {
+		var pendingCallback types.PendingCallback
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &pendingCallback)
+
+		expired = append(expired, pendingCallback)
+	}
+
+	for _, pendingCallback := range expired {
+		k.DeletePendingCallback(ctx, pendingCallback)
+		types.EmitCallbackRetryEvent(ctx, types.EventTypeCallbackRetryExpired, pendingCallback)
+	}
+}
+
+// GetChannelCallback returns the callback address registered for the channel lifecycle callbacks of the given channel.
+func (k Keeper) GetChannelCallback(ctx sdk.Context, portID, channelID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelCallbackKey(portID, channelID))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetChannelCallback stores the callback address registered for the channel lifecycle callbacks of the given channel.
+func (k Keeper) SetChannelCallback(ctx sdk.Context, portID, channelID, callbackAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelCallbackKey(portID, channelID), []byte(callbackAddress))
+}
+
+// DeleteChannelCallback removes the callback address registered for the channel lifecycle callbacks of the given channel.
+func (k Keeper) DeleteChannelCallback(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelCallbackKey(portID, channelID))
+}
+
+// IsChannelOwner returns true if the address owns the channels of the given port. The channels of a port are
+// owned by the module account of the module bound to the port. The channels of an interchain accounts
+// controller port and of a CosmWasm contract port are also owned by the controller account or the contract
+// encoded in the port identifier.
+func (k Keeper) IsChannelOwner(ctx sdk.Context, portID, address string) bool {
+	if owner, found := strings.CutPrefix(portID, icatypes.ControllerPortPrefix); found && owner == address {
+		return true
+	}
+
+	if contract, found := strings.CutPrefix(portID, types.WasmPortPrefix); found && contract == address {
+		return true
+	}
+
+	module, _, err := k.portKeeper.LookupModuleByPort(ctx, portID)
+	if err != nil {
+		return false
+	}
+
+	return authtypes.NewModuleAddress(module).String() == address
+}
+
+// IsChannelClosed returns true if the channel exists and is in the CLOSED state.
+func (k Keeper) IsChannelClosed(ctx sdk.Context, portID, channelID string) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	return found && channel.State == channeltypes.CLOSED
+}
+
+// GetAllChannelCallbacks returns all callback addresses registered for channel lifecycle callbacks.
+func (k Keeper) GetAllChannelCallbacks(ctx sdk.Context) []types.ChannelCallback {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ChannelCallbackKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var channelCallbacks []types.ChannelCallback
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		portID, channelID := keySplit[1], keySplit[2]
+
+		channelCallbacks = append(channelCallbacks, types.NewChannelCallback(portID, channelID, string(iterator.Value())))
+	}
+
+	return channelCallbacks
+}
+
+// pendingCallbackKey returns the store key of the provided pending callback.
+func pendingCallbackKey(pendingCallback types.PendingCallback) []byte {
+	return types.PendingCallbackKey(
+		pendingCallback.GetCallbackType(), pendingCallback.GetPortID(), pendingCallback.GetChannelID(), pendingCallback.Packet.GetSequence(),
+	)
+}
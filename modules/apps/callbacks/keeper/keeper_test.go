@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+}
+
+// SetupTestingApp provides the duplicated simapp which is specific to the callbacks module on chain creation.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	db := dbm.NewMemDB()
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{})
+	return app, app.DefaultGenesis()
+}
+
+// GetSimApp returns the duplicated SimApp from within the callbacks directory.
+func GetSimApp(chain *ibctesting.TestChain) *simapp.SimApp {
+	app, ok := chain.App.(*simapp.SimApp)
+	if !ok {
+		panic(errors.New("chain is not a simapp.SimApp"))
+	}
+	return app
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 1)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// newPendingCallback returns an acknowledgement packet callback for the provided contract address
+// expiring at the given time.
+func newPendingCallback(sequence uint64, contractAddress string, expiry time.Time) types.PendingCallback {
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, sequence, ibctesting.MockPort, ibctesting.FirstChannelID,
+		ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0,
+	)
+
+	callbackData := types.CallbackData{
+		CallbackAddress:   contractAddress,
+		SenderAddress:     ibctesting.TestAccAddress,
+		ExecutionGasLimit: 1_000_000,
+		CommitGasLimit:    1_000_000,
+	}
+
+	return types.NewPendingCallback(
+		types.CallbackTypeAcknowledgementPacket, packet, ibcmock.MockAcknowledgement.Acknowledgement(), true,
+		ibctesting.TestAccAddress, callbackData, expiry,
+	)
+}
+
+// newChannel returns an unordered mock channel in the provided state.
+func newChannel(state channeltypes.State) channeltypes.Channel {
+	counterparty := channeltypes.NewCounterparty(ibctesting.MockPort, ibctesting.FirstChannelID)
+	return channeltypes.NewChannel(state, channeltypes.UNORDERED, counterparty, []string{ibctesting.FirstConnectionID}, ibcmock.Version)
+}
+
+func (s *KeeperTestSuite) TestPendingCallbacks() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	pendingCallbacks := []types.PendingCallback{
+		newPendingCallback(1, simapp.SuccessContract, expiry),
+		newPendingCallback(2, simapp.SuccessContract, expiry),
+	}
+
+	for _, pendingCallback := range pendingCallbacks {
+		callbacksKeeper.SetPendingCallback(ctx, pendingCallback)
+	}
+
+	pendingCallback, found := callbacksKeeper.GetPendingCallback(ctx, types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+	s.Require().True(found)
+	s.Require().Equal(pendingCallbacks[0], pendingCallback)
+	s.Require().Equal(pendingCallbacks, callbacksKeeper.GetAllPendingCallbacks(ctx))
+
+	callbacksKeeper.DeletePendingCallback(ctx, pendingCallbacks[0])
+
+	_, found = callbacksKeeper.GetPendingCallback(ctx, types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+	s.Require().False(found)
+	s.Require().Equal(pendingCallbacks[1:], callbacksKeeper.GetAllPendingCallbacks(ctx))
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredCallbacks() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	expired := newPendingCallback(1, simapp.SuccessContract, ctx.BlockTime())
+	active := newPendingCallback(2, simapp.SuccessContract, ctx.BlockTime().Add(time.Second))
+
+	callbacksKeeper.SetPendingCallback(ctx, expired)
+	callbacksKeeper.SetPendingCallback(ctx, active)
+
+	callbacksKeeper.PruneExpiredCallbacks(ctx)
+
+	_, found := callbacksKeeper.GetPendingCallback(ctx, types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+	s.Require().False(found)
+
+	_, found = callbacksKeeper.GetPendingCallback(ctx, types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 2)
+	s.Require().True(found)
+
+	var expiredEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCallbackRetryExpired {
+			expiredEvents++
+		}
+	}
+	s.Require().Equal(1, expiredEvents)
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredCallbacksLimit() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		callbacksKeeper.SetPendingCallback(ctx, newPendingCallback(sequence, simapp.SuccessContract, ctx.BlockTime().Add(-time.Duration(sequence)*time.Second)))
+	}
+
+	// the callbacks which expired first are removed first
+	callbacksKeeper.PruneExpiredCallbacksWithLimit(ctx, 2)
+
+	pendingCallbacks := callbacksKeeper.GetAllPendingCallbacks(ctx)
+	s.Require().Len(pendingCallbacks, 1)
+	s.Require().Equal(uint64(1), pendingCallbacks[0].Packet.Sequence)
+
+	callbacksKeeper.PruneExpiredCallbacksWithLimit(ctx, 2)
+	s.Require().Empty(callbacksKeeper.GetAllPendingCallbacks(ctx))
+}
+
+func (s *KeeperTestSuite) TestChannelCallbacks() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	callbacksKeeper.SetChannelCallback(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, simapp.SuccessContract)
+	callbacksKeeper.SetChannelCallback(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID, simapp.ErrorContract)
+
+	callbackAddress, found := callbacksKeeper.GetChannelCallback(ctx, ibctesting.MockPort, ibctesting.FirstChannelID)
+	s.Require().True(found)
+	s.Require().Equal(simapp.SuccessContract, callbackAddress)
+
+	expChannelCallbacks := []types.ChannelCallback{
+		types.NewChannelCallback(ibctesting.MockFeePort, ibctesting.FirstChannelID, simapp.ErrorContract),
+		types.NewChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, simapp.SuccessContract),
+	}
+	s.Require().ElementsMatch(expChannelCallbacks, callbacksKeeper.GetAllChannelCallbacks(ctx))
+
+	callbacksKeeper.DeleteChannelCallback(ctx, ibctesting.MockPort, ibctesting.FirstChannelID)
+
+	_, found = callbacksKeeper.GetChannelCallback(ctx, ibctesting.MockPort, ibctesting.FirstChannelID)
+	s.Require().False(found)
+	s.Require().Equal(expChannelCallbacks[:1], callbacksKeeper.GetAllChannelCallbacks(ctx))
+}
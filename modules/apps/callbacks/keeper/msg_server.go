@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// ExecuteCallback defines a rpc handler method for MsgExecuteCallback. Any account may retry a
+// pending callback before it expires. Callback execution errors do not fail the transaction,
+// they are reported in the response and the emitted callback event.
+func (k Keeper) ExecuteCallback(goCtx context.Context, msg *types.MsgExecuteCallback) (*types.MsgExecuteCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pendingCallback, found := k.GetPendingCallback(ctx, types.CallbackType(msg.CallbackType), msg.PortId, msg.ChannelId, msg.Sequence)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrPendingCallbackNotFound, "callback type: %s, port ID: %s, channel ID: %s, sequence: %d",
+			msg.CallbackType, msg.PortId, msg.ChannelId, msg.Sequence,
+		)
+	}
+
+	if !ctx.BlockTime().Before(pendingCallback.Expiry) {
+		return nil, errorsmod.Wrapf(types.ErrPendingCallbackExpired, "callback expired at %s", pendingCallback.Expiry)
+	}
+
+	if err := k.ExecutePendingCallback(ctx, pendingCallback); err != nil {
+		k.Logger(ctx).Info("pending callback execution failed", "callback-type", msg.CallbackType, "port-id", msg.PortId, "channel-id", msg.ChannelId, "sequence", msg.Sequence, "error", err.Error())
+		return &types.MsgExecuteCallbackResponse{Success: false}, nil
+	}
+
+	return &types.MsgExecuteCallbackResponse{Success: true}, nil
+}
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterChannelCallback defines a rpc handler method for MsgRegisterChannelCallback. The signer must be
+// the owner of the channel or the module authority, see IsChannelOwner. Only the module authority may replace
+// an existing registration. The channel must exist and must not be closed.
+func (k Keeper) RegisterChannelCallback(goCtx context.Context, msg *types.MsgRegisterChannelCallback) (*types.MsgRegisterChannelCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	isAuthority := k.GetAuthority() == msg.Signer
+	if !isAuthority && !k.IsChannelOwner(ctx, msg.PortId, msg.Signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "signer %s is not the owner of port ID (%s) or the authority %s", msg.Signer, msg.PortId, k.GetAuthority())
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	if channel.State == channeltypes.CLOSED {
+		return nil, errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "channel is %s", channel.State)
+	}
+
+	if callbackAddress, found := k.GetChannelCallback(ctx, msg.PortId, msg.ChannelId); found && !isAuthority {
+		return nil, errorsmod.Wrapf(types.ErrChannelCallbackExists, "callback address %s is registered for port ID (%s) channel ID (%s)", callbackAddress, msg.PortId, msg.ChannelId)
+	}
+
+	k.SetChannelCallback(ctx, msg.PortId, msg.ChannelId, msg.CallbackAddress)
+
+	k.Logger(ctx).Info("channel callback registered", "port-id", msg.PortId, "channel-id", msg.ChannelId, "callback-address", msg.CallbackAddress)
+
+	return &types.MsgRegisterChannelCallbackResponse{}, nil
+}
+
+// DeregisterChannelCallback defines a rpc handler method for MsgDeregisterChannelCallback. The signer must be
+// the registered callback address, the owner of the channel or the module authority.
+func (k Keeper) DeregisterChannelCallback(goCtx context.Context, msg *types.MsgDeregisterChannelCallback) (*types.MsgDeregisterChannelCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	callbackAddress, found := k.GetChannelCallback(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrChannelCallbackNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	if msg.Signer != callbackAddress && msg.Signer != k.GetAuthority() && !k.IsChannelOwner(ctx, msg.PortId, msg.Signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, the owner of port ID (%s) or %s, got %s", callbackAddress, msg.PortId, k.GetAuthority(), msg.Signer)
+	}
+
+	k.DeleteChannelCallback(ctx, msg.PortId, msg.ChannelId)
+
+	k.Logger(ctx).Info("channel callback deregistered", "port-id", msg.PortId, "channel-id", msg.ChannelId, "callback-address", callbackAddress)
+
+	return &types.MsgDeregisterChannelCallbackResponse{}, nil
+}
@@ -0,0 +1,408 @@
+package keeper_test
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (s *KeeperTestSuite) TestExecuteCallback() {
+	var (
+		pendingCallback types.PendingCallback
+		msg             *types.MsgExecuteCallback
+		gasMeter        storetypes.GasMeter
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+		expPending bool
+		expErr     error
+		expPanic   bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+			false,
+			nil,
+			false,
+		},
+		{
+			"success: callback error is reported in the response",
+			func() {
+				pendingCallback.CallbackAddress = simapp.ErrorContract
+			},
+			false,
+			false,
+			nil,
+			false,
+		},
+		{
+			"success: callback out of gas with the commit gas limit is not retried",
+			func() {
+				pendingCallback.CallbackAddress = simapp.OogPanicContract
+			},
+			false,
+			false,
+			nil,
+			false,
+		},
+		{
+			"failure: callback remains pending when the signer provides insufficient gas",
+			func() {
+				pendingCallback.CallbackAddress = simapp.OogPanicContract
+				gasMeter = storetypes.NewGasMeter(pendingCallback.CommitGasLimit - 1)
+			},
+			false,
+			true,
+			nil,
+			true,
+		},
+		{
+			"failure: callback remains pending when the callback address is denied",
+			func() {
+				params := types.DefaultParams()
+				params.DeniedCallbackAddresses = []string{simapp.SuccessContract}
+				GetSimApp(s.chainA).IBCCallbacksKeeper.SetParams(s.chainA.GetContext(), params)
+			},
+			false,
+			true,
+			nil,
+			false,
+		},
+		{
+			"failure: callback remains pending when the block gas budget of the callback address is exhausted",
+			func() {
+				params := types.DefaultParams()
+				params.MaxBlockGasPerAddress = pendingCallback.CommitGasLimit
+				GetSimApp(s.chainA).IBCCallbacksKeeper.SetParams(s.chainA.GetContext(), params)
+				GetSimApp(s.chainA).IBCCallbacksKeeper.ConsumeCallbackGas(s.chainA.GetContext(), simapp.SuccessContract, 1)
+			},
+			false,
+			true,
+			nil,
+			false,
+		},
+		{
+			"failure: pending callback not found",
+			func() {
+				msg.Sequence = 2
+			},
+			false,
+			true,
+			types.ErrPendingCallbackNotFound,
+			false,
+		},
+		{
+			"failure: pending callback expired",
+			func() {
+				pendingCallback.Expiry = s.chainA.GetContext().BlockTime()
+			},
+			false,
+			true,
+			types.ErrPendingCallbackExpired,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			pendingCallback = newPendingCallback(1, simapp.SuccessContract, ctx.BlockTime().Add(time.Hour))
+			msg = types.NewMsgExecuteCallback(types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1, ibctesting.TestAccAddress)
+			gasMeter = storetypes.NewInfiniteGasMeter()
+
+			tc.malleate()
+
+			callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+			callbacksKeeper.SetPendingCallback(ctx, pendingCallback)
+
+			if tc.expPanic {
+				s.Require().Panics(func() {
+					_, _ = callbacksKeeper.ExecuteCallback(ctx.WithGasMeter(gasMeter), msg)
+				})
+			} else {
+				res, err := callbacksKeeper.ExecuteCallback(ctx.WithGasMeter(gasMeter), msg)
+				s.assertExecuteCallbackResult(res, err, tc.expSuccess, tc.expErr)
+			}
+
+			_, found := callbacksKeeper.GetPendingCallback(ctx, types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+			s.Require().Equal(tc.expPending, found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) assertExecuteCallbackResult(res *types.MsgExecuteCallbackResponse, err error, expSuccess bool, expErr error) {
+	if expErr == nil {
+		s.Require().NoError(err)
+		s.Require().Equal(expSuccess, res.Success)
+	} else {
+		s.Require().ErrorIs(err, expErr)
+		s.Require().Nil(res)
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	authority := GetSimApp(s.chainA).IBCCallbacksKeeper.GetAuthority()
+	params := types.NewParams(time.Hour, []string{simapp.SuccessContract}, []string{simapp.ErrorContract}, 10_000_000, true)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(authority, params),
+			nil,
+		},
+		{
+			"failure: invalid authority",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, params),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+			_, err := callbacksKeeper.UpdateParams(ctx, tc.msg)
+
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(params, callbacksKeeper.GetParams(ctx))
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Equal(types.DefaultParams(), callbacksKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRegisterChannelCallback() {
+	var (
+		msg *types.MsgRegisterChannelCallback
+		err error
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: interchain accounts controller owner",
+			func() {
+				msg.PortId, err = icatypes.NewControllerPortID(ibctesting.TestAccAddress)
+				s.Require().NoError(err)
+				msg.Signer = ibctesting.TestAccAddress
+
+				GetSimApp(s.chainA).IBCKeeper.ChannelKeeper.SetChannel(s.chainA.GetContext(), msg.PortId, ibctesting.FirstChannelID, newChannel(channeltypes.OPEN))
+			},
+			nil,
+		},
+		{
+			"success: contract bound to a wasm port",
+			func() {
+				msg.PortId = types.WasmPortPrefix + simapp.SuccessContract
+				msg.Signer = simapp.SuccessContract
+
+				GetSimApp(s.chainA).IBCKeeper.ChannelKeeper.SetChannel(s.chainA.GetContext(), msg.PortId, ibctesting.FirstChannelID, newChannel(channeltypes.OPEN))
+			},
+			nil,
+		},
+		{
+			"success: authority replaces an existing registration",
+			func() {
+				GetSimApp(s.chainA).IBCCallbacksKeeper.SetChannelCallback(s.chainA.GetContext(), ibctesting.MockPort, ibctesting.FirstChannelID, simapp.SuccessContract)
+				msg.Signer = GetSimApp(s.chainA).IBCCallbacksKeeper.GetAuthority()
+			},
+			nil,
+		},
+		{
+			"failure: signer is not the channel owner or the authority",
+			func() {
+				msg.Signer = simapp.SuccessContract
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is the callback address but not the channel owner",
+			func() {
+				msg.Signer = msg.CallbackAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is the owner of another interchain accounts controller port",
+			func() {
+				msg.PortId, err = icatypes.NewControllerPortID(simapp.SuccessContract)
+				s.Require().NoError(err)
+				msg.Signer = ibctesting.TestAccAddress
+
+				GetSimApp(s.chainA).IBCKeeper.ChannelKeeper.SetChannel(s.chainA.GetContext(), msg.PortId, ibctesting.FirstChannelID, newChannel(channeltypes.OPEN))
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: signer is not the contract bound to the wasm port",
+			func() {
+				msg.PortId = types.WasmPortPrefix + simapp.SuccessContract
+				msg.Signer = ibctesting.TestAccAddress
+
+				GetSimApp(s.chainA).IBCKeeper.ChannelKeeper.SetChannel(s.chainA.GetContext(), msg.PortId, ibctesting.FirstChannelID, newChannel(channeltypes.OPEN))
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is closed",
+			func() {
+				GetSimApp(s.chainA).IBCKeeper.ChannelKeeper.SetChannel(s.chainA.GetContext(), ibctesting.MockPort, ibctesting.FirstChannelID, newChannel(channeltypes.CLOSED))
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"failure: callback already registered",
+			func() {
+				GetSimApp(s.chainA).IBCCallbacksKeeper.SetChannelCallback(s.chainA.GetContext(), ibctesting.MockPort, ibctesting.FirstChannelID, simapp.SuccessContract)
+			},
+			types.ErrChannelCallbackExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// the channels of the mock port are owned by the module account of the module bound to the port
+			module, _, err := GetSimApp(s.chainA).IBCKeeper.PortKeeper.LookupModuleByPort(s.chainA.GetContext(), ibctesting.MockPort)
+			s.Require().NoError(err)
+			portOwner := authtypes.NewModuleAddress(module).String()
+
+			GetSimApp(s.chainA).IBCKeeper.ChannelKeeper.SetChannel(s.chainA.GetContext(), ibctesting.MockPort, ibctesting.FirstChannelID, newChannel(channeltypes.OPEN))
+			msg = types.NewMsgRegisterChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.TestAccAddress, portOwner)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+			_, err = callbacksKeeper.RegisterChannelCallback(ctx, msg)
+
+			callbackAddress, found := callbacksKeeper.GetChannelCallback(ctx, msg.PortId, ibctesting.FirstChannelID)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().True(found)
+				s.Require().Equal(msg.CallbackAddress, callbackAddress)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().NotEqual(msg.CallbackAddress, callbackAddress)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDeregisterChannelCallback() {
+	var msg *types.MsgDeregisterChannelCallback
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: authority removes the registration",
+			func() {
+				msg.Signer = GetSimApp(s.chainA).IBCCallbacksKeeper.GetAuthority()
+			},
+			nil,
+		},
+		{
+			"success: channel owner removes the registration",
+			func() {
+				module, _, err := GetSimApp(s.chainA).IBCKeeper.PortKeeper.LookupModuleByPort(s.chainA.GetContext(), ibctesting.MockPort)
+				s.Require().NoError(err)
+				msg.Signer = authtypes.NewModuleAddress(module).String()
+			},
+			nil,
+		},
+		{
+			"failure: signer is not the registered callback address or the authority",
+			func() {
+				msg.Signer = simapp.SuccessContract
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: callback not registered",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			types.ErrChannelCallbackNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			GetSimApp(s.chainA).IBCCallbacksKeeper.SetChannelCallback(s.chainA.GetContext(), ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.TestAccAddress)
+			msg = types.NewMsgDeregisterChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.TestAccAddress)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+			_, err := callbacksKeeper.DeregisterChannelCallback(ctx, msg)
+
+			_, found := callbacksKeeper.GetChannelCallback(ctx, ibctesting.MockPort, ibctesting.FirstChannelID)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+				s.Require().False(found)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().True(found)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+// ValidateCallbackAddress returns an error if the callback parameters do not permit the execution of the
+// callback of the given type. The callback address must be permitted by the allowlist and denylist, and
+// if required, the callback address of source callbacks must be the packet sender.
+func (k Keeper) ValidateCallbackAddress(ctx sdk.Context, callbackType types.CallbackType, callbackData types.CallbackData) error {
+	params := k.GetParams(ctx)
+
+	if !params.IsCallbackAddressAllowed(callbackData.CallbackAddress) {
+		return errorsmod.Wrapf(types.ErrCallbackAddressNotAllowed, "callback address: %s", callbackData.CallbackAddress)
+	}
+
+	if params.RequireSenderCallbackAddress && isSourceCallbackType(callbackType) && callbackData.CallbackAddress != callbackData.SenderAddress {
+		return errorsmod.Wrapf(types.ErrCallbackSenderMismatch, "expected %s, got %s", callbackData.SenderAddress, callbackData.CallbackAddress)
+	}
+
+	return nil
+}
+
+// ValidateCallbackGasBudget returns an error if the commit gas limit of the callback exceeds the gas remaining
+// in the per block gas budget of the callback address.
+func (k Keeper) ValidateCallbackGasBudget(ctx sdk.Context, callbackData types.CallbackData) error {
+	maxBlockGas := k.GetParams(ctx).MaxBlockGasPerAddress
+	if maxBlockGas == 0 {
+		return nil
+	}
+
+	gasUsed := k.GetCallbackGasUsed(ctx, callbackData.CallbackAddress)
+	if gasUsed >= maxBlockGas || callbackData.CommitGasLimit > maxBlockGas-gasUsed {
+		return errorsmod.Wrapf(
+			types.ErrCallbackGasBudgetExceeded, "callback address %s has consumed %d of %d gas in the current block, callback requires %d",
+			callbackData.CallbackAddress, gasUsed, maxBlockGas, callbackData.CommitGasLimit,
+		)
+	}
+
+	return nil
+}
+
+// GetCallbackGasUsed returns the gas consumed by callbacks to the given callback address in the current block.
+func (k Keeper) GetCallbackGasUsed(ctx sdk.Context, callbackAddress string) uint64 {
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := store.Get(types.CallbackGasUsageKey(callbackAddress))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// ConsumeCallbackGas adds the provided amount of gas to the gas consumed by callbacks to the given
+// callback address in the current block. The gas usage is kept in the transient store and therefore
+// reset at the end of every block.
+func (k Keeper) ConsumeCallbackGas(ctx sdk.Context, callbackAddress string, gas uint64) {
+	gasUsed := k.GetCallbackGasUsed(ctx, callbackAddress)
+	if gas > math.MaxUint64-gasUsed {
+		gasUsed = math.MaxUint64
+	} else {
+		gasUsed += gas
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set(types.CallbackGasUsageKey(callbackAddress), sdk.Uint64ToBigEndian(gasUsed))
+}
+
+// isSourceCallbackType returns true if the callback type is executed on the chain which sent the packet.
+func isSourceCallbackType(callbackType types.CallbackType) bool {
+	switch callbackType {
+	case types.CallbackTypeSendPacket, types.CallbackTypeAcknowledgementPacket, types.CallbackTypeTimeoutPacket:
+		return true
+	default:
+		return false
+	}
+}
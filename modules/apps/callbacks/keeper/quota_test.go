@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"math"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (s *KeeperTestSuite) TestValidateCallbackAddress() {
+	var (
+		params       types.Params
+		callbackType types.CallbackType
+		callbackData types.CallbackData
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: default params",
+			func() {},
+			nil,
+		},
+		{
+			"success: callback address is allowed",
+			func() {
+				params.AllowedCallbackAddresses = []string{simapp.SuccessContract}
+			},
+			nil,
+		},
+		{
+			"success: callback address is the packet sender",
+			func() {
+				params.RequireSenderCallbackAddress = true
+				callbackData.SenderAddress = simapp.SuccessContract
+			},
+			nil,
+		},
+		{
+			"success: destination callbacks are not required to be the packet sender",
+			func() {
+				params.RequireSenderCallbackAddress = true
+				callbackType = types.CallbackTypeReceivePacket
+			},
+			nil,
+		},
+		{
+			"failure: callback address is not in the allowlist",
+			func() {
+				params.AllowedCallbackAddresses = []string{simapp.ErrorContract}
+			},
+			types.ErrCallbackAddressNotAllowed,
+		},
+		{
+			"failure: callback address is denied",
+			func() {
+				params.DeniedCallbackAddresses = []string{simapp.SuccessContract}
+			},
+			types.ErrCallbackAddressNotAllowed,
+		},
+		{
+			"failure: callback address is not the packet sender",
+			func() {
+				params.RequireSenderCallbackAddress = true
+			},
+			types.ErrCallbackSenderMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			params = types.DefaultParams()
+			callbackType = types.CallbackTypeAcknowledgementPacket
+			callbackData = types.CallbackData{
+				CallbackAddress:   simapp.SuccessContract,
+				SenderAddress:     ibctesting.TestAccAddress,
+				ExecutionGasLimit: 1_000_000,
+				CommitGasLimit:    1_000_000,
+			}
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+			callbacksKeeper.SetParams(ctx, params)
+
+			err := callbacksKeeper.ValidateCallbackAddress(ctx, callbackType, callbackData)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestValidateCallbackGasBudget() {
+	const maxBlockGas = uint64(1_000_000)
+
+	testCases := []struct {
+		name           string
+		maxBlockGas    uint64
+		gasUsed        uint64
+		commitGasLimit uint64
+		expErr         error
+	}{
+		{
+			"success: block gas budget is disabled",
+			0,
+			math.MaxUint64,
+			maxBlockGas,
+			nil,
+		},
+		{
+			"success: callback fits the remaining block gas budget",
+			maxBlockGas,
+			maxBlockGas / 2,
+			maxBlockGas / 2,
+			nil,
+		},
+		{
+			"failure: callback exceeds the remaining block gas budget",
+			maxBlockGas,
+			maxBlockGas / 2,
+			maxBlockGas/2 + 1,
+			types.ErrCallbackGasBudgetExceeded,
+		},
+		{
+			"failure: block gas budget is exhausted",
+			maxBlockGas,
+			maxBlockGas + 1,
+			0,
+			types.ErrCallbackGasBudgetExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+			params := types.DefaultParams()
+			params.MaxBlockGasPerAddress = tc.maxBlockGas
+			callbacksKeeper.SetParams(ctx, params)
+			callbacksKeeper.ConsumeCallbackGas(ctx, simapp.SuccessContract, tc.gasUsed)
+
+			callbackData := types.CallbackData{
+				CallbackAddress:   simapp.SuccessContract,
+				ExecutionGasLimit: tc.commitGasLimit,
+				CommitGasLimit:    tc.commitGasLimit,
+			}
+
+			err := callbacksKeeper.ValidateCallbackGasBudget(ctx, callbackData)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCallbackGasUsage() {
+	ctx := s.chainA.GetContext()
+	callbacksKeeper := GetSimApp(s.chainA).IBCCallbacksKeeper
+
+	s.Require().Zero(callbacksKeeper.GetCallbackGasUsed(ctx, simapp.SuccessContract))
+
+	callbacksKeeper.ConsumeCallbackGas(ctx, simapp.SuccessContract, 100)
+	callbacksKeeper.ConsumeCallbackGas(ctx, simapp.SuccessContract, 50)
+	callbacksKeeper.ConsumeCallbackGas(ctx, simapp.ErrorContract, math.MaxUint64)
+	callbacksKeeper.ConsumeCallbackGas(ctx, simapp.ErrorContract, 1)
+
+	s.Require().Equal(uint64(150), callbacksKeeper.GetCallbackGasUsed(ctx, simapp.SuccessContract))
+	s.Require().Equal(uint64(math.MaxUint64), callbacksKeeper.GetCallbackGasUsed(ctx, simapp.ErrorContract))
+
+	// the gas usage is discarded once the block is committed
+	s.coordinator.CommitBlock(s.chainA)
+	ctx = s.chainA.GetContext()
+
+	s.Require().Zero(callbacksKeeper.GetCallbackGasUsed(ctx, simapp.SuccessContract))
+	s.Require().Zero(callbacksKeeper.GetCallbackGasUsed(ctx, simapp.ErrorContract))
+}
@@ -14,8 +14,11 @@ import (
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
 
-// MockKeeper implements callbacktypes.ContractKeeper
-var _ callbacktypes.ContractKeeper = (*ContractKeeper)(nil)
+// MockKeeper implements callbacktypes.ContractKeeper and callbacktypes.ChannelContractKeeper
+var (
+	_ callbacktypes.ContractKeeper        = (*ContractKeeper)(nil)
+	_ callbacktypes.ChannelContractKeeper = (*ContractKeeper)(nil)
+)
 
 var StatefulCounterKey = "stateful-callback-counter"
 
@@ -203,6 +206,30 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	return k.IBCReceivePacketCallbackFn(ctx, packet, ack, contractAddress)
 }
 
+// IBCOnChanOpenCallback increments the stateful entry counter and the channel_open callback counter.
+// The callback behaves as described in ProcessMockCallback.
+func (k ContractKeeper) IBCOnChanOpenCallback(ctx sdk.Context, _, _, _, contractAddress string) error {
+	return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeChannelOpen, contractAddress)
+}
+
+// IBCOnChanCloseCallback increments the stateful entry counter and the channel_close callback counter.
+// The callback behaves as described in ProcessMockCallback.
+func (k ContractKeeper) IBCOnChanCloseCallback(ctx sdk.Context, _, _, contractAddress string) error {
+	return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeChannelClose, contractAddress)
+}
+
+// IBCOnChanUpgradeOpenCallback increments the stateful entry counter and the channel_upgrade_open callback counter.
+// The callback behaves as described in ProcessMockCallback.
+func (k ContractKeeper) IBCOnChanUpgradeOpenCallback(ctx sdk.Context, _, _ string, _ channeltypes.Order, _ []string, _, contractAddress string) error {
+	return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeChannelUpgradeOpen, contractAddress)
+}
+
+// IBCOnChanUpgradeCancelCallback increments the stateful entry counter and the channel_upgrade_cancel callback counter.
+// The callback behaves as described in ProcessMockCallback.
+func (k ContractKeeper) IBCOnChanUpgradeCancelCallback(ctx sdk.Context, _, _, contractAddress string) error {
+	return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeChannelUpgradeCancel, contractAddress)
+}
+
 // ProcessMockCallback processes a mock callback.
 // It increments the stateful entry counter and the callback counter.
 // This function:
@@ -112,6 +112,124 @@ func (s *CallbacksTestSuite) TestTransferCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestTransferCallbacksWithParams() {
+	var (
+		sourceParams types.Params
+		destParams   types.Params
+		memo         string
+	)
+
+	testCases := []struct {
+		name         string
+		transferMemo string
+		malleate     func()
+		expCallback  types.CallbackType
+		expSuccess   bool
+	}{
+		{
+			"success: source callback to allowed address",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				sourceParams.AllowedCallbackAddresses = []string{simapp.SuccessContract}
+			},
+			types.CallbackTypeAcknowledgementPacket,
+			true,
+		},
+		{
+			"success: dest callback within the block gas budget",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				destParams.MaxBlockGasPerAddress = maxCallbackGas
+			},
+			types.CallbackTypeReceivePacket,
+			true,
+		},
+		{
+			"success: dest callback is not required to be the packet sender",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				destParams.RequireSenderCallbackAddress = true
+			},
+			types.CallbackTypeReceivePacket,
+			true,
+		},
+		{
+			"success: source callback address is the packet sender",
+			"",
+			func() {
+				memo = fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, s.chainA.SenderAccount.GetAddress())
+				sourceParams.RequireSenderCallbackAddress = true
+			},
+			types.CallbackTypeAcknowledgementPacket,
+			true,
+		},
+		{
+			"failure: source callback address is not in the allowlist",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				sourceParams.AllowedCallbackAddresses = []string{simapp.ErrorContract}
+			},
+			"none", // the packet send is rejected
+			true,
+		},
+		{
+			"failure: source callback address is denied",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				sourceParams.DeniedCallbackAddresses = []string{simapp.SuccessContract}
+			},
+			"none", // the packet send is rejected
+			true,
+		},
+		{
+			"failure: source callback address is not the packet sender",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				sourceParams.RequireSenderCallbackAddress = true
+			},
+			"none", // the packet send is rejected
+			true,
+		},
+		{
+			"failure: dest callback address is denied",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				destParams.DeniedCallbackAddresses = []string{simapp.SuccessContract}
+			},
+			"none", // the packet is received without executing the callback
+			true,
+		},
+		{
+			"failure: dest callback exceeds the block gas budget",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			func() {
+				destParams.MaxBlockGasPerAddress = maxCallbackGas - 1
+			},
+			"none", // the packet is received without executing the callback
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			sourceParams = types.DefaultParams()
+			destParams = types.DefaultParams()
+			memo = tc.transferMemo
+
+			tc.malleate()
+
+			GetSimApp(s.chainA).IBCCallbacksKeeper.SetParams(s.chainA.GetContext(), sourceParams)
+			GetSimApp(s.chainB).IBCCallbacksKeeper.SetParams(s.chainB.GetContext(), destParams)
+
+			s.ExecuteTransfer(memo)
+			s.AssertHasExecutedExpectedCallback(tc.expCallback, tc.expSuccess)
+		})
+	}
+}
+
 func (s *CallbacksTestSuite) TestTransferTimeoutCallbacks() {
 	testCases := []struct {
 		name         string
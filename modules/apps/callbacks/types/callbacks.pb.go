@@ -0,0 +1,1330 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/callbacks/v1/callbacks.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of IBC callbacks middleware parameters.
+type Params struct {
+	// retry_window is the duration for which a callback that ran out of gas may be
+	// retried using MsgExecuteCallback before it expires.
+	RetryWindow time.Duration `protobuf:"bytes,1,opt,name=retry_window,json=retryWindow,proto3,stdduration" json:"retry_window"`
+	// allowed_callback_addresses is the list of callback addresses permitted to receive callbacks.
+	// If empty, all callback addresses which are not denied are permitted.
+	AllowedCallbackAddresses []string `protobuf:"bytes,2,rep,name=allowed_callback_addresses,json=allowedCallbackAddresses,proto3" json:"allowed_callback_addresses,omitempty"`
+	// denied_callback_addresses is the list of callback addresses which are not permitted to receive callbacks.
+	DeniedCallbackAddresses []string `protobuf:"bytes,3,rep,name=denied_callback_addresses,json=deniedCallbackAddresses,proto3" json:"denied_callback_addresses,omitempty"`
+	// max_block_gas_per_address is the maximum amount of gas that callbacks to a single callback address
+	// may consume within a block. A value of zero disables the limit.
+	MaxBlockGasPerAddress uint64 `protobuf:"varint,4,opt,name=max_block_gas_per_address,json=maxBlockGasPerAddress,proto3" json:"max_block_gas_per_address,omitempty"`
+	// require_sender_callback_address requires the callback address of source callbacks to be the packet sender.
+	RequireSenderCallbackAddress bool `protobuf:"varint,5,opt,name=require_sender_callback_address,json=requireSenderCallbackAddress,proto3" json:"require_sender_callback_address,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7769659511ffe57, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetryWindow() time.Duration {
+	if m != nil {
+		return m.RetryWindow
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedCallbackAddresses() []string {
+	if m != nil {
+		return m.AllowedCallbackAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedCallbackAddresses() []string {
+	if m != nil {
+		return m.DeniedCallbackAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBlockGasPerAddress() uint64 {
+	if m != nil {
+		return m.MaxBlockGasPerAddress
+	}
+	return 0
+}
+
+func (m *Params) GetRequireSenderCallbackAddress() bool {
+	if m != nil {
+		return m.RequireSenderCallbackAddress
+	}
+	return false
+}
+
+// PendingCallback defines a callback which ran out of gas during packet relaying and
+// has been stored so that it may be retried with a higher gas limit.
+type PendingCallback struct {
+	// the callback type, i.e. acknowledgement_packet, timeout_packet or receive_packet
+	CallbackType string `protobuf:"bytes,1,opt,name=callback_type,json=callbackType,proto3" json:"callback_type,omitempty"`
+	// the packet for which the callback is executed
+	Packet types.Packet `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	// the acknowledgement bytes provided to acknowledgement and receive packet callbacks
+	Acknowledgement []byte `protobuf:"bytes,3,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// the relayer which relayed the packet, empty for asynchronous acknowledgements
+	Relayer string `protobuf:"bytes,4,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the address of the callback actor
+	CallbackAddress string `protobuf:"bytes,5,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+	// the sender of the packet, empty for destination callbacks
+	SenderAddress string `protobuf:"bytes,6,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// the gas limit the callback is entitled to during execution
+	CommitGasLimit uint64 `protobuf:"varint,7,opt,name=commit_gas_limit,json=commitGasLimit,proto3" json:"commit_gas_limit,omitempty"`
+	// the block time after which the callback may no longer be retried
+	Expiry time.Time `protobuf:"bytes,8,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// whether the acknowledgement provided to receive packet callbacks is successful
+	AcknowledgementSuccess bool `protobuf:"varint,9,opt,name=acknowledgement_success,json=acknowledgementSuccess,proto3" json:"acknowledgement_success,omitempty"`
+}
+
+func (m *PendingCallback) Reset()         { *m = PendingCallback{} }
+func (m *PendingCallback) String() string { return proto.CompactTextString(m) }
+func (*PendingCallback) ProtoMessage()    {}
+func (*PendingCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7769659511ffe57, []int{1}
+}
+func (m *PendingCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCallback.Merge(m, src)
+}
+func (m *PendingCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCallback proto.InternalMessageInfo
+
+// ChannelCallback defines the callback address registered to receive the channel
+// lifecycle callbacks of a channel.
+type ChannelCallback struct {
+	// the port identifier of the channel
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address of the callback actor
+	CallbackAddress string `protobuf:"bytes,3,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+}
+
+func (m *ChannelCallback) Reset()         { *m = ChannelCallback{} }
+func (m *ChannelCallback) String() string { return proto.CompactTextString(m) }
+func (*ChannelCallback) ProtoMessage()    {}
+func (*ChannelCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7769659511ffe57, []int{2}
+}
+func (m *ChannelCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCallback.Merge(m, src)
+}
+func (m *ChannelCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCallback proto.InternalMessageInfo
+
+func (m *ChannelCallback) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelCallback) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelCallback) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.applications.callbacks.v1.Params")
+	proto.RegisterType((*PendingCallback)(nil), "ibc.applications.callbacks.v1.PendingCallback")
+	proto.RegisterType((*ChannelCallback)(nil), "ibc.applications.callbacks.v1.ChannelCallback")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/callbacks/v1/callbacks.proto", fileDescriptor_b7769659511ffe57)
+}
+
+var fileDescriptor_b7769659511ffe57 = []byte{
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x93, 0x7c, 0x69, 0x33, 0xfd, 0x49, 0x35, 0xfa, 0xa0, 0x6e, 0xa0, 0x49, 0x28, 0x42,
+	0x32, 0x8b, 0xda, 0x2a, 0x08, 0x01, 0x55, 0x37, 0xa4, 0x40, 0x55, 0x09, 0x89, 0xc8, 0xad, 0x84,
+	0xc4, 0xc6, 0x1a, 0x8f, 0x2f, 0xee, 0x28, 0xb6, 0xc7, 0xcc, 0x38, 0x4d, 0xf3, 0x06, 0x2c, 0xbb,
+	0x64, 0xc9, 0x13, 0xf0, 0x1c, 0x95, 0xd8, 0x74, 0xc9, 0x0a, 0x50, 0xfb, 0x22, 0xc8, 0xe3, 0x71,
+	0x5b, 0xd2, 0xec, 0x3c, 0xf7, 0x9c, 0xe3, 0xb9, 0xf7, 0x9c, 0xab, 0x41, 0x9b, 0xcc, 0xa7, 0x0e,
+	0x49, 0xd3, 0x88, 0x51, 0x92, 0x31, 0x9e, 0x48, 0x87, 0x92, 0x28, 0xf2, 0x09, 0x1d, 0x4a, 0xe7,
+	0x78, 0xeb, 0xfa, 0x60, 0xa7, 0x82, 0x67, 0x1c, 0xaf, 0x33, 0x9f, 0xda, 0x37, 0xe9, 0xf6, 0x35,
+	0xe3, 0x78, 0xab, 0xfd, 0x7f, 0xc8, 0x43, 0xae, 0x98, 0x4e, 0xfe, 0x55, 0x88, 0xda, 0x9d, 0x90,
+	0xf3, 0x30, 0x02, 0x47, 0x9d, 0xfc, 0xd1, 0x27, 0x27, 0x18, 0x09, 0xa5, 0xd6, 0x78, 0x77, 0x1a,
+	0xcf, 0x58, 0x0c, 0x32, 0x23, 0x71, 0xaa, 0x09, 0x0f, 0xf2, 0x26, 0x29, 0x17, 0xe0, 0xd0, 0x23,
+	0x92, 0x24, 0x10, 0xa9, 0xd6, 0x8a, 0xcf, 0x82, 0xb2, 0xf1, 0xa3, 0x8a, 0x1a, 0x03, 0x22, 0x48,
+	0x2c, 0xf1, 0x5b, 0xb4, 0x28, 0x20, 0x13, 0x13, 0x6f, 0xcc, 0x92, 0x80, 0x8f, 0x4d, 0xa3, 0x67,
+	0x58, 0x0b, 0x4f, 0xd6, 0xec, 0xe2, 0x16, 0xbb, 0xbc, 0xc5, 0x7e, 0xad, 0xbb, 0xe8, 0xcf, 0x9f,
+	0xfd, 0xea, 0x56, 0xbe, 0xfe, 0xee, 0x1a, 0xee, 0x82, 0x12, 0x7e, 0x50, 0x3a, 0xbc, 0x83, 0xda,
+	0x24, 0x8a, 0xf8, 0x18, 0x02, 0xaf, 0x1c, 0xd2, 0x23, 0x41, 0x20, 0x40, 0x4a, 0x90, 0x66, 0xb5,
+	0x57, 0xb3, 0x9a, 0xae, 0xa9, 0x19, 0xbb, 0x9a, 0xf0, 0xaa, 0xc4, 0xf1, 0x36, 0x5a, 0x0b, 0x20,
+	0x61, 0xb3, 0xc5, 0x35, 0x25, 0x5e, 0x2d, 0x08, 0xb7, 0xb5, 0x2f, 0xd0, 0x5a, 0x4c, 0x4e, 0x3c,
+	0x3f, 0xe2, 0x74, 0xe8, 0x85, 0x44, 0x7a, 0x29, 0x88, 0x52, 0x6d, 0xd6, 0x7b, 0x86, 0x55, 0x77,
+	0xef, 0xc4, 0xe4, 0xa4, 0x9f, 0xe3, 0x7b, 0x44, 0x0e, 0x40, 0x68, 0x2d, 0x7e, 0x83, 0xba, 0x02,
+	0x3e, 0x8f, 0x98, 0x00, 0x4f, 0x42, 0x12, 0x80, 0xb8, 0x75, 0xbb, 0xf9, 0x5f, 0xcf, 0xb0, 0xe6,
+	0xdd, 0xfb, 0x9a, 0x76, 0xa0, 0x58, 0x53, 0x2d, 0x6c, 0x7c, 0xaf, 0xa1, 0xd6, 0x00, 0x92, 0x80,
+	0x25, 0x61, 0x09, 0xe1, 0x87, 0x68, 0xe9, 0xea, 0x5f, 0xd9, 0x24, 0x05, 0xe5, 0x6b, 0xd3, 0x5d,
+	0x2c, 0x8b, 0x87, 0x93, 0x14, 0xf0, 0x4b, 0xd4, 0x48, 0x09, 0x1d, 0x42, 0x66, 0x56, 0x95, 0xeb,
+	0xf7, 0xec, 0x7c, 0x61, 0xf2, 0xe8, 0xec, 0x32, 0xaf, 0xe3, 0x2d, 0x7b, 0xa0, 0x28, 0xfd, 0x7a,
+	0xee, 0xbb, 0xab, 0x05, 0xd8, 0x42, 0x2d, 0x42, 0x87, 0x09, 0x1f, 0x47, 0x10, 0x84, 0x10, 0x43,
+	0x92, 0x99, 0xb5, 0x9e, 0x61, 0x2d, 0xba, 0xd3, 0x65, 0x6c, 0xa2, 0x39, 0x01, 0x11, 0x99, 0x80,
+	0x50, 0x66, 0x34, 0xdd, 0xf2, 0x88, 0x1f, 0xa3, 0x95, 0x99, 0xf3, 0x36, 0xdd, 0x16, 0xfd, 0x77,
+	0x44, 0xfc, 0x08, 0x2d, 0x6b, 0x87, 0x4a, 0x62, 0x43, 0x11, 0x97, 0x8a, 0x6a, 0x49, 0xb3, 0xd0,
+	0x0a, 0xe5, 0x71, 0xcc, 0x32, 0x95, 0x43, 0xc4, 0x62, 0x96, 0x99, 0x73, 0x2a, 0x81, 0xe5, 0xa2,
+	0xbe, 0x47, 0xe4, 0xbb, 0xbc, 0x8a, 0x77, 0x50, 0x03, 0x4e, 0x52, 0x26, 0x26, 0xe6, 0xbc, 0x1a,
+	0xbd, 0x7d, 0x6b, 0xe1, 0x0e, 0xcb, 0xb5, 0x2e, 0x36, 0xee, 0x34, 0xdf, 0x38, 0xad, 0xc1, 0xcf,
+	0xd1, 0xea, 0xd4, 0x98, 0x9e, 0x1c, 0x51, 0x9a, 0xf7, 0xd5, 0x54, 0x81, 0xdd, 0x9d, 0x82, 0x0f,
+	0x0a, 0x74, 0xbb, 0xfe, 0xe5, 0x5b, 0xb7, 0xb2, 0x91, 0xa1, 0xd6, 0x6e, 0xe1, 0xef, 0x55, 0x5e,
+	0xab, 0x68, 0x2e, 0xe5, 0x22, 0xf3, 0x58, 0xa0, 0x93, 0x6a, 0xe4, 0xc7, 0xfd, 0x00, 0xaf, 0x23,
+	0xa4, 0xb3, 0xc8, 0xb1, 0xaa, 0xc2, 0x9a, 0xba, 0xb2, 0x1f, 0xcc, 0xf4, 0xb0, 0x36, 0xd3, 0xc3,
+	0xfe, 0xfb, 0xb3, 0x8b, 0x8e, 0x71, 0x7e, 0xd1, 0x31, 0xfe, 0x5c, 0x74, 0x8c, 0xd3, 0xcb, 0x4e,
+	0xe5, 0xfc, 0xb2, 0x53, 0xf9, 0x79, 0xd9, 0xa9, 0x7c, 0x7c, 0x16, 0xb2, 0xec, 0x68, 0xe4, 0xdb,
+	0x94, 0xc7, 0x0e, 0xe5, 0x32, 0xe6, 0xd2, 0x61, 0x3e, 0xdd, 0x0c, 0xb9, 0x13, 0xf3, 0x60, 0x14,
+	0x81, 0xcc, 0xdf, 0x9c, 0x9b, 0x6f, 0x4d, 0xbe, 0x51, 0xd2, 0x6f, 0x28, 0xaf, 0x9e, 0xfe, 0x1d,
+	0x00, 0x04, 0x8c, 0x27, 0x0c, 0x96, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireSenderCallbackAddress {
+		i--
+		if m.RequireSenderCallbackAddress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBlockGasPerAddress != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.MaxBlockGasPerAddress))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DeniedCallbackAddresses) > 0 {
+		for iNdEx := len(m.DeniedCallbackAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedCallbackAddresses[iNdEx])
+			copy(dAtA[i:], m.DeniedCallbackAddresses[iNdEx])
+			i = encodeVarintCallbacks(dAtA, i, uint64(len(m.DeniedCallbackAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedCallbackAddresses) > 0 {
+		for iNdEx := len(m.AllowedCallbackAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCallbackAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedCallbackAddresses[iNdEx])
+			i = encodeVarintCallbacks(dAtA, i, uint64(len(m.AllowedCallbackAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCallbacks(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcknowledgementSuccess {
+		i--
+		if m.AcknowledgementSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCallbacks(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if m.CommitGasLimit != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.CommitGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallbacks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CallbackType) > 0 {
+		i -= len(m.CallbackType)
+		copy(dAtA[i:], m.CallbackType)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCallbacks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryWindow)
+	n += 1 + l + sovCallbacks(uint64(l))
+	if len(m.AllowedCallbackAddresses) > 0 {
+		for _, s := range m.AllowedCallbackAddresses {
+			l = len(s)
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
+	if len(m.DeniedCallbackAddresses) > 0 {
+		for _, s := range m.DeniedCallbackAddresses {
+			l = len(s)
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
+	if m.MaxBlockGasPerAddress != 0 {
+		n += 1 + sovCallbacks(uint64(m.MaxBlockGasPerAddress))
+	}
+	if m.RequireSenderCallbackAddress {
+		n += 2
+	}
+	return n
+}
+
+func (m *PendingCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackType)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovCallbacks(uint64(l))
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if m.CommitGasLimit != 0 {
+		n += 1 + sovCallbacks(uint64(m.CommitGasLimit))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovCallbacks(uint64(l))
+	if m.AcknowledgementSuccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChannelCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	return n
+}
+
+func sovCallbacks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallbacks(x uint64) (n int) {
+	return sovCallbacks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetryWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCallbackAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCallbackAddresses = append(m.AllowedCallbackAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedCallbackAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedCallbackAddresses = append(m.DeniedCallbackAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGasPerAddress", wireType)
+			}
+			m.MaxBlockGasPerAddress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGasPerAddress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireSenderCallbackAddress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireSenderCallbackAddress = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitGasLimit", wireType)
+			}
+			m.CommitGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgementSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcknowledgementSuccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCallbacks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCallbacks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCallbacks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCallbacks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCallbacks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCallbacks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCallbacks = fmt.Errorf("proto: unexpected end of group")
+)
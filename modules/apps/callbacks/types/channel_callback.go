@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// NewChannelCallback creates a new ChannelCallback instance
+func NewChannelCallback(portID, channelID, callbackAddress string) ChannelCallback {
+	return ChannelCallback{
+		PortId:          portID,
+		ChannelId:       channelID,
+		CallbackAddress: callbackAddress,
+	}
+}
+
+// Validate performs a basic validation of the ChannelCallback fields.
+func (cc ChannelCallback) Validate() error {
+	if err := host.PortIdentifierValidator(cc.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(cc.ChannelId); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(cc.CallbackAddress) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "callback address cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary ibc callbacks interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgExecuteCallback{}, "cosmos-sdk/MsgExecuteCallback")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterChannelCallback{}, "cosmos-sdk/MsgRegisterChannelCallback")
+	legacy.RegisterAminoMsg(cdc, &MsgDeregisterChannelCallback{}, "cosmos-sdk/MsgDeregisterChannelCallback")
+}
+
+// RegisterInterfaces registers the ibc callbacks middleware interfaces to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgExecuteCallback{},
+		&MsgUpdateParams{},
+		&MsgRegisterChannelCallback{},
+		&MsgDeregisterChannelCallback{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// ModuleCdc references the global ibc callbacks module codec. Note, the codec
+// should ONLY be used in certain instances of tests and for JSON encoding.
+//
+// The actual codec used for serialization should be provided to the ibc callbacks
+// middleware and defined at the application level.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -11,4 +11,16 @@ var (
 	ErrCallbackAddressNotFound   = errorsmod.Register(ModuleName, 5, "callback address not found in packet data")
 	ErrCallbackOutOfGas          = errorsmod.Register(ModuleName, 6, "callback out of gas")
 	ErrCallbackPanic             = errorsmod.Register(ModuleName, 7, "callback panic")
+	ErrPendingCallbackNotFound   = errorsmod.Register(ModuleName, 8, "pending callback not found")
+	ErrPendingCallbackExpired    = errorsmod.Register(ModuleName, 9, "pending callback expired")
+	ErrInvalidCallbackType       = errorsmod.Register(ModuleName, 10, "invalid callback type")
+	ErrInvalidParams             = errorsmod.Register(ModuleName, 11, "invalid callbacks params")
+	ErrCallbackRouteNotFound     = errorsmod.Register(ModuleName, 12, "callback route not found")
+	ErrChannelCallbackNotFound   = errorsmod.Register(ModuleName, 13, "channel callback not found")
+	ErrChannelCallbackExists     = errorsmod.Register(ModuleName, 14, "channel callback already registered")
+	ErrNotChannelContractKeeper  = errorsmod.Register(ModuleName, 15, "callback handler does not implement channel callbacks")
+	ErrCallbackAddressNotAllowed = errorsmod.Register(ModuleName, 16, "callback address not allowed")
+	ErrCallbackSenderMismatch    = errorsmod.Register(ModuleName, 17, "callback address is not the packet sender")
+	ErrCallbackGasBudgetExceeded = errorsmod.Register(ModuleName, 18, "callback address block gas budget exceeded")
+	ErrCallbackRouteGasExceeded  = errorsmod.Register(ModuleName, 19, "callback route gas limit exceeded")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,6 +12,12 @@ const (
 	EventTypeSourceCallback = "ibc_src_callback"
 	// EventTypeDestinationCallback is the event type for a destination callback
 	EventTypeDestinationCallback = "ibc_dest_callback"
+	// EventTypeChannelCallback is the event type for a channel lifecycle callback
+	EventTypeChannelCallback = "ibc_channel_callback"
+	// EventTypeCallbackRetryScheduled is the event type for a callback which ran out of gas and was stored for retry
+	EventTypeCallbackRetryScheduled = "ibc_callback_retry_scheduled"
+	// EventTypeCallbackRetryExpired is the event type for a pending callback which expired before being retried
+	EventTypeCallbackRetryExpired = "ibc_callback_retry_expired"
 
 	// AttributeKeyCallbackType denotes the condition that the callback is executed on:
 	//   "acknowledgement": the callback is executed on the acknowledgement of the packet
@@ -42,6 +49,12 @@ const (
 	AttributeKeyCallbackDestChannelID = "packet_dest_channel"
 	// AttributeKeyCallbackSequence denotes the sequence of the packet
 	AttributeKeyCallbackSequence = "packet_sequence"
+	// AttributeKeyCallbackPortID denotes the port ID the pending callback is scheduled on, or the port ID of the channel callback
+	AttributeKeyCallbackPortID = "port_id"
+	// AttributeKeyCallbackChannelID denotes the channel ID the pending callback is scheduled on, or the channel ID of the channel callback
+	AttributeKeyCallbackChannelID = "channel_id"
+	// AttributeKeyCallbackRetryExpiry denotes the block time after which a pending callback can no longer be retried
+	AttributeKeyCallbackRetryExpiry = "retry_expiry"
 
 	// AttributeValueCallbackSuccess denotes that the callback is successfully executed
 	AttributeValueCallbackSuccess = "success"
@@ -100,3 +113,56 @@ func EmitCallbackEvent(
 		),
 	)
 }
+
+// EmitChannelCallbackEvent emits an event for a channel lifecycle callback
+func EmitChannelCallbackEvent(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	callbackType CallbackType,
+	callbackData CallbackData,
+	err error,
+) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyCallbackType, string(callbackType)),
+		sdk.NewAttribute(AttributeKeyCallbackAddress, callbackData.CallbackAddress),
+		sdk.NewAttribute(AttributeKeyCallbackGasLimit, fmt.Sprintf("%d", callbackData.ExecutionGasLimit)),
+		sdk.NewAttribute(AttributeKeyCallbackCommitGasLimit, fmt.Sprintf("%d", callbackData.CommitGasLimit)),
+		sdk.NewAttribute(AttributeKeyCallbackPortID, portID),
+		sdk.NewAttribute(AttributeKeyCallbackChannelID, channelID),
+	}
+	if err == nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackSuccess))
+	} else {
+		attributes = append(
+			attributes,
+			sdk.NewAttribute(AttributeKeyCallbackError, err.Error()),
+			sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackFailure),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeChannelCallback,
+			attributes...,
+		),
+	)
+}
+
+// EmitCallbackRetryEvent emits an event of the given type for a pending callback
+func EmitCallbackRetryEvent(ctx sdk.Context, eventType string, pendingCallback PendingCallback) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyCallbackType, pendingCallback.CallbackType),
+			sdk.NewAttribute(AttributeKeyCallbackAddress, pendingCallback.CallbackAddress),
+			sdk.NewAttribute(AttributeKeyCallbackCommitGasLimit, fmt.Sprintf("%d", pendingCallback.CommitGasLimit)),
+			sdk.NewAttribute(AttributeKeyCallbackPortID, pendingCallback.GetPortID()),
+			sdk.NewAttribute(AttributeKeyCallbackChannelID, pendingCallback.GetChannelID()),
+			sdk.NewAttribute(AttributeKeyCallbackSequence, fmt.Sprintf("%d", pendingCallback.Packet.GetSequence())),
+			sdk.NewAttribute(AttributeKeyCallbackRetryExpiry, pendingCallback.Expiry.UTC().Format(time.RFC3339Nano)),
+		),
+	)
+}
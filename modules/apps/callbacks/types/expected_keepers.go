@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -81,3 +82,58 @@ type ContractKeeper interface {
 		contractAddress string,
 	) error
 }
+
+// ChannelContractKeeper defines the optional entry points exposed to the VM module for channel lifecycle
+// callbacks. Channel lifecycle callbacks are only executed for the callback address registered for a channel
+// using MsgRegisterChannelCallback. They are executed with a cached context and a gas limit of maxCallbackGas.
+// Errors and panics are not propagated to the underlying IBC application and do not block the channel
+// handshake or upgrade. If the callback runs out of gas because the relayer has not provided maxCallbackGas,
+// the transaction is reverted.
+type ChannelContractKeeper interface {
+	// IBCOnChanOpenCallback is called once the channel has been opened, that is when the channel is moved
+	// to the OPEN state in the ChanOpenAck or ChanOpenConfirm handshake steps.
+	IBCOnChanOpenCallback(
+		cachedCtx sdk.Context,
+		portID,
+		channelID,
+		version,
+		contractAddress string,
+	) error
+	// IBCOnChanCloseCallback is called once the channel has been closed, that is when the channel is moved
+	// to the CLOSED state in the ChanCloseInit or ChanCloseConfirm handshake steps.
+	IBCOnChanCloseCallback(
+		cachedCtx sdk.Context,
+		portID,
+		channelID,
+		contractAddress string,
+	) error
+	// IBCOnChanUpgradeOpenCallback is called once a channel upgrade has successfully completed and the
+	// channel has returned to the OPEN state with the upgraded parameters.
+	IBCOnChanUpgradeOpenCallback(
+		cachedCtx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version,
+		contractAddress string,
+	) error
+	// IBCOnChanUpgradeCancelCallback is called once a channel upgrade has been cancelled, timed out or
+	// aborted and the channel has been restored to its pre-upgrade parameters.
+	IBCOnChanUpgradeCancelCallback(
+		cachedCtx sdk.Context,
+		portID,
+		channelID,
+		contractAddress string,
+	) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	LookupModuleByPort(ctx sdk.Context, portID string) (string, *capabilitytypes.Capability, error)
+}
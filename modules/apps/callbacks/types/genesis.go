@@ -0,0 +1,51 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a callbacks middleware GenesisState instance.
+func NewGenesisState(params Params, pendingCallbacks []PendingCallback, channelCallbacks []ChannelCallback) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		PendingCallbacks: pendingCallbacks,
+		ChannelCallbacks: channelCallbacks,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with default params, no pending callbacks and no channel callbacks.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:           DefaultParams(),
+		PendingCallbacks: []PendingCallback{},
+		ChannelCallbacks: []ChannelCallback{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, pendingCallback := range gs.PendingCallbacks {
+		if err := pendingCallback.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, channelCallback := range gs.ChannelCallbacks {
+		if err := channelCallback.Validate(); err != nil {
+			return err
+		}
+
+		channelKey := string(ChannelCallbackKey(channelCallback.PortId, channelCallback.ChannelId))
+		if seenChannels[channelKey] {
+			return errorsmod.Wrapf(ErrChannelCallbackExists, "duplicate channel callback for port ID %s, channel ID %s", channelCallback.PortId, channelCallback.ChannelId)
+		}
+		seenChannels[channelKey] = true
+	}
+
+	return nil
+}
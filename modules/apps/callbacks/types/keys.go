@@ -1,15 +1,50 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type CallbackType string
 
 const (
 	ModuleName = "ibccallbacks"
 
+	// StoreKey is the store key string for the IBC callbacks middleware. It differs from the
+	// module name as store keys may not share a common prefix with the ibc store key.
+	StoreKey = "callbacks"
+
+	// TStoreKey is the transient store key string for the IBC callbacks middleware. The transient store
+	// holds the gas consumed by callbacks in the current block and is discarded when the block is committed.
+	TStoreKey = "transient_callbacks"
+
+	// ParamsKey is the store key for the IBC callbacks middleware parameters
+	ParamsKey = "params"
+
+	// PendingCallbackKeyPrefix is the key prefix for callbacks awaiting retry
+	PendingCallbackKeyPrefix = "pendingCallback"
+
+	// PendingCallbackExpiryKeyPrefix is the key prefix for the pending callback expiry queue
+	PendingCallbackExpiryKeyPrefix = "pendingCallbackExpiry"
+
+	// ChannelCallbackKeyPrefix is the key prefix for callback addresses registered for channel lifecycle callbacks
+	ChannelCallbackKeyPrefix = "channelCallback"
+
+	// CallbackGasUsageKeyPrefix is the key prefix for the gas consumed by callbacks to a callback address in the current block
+	CallbackGasUsageKeyPrefix = "callbackGasUsage"
+
 	CallbackTypeSendPacket            CallbackType = "send_packet"
 	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
 	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
 	CallbackTypeReceivePacket         CallbackType = "receive_packet"
 
+	CallbackTypeChannelOpen          CallbackType = "channel_open"
+	CallbackTypeChannelClose         CallbackType = "channel_close"
+	CallbackTypeChannelUpgradeOpen   CallbackType = "channel_upgrade_open"
+	CallbackTypeChannelUpgradeCancel CallbackType = "channel_upgrade_cancel"
+
 	// Source callback packet data is set inside the underlying packet data using the this key.
 	// ICS20 and ICS27 will store the callback packet data in the memo field as a json object.
 	// The expected format is as follows:
@@ -28,4 +63,38 @@ const (
 	// The expected format for ICS20 and ICS27 memo field is as follows:
 	// { "{callbackKey}": { ... , "gas_limit": {stringForCallback} }
 	UserDefinedGasLimitKey = "gas_limit"
+
+	// WasmPortPrefix is the prefix of the ports bound by CosmWasm contracts. The port identifier of a contract
+	// is the contract address prefixed with WasmPortPrefix, following the wasmd port identifier convention.
+	WasmPortPrefix = "wasm."
 )
+
+// PendingCallbackKey returns the store key under which a pending callback is stored
+// for the given callback type, port identifier, channel identifier and packet sequence.
+func PendingCallbackKey(callbackType CallbackType, portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%d", PendingCallbackKeyPrefix, callbackType, portID, channelID, sequence))
+}
+
+// PendingCallbackExpiryPrefix returns the prefix of the expiry queue for all
+// pending callbacks expiring at the given time.
+func PendingCallbackExpiryPrefix(expiry time.Time) []byte {
+	return append([]byte(PendingCallbackExpiryKeyPrefix+"/"), sdk.FormatTimeBytes(expiry)...)
+}
+
+// PendingCallbackExpiryKey returns the expiry queue key for a pending callback. The
+// value stored under the key is the pending callback store key.
+func PendingCallbackExpiryKey(expiry time.Time, pendingCallbackKey []byte) []byte {
+	return append(append(PendingCallbackExpiryPrefix(expiry), '/'), pendingCallbackKey...)
+}
+
+// ChannelCallbackKey returns the store key under which the callback address registered for the
+// channel lifecycle callbacks of the given channel is stored.
+func ChannelCallbackKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ChannelCallbackKeyPrefix, portID, channelID))
+}
+
+// CallbackGasUsageKey returns the store key under which the gas consumed by callbacks to the given
+// callback address in the current block is stored.
+func CallbackGasUsageKey(callbackAddress string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", CallbackGasUsageKeyPrefix, callbackAddress))
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgExecuteCallback)(nil)
+	_ sdk.HasValidateBasic = (*MsgExecuteCallback)(nil)
+
+	_ sdk.Msg              = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+
+	_ sdk.Msg              = (*MsgRegisterChannelCallback)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterChannelCallback)(nil)
+
+	_ sdk.Msg              = (*MsgDeregisterChannelCallback)(nil)
+	_ sdk.HasValidateBasic = (*MsgDeregisterChannelCallback)(nil)
+)
+
+// NewMsgExecuteCallback creates a new MsgExecuteCallback instance
+func NewMsgExecuteCallback(callbackType CallbackType, portID, channelID string, sequence uint64, signer string) *MsgExecuteCallback {
+	return &MsgExecuteCallback{
+		CallbackType: string(callbackType),
+		PortId:       portID,
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgExecuteCallback) ValidateBasic() error {
+	if err := ValidateRetryableCallbackType(CallbackType(msg.CallbackType)); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if msg.Sequence == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgRegisterChannelCallback creates a new MsgRegisterChannelCallback instance
+func NewMsgRegisterChannelCallback(portID, channelID, callbackAddress, signer string) *MsgRegisterChannelCallback {
+	return &MsgRegisterChannelCallback{
+		PortId:          portID,
+		ChannelId:       channelID,
+		CallbackAddress: callbackAddress,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgRegisterChannelCallback) ValidateBasic() error {
+	if err := NewChannelCallback(msg.PortId, msg.ChannelId, msg.CallbackAddress).Validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgDeregisterChannelCallback creates a new MsgDeregisterChannelCallback instance
+func NewMsgDeregisterChannelCallback(portID, channelID, signer string) *MsgDeregisterChannelCallback {
+	return &MsgDeregisterChannelCallback{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgDeregisterChannelCallback) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
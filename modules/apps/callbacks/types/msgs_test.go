@@ -0,0 +1,142 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestMsgExecuteCallbackValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgExecuteCallback
+		expPass bool
+	}{
+		{
+			"success",
+			types.NewMsgExecuteCallback(types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1, ibctesting.TestAccAddress),
+			true,
+		},
+		{
+			"failure: send packet callbacks cannot be retried",
+			types.NewMsgExecuteCallback(types.CallbackTypeSendPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1, ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewMsgExecuteCallback(types.CallbackTypeTimeoutPacket, "", ibctesting.FirstChannelID, 1, ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: invalid channel ID",
+			types.NewMsgExecuteCallback(types.CallbackTypeReceivePacket, ibctesting.MockPort, "channel", 1, ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: zero sequence",
+			types.NewMsgExecuteCallback(types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 0, ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: invalid signer",
+			types.NewMsgExecuteCallback(types.CallbackTypeAcknowledgementPacket, ibctesting.MockPort, ibctesting.FirstChannelID, 1, "signer"),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterChannelCallbackValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterChannelCallback
+		expPass bool
+	}{
+		{
+			"success",
+			types.NewMsgRegisterChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.TestAccAddress, ibctesting.TestAccAddress),
+			true,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewMsgRegisterChannelCallback("", ibctesting.FirstChannelID, ibctesting.TestAccAddress, ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: invalid channel ID",
+			types.NewMsgRegisterChannelCallback(ibctesting.MockPort, "channel", ibctesting.TestAccAddress, ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: empty callback address",
+			types.NewMsgRegisterChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, " ", ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: invalid signer",
+			types.NewMsgRegisterChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.TestAccAddress, "signer"),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgDeregisterChannelCallbackValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgDeregisterChannelCallback
+		expPass bool
+	}{
+		{
+			"success",
+			types.NewMsgDeregisterChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.TestAccAddress),
+			true,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewMsgDeregisterChannelCallback("", ibctesting.FirstChannelID, ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: invalid channel ID",
+			types.NewMsgDeregisterChannelCallback(ibctesting.MockPort, "channel", ibctesting.TestAccAddress),
+			false,
+		},
+		{
+			"failure: invalid signer",
+			types.NewMsgDeregisterChannelCallback(ibctesting.MockPort, ibctesting.FirstChannelID, "signer"),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultRetryWindow is the default duration for which a callback that ran out of gas may be retried.
+const DefaultRetryWindow = 7 * 24 * time.Hour
+
+// NewParams creates a new parameter configuration for the callbacks middleware
+func NewParams(
+	retryWindow time.Duration, allowedCallbackAddresses, deniedCallbackAddresses []string,
+	maxBlockGasPerAddress uint64, requireSenderCallbackAddress bool,
+) Params {
+	return Params{
+		RetryWindow:                  retryWindow,
+		AllowedCallbackAddresses:     allowedCallbackAddresses,
+		DeniedCallbackAddresses:      deniedCallbackAddresses,
+		MaxBlockGasPerAddress:        maxBlockGasPerAddress,
+		RequireSenderCallbackAddress: requireSenderCallbackAddress,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the callbacks middleware.
+// By default all callback addresses are permitted and the gas consumed by callbacks is not limited per block.
+func DefaultParams() Params {
+	return NewParams(DefaultRetryWindow, nil, nil, 0, false)
+}
+
+// Validate validates all callbacks middleware parameters
+func (p Params) Validate() error {
+	if p.RetryWindow <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "retry window must be positive, got %s", p.RetryWindow)
+	}
+
+	if err := validateCallbackAddresses(p.AllowedCallbackAddresses); err != nil {
+		return errorsmod.Wrap(err, "invalid allowed callback addresses")
+	}
+
+	if err := validateCallbackAddresses(p.DeniedCallbackAddresses); err != nil {
+		return errorsmod.Wrap(err, "invalid denied callback addresses")
+	}
+
+	for _, address := range p.DeniedCallbackAddresses {
+		if slices.Contains(p.AllowedCallbackAddresses, address) {
+			return errorsmod.Wrapf(ErrInvalidParams, "callback address %s cannot be both allowed and denied", address)
+		}
+	}
+
+	return nil
+}
+
+// IsCallbackAddressAllowed returns true if callbacks to the provided callback address are permitted.
+// A callback address is permitted if it is not denied and either the allowlist is empty or it contains
+// the callback address.
+func (p Params) IsCallbackAddressAllowed(callbackAddress string) bool {
+	if slices.Contains(p.DeniedCallbackAddresses, callbackAddress) {
+		return false
+	}
+
+	return len(p.AllowedCallbackAddresses) == 0 || slices.Contains(p.AllowedCallbackAddresses, callbackAddress)
+}
+
+// validateCallbackAddresses returns an error if the provided list contains empty or duplicate callback addresses.
+func validateCallbackAddresses(callbackAddresses []string) error {
+	seen := make(map[string]struct{}, len(callbackAddresses))
+	for _, address := range callbackAddresses {
+		if strings.TrimSpace(address) == "" {
+			return errorsmod.Wrap(ErrInvalidParams, "callback address cannot be empty")
+		}
+
+		if _, ok := seen[address]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate callback address %s", address)
+		}
+		seen[address] = struct{}{}
+	}
+
+	return nil
+}
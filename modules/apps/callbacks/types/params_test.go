@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{
+			"success: default params",
+			types.DefaultParams(),
+			true,
+		},
+		{
+			"success: allowlist, denylist and block gas budget",
+			types.NewParams(time.Hour, []string{"cosmos1allowed"}, []string{"cosmos1denied"}, 1_000_000, true),
+			true,
+		},
+		{
+			"failure: zero retry window",
+			types.NewParams(0, nil, nil, 0, false),
+			false,
+		},
+		{
+			"failure: empty allowed callback address",
+			types.NewParams(time.Hour, []string{" "}, nil, 0, false),
+			false,
+		},
+		{
+			"failure: duplicate denied callback address",
+			types.NewParams(time.Hour, nil, []string{"cosmos1denied", "cosmos1denied"}, 0, false),
+			false,
+		},
+		{
+			"failure: callback address is both allowed and denied",
+			types.NewParams(time.Hour, []string{"cosmos1address"}, []string{"cosmos1address"}, 0, false),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestIsCallbackAddressAllowed(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsCallbackAddressAllowed("cosmos1address"))
+
+	params.DeniedCallbackAddresses = []string{"cosmos1denied"}
+	require.True(t, params.IsCallbackAddressAllowed("cosmos1address"))
+	require.False(t, params.IsCallbackAddressAllowed("cosmos1denied"))
+
+	params.AllowedCallbackAddresses = []string{"cosmos1allowed"}
+	require.True(t, params.IsCallbackAddressAllowed("cosmos1allowed"))
+	require.False(t, params.IsCallbackAddressAllowed("cosmos1address"))
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ ibcexported.Acknowledgement = (*pendingAcknowledgement)(nil)
+
+// RetryGasReserve is the amount of gas withheld from the execution gas limit of callbacks which may be
+// retried. After such a callback has used up its execution gas, the reserved gas allows the packet
+// handler to complete so that the callback stored for retry is committed instead of being reverted by
+// an out of gas panic.
+const RetryGasReserve uint64 = 100_000
+
+// NewPendingCallback creates a new PendingCallback instance for a callback which ran out of gas.
+// The acknowledgement is empty for timeout packet callbacks and the acknowledgement success flag
+// is only used by receive packet callbacks.
+func NewPendingCallback(
+	callbackType CallbackType, packet ibcexported.PacketI, acknowledgement []byte, acknowledgementSuccess bool,
+	relayer string, callbackData CallbackData, expiry time.Time,
+) PendingCallback {
+	return PendingCallback{
+		CallbackType: string(callbackType),
+		Packet: channeltypes.NewPacket(
+			packet.GetData(), packet.GetSequence(), packet.GetSourcePort(), packet.GetSourceChannel(),
+			packet.GetDestPort(), packet.GetDestChannel(),
+			clienttypes.NewHeight(packet.GetTimeoutHeight().GetRevisionNumber(), packet.GetTimeoutHeight().GetRevisionHeight()),
+			packet.GetTimeoutTimestamp(),
+		),
+		Acknowledgement:        acknowledgement,
+		AcknowledgementSuccess: acknowledgementSuccess,
+		Relayer:                relayer,
+		CallbackAddress:        callbackData.CallbackAddress,
+		SenderAddress:          callbackData.SenderAddress,
+		CommitGasLimit:         callbackData.CommitGasLimit,
+		Expiry:                 expiry,
+	}
+}
+
+// GetCallbackType returns the callback type of the pending callback.
+func (pc PendingCallback) GetCallbackType() CallbackType {
+	return CallbackType(pc.CallbackType)
+}
+
+// GetPortID returns the port identifier the callback is scheduled on. Destination callbacks
+// are identified by the destination port, all other callbacks by the source port.
+func (pc PendingCallback) GetPortID() string {
+	if pc.GetCallbackType() == CallbackTypeReceivePacket {
+		return pc.Packet.GetDestPort()
+	}
+
+	return pc.Packet.GetSourcePort()
+}
+
+// GetChannelID returns the channel identifier the callback is scheduled on. Destination callbacks
+// are identified by the destination channel, all other callbacks by the source channel.
+func (pc PendingCallback) GetChannelID() string {
+	if pc.GetCallbackType() == CallbackTypeReceivePacket {
+		return pc.Packet.GetDestChannel()
+	}
+
+	return pc.Packet.GetSourceChannel()
+}
+
+// GetAcknowledgement returns the stored acknowledgement as an ibcexported.Acknowledgement.
+func (pc PendingCallback) GetAcknowledgement() ibcexported.Acknowledgement {
+	return pendingAcknowledgement{
+		acknowledgement: pc.Acknowledgement,
+		success:         pc.AcknowledgementSuccess,
+	}
+}
+
+// Validate performs basic validation of the pending callback.
+func (pc PendingCallback) Validate() error {
+	if err := ValidateRetryableCallbackType(pc.GetCallbackType()); err != nil {
+		return err
+	}
+
+	if err := pc.Packet.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(pc.CallbackAddress) == "" {
+		return ErrCallbackAddressNotFound
+	}
+
+	if pc.CommitGasLimit == 0 {
+		return errorsmod.Wrap(ErrCallbackOutOfGas, "commit gas limit cannot be zero")
+	}
+
+	return nil
+}
+
+// ValidateRetryableCallbackType returns an error if the provided callback type may not be retried.
+// Send packet callbacks are never stored for retry as their failure rejects the packet send.
+func ValidateRetryableCallbackType(callbackType CallbackType) error {
+	switch callbackType {
+	case CallbackTypeAcknowledgementPacket, CallbackTypeTimeoutPacket, CallbackTypeReceivePacket:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidCallbackType, "callback type %s cannot be retried", callbackType)
+	}
+}
+
+// pendingAcknowledgement wraps the acknowledgement of a stored receive packet callback.
+type pendingAcknowledgement struct {
+	acknowledgement []byte
+	success         bool
+}
+
+// Success implements the Acknowledgement interface.
+func (ack pendingAcknowledgement) Success() bool {
+	return ack.success
+}
+
+// Acknowledgement implements the Acknowledgement interface.
+func (ack pendingAcknowledgement) Acknowledgement() []byte {
+	return ack.acknowledgement
+}
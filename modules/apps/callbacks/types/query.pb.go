@@ -0,0 +1,2445 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/callbacks/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// QueryPendingCallbacksRequest defines the request type for the PendingCallbacks rpc
+type QueryPendingCallbacksRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingCallbacksRequest) Reset()         { *m = QueryPendingCallbacksRequest{} }
+func (m *QueryPendingCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbacksRequest) ProtoMessage()    {}
+func (*QueryPendingCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{2}
+}
+func (m *QueryPendingCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbacksRequest.Merge(m, src)
+}
+func (m *QueryPendingCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryPendingCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingCallbacksResponse defines the response type for the PendingCallbacks rpc
+type QueryPendingCallbacksResponse struct {
+	// list of callbacks awaiting retry
+	PendingCallbacks []PendingCallback `protobuf:"bytes,1,rep,name=pending_callbacks,json=pendingCallbacks,proto3" json:"pending_callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingCallbacksResponse) Reset()         { *m = QueryPendingCallbacksResponse{} }
+func (m *QueryPendingCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbacksResponse) ProtoMessage()    {}
+func (*QueryPendingCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{3}
+}
+func (m *QueryPendingCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbacksResponse.Merge(m, src)
+}
+func (m *QueryPendingCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryPendingCallbacksResponse) GetPendingCallbacks() []PendingCallback {
+	if m != nil {
+		return m.PendingCallbacks
+	}
+	return nil
+}
+
+func (m *QueryPendingCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingCallbackRequest defines the request type for the PendingCallback rpc
+type QueryPendingCallbackRequest struct {
+	// the callback type of the pending callback
+	CallbackType string `protobuf:"bytes,1,opt,name=callback_type,json=callbackType,proto3" json:"callback_type,omitempty"`
+	// the port identifier the callback was scheduled on
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier the callback was scheduled on
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the packet sequence
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryPendingCallbackRequest) Reset()         { *m = QueryPendingCallbackRequest{} }
+func (m *QueryPendingCallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbackRequest) ProtoMessage()    {}
+func (*QueryPendingCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{4}
+}
+func (m *QueryPendingCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbackRequest.Merge(m, src)
+}
+func (m *QueryPendingCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbackRequest proto.InternalMessageInfo
+
+func (m *QueryPendingCallbackRequest) GetCallbackType() string {
+	if m != nil {
+		return m.CallbackType
+	}
+	return ""
+}
+
+func (m *QueryPendingCallbackRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPendingCallbackRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPendingCallbackRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryPendingCallbackResponse defines the response type for the PendingCallback rpc
+type QueryPendingCallbackResponse struct {
+	// the callback awaiting retry
+	PendingCallback PendingCallback `protobuf:"bytes,1,opt,name=pending_callback,json=pendingCallback,proto3" json:"pending_callback"`
+}
+
+func (m *QueryPendingCallbackResponse) Reset()         { *m = QueryPendingCallbackResponse{} }
+func (m *QueryPendingCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbackResponse) ProtoMessage()    {}
+func (*QueryPendingCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{5}
+}
+func (m *QueryPendingCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbackResponse.Merge(m, src)
+}
+func (m *QueryPendingCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbackResponse proto.InternalMessageInfo
+
+func (m *QueryPendingCallbackResponse) GetPendingCallback() PendingCallback {
+	if m != nil {
+		return m.PendingCallback
+	}
+	return PendingCallback{}
+}
+
+// QueryChannelCallbackRequest defines the request type for the ChannelCallback rpc
+type QueryChannelCallbackRequest struct {
+	// the port identifier of the channel
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelCallbackRequest) Reset()         { *m = QueryChannelCallbackRequest{} }
+func (m *QueryChannelCallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelCallbackRequest) ProtoMessage()    {}
+func (*QueryChannelCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{6}
+}
+func (m *QueryChannelCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelCallbackRequest.Merge(m, src)
+}
+func (m *QueryChannelCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelCallbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelCallbackRequest proto.InternalMessageInfo
+
+func (m *QueryChannelCallbackRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryChannelCallbackRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryChannelCallbackResponse defines the response type for the ChannelCallback rpc
+type QueryChannelCallbackResponse struct {
+	// the callback address registered for the channel
+	CallbackAddress string `protobuf:"bytes,1,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+}
+
+func (m *QueryChannelCallbackResponse) Reset()         { *m = QueryChannelCallbackResponse{} }
+func (m *QueryChannelCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelCallbackResponse) ProtoMessage()    {}
+func (*QueryChannelCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{7}
+}
+func (m *QueryChannelCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelCallbackResponse.Merge(m, src)
+}
+func (m *QueryChannelCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelCallbackResponse proto.InternalMessageInfo
+
+func (m *QueryChannelCallbackResponse) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
+	}
+	return ""
+}
+
+// QueryCallbackAddressStatusRequest defines the request type for the CallbackAddressStatus rpc
+type QueryCallbackAddressStatusRequest struct {
+	// the callback address
+	CallbackAddress string `protobuf:"bytes,1,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+}
+
+func (m *QueryCallbackAddressStatusRequest) Reset()         { *m = QueryCallbackAddressStatusRequest{} }
+func (m *QueryCallbackAddressStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackAddressStatusRequest) ProtoMessage()    {}
+func (*QueryCallbackAddressStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{8}
+}
+func (m *QueryCallbackAddressStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbackAddressStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackAddressStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallbackAddressStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackAddressStatusRequest.Merge(m, src)
+}
+func (m *QueryCallbackAddressStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbackAddressStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackAddressStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallbackAddressStatusRequest proto.InternalMessageInfo
+
+func (m *QueryCallbackAddressStatusRequest) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
+	}
+	return ""
+}
+
+// QueryCallbackAddressStatusResponse defines the response type for the CallbackAddressStatus rpc
+type QueryCallbackAddressStatusResponse struct {
+	// true if the callback address is permitted to receive callbacks by the allowlist and denylist
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// the gas consumed by callbacks to the callback address in the current block
+	BlockGasUsed uint64 `protobuf:"varint,2,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+	// the maximum amount of gas callbacks to the callback address may consume within a block, zero if unlimited
+	MaxBlockGas uint64 `protobuf:"varint,3,opt,name=max_block_gas,json=maxBlockGas,proto3" json:"max_block_gas,omitempty"`
+}
+
+func (m *QueryCallbackAddressStatusResponse) Reset()         { *m = QueryCallbackAddressStatusResponse{} }
+func (m *QueryCallbackAddressStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackAddressStatusResponse) ProtoMessage()    {}
+func (*QueryCallbackAddressStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{9}
+}
+func (m *QueryCallbackAddressStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCallbackAddressStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackAddressStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCallbackAddressStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackAddressStatusResponse.Merge(m, src)
+}
+func (m *QueryCallbackAddressStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCallbackAddressStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackAddressStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCallbackAddressStatusResponse proto.InternalMessageInfo
+
+func (m *QueryCallbackAddressStatusResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QueryCallbackAddressStatusResponse) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
+func (m *QueryCallbackAddressStatusResponse) GetMaxBlockGas() uint64 {
+	if m != nil {
+		return m.MaxBlockGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.callbacks.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.callbacks.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingCallbacksRequest)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbacksRequest")
+	proto.RegisterType((*QueryPendingCallbacksResponse)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbacksResponse")
+	proto.RegisterType((*QueryPendingCallbackRequest)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbackRequest")
+	proto.RegisterType((*QueryPendingCallbackResponse)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbackResponse")
+	proto.RegisterType((*QueryChannelCallbackRequest)(nil), "ibc.applications.callbacks.v1.QueryChannelCallbackRequest")
+	proto.RegisterType((*QueryChannelCallbackResponse)(nil), "ibc.applications.callbacks.v1.QueryChannelCallbackResponse")
+	proto.RegisterType((*QueryCallbackAddressStatusRequest)(nil), "ibc.applications.callbacks.v1.QueryCallbackAddressStatusRequest")
+	proto.RegisterType((*QueryCallbackAddressStatusResponse)(nil), "ibc.applications.callbacks.v1.QueryCallbackAddressStatusResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/callbacks/v1/query.proto", fileDescriptor_8e264909e6193ff2)
+}
+
+var fileDescriptor_8e264909e6193ff2 = []byte{
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x4f, 0xe3, 0x46,
+	0x18, 0x8d, 0x43, 0x1a, 0x60, 0x80, 0x26, 0x9d, 0x52, 0x35, 0x4a, 0x49, 0xa0, 0x6e, 0x69, 0x03,
+	0x12, 0x9e, 0x26, 0x55, 0x2f, 0x85, 0x56, 0x05, 0xaa, 0x22, 0x2e, 0x94, 0xba, 0xd0, 0x43, 0x2f,
+	0xd1, 0xd8, 0x9e, 0x1a, 0x0b, 0xc7, 0x63, 0x32, 0x0e, 0x05, 0x45, 0x51, 0xa5, 0x5e, 0x76, 0x8f,
+	0x2b, 0xad, 0xc4, 0x9f, 0xb2, 0xd7, 0xbd, 0x22, 0xed, 0x05, 0x69, 0x2f, 0x7b, 0x5a, 0xad, 0xc2,
+	0xfe, 0x21, 0x2b, 0xcf, 0x8c, 0x4d, 0xe2, 0x4d, 0x42, 0xc8, 0xde, 0xec, 0x6f, 0xbe, 0x1f, 0xef,
+	0x3d, 0xcf, 0xf7, 0x64, 0xb0, 0xe6, 0x18, 0x26, 0xc2, 0xbe, 0xef, 0x3a, 0x26, 0x0e, 0x1c, 0xea,
+	0x31, 0x64, 0x62, 0xd7, 0x35, 0xb0, 0x79, 0xca, 0xd0, 0x79, 0x15, 0x9d, 0xb5, 0x48, 0xf3, 0x52,
+	0xf3, 0x9b, 0x34, 0xa0, 0xb0, 0xe4, 0x18, 0xa6, 0xd6, 0x9b, 0xaa, 0xc5, 0xa9, 0xda, 0x79, 0xb5,
+	0xb8, 0x68, 0x53, 0x9b, 0xf2, 0x4c, 0x14, 0x3e, 0x89, 0xa2, 0xe2, 0x92, 0x4d, 0xa9, 0xed, 0x12,
+	0x84, 0x7d, 0x07, 0x61, 0xcf, 0xa3, 0x81, 0x2c, 0x15, 0xa7, 0xeb, 0x26, 0x65, 0x0d, 0xca, 0x90,
+	0x81, 0x19, 0x11, 0xb3, 0xd0, 0x79, 0xd5, 0x20, 0x01, 0xae, 0x22, 0x1f, 0xdb, 0x8e, 0xc7, 0x93,
+	0x65, 0xee, 0xc6, 0x68, 0xa4, 0x77, 0x58, 0x78, 0xba, 0xba, 0x08, 0xe0, 0x1f, 0x61, 0xc3, 0x43,
+	0xdc, 0xc4, 0x0d, 0xa6, 0x93, 0xb3, 0x16, 0x61, 0x81, 0x7a, 0x04, 0x3e, 0xed, 0x8b, 0x32, 0x9f,
+	0x7a, 0x8c, 0xc0, 0x9f, 0x40, 0xd6, 0xe7, 0x91, 0x82, 0xb2, 0xa2, 0x54, 0xe6, 0x6a, 0xab, 0xda,
+	0x48, 0xae, 0x9a, 0x2c, 0x97, 0x45, 0xea, 0x3f, 0x60, 0x49, 0x74, 0x25, 0x9e, 0xe5, 0x78, 0xf6,
+	0x6e, 0x94, 0x2a, 0xa7, 0xc2, 0xdf, 0x00, 0xb8, 0xa3, 0x23, 0x47, 0x7c, 0xa3, 0x09, 0xee, 0x5a,
+	0xc8, 0x5d, 0x13, 0x3a, 0x4b, 0xee, 0xda, 0x21, 0xb6, 0x89, 0xac, 0xd5, 0x7b, 0x2a, 0xd5, 0x17,
+	0x0a, 0x28, 0x0d, 0x19, 0x24, 0x89, 0x60, 0xf0, 0x89, 0x2f, 0xce, 0xea, 0x31, 0xe0, 0x82, 0xb2,
+	0x32, 0x55, 0x99, 0xab, 0x69, 0xf7, 0x71, 0xea, 0xef, 0xb9, 0x93, 0xb9, 0x7e, 0xbd, 0x9c, 0xd2,
+	0xf3, 0x7e, 0x62, 0x14, 0xdc, 0xeb, 0x23, 0x93, 0xe6, 0x64, 0xbe, 0xbd, 0x97, 0x8c, 0xc0, 0xd7,
+	0xc7, 0xe6, 0x4a, 0x01, 0x5f, 0x0c, 0x62, 0x13, 0xa9, 0xf6, 0x15, 0x58, 0x88, 0x00, 0xd6, 0x83,
+	0x4b, 0x9f, 0x70, 0xe1, 0x66, 0xf5, 0xf9, 0x28, 0x78, 0x74, 0xe9, 0x13, 0xf8, 0x39, 0x98, 0xf6,
+	0x69, 0x33, 0xa8, 0x3b, 0x16, 0x87, 0x32, 0xab, 0x67, 0xc3, 0xd7, 0x7d, 0x0b, 0x96, 0x00, 0x30,
+	0x4f, 0xb0, 0xe7, 0x11, 0x37, 0x3c, 0x9b, 0xe2, 0x67, 0xb3, 0x32, 0xb2, 0x6f, 0xc1, 0x22, 0x98,
+	0x61, 0xe1, 0x1c, 0xcf, 0x24, 0x85, 0xcc, 0x8a, 0x52, 0xc9, 0xe8, 0xf1, 0xbb, 0xfa, 0xdf, 0xe0,
+	0xcf, 0x19, 0x8b, 0x5c, 0x07, 0xf9, 0xa4, 0xc8, 0xf2, 0xa3, 0x4e, 0xa6, 0x71, 0x2e, 0xa1, 0xb1,
+	0x7a, 0x2c, 0x85, 0xd9, 0x15, 0x70, 0x93, 0xc2, 0xf4, 0x70, 0x56, 0x46, 0x70, 0x4e, 0x27, 0x38,
+	0xab, 0xfb, 0x60, 0x69, 0x70, 0x5b, 0xc9, 0x6b, 0x0d, 0xe4, 0x63, 0xc1, 0xb1, 0x65, 0x35, 0x09,
+	0x63, 0x72, 0x40, 0x2e, 0x8a, 0x6f, 0x8b, 0xb0, 0x7a, 0x00, 0xbe, 0x14, 0xad, 0xfa, 0xe3, 0x7f,
+	0x06, 0x38, 0x68, 0xc5, 0xd7, 0xfe, 0x01, 0xfd, 0x1e, 0x2b, 0x40, 0x1d, 0xd5, 0x50, 0x22, 0x2c,
+	0x80, 0x69, 0xec, 0xba, 0xf4, 0x5f, 0x22, 0x98, 0xcf, 0xe8, 0xd1, 0x2b, 0xfc, 0x1a, 0x7c, 0x6c,
+	0xb8, 0xd4, 0x3c, 0xad, 0xdb, 0x98, 0xd5, 0x5b, 0x8c, 0x08, 0xfa, 0x19, 0x7d, 0x9e, 0x47, 0xf7,
+	0x30, 0x3b, 0x66, 0xc4, 0x82, 0x2a, 0x58, 0x68, 0xe0, 0x8b, 0x7a, 0x9c, 0xc9, 0xef, 0x45, 0x46,
+	0x9f, 0x6b, 0xe0, 0x8b, 0x1d, 0x99, 0x57, 0x7b, 0x36, 0x03, 0x3e, 0xe2, 0x50, 0xe0, 0x95, 0x02,
+	0xb2, 0x62, 0xd3, 0x61, 0xf5, 0x9e, 0x0f, 0xfb, 0xbe, 0xd5, 0x14, 0x6b, 0x0f, 0x29, 0x11, 0xfc,
+	0xd4, 0xd5, 0xff, 0x5f, 0xbe, 0x7d, 0x9a, 0x5e, 0x86, 0x25, 0x24, 0xcd, 0x2e, 0x61, 0x72, 0xc2,
+	0x6f, 0xe0, 0x73, 0x05, 0xe4, 0x93, 0x16, 0x00, 0x37, 0xc7, 0x9a, 0x37, 0xd8, 0xa1, 0x8a, 0x5b,
+	0x93, 0x15, 0x4b, 0xd8, 0xdf, 0x71, 0xd8, 0xeb, 0xb0, 0x32, 0x0c, 0x76, 0xd2, 0x92, 0xe0, 0xa3,
+	0x34, 0xc8, 0x25, 0xda, 0xc1, 0x1f, 0x27, 0xc0, 0x10, 0xe1, 0xdf, 0x9c, 0xa8, 0x56, 0xc2, 0x6f,
+	0x73, 0xf8, 0x2d, 0xc8, 0xc6, 0x85, 0x8f, 0xda, 0x7d, 0xc6, 0xd4, 0x41, 0xe1, 0xfe, 0x31, 0xd4,
+	0x96, 0x5b, 0xd9, 0x41, 0x72, 0xe7, 0xc2, 0xc4, 0x78, 0x1f, 0x3b, 0x28, 0x32, 0x18, 0x86, 0xda,
+	0xd1, 0x63, 0x07, 0x76, 0x15, 0x90, 0x4b, 0x2c, 0xe4, 0x78, 0x4a, 0x0c, 0x36, 0x87, 0xe2, 0xe6,
+	0x44, 0xb5, 0x52, 0x89, 0xbf, 0xb8, 0x12, 0x87, 0xf0, 0x60, 0x88, 0x12, 0x11, 0x9b, 0xbb, 0xe0,
+	0x58, 0xcc, 0x43, 0x92, 0x9f, 0x0d, 0xdc, 0x6c, 0xf8, 0xcb, 0x58, 0x70, 0x47, 0xb8, 0x4c, 0x71,
+	0xfb, 0x03, 0x3a, 0x48, 0xda, 0xbf, 0x72, 0xda, 0x3f, 0xc3, 0xad, 0x61, 0xb4, 0x13, 0x2e, 0x46,
+	0x7a, 0x6f, 0x80, 0x8c, 0x75, 0x76, 0x7e, 0xbf, 0xee, 0x96, 0x95, 0x9b, 0x6e, 0x59, 0x79, 0xd3,
+	0x2d, 0x2b, 0x4f, 0x6e, 0xcb, 0xa9, 0x9b, 0xdb, 0x72, 0xea, 0xd5, 0x6d, 0x39, 0xf5, 0xf7, 0x0f,
+	0xb6, 0x13, 0x9c, 0xb4, 0x0c, 0xcd, 0xa4, 0x0d, 0x24, 0xff, 0x78, 0x1c, 0xc3, 0xdc, 0xb0, 0x29,
+	0x6a, 0x50, 0xab, 0xe5, 0x12, 0x96, 0x9c, 0x19, 0xde, 0x2a, 0x66, 0x64, 0xf9, 0x9f, 0xcc, 0xf7,
+	0xef, 0x06, 0x00, 0x99, 0xc5, 0xbe, 0xa3, 0xa4, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the callbacks middleware.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingCallbacks returns all callbacks awaiting retry
+	PendingCallbacks(ctx context.Context, in *QueryPendingCallbacksRequest, opts ...grpc.CallOption) (*QueryPendingCallbacksResponse, error)
+	// PendingCallback returns the pending callback for the given callback type and packet identifiers
+	PendingCallback(ctx context.Context, in *QueryPendingCallbackRequest, opts ...grpc.CallOption) (*QueryPendingCallbackResponse, error)
+	// ChannelCallback returns the callback address registered for the channel lifecycle callbacks of a channel
+	ChannelCallback(ctx context.Context, in *QueryChannelCallbackRequest, opts ...grpc.CallOption) (*QueryChannelCallbackResponse, error)
+	// CallbackAddressStatus returns whether callbacks to the given callback address are permitted and the gas
+	// consumed by callbacks to the callback address in the current block
+	CallbackAddressStatus(ctx context.Context, in *QueryCallbackAddressStatusRequest, opts ...grpc.CallOption) (*QueryCallbackAddressStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCallbacks(ctx context.Context, in *QueryPendingCallbacksRequest, opts ...grpc.CallOption) (*QueryPendingCallbacksResponse, error) {
+	out := new(QueryPendingCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/PendingCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCallback(ctx context.Context, in *QueryPendingCallbackRequest, opts ...grpc.CallOption) (*QueryPendingCallbackResponse, error) {
+	out := new(QueryPendingCallbackResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/PendingCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelCallback(ctx context.Context, in *QueryChannelCallbackRequest, opts ...grpc.CallOption) (*QueryChannelCallbackResponse, error) {
+	out := new(QueryChannelCallbackResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/ChannelCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallbackAddressStatus(ctx context.Context, in *QueryCallbackAddressStatusRequest, opts ...grpc.CallOption) (*QueryCallbackAddressStatusResponse, error) {
+	out := new(QueryCallbackAddressStatusResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/CallbackAddressStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the callbacks middleware.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingCallbacks returns all callbacks awaiting retry
+	PendingCallbacks(context.Context, *QueryPendingCallbacksRequest) (*QueryPendingCallbacksResponse, error)
+	// PendingCallback returns the pending callback for the given callback type and packet identifiers
+	PendingCallback(context.Context, *QueryPendingCallbackRequest) (*QueryPendingCallbackResponse, error)
+	// ChannelCallback returns the callback address registered for the channel lifecycle callbacks of a channel
+	ChannelCallback(context.Context, *QueryChannelCallbackRequest) (*QueryChannelCallbackResponse, error)
+	// CallbackAddressStatus returns whether callbacks to the given callback address are permitted and the gas
+	// consumed by callbacks to the callback address in the current block
+	CallbackAddressStatus(context.Context, *QueryCallbackAddressStatusRequest) (*QueryCallbackAddressStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PendingCallbacks(ctx context.Context, req *QueryPendingCallbacksRequest) (*QueryPendingCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCallbacks not implemented")
+}
+func (*UnimplementedQueryServer) PendingCallback(ctx context.Context, req *QueryPendingCallbackRequest) (*QueryPendingCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCallback not implemented")
+}
+func (*UnimplementedQueryServer) ChannelCallback(ctx context.Context, req *QueryChannelCallbackRequest) (*QueryChannelCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCallback not implemented")
+}
+func (*UnimplementedQueryServer) CallbackAddressStatus(ctx context.Context, req *QueryCallbackAddressStatusRequest) (*QueryCallbackAddressStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbackAddressStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/PendingCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCallbacks(ctx, req.(*QueryPendingCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/PendingCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCallback(ctx, req.(*QueryPendingCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/ChannelCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelCallback(ctx, req.(*QueryChannelCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallbackAddressStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbackAddressStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallbackAddressStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/CallbackAddressStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallbackAddressStatus(ctx, req.(*QueryCallbackAddressStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.callbacks.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingCallbacks",
+			Handler:    _Query_PendingCallbacks_Handler,
+		},
+		{
+			MethodName: "PendingCallback",
+			Handler:    _Query_PendingCallback_Handler,
+		},
+		{
+			MethodName: "ChannelCallback",
+			Handler:    _Query_ChannelCallback_Handler,
+		},
+		{
+			MethodName: "CallbackAddressStatus",
+			Handler:    _Query_CallbackAddressStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/callbacks/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingCallbacks) > 0 {
+		for iNdEx := len(m.PendingCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackType) > 0 {
+		i -= len(m.CallbackType)
+		copy(dAtA[i:], m.CallbackType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingCallback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackAddressStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackAddressStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackAddressStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackAddressStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackAddressStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackAddressStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlockGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingCallbacks) > 0 {
+		for _, e := range m.PendingCallbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingCallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPendingCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingCallback.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelCallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbackAddressStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCallbackAddressStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.BlockGasUsed))
+	}
+	if m.MaxBlockGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlockGas))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCallbacks = append(m.PendingCallbacks, PendingCallback{})
+			if err := m.PendingCallbacks[len(m.PendingCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCallback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingCallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelCallbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelCallbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbackAddressStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackAddressStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackAddressStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCallbackAddressStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackAddressStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackAddressStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGas", wireType)
+			}
+			m.MaxBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,279 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var (
+	_ ContractKeeper        = (*CallbackRouter)(nil)
+	_ ChannelContractKeeper = (*CallbackRouter)(nil)
+)
+
+// CallbackRoute defines a callback handler together with the maximum amount of gas
+// it may consume for a single callback execution. A gas limit of zero means the handler
+// is only limited by the gas limit provided by the callbacks middleware.
+type CallbackRoute struct {
+	Handler        ContractKeeper
+	MaxCallbackGas uint64
+}
+
+// prefixRoute defines a callback route registered for all callback addresses
+// starting with the given prefix.
+type prefixRoute struct {
+	prefix string
+	route  CallbackRoute
+}
+
+// CallbackRouter dispatches IBC callbacks to the ContractKeeper registered for the callback address.
+// Handlers may be registered for an exact address, such as a module account, or for an address
+// prefix, such as the addresses of a smart contract VM. Exact routes take precedence over prefix
+// routes and the longest matching prefix is chosen when several prefix routes match.
+// The CallbackRouter implements the ContractKeeper interface and may be provided to the callbacks
+// middleware in place of a single ContractKeeper.
+type CallbackRouter struct {
+	routes       map[string]CallbackRoute
+	prefixRoutes []prefixRoute
+	sealed       bool
+}
+
+// NewCallbackRouter creates a new CallbackRouter instance without any registered routes.
+func NewCallbackRouter() *CallbackRouter {
+	return &CallbackRouter{
+		routes: make(map[string]CallbackRoute),
+	}
+}
+
+// Seal prevents the CallbackRouter from any subsequent route handlers to be registered.
+// Seal will panic if called more than once.
+func (rtr *CallbackRouter) Seal() {
+	if rtr.sealed {
+		panic(errors.New("callback router already sealed"))
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the CallbackRouter is sealed or not.
+func (rtr CallbackRouter) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute registers the handler for callbacks addressed to the given callback address.
+// It returns the CallbackRouter so AddRoute calls can be linked. It will panic if the
+// CallbackRouter is sealed or if a route is already registered for the address.
+func (rtr *CallbackRouter) AddRoute(address string, handler ContractKeeper, maxCallbackGas uint64) *CallbackRouter {
+	if rtr.sealed {
+		panic(fmt.Errorf("callback router sealed; cannot register %s route", address))
+	}
+	if strings.TrimSpace(address) == "" {
+		panic(errors.New("callback route address cannot be empty"))
+	}
+	if handler == nil {
+		panic(fmt.Errorf("callback route %s handler cannot be nil", address))
+	}
+	if _, ok := rtr.routes[address]; ok {
+		panic(fmt.Errorf("callback route %s has already been registered", address))
+	}
+
+	rtr.routes[address] = CallbackRoute{Handler: handler, MaxCallbackGas: maxCallbackGas}
+	return rtr
+}
+
+// AddPrefixRoute registers the handler for callbacks addressed to any callback address starting
+// with the given prefix. It returns the CallbackRouter so AddPrefixRoute calls can be linked. It will
+// panic if the CallbackRouter is sealed or if a route is already registered for the prefix.
+func (rtr *CallbackRouter) AddPrefixRoute(prefix string, handler ContractKeeper, maxCallbackGas uint64) *CallbackRouter {
+	if rtr.sealed {
+		panic(fmt.Errorf("callback router sealed; cannot register %s prefix route", prefix))
+	}
+	if strings.TrimSpace(prefix) == "" {
+		panic(errors.New("callback route prefix cannot be empty"))
+	}
+	if handler == nil {
+		panic(fmt.Errorf("callback prefix route %s handler cannot be nil", prefix))
+	}
+	for _, r := range rtr.prefixRoutes {
+		if r.prefix == prefix {
+			panic(fmt.Errorf("callback prefix route %s has already been registered", prefix))
+		}
+	}
+
+	rtr.prefixRoutes = append(rtr.prefixRoutes, prefixRoute{
+		prefix: prefix,
+		route:  CallbackRoute{Handler: handler, MaxCallbackGas: maxCallbackGas},
+	})
+
+	// keep the prefix routes sorted by descending prefix length so that the longest prefix matches first
+	sort.SliceStable(rtr.prefixRoutes, func(i, j int) bool {
+		return len(rtr.prefixRoutes[i].prefix) > len(rtr.prefixRoutes[j].prefix)
+	})
+
+	return rtr
+}
+
+// HasRoute returns true if a route matches the provided callback address or false otherwise.
+func (rtr *CallbackRouter) HasRoute(address string) bool {
+	_, ok := rtr.GetRoute(address)
+	return ok
+}
+
+// GetRoute returns the CallbackRoute matching the provided callback address.
+func (rtr *CallbackRouter) GetRoute(address string) (CallbackRoute, bool) {
+	if route, ok := rtr.routes[address]; ok {
+		return route, true
+	}
+
+	for _, r := range rtr.prefixRoutes {
+		if strings.HasPrefix(address, r.prefix) {
+			return r.route, true
+		}
+	}
+
+	return CallbackRoute{}, false
+}
+
+// IBCSendPacketCallback dispatches the send packet callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCSendPacketCallback(
+	cachedCtx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	packetData []byte,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return rtr.dispatch(cachedCtx, contractAddress, func(ctx sdk.Context, handler ContractKeeper) error {
+		return handler.IBCSendPacketCallback(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, contractAddress, packetSenderAddress)
+	})
+}
+
+// IBCOnAcknowledgementPacketCallback dispatches the acknowledgement packet callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCOnAcknowledgementPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return rtr.dispatch(cachedCtx, contractAddress, func(ctx sdk.Context, handler ContractKeeper) error {
+		return handler.IBCOnAcknowledgementPacketCallback(ctx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress)
+	})
+}
+
+// IBCOnTimeoutPacketCallback dispatches the timeout packet callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCOnTimeoutPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return rtr.dispatch(cachedCtx, contractAddress, func(ctx sdk.Context, handler ContractKeeper) error {
+		return handler.IBCOnTimeoutPacketCallback(ctx, packet, relayer, contractAddress, packetSenderAddress)
+	})
+}
+
+// IBCReceivePacketCallback dispatches the receive packet callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCReceivePacketCallback(
+	cachedCtx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	contractAddress string,
+) error {
+	return rtr.dispatch(cachedCtx, contractAddress, func(ctx sdk.Context, handler ContractKeeper) error {
+		return handler.IBCReceivePacketCallback(ctx, packet, ack, contractAddress)
+	})
+}
+
+// IBCOnChanOpenCallback dispatches the channel open callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCOnChanOpenCallback(cachedCtx sdk.Context, portID, channelID, version, contractAddress string) error {
+	return rtr.dispatchChannelCallback(cachedCtx, contractAddress, func(ctx sdk.Context, handler ChannelContractKeeper) error {
+		return handler.IBCOnChanOpenCallback(ctx, portID, channelID, version, contractAddress)
+	})
+}
+
+// IBCOnChanCloseCallback dispatches the channel close callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCOnChanCloseCallback(cachedCtx sdk.Context, portID, channelID, contractAddress string) error {
+	return rtr.dispatchChannelCallback(cachedCtx, contractAddress, func(ctx sdk.Context, handler ChannelContractKeeper) error {
+		return handler.IBCOnChanCloseCallback(ctx, portID, channelID, contractAddress)
+	})
+}
+
+// IBCOnChanUpgradeOpenCallback dispatches the channel upgrade open callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCOnChanUpgradeOpenCallback(
+	cachedCtx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version,
+	contractAddress string,
+) error {
+	return rtr.dispatchChannelCallback(cachedCtx, contractAddress, func(ctx sdk.Context, handler ChannelContractKeeper) error {
+		return handler.IBCOnChanUpgradeOpenCallback(ctx, portID, channelID, order, connectionHops, version, contractAddress)
+	})
+}
+
+// IBCOnChanUpgradeCancelCallback dispatches the channel upgrade cancel callback to the handler registered for the contract address.
+func (rtr *CallbackRouter) IBCOnChanUpgradeCancelCallback(cachedCtx sdk.Context, portID, channelID, contractAddress string) error {
+	return rtr.dispatchChannelCallback(cachedCtx, contractAddress, func(ctx sdk.Context, handler ChannelContractKeeper) error {
+		return handler.IBCOnChanUpgradeCancelCallback(ctx, portID, channelID, contractAddress)
+	})
+}
+
+// dispatchChannelCallback executes the channel lifecycle callback using the handler registered for the contract
+// address. An error is returned if the handler does not implement the ChannelContractKeeper interface.
+func (rtr *CallbackRouter) dispatchChannelCallback(ctx sdk.Context, contractAddress string, callback func(sdk.Context, ChannelContractKeeper) error) error {
+	return rtr.dispatch(ctx, contractAddress, func(ctx sdk.Context, handler ContractKeeper) error {
+		channelHandler, ok := handler.(ChannelContractKeeper)
+		if !ok {
+			return errorsmod.Wrapf(ErrNotChannelContractKeeper, "callback address: %s", contractAddress)
+		}
+
+		return callback(ctx, channelHandler)
+	})
+}
+
+// dispatch executes the callback using the handler registered for the contract address. If the route
+// defines a gas limit lower than the gas remaining in the context, the handler is executed with a gas
+// meter limited to the route gas limit. Exceeding the route gas limit results in an ErrCallbackRouteGasExceeded
+// error rather than an out of gas panic or an ErrCallbackOutOfGas error, since providing more gas to the
+// callbacks middleware would not allow the callback to succeed and the callback is therefore never retried.
+func (rtr *CallbackRouter) dispatch(ctx sdk.Context, contractAddress string, callback func(sdk.Context, ContractKeeper) error) (err error) {
+	route, ok := rtr.GetRoute(contractAddress)
+	if !ok {
+		return errorsmod.Wrapf(ErrCallbackRouteNotFound, "callback address: %s", contractAddress)
+	}
+
+	remainingGas := ctx.GasMeter().GasRemaining()
+	if route.MaxCallbackGas == 0 || route.MaxCallbackGas >= remainingGas {
+		return callback(ctx, route.Handler)
+	}
+
+	handlerCtx := ctx.WithGasMeter(storetypes.NewGasMeter(route.MaxCallbackGas))
+	defer func() {
+		ctx.GasMeter().ConsumeGas(handlerCtx.GasMeter().GasConsumedToLimit(), "ibc callback route")
+
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok || !handlerCtx.GasMeter().IsPastLimit() {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(ErrCallbackRouteGasExceeded, "callback address %s exceeded route gas limit %d", contractAddress, route.MaxCallbackGas)
+		}
+	}()
+
+	return callback(handlerCtx, route.Handler)
+}
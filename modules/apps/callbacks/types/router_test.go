@@ -0,0 +1,219 @@
+package types_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+var _ types.ContractKeeper = (*mockCallbackHandler)(nil)
+
+// mockCallbackHandler records the callback addresses it was invoked with and consumes the configured amount of gas.
+type mockCallbackHandler struct {
+	gasConsumed uint64
+	addresses   []string
+}
+
+func (h *mockCallbackHandler) handle(ctx sdk.Context, contractAddress string) error {
+	h.addresses = append(h.addresses, contractAddress)
+	ctx.GasMeter().ConsumeGas(h.gasConsumed, "mock callback handler")
+	return nil
+}
+
+func (h *mockCallbackHandler) IBCSendPacketCallback(ctx sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, contractAddress, _ string) error {
+	return h.handle(ctx, contractAddress)
+}
+
+func (h *mockCallbackHandler) IBCOnAcknowledgementPacketCallback(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, contractAddress, _ string) error {
+	return h.handle(ctx, contractAddress)
+}
+
+func (h *mockCallbackHandler) IBCOnTimeoutPacketCallback(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, contractAddress, _ string) error {
+	return h.handle(ctx, contractAddress)
+}
+
+func (h *mockCallbackHandler) IBCReceivePacketCallback(ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress string) error {
+	return h.handle(ctx, contractAddress)
+}
+
+func (s *CallbacksTypesTestSuite) TestCallbackRouterDispatch() {
+	moduleHandler := &mockCallbackHandler{}
+	contractHandler := &mockCallbackHandler{}
+	nestedContractHandler := &mockCallbackHandler{}
+
+	router := types.NewCallbackRouter().
+		AddRoute("cosmos1module", moduleHandler, 0).
+		AddPrefixRoute("cosmos1", contractHandler, 0).
+		AddPrefixRoute("cosmos1nested", nestedContractHandler, 0)
+	router.Seal()
+
+	s.Require().True(router.Sealed())
+	s.Require().True(router.HasRoute("cosmos1module"))
+	s.Require().True(router.HasRoute("cosmos1contract"))
+	s.Require().False(router.HasRoute("osmo1contract"))
+
+	ctx := s.chain.GetContext()
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	s.Require().NoError(router.IBCSendPacketCallback(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 0, nil, "cosmos1module", ""))
+	s.Require().NoError(router.IBCOnAcknowledgementPacketCallback(ctx, packet, nil, nil, "cosmos1contract", ""))
+	s.Require().NoError(router.IBCOnTimeoutPacketCallback(ctx, packet, nil, "cosmos1nestedcontract", ""))
+	s.Require().NoError(router.IBCReceivePacketCallback(ctx, packet, ibcmock.MockAcknowledgement, "cosmos1contract"))
+
+	s.Require().Equal([]string{"cosmos1module"}, moduleHandler.addresses)
+	s.Require().Equal([]string{"cosmos1contract", "cosmos1contract"}, contractHandler.addresses)
+	s.Require().Equal([]string{"cosmos1nestedcontract"}, nestedContractHandler.addresses)
+
+	err := router.IBCReceivePacketCallback(ctx, packet, ibcmock.MockAcknowledgement, "osmo1contract")
+	s.Require().ErrorIs(err, types.ErrCallbackRouteNotFound)
+
+	s.Require().Panics(func() {
+		router.AddRoute("cosmos1other", moduleHandler, 0)
+	}, "route added to sealed router")
+	s.Require().Panics(func() {
+		router.Seal()
+	}, "router sealed twice")
+}
+
+func (s *CallbacksTypesTestSuite) TestCallbackRouterAddRoute() {
+	handler := &mockCallbackHandler{}
+
+	testCases := []struct {
+		name     string
+		malleate func(router *types.CallbackRouter)
+		expPanic bool
+	}{
+		{
+			"success",
+			func(router *types.CallbackRouter) {
+				router.AddRoute("cosmos1other", handler, 0).AddPrefixRoute("osmo1", handler, 0)
+			},
+			false,
+		},
+		{
+			"failure: duplicate route",
+			func(router *types.CallbackRouter) {
+				router.AddRoute("cosmos1module", handler, 0)
+			},
+			true,
+		},
+		{
+			"failure: duplicate prefix route",
+			func(router *types.CallbackRouter) {
+				router.AddPrefixRoute("cosmos1", handler, 0)
+			},
+			true,
+		},
+		{
+			"failure: empty address",
+			func(router *types.CallbackRouter) {
+				router.AddRoute(" ", handler, 0)
+			},
+			true,
+		},
+		{
+			"failure: empty prefix",
+			func(router *types.CallbackRouter) {
+				router.AddPrefixRoute("", handler, 0)
+			},
+			true,
+		},
+		{
+			"failure: nil handler",
+			func(router *types.CallbackRouter) {
+				router.AddRoute("cosmos1other", nil, 0)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			router := types.NewCallbackRouter().
+				AddRoute("cosmos1module", handler, 0).
+				AddPrefixRoute("cosmos1", handler, 0)
+
+			if tc.expPanic {
+				s.Require().Panics(func() { tc.malleate(router) })
+			} else {
+				s.Require().NotPanics(func() { tc.malleate(router) })
+			}
+		})
+	}
+}
+
+func (s *CallbacksTypesTestSuite) TestCallbackRouterGasLimit() {
+	const routeGasLimit = uint64(100_000)
+
+	testCases := []struct {
+		name           string
+		gasConsumed    uint64
+		gasLimit       uint64
+		expGasConsumed uint64
+		expErr         error
+		expPanic       bool
+	}{
+		{
+			"success: handler consumes less than the route gas limit",
+			routeGasLimit - 1,
+			1_000_000,
+			routeGasLimit - 1,
+			nil,
+			false,
+		},
+		{
+			"failure: handler exceeds the route gas limit",
+			routeGasLimit + 1,
+			1_000_000,
+			routeGasLimit,
+			types.ErrCallbackRouteGasExceeded,
+			false,
+		},
+		{
+			"failure: handler exceeds the gas limit provided by the middleware which is lower than the route gas limit",
+			routeGasLimit - 1,
+			routeGasLimit / 2,
+			0,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			handler := &mockCallbackHandler{gasConsumed: tc.gasConsumed}
+			router := types.NewCallbackRouter().AddRoute("cosmos1module", handler, routeGasLimit)
+
+			ctx := s.chain.GetContext().WithGasMeter(storetypes.NewGasMeter(tc.gasLimit))
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			if tc.expPanic {
+				s.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "mock callback handler"}, func() {
+					_ = router.IBCOnTimeoutPacketCallback(ctx, packet, nil, "cosmos1module", "")
+				})
+				return
+			}
+
+			err := router.IBCOnTimeoutPacketCallback(ctx, packet, nil, "cosmos1module", "")
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+				// exceeding the route gas limit must not be treated as a retryable out of gas error
+				s.Require().NotErrorIs(err, types.ErrCallbackOutOfGas)
+			}
+			s.Require().Equal(tc.expGasConsumed, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
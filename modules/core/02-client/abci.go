@@ -8,7 +8,8 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
-// BeginBlocker is used to perform IBC client upgrades
+// BeginBlocker is used to perform IBC client upgrades, to emit warnings for clients approaching expiry,
+// to notify the client hooks of expired clients and to prune expired consensus states
 func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 	plan, err := k.GetUpgradePlan(ctx)
 	if err == nil {
@@ -39,4 +40,8 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 			k.UpdateLocalhostClient(ctx, clientState)
 		}
 	}
+
+	k.EmitClientExpiryWarnings(ctx)
+	k.CheckExpiredClients(ctx)
+	k.PruneExpiredConsensusStates(ctx)
 }
@@ -22,12 +22,16 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryDeletableClients(),
+		GetCmdQueryExpiringClients(),
+		GetCmdQueryCounterparty(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
 		GetCmdClientParams(),
+		GetCmdClientCreationPolicy(),
 	)
 
 	return queryCmd
@@ -48,7 +52,10 @@ func NewTxCmd() *cobra.Command {
 		newUpdateClientCmd(),
 		newSubmitMisbehaviourCmd(), // Deprecated
 		newUpgradeClientCmd(),
+		newDeleteClientCmd(),
+		newProvideCounterpartyCmd(),
 		newSubmitRecoverClientProposalCmd(),
+		newSubmitPruneConsensusStatesProposalCmd(),
 		newScheduleIBCUpgradeProposalCmd(),
 	)
 
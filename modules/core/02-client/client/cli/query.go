@@ -17,6 +17,7 @@ import (
 
 const (
 	flagLatestHeight = "latest-height"
+	flagThreshold    = "threshold"
 )
 
 // GetCmdQueryClientStates defines the command to query all the light clients
@@ -58,6 +59,90 @@ func GetCmdQueryClientStates() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDeletableClients defines the command to query all expired or frozen clients which may be deleted.
+func GetCmdQueryDeletableClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deletable",
+		Short:   "Query all light clients which may be deleted",
+		Long:    "Query all expired or frozen light clients which are not referenced by any open connection and may be deleted",
+		Example: fmt.Sprintf("%s query %s %s deletable", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeletableClientsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DeletableClients(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deletable clients")
+
+	return cmd
+}
+
+// GetCmdQueryExpiringClients defines the command to query all clients sorted by their remaining trusting period.
+func GetCmdQueryExpiringClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring",
+		Short: "Query light clients sorted by their remaining trusting period",
+		Long: `Query all active and expired light clients which expire after a trusting period, sorted by their remaining trusting period.
+If a threshold is provided, only clients whose remaining trusting period is less than or equal to the threshold are returned.`,
+		Example: fmt.Sprintf("%s query %s %s expiring --%s 72h", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagThreshold),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			threshold, err := cmd.Flags().GetDuration(flagThreshold)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryExpiringClientsRequest{
+				Threshold:  threshold,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ExpiringClients(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Duration(flagThreshold, 0, "only return clients with a remaining trusting period less than or equal to the threshold")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring clients")
+
+	return cmd
+}
+
 // GetCmdQueryClientState defines the command to query the state of a client with
 // a given id as defined in https://github.com/cosmos/ibc/tree/master/spec/core/ics-002-client-semantics#query
 func GetCmdQueryClientState() *cobra.Command {
@@ -125,6 +210,41 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCounterparty defines the command to query the counterparty of a client with a given id
+func GetCmdQueryCounterparty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty [client-id]",
+		Short:   "Query the counterparty of a client",
+		Long:    "Query the counterparty client identifier and merkle path prefix provided for a client",
+		Example: fmt.Sprintf("%s query %s %s counterparty [client-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCounterpartyRequest{
+				ClientId: clientID,
+			}
+
+			res, err := queryClient.Counterparty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -336,3 +456,36 @@ func GetCmdClientParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdClientCreationPolicy returns the command handler for querying the client creation policy of a client type.
+func GetCmdClientCreationPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "creation-policy [client-type]",
+		Short:   "Query the client creation policy of a client type",
+		Long:    "Query the client creation policy configured for a client type",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s %s creation-policy 07-tendermint", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClientCreationPolicyRequest{
+				ClientType: args[0],
+			}
+
+			res, err := queryClient.ClientCreationPolicy(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Policy)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -20,10 +21,14 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-const FlagAuthority = "authority"
+const (
+	FlagAuthority = "authority"
+	FlagOverrides = "overrides"
+)
 
 // newCreateClientCmd defines the command to create a new IBC light client.
 func newCreateClientCmd() *cobra.Command {
@@ -245,6 +250,58 @@ func newUpgradeClientCmd() *cobra.Command {
 	return cmd
 }
 
+// newDeleteClientCmd defines the command to delete an expired or frozen IBC client.
+func newDeleteClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete [client-id]",
+		Short:   "delete an expired or frozen client",
+		Long:    "delete an expired or frozen client and all of its associated state. Any account may delete the client once the client deletion grace period has passed.",
+		Example: fmt.Sprintf("%s tx ibc %s delete [client-id] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteClient(args[0], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newProvideCounterpartyCmd defines the command to provide the counterparty of an IBC light client.
+func newProvideCounterpartyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provide-counterparty [client-id] [counterparty-client-id] [counterparty-merkle-path-prefix]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "provide the counterparty of a client",
+		Long:    "provide the counterparty client identifier and merkle path prefix of a client, enabling packets to be routed by client identifier. The merkle path prefix is a comma separated list of keys. Only the creator of the client may provide its counterparty.",
+		Example: fmt.Sprintf("%s tx ibc %s provide-counterparty 07-tendermint-0 07-tendermint-1 ibc --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			merklePathPrefix := commitmenttypes.NewMerklePath(strings.Split(args[2], ",")...)
+			counterparty := types.NewCounterparty(args[1], merklePathPrefix)
+			msg := types.NewMsgProvideCounterparty(args[0], counterparty, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // newSubmitRecoverClientProposalCmd defines the command to recover an IBC light client.
 func newSubmitRecoverClientProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -253,7 +310,9 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 		Short: "recover an IBC client",
 		Long: `Submit a recover IBC client proposal along with an initial deposit
 		Please specify a subject client identifier you want to recover
-		Please specify the substitute client the subject client will be recovered to.`,
+		Please specify the substitute client the subject client will be recovered to.
+		Optionally specify overrides of the client state parameters of the recovered client, as JSON or as a path to a .json file.`,
+		Example: fmt.Sprintf(`%s tx ibc %s recover-client 07-tendermint-0 07-tendermint-1 --overrides '{"trusting_period":"1209600s","trust_level_numerator":"1","trust_level_denominator":"3"}' --title "recover client" --summary "recover client with overrides" --from node0 --home ../node0/<app>cli --chain-id $CID`, version.AppName, types.SubModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -276,7 +335,25 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
 			}
 
-			msg := types.NewMsgRecoverClient(authority, subjectClientID, substituteClientID)
+			var overrides *types.RecoveryOverrides
+			if overridesContentOrFileName, _ := cmd.Flags().GetString(FlagOverrides); overridesContentOrFileName != "" {
+				cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+				overrides = &types.RecoveryOverrides{}
+				if err := cdc.UnmarshalJSON([]byte(overridesContentOrFileName), overrides); err != nil {
+					// check for file path if JSON input is not provided
+					contents, err := os.ReadFile(overridesContentOrFileName)
+					if err != nil {
+						return fmt.Errorf("neither JSON input nor path to .json file for recovery overrides were provided: %w", err)
+					}
+
+					if err := cdc.UnmarshalJSON(contents, overrides); err != nil {
+						return fmt.Errorf("error unmarshalling recovery overrides file: %w", err)
+					}
+				}
+			}
+
+			msg := types.NewMsgRecoverClientWithOverrides(authority, subjectClientID, substituteClientID, overrides)
 
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("error validating %T: %w", types.MsgRecoverClient{}, err)
@@ -290,6 +367,67 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
+	cmd.Flags().String(FlagOverrides, "", "Overrides of the client state parameters of the recovered client, as JSON or as a path to a .json file")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// newSubmitPruneConsensusStatesProposalCmd defines the command to prune expired consensus states of an IBC light client.
+func newSubmitPruneConsensusStatesProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-consensus-states [client-id] [limit] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "prune expired consensus states of an IBC client",
+		Long: `Submit a proposal to prune expired consensus states of an IBC client along with an initial deposit
+		Please specify the client identifier and the maximum number of consensus states to prune.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			limit, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid limit: %w", err)
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			msg := types.NewMsgPruneConsensusStates(clientID, limit, authority)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgPruneConsensusStates{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create prune consensus states proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
 	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
 
 	flags.AddTxFlagsToCmd(cmd)
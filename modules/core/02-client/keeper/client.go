@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	metrics "github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
@@ -17,7 +19,16 @@ import (
 // client identifier. The light client module is responsible for setting any client-specific data in the store
 // via the Initialize method. This includes the client state, initial consensus state and any associated
 // metadata. The generated client identifier will be returned if a client was successfully initialized.
+// The client is created without a creator, see CreateClientWithCreator.
 func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, consensusState []byte) (string, error) {
+	return k.CreateClientWithCreator(ctx, clientType, clientState, consensusState, "")
+}
+
+// CreateClientWithCreator creates a new client as described in CreateClient on behalf of the provided creator.
+// If a client creation policy is configured for the client type, it is evaluated against the creator and the
+// client state before the client is initialized, an empty creator is only permitted by policies which do not
+// restrict the allowed creators.
+func (k *Keeper) CreateClientWithCreator(ctx sdk.Context, clientType string, clientState, consensusState []byte, creator string) (string, error) {
 	if clientType == exported.Localhost {
 		return "", errorsmod.Wrapf(types.ErrInvalidClientType, "cannot create client of type: %s", clientType)
 	}
@@ -37,6 +48,12 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 		return "", errorsmod.Wrap(types.ErrRouteNotFound, clientID)
 	}
 
+	if policy, found := params.GetClientCreationPolicy(clientType); found {
+		if err := verifyClientCreationPolicy(policy, clientModule, creator, clientState); err != nil {
+			return "", err
+		}
+	}
+
 	if err := clientModule.Initialize(ctx, clientID, clientState, consensusState); err != nil {
 		return "", err
 	}
@@ -60,6 +77,8 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 }
 
 // UpdateClient updates the consensus state and the state root from a provided header.
+// Light client modules may accept client messages containing a batch of headers, in which case
+// a single update event is emitted containing every consensus height stored by the update.
 func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
 	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
@@ -97,6 +116,8 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 
 		emitSubmitMisbehaviourEvent(ctx, clientID, clientType)
 
+		k.onClientFrozen(ctx, clientID)
+
 		return nil
 	}
 
@@ -159,6 +180,8 @@ func (k *Keeper) UpgradeClient(
 
 	emitUpgradeClientEvent(ctx, clientID, clientType, latestHeight)
 
+	k.onClientUpgraded(ctx, clientID)
+
 	return nil
 }
 
@@ -168,6 +191,14 @@ func (k *Keeper) UpgradeClient(
 // as well as copying the necessary consensus states from the substitute to the subject client store.
 // The substitute must be Active and the subject must not be Active.
 func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	return k.RecoverClientWithOverrides(ctx, subjectClientID, substituteClientID, nil)
+}
+
+// RecoverClientWithOverrides recovers the subject client given a substitute client identifier, as RecoverClient does,
+// and additionally overrides the client state parameters of the recovered client with the provided overrides. If the
+// overrides are not empty, the light client module of the subject client must implement the types.RecoveryOverridesModule
+// interface, and is responsible for validating the overrides as well as the resulting client state.
+func (k *Keeper) RecoverClientWithOverrides(ctx sdk.Context, subjectClientID, substituteClientID string, overrides *types.RecoveryOverrides) error {
 	if status := k.GetClientStatus(ctx, subjectClientID); status == exported.Active {
 		return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover %s subject client", exported.Active)
 	}
@@ -192,8 +223,19 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 		return errorsmod.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectLatestHeight, substituteLatestHeight)
 	}
 
-	if err := clientModule.RecoverClient(ctx, subjectClientID, substituteClientID); err != nil {
-		return err
+	if overrides.IsEmpty() {
+		if err := clientModule.RecoverClient(ctx, subjectClientID, substituteClientID); err != nil {
+			return err
+		}
+	} else {
+		overridesModule, ok := clientModule.(types.RecoveryOverridesModule)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "light client module for client type %s does not support recovery overrides", clientType)
+		}
+
+		if err := overridesModule.RecoverClientWithOverrides(ctx, subjectClientID, substituteClientID, overrides); err != nil {
+			return err
+		}
 	}
 
 	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
@@ -211,5 +253,156 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 	// emitting events in the keeper for recovering clients
 	emitRecoverClientEvent(ctx, subjectClientID, clientType)
 
+	k.onClientRecovered(ctx, subjectClientID, substituteClientID)
+
+	return nil
+}
+
+// DeleteClient removes all state associated with an Expired or Frozen client, including the client state,
+// consensus states and metadata, by invoking the associated light client module. A client cannot be deleted
+// while it is referenced by a connection which has not been closed.
+func (k *Keeper) DeleteClient(ctx sdk.Context, clientID string) error {
+	if clientID == exported.LocalhostClientID {
+		return errorsmod.Wrapf(types.ErrInvalidClientType, "cannot delete client: %s", exported.LocalhostClientID)
+	}
+
+	if err := k.validateClientDeletable(ctx, clientID); err != nil {
+		return err
+	}
+
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to parse client identifier %s", clientID)
+	}
+
+	clientModule, found := k.router.GetRoute(clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	if err := clientModule.DeleteClient(ctx, clientID); err != nil {
+		return err
+	}
+
+	k.deleteReportedExpiry(ctx, clientID)
+	k.deleteEmittedExpiryWarning(ctx, clientID)
+
+	k.Logger(ctx).Info("client deleted", "client-id", clientID)
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "delete"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, clientType),
+			telemetry.NewLabel(types.LabelClientID, clientID),
+		},
+	)
+
+	emitDeleteClientEvent(ctx, clientID, clientType)
+
+	return nil
+}
+
+// PruneConsensusStates prunes at most limit expired consensus states of the client by invoking the associated light
+// client module, which must implement the exported.ConsensusStatePruningModule interface. The number of consensus
+// states pruned is returned.
+func (k *Keeper) PruneConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error) {
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "unable to parse client identifier %s", clientID)
+	}
+
+	clientModule, found := k.router.GetRoute(clientID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	pruningModule, ok := clientModule.(exported.ConsensusStatePruningModule)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrPruningNotSupported, "client type %s", clientType)
+	}
+
+	pruned, err := pruningModule.PruneExpiredConsensusStates(ctx, clientID, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	if pruned == 0 {
+		return 0, nil
+	}
+
+	k.Logger(ctx).Info("consensus states pruned", "client-id", clientID, "pruned", pruned)
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "prune"},
+		float32(pruned),
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, clientType),
+			telemetry.NewLabel(types.LabelClientID, clientID),
+		},
+	)
+
+	emitPruneConsensusStatesEvent(ctx, clientID, clientType, pruned)
+
+	return pruned, nil
+}
+
+// IsPermissionlessDeletionAllowed returns true if the client deletion grace period has passed since the timestamp
+// of the latest consensus state of the client, in which case any account may delete the client. It returns false
+// if the grace period param is not set.
+func (k *Keeper) IsPermissionlessDeletionAllowed(ctx sdk.Context, clientID string) bool {
+	params := k.GetParams(ctx)
+	if params.ClientDeletionGracePeriod == 0 {
+		return false
+	}
+
+	timestamp, err := k.GetClientTimestampAtHeight(ctx, clientID, k.GetClientLatestHeight(ctx, clientID))
+	if err != nil {
+		return false
+	}
+
+	return params.IsPermissionlessDeletionAllowed(time.Unix(0, int64(timestamp)), ctx.BlockTime())
+}
+
+// validateClientDeletable returns an error if the client is not Expired or Frozen, or if the client is
+// referenced by a connection which has not been closed.
+func (k *Keeper) validateClientDeletable(ctx sdk.Context, clientID string) error {
+	switch status := k.GetClientStatus(ctx, clientID); status {
+	case exported.Expired, exported.Frozen:
+	case exported.Unknown:
+		return errorsmod.Wrap(types.ErrClientNotFound, clientID)
+	default:
+		return errorsmod.Wrapf(types.ErrClientNotDeletable, "cannot delete client (%s) with status %s", clientID, status)
+	}
+
+	if connectionID, found := k.getOpenClientConnection(ctx, clientID); found {
+		return errorsmod.Wrapf(types.ErrClientInUse, "client (%s) is referenced by connection (%s)", clientID, connectionID)
+	}
+
 	return nil
 }
+
+// verifyClientCreationPolicy evaluates the client creation policy against the creator and the client state
+// bytes. The client state is only decoded if the policy constrains fields of the client state, in which case
+// the light client module must implement exported.ClientStateDecoderModule.
+func verifyClientCreationPolicy(policy types.ClientCreationPolicy, clientModule exported.LightClientModule, creator string, clientStateBz []byte) error {
+	if err := policy.VerifyCreator(creator); err != nil {
+		return err
+	}
+
+	if !policy.HasClientStateConstraints() {
+		return nil
+	}
+
+	decoderModule, ok := clientModule.(exported.ClientStateDecoderModule)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrClientCreationPolicyViolation, "light client module for client type %s cannot decode client states", policy.ClientType)
+	}
+
+	clientState, err := decoderModule.DecodeClientState(clientStateBz)
+	if err != nil {
+		return err
+	}
+
+	return policy.VerifyClientState(clientState)
+}
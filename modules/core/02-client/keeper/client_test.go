@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
@@ -22,8 +23,21 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	var (
 		clientState    []byte
 		consensusState []byte
+		creator        string
 	)
 
+	setTendermintClientState := func() {
+		tmClientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+		clientState = suite.chainA.App.AppCodec().MustMarshal(tmClientState)
+		consensusState = suite.chainA.App.AppCodec().MustMarshal(suite.consensusState)
+	}
+
+	setClientCreationPolicy := func(policy clienttypes.ClientCreationPolicy) {
+		params := clienttypes.DefaultParams()
+		params.ClientCreationPolicies = []clienttypes.ClientCreationPolicy{policy}
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+	}
+
 	testCases := []struct {
 		msg        string
 		malleate   func()
@@ -62,6 +76,98 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 			exported.Solomachine,
 			true,
 		},
+		{
+			"success: 07-tendermint client satisfies creation policy",
+			func() {
+				setTendermintClientState()
+				setClientCreationPolicy(clienttypes.ClientCreationPolicy{
+					ClientType:             exported.Tendermint,
+					AllowedCreators:        []string{ibctesting.TestAccAddress},
+					AllowedChainIds:        []string{testChainID},
+					MaxTrustingPeriodRatio: "0.67",
+					MinTrustLevel:          "0.333",
+				})
+			},
+			exported.Tendermint,
+			true,
+		},
+		{
+			"failure: creator not allowed by creation policy",
+			func() {
+				setTendermintClientState()
+				setClientCreationPolicy(clienttypes.ClientCreationPolicy{
+					ClientType:      exported.Tendermint,
+					AllowedCreators: []string{ibctesting.TestAccAddress},
+				})
+				creator = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			exported.Tendermint,
+			false,
+		},
+		{
+			"failure: client created without a creator is not allowed by creation policy",
+			func() {
+				setTendermintClientState()
+				setClientCreationPolicy(clienttypes.ClientCreationPolicy{
+					ClientType:      exported.Tendermint,
+					AllowedCreators: []string{ibctesting.TestAccAddress},
+				})
+				creator = ""
+			},
+			exported.Tendermint,
+			false,
+		},
+		{
+			"failure: chain ID not allowed by creation policy",
+			func() {
+				setTendermintClientState()
+				setClientCreationPolicy(clienttypes.ClientCreationPolicy{
+					ClientType:      exported.Tendermint,
+					AllowedChainIds: []string{testChainIDRevision1},
+				})
+			},
+			exported.Tendermint,
+			false,
+		},
+		{
+			"failure: trusting period ratio exceeds creation policy maximum",
+			func() {
+				setTendermintClientState()
+				setClientCreationPolicy(clienttypes.ClientCreationPolicy{
+					ClientType:             exported.Tendermint,
+					MaxTrustingPeriodRatio: "0.5",
+				})
+			},
+			exported.Tendermint,
+			false,
+		},
+		{
+			"failure: trust level below creation policy minimum",
+			func() {
+				setTendermintClientState()
+				setClientCreationPolicy(clienttypes.ClientCreationPolicy{
+					ClientType:    exported.Tendermint,
+					MinTrustLevel: "0.5",
+				})
+			},
+			exported.Tendermint,
+			false,
+		},
+		{
+			"failure: 06-solomachine client state cannot be evaluated against creation policy",
+			func() {
+				smClientState := solomachine.NewClientState(1, &solomachine.ConsensusState{PublicKey: suite.solomachine.ConsensusState().PublicKey, Diversifier: suite.solomachine.Diversifier, Timestamp: suite.solomachine.Time})
+				smConsensusState := &solomachine.ConsensusState{PublicKey: suite.solomachine.ConsensusState().PublicKey, Diversifier: suite.solomachine.Diversifier, Timestamp: suite.solomachine.Time}
+				clientState = suite.chainA.App.AppCodec().MustMarshal(smClientState)
+				consensusState = suite.chainA.App.AppCodec().MustMarshal(smConsensusState)
+				setClientCreationPolicy(clienttypes.ClientCreationPolicy{
+					ClientType:      exported.Solomachine,
+					AllowedChainIds: []string{testChainID},
+				})
+			},
+			exported.Solomachine,
+			false,
+		},
 		{
 			"failure: 09-localhost client type not supported",
 			func() {
@@ -79,10 +185,11 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 			clientState, consensusState = []byte{}, []byte{}
+			creator = ibctesting.TestAccAddress
 
 			tc.malleate()
 
-			clientID, err := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.CreateClient(suite.chainA.GetContext(), tc.clientType, clientState, consensusState)
+			clientID, err := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.CreateClientWithCreator(suite.chainA.GetContext(), tc.clientType, clientState, consensusState, creator)
 
 			// assert correct behaviour based on expected error
 			clientState, found := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
@@ -688,3 +795,309 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRecoverClientWithOverrides() {
+	var (
+		subject, substitute string
+		overrides           *clienttypes.RecoveryOverrides
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: overridden parameters do not need to match the substitute",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty overrides",
+			func() {
+				tmClientState, ok := suite.chainA.GetClientState(substitute).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				tmSubjectClientState, ok := suite.chainA.GetClientState(subject).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				tmClientState.UnbondingPeriod = tmSubjectClientState.UnbondingPeriod
+				tmClientState.TrustLevel = tmSubjectClientState.TrustLevel
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+
+				overrides = &clienttypes.RecoveryOverrides{}
+			},
+			nil,
+		},
+		{
+			"failure: parameters which are not overridden do not match the substitute",
+			func() {
+				overrides.UnbondingPeriod = nil
+			},
+			clienttypes.ErrInvalidSubstitute,
+		},
+		{
+			"failure: recovered client state is invalid",
+			func() {
+				overrides.TrustLevelNumerator = 1
+				overrides.TrustLevelDenominator = 10
+			},
+			clienttypes.ErrInvalidRecoveryClient,
+		},
+		{
+			"failure: light client module does not support recovery overrides",
+			func() {
+				subjectSolomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "subject", "testing", 1)
+				subject = subjectSolomachine.CreateClient(suite.chainA)
+				solomachineClientState := subjectSolomachine.ClientState()
+				solomachineClientState.IsFrozen = true
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, solomachineClientState)
+
+				substituteSolomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "substitute", "testing", 1)
+				substituteSolomachine.Sequence = subjectSolomachine.Sequence + 1
+				substitute = substituteSolomachine.CreateClient(suite.chainA)
+			},
+			clienttypes.ErrInvalidRecoveryClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+			subject = subjectPath.EndpointA.ClientID
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+			substitute = substitutePath.EndpointA.ClientID
+
+			err := substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			// the substitute is configured with a different unbonding period and trust level than the subject
+			substituteClientState, ok := suite.chainA.GetClientState(substitute).(*ibctm.ClientState)
+			suite.Require().True(ok)
+			substituteClientState.UnbondingPeriod += time.Hour
+			substituteClientState.TrustLevel = ibctm.Fraction{Numerator: 2, Denominator: 3}
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, substituteClientState)
+
+			subjectClientState, ok := suite.chainA.GetClientState(subject).(*ibctm.ClientState)
+			suite.Require().True(ok)
+			subjectClientState.FrozenHeight = subjectClientState.LatestHeight
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, subjectClientState)
+
+			// keep the configuration of the subject
+			unbondingPeriod := subjectClientState.UnbondingPeriod
+			overrides = &clienttypes.RecoveryOverrides{
+				UnbondingPeriod:       &unbondingPeriod,
+				TrustLevelNumerator:   subjectClientState.TrustLevel.Numerator,
+				TrustLevelDenominator: subjectClientState.TrustLevel.Denominator,
+			}
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.RecoverClientWithOverrides(suite.chainA.GetContext(), subject, substitute, overrides)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(exported.Active, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), subject))
+
+				recoveredClientState, ok := suite.chainA.GetClientState(subject).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(subjectClientState.UnbondingPeriod, recoveredClientState.UnbondingPeriod)
+				suite.Require().Equal(subjectClientState.TrustLevel, recoveredClientState.TrustLevel)
+				suite.Require().Equal(substituteClientState.LatestHeight, recoveredClientState.LatestHeight)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeleteClient() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+	)
+
+	freezeClient := func() {
+		tmClientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+		suite.Require().True(ok)
+		tmClientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+		path.EndpointA.SetClientState(tmClientState)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: frozen client",
+			freezeClient,
+			nil,
+		},
+		{
+			"success: expired client",
+			func() {
+				tmClientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod)
+			},
+			nil,
+		},
+		{
+			"success: client referenced by an uninitialized connection",
+			func() {
+				freezeClient()
+
+				connectionID := ibctesting.FirstConnectionID
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetClientConnectionPaths(suite.chainA.GetContext(), clientID, []string{connectionID})
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), connectionID, connectiontypes.ConnectionEnd{ClientId: clientID, State: connectiontypes.UNINITIALIZED})
+			},
+			nil,
+		},
+		{
+			"success: client referenced by a closed connection",
+			func() {
+				freezeClient()
+
+				connectionID := ibctesting.FirstConnectionID
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetClientConnectionPaths(suite.chainA.GetContext(), clientID, []string{connectionID})
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), connectionID, connectiontypes.ConnectionEnd{ClientId: clientID, State: connectiontypes.CLOSED})
+			},
+			nil,
+		},
+		{
+			"failure: client is active",
+			func() {},
+			clienttypes.ErrClientNotDeletable,
+		},
+		{
+			"failure: client does not exist",
+			func() {
+				clientID = ibctesting.SecondClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: localhost client",
+			func() {
+				clientID = exported.LocalhostClientID
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: client referenced by an open connection",
+			func() {
+				path.CreateConnections()
+				freezeClient()
+			},
+			clienttypes.ErrClientInUse,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.DeleteClient(ctx, clientID)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						clienttypes.EventTypeDeleteClient,
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+
+				// Assert that all client state has been removed
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, clientID)
+				suite.Require().False(found)
+
+				iterator := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID).Iterator(nil, nil)
+				defer iterator.Close()
+				suite.Require().False(iterator.Valid())
+
+				suite.Require().Equal(exported.Unknown, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIsPermissionlessDeletionAllowed() {
+	var (
+		path        *ibctesting.Path
+		gracePeriod time.Duration
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"grace period has passed",
+			func() {},
+			true,
+		},
+		{
+			"grace period has not passed",
+			func() {
+				gracePeriod = 2 * time.Hour
+			},
+			false,
+		},
+		{
+			"grace period is not set",
+			func() {
+				gracePeriod = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			gracePeriod = time.Hour
+
+			tc.malleate()
+
+			params := clienttypes.DefaultParams()
+			params.ClientDeletionGracePeriod = gracePeriod
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			suite.coordinator.IncrementTimeBy(time.Hour)
+
+			allowed := suite.chainA.App.GetIBCKeeper().ClientKeeper.IsPermissionlessDeletionAllowed(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().Equal(tc.expPass, allowed)
+		})
+	}
+}
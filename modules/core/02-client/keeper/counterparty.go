@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// ProvideCounterparty sets the counterparty of the client, enabling packets to be routed directly
+// between the client and its counterparty without a connection or channel. Only the creator of the
+// client may provide the counterparty and it may only be provided once. The creator of the client is
+// removed from the store once the counterparty has been set.
+func (k *Keeper) ProvideCounterparty(ctx sdk.Context, clientID string, counterparty types.Counterparty, signer string) error {
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return errorsmod.Wrapf(types.ErrClientNotFound, "client (%s) not found", clientID)
+	}
+
+	creator, found := k.GetClientCreator(ctx, clientID)
+	if !found {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "client (%s) creator not found", clientID)
+	}
+
+	if creator != signer {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected client creator %s, got %s", creator, signer)
+	}
+
+	if _, found := k.GetCounterparty(ctx, clientID); found {
+		return errorsmod.Wrapf(types.ErrInvalidCounterparty, "counterparty already provided for client (%s)", clientID)
+	}
+
+	k.SetCounterparty(ctx, clientID, counterparty)
+	k.DeleteClientCreator(ctx, clientID)
+
+	k.Logger(ctx).Info("client counterparty provided", "client-id", clientID, "counterparty-client-id", counterparty.ClientId)
+
+	emitProvideCounterpartyEvent(ctx, clientID, counterparty)
+
+	return nil
+}
+
+// SetCounterparty stores the counterparty of the client in the client store.
+func (k *Keeper) SetCounterparty(ctx sdk.Context, clientID string, counterparty types.Counterparty) {
+	store := k.ClientStore(ctx, clientID)
+	store.Set([]byte(types.KeyCounterparty), k.cdc.MustMarshal(&counterparty))
+}
+
+// GetCounterparty returns the counterparty of the client, if one has been provided.
+func (k *Keeper) GetCounterparty(ctx sdk.Context, clientID string) (types.Counterparty, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get([]byte(types.KeyCounterparty))
+	if len(bz) == 0 {
+		return types.Counterparty{}, false
+	}
+
+	var counterparty types.Counterparty
+	k.cdc.MustUnmarshal(bz, &counterparty)
+	return counterparty, true
+}
+
+// SetClientCreator stores the address of the creator of the client in the client store.
+func (k *Keeper) SetClientCreator(ctx sdk.Context, clientID string, creator string) {
+	store := k.ClientStore(ctx, clientID)
+	store.Set([]byte(types.KeyClientCreator), []byte(creator))
+}
+
+// GetClientCreator returns the address of the creator of the client, if it is stored.
+func (k *Keeper) GetClientCreator(ctx sdk.Context, clientID string) (string, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get([]byte(types.KeyClientCreator))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// DeleteClientCreator removes the address of the creator of the client from the client store.
+func (k *Keeper) DeleteClientCreator(ctx sdk.Context, clientID string) {
+	store := k.ClientStore(ctx, clientID)
+	store.Delete([]byte(types.KeyClientCreator))
+}
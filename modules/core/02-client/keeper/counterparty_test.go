@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestProvideCounterparty() {
+	var (
+		clientID     string
+		counterparty clienttypes.Counterparty
+		signer       string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client does not exist",
+			func() {
+				clientID = ibctesting.SecondClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: signer is not the client creator",
+			func() {
+				signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: counterparty already provided",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ProvideCounterparty(suite.chainA.GetContext(), clientID, counterparty, signer)
+				suite.Require().NoError(err)
+
+				// the creator is removed once the counterparty is provided, restore it to check the counterparty cannot be overwritten
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientCreator(suite.chainA.GetContext(), clientID, signer)
+			},
+			clienttypes.ErrInvalidCounterparty,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			clientID = path.EndpointA.ClientID
+			counterparty = clienttypes.NewCounterparty(path.EndpointB.ClientID, commitmenttypes.NewMerklePath("ibc"))
+			signer = suite.chainA.SenderAccount.GetAddress().String()
+
+			creator, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreator(suite.chainA.GetContext(), clientID)
+			suite.Require().True(found)
+			suite.Require().Equal(signer, creator)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ProvideCounterparty(suite.chainA.GetContext(), clientID, counterparty, signer)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				storedCounterparty, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetCounterparty(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+				suite.Require().Equal(counterparty, storedCounterparty)
+
+				_, found = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreator(suite.chainA.GetContext(), clientID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -105,6 +106,54 @@ func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	})
 }
 
+// emitDeleteClientEvent emits a delete client event
+func emitDeleteClientEvent(ctx sdk.Context, clientID, clientType string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitClientExpiryWarningEvent emits a client expiry warning event
+func emitClientExpiryWarningEvent(ctx sdk.Context, clientID, clientType string, remaining time.Duration, expiryTime time.Time) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClientExpiryWarning,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyRemainingTrustingPeriod, remaining.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryTime, expiryTime.Format(time.RFC3339Nano)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitPruneConsensusStatesEvent emits a prune consensus states event
+func emitPruneConsensusStatesEvent(ctx sdk.Context, clientID, clientType string, pruned uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePruneConsensusStates,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyConsensusStatesPruned, strconv.FormatUint(pruned, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitScheduleIBCSoftwareUpgradeEvent emits a schedule IBC software upgrade event
 func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -134,3 +183,18 @@ func EmitUpgradeChainEvent(ctx sdk.Context, height int64) {
 		),
 	})
 }
+
+// emitProvideCounterpartyEvent emits a provide counterparty event
+func emitProvideCounterpartyEvent(ctx sdk.Context, clientID string, counterparty types.Counterparty) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProvideCounterparty,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, counterparty.ClientId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -92,3 +92,59 @@ func (suite *KeeperTestSuite) TestMsgUpdateClientEvents() {
 	expectedEvents = sdk.MarkEventsToIndex(expectedEvents, indexSet)
 	ibctesting.AssertEvents(&suite.Suite, expectedEvents, events)
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateClientHeaderBatchEvents() {
+	suite.SetupTest()
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+
+	suite.Require().NoError(path.EndpointA.CreateClient())
+
+	trustedHeight := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+
+	var headers []*ibctm.Header
+	for i := 0; i < 3; i++ {
+		suite.chainB.Coordinator.CommitBlock(suite.chainB)
+
+		header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+		suite.Require().NoError(err)
+
+		headers = append(headers, header)
+		trustedHeight = header.GetHeight().(clienttypes.Height)
+	}
+
+	msg, err := clienttypes.NewMsgUpdateClient(
+		ibctesting.FirstClientID, ibctm.NewHeaderBatch(headers, []uint32{0}),
+		path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	var updateEvents int
+	for _, event := range res.Events {
+		if event.Type == clienttypes.EventTypeUpdateClient {
+			updateEvents++
+		}
+	}
+	suite.Require().Equal(1, updateEvents)
+
+	firstHeight, lastHeight := headers[0].GetHeight(), headers[2].GetHeight()
+	suite.Require().Equal(lastHeight, path.EndpointA.GetClientLatestHeight())
+
+	events := res.Events
+	expectedEvents := sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeUpdateClient,
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, ibctesting.FirstClientID),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientType, path.EndpointA.GetClientState().ClientType()),
+			sdk.NewAttribute(clienttypes.AttributeKeyConsensusHeight, firstHeight.String()),
+			sdk.NewAttribute(clienttypes.AttributeKeyConsensusHeights, firstHeight.String()+","+lastHeight.String()),
+		),
+	}.ToABCIEvents()
+
+	var indexSet map[string]struct{}
+	expectedEvents = sdk.MarkEventsToIndex(expectedEvents, indexSet)
+	ibctesting.AssertEvents(&suite.Suite, expectedEvents, events)
+}
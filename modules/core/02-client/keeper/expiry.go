@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// GetClientExpiry returns the time at which the client expires, computed as the timestamp of the latest
+// consensus state plus the trusting period of the client, and the trusting period remaining at the current
+// block time. The remaining trusting period is zero if the client has expired. False is returned if the client
+// does not exist, the client state does not implement types.TrustingPeriodClientState or the timestamp of the
+// latest consensus state cannot be retrieved.
+func (k *Keeper) GetClientExpiry(ctx sdk.Context, clientID string) (time.Time, time.Duration, bool) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return time.Time{}, 0, false
+	}
+
+	trustingPeriodClientState, ok := clientState.(types.TrustingPeriodClientState)
+	if !ok {
+		return time.Time{}, 0, false
+	}
+
+	timestamp, err := k.GetClientTimestampAtHeight(ctx, clientID, k.GetClientLatestHeight(ctx, clientID))
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	expiryTime := time.Unix(0, int64(timestamp)).UTC().Add(trustingPeriodClientState.GetTrustingPeriod())
+	remaining := expiryTime.Sub(ctx.BlockTime())
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return expiryTime, remaining, true
+}
+
+// GetExpiringClients returns all active and expired clients which expire after a trusting period, sorted by
+// their remaining trusting period in ascending order. Clients with a remaining trusting period greater than the
+// provided threshold are omitted, unless the threshold is zero.
+func (k *Keeper) GetExpiringClients(ctx sdk.Context, threshold time.Duration) []types.ExpiringClient {
+	var clientIDs []string
+	k.IterateClientStates(ctx, nil, func(clientID string, _ exported.ClientState) bool {
+		if clientID != exported.LocalhostClientID {
+			clientIDs = append(clientIDs, clientID)
+		}
+		return false
+	})
+
+	var expiringClients []types.ExpiringClient
+	for _, clientID := range clientIDs {
+		status := k.GetClientStatus(ctx, clientID)
+		if status != exported.Active && status != exported.Expired {
+			continue
+		}
+
+		expiryTime, remaining, ok := k.GetClientExpiry(ctx, clientID)
+		if !ok {
+			continue
+		}
+
+		if threshold != 0 && remaining > threshold {
+			continue
+		}
+
+		expiringClients = append(expiringClients, types.ExpiringClient{
+			ClientId:                clientID,
+			Status:                  status.String(),
+			RemainingTrustingPeriod: remaining,
+			ExpiryTime:              expiryTime,
+		})
+	}
+
+	sort.SliceStable(expiringClients, func(i, j int) bool {
+		return expiringClients[i].RemainingTrustingPeriod < expiringClients[j].RemainingTrustingPeriod
+	})
+
+	return expiringClients
+}
+
+// EmitClientExpiryWarnings emits a client expiry warning event for every active client whose remaining
+// trusting period has dropped to or below the ClientExpiryWarningThreshold param. The event is emitted once
+// per client, a client which is updated such that its remaining trusting period exceeds the threshold again
+// will be warned about again. At most types.MaxClientExpiryChecksPerBlock clients are checked per call, resuming
+// from the client following the last client checked in the previous call. No events are emitted if the threshold
+// is zero.
+func (k *Keeper) EmitClientExpiryWarnings(ctx sdk.Context) {
+	threshold := k.GetParams(ctx).ClientExpiryWarningThreshold
+	if threshold == 0 {
+		return
+	}
+
+	k.visitClients(ctx, []byte(types.KeyExpiryWarningCheckCursor), types.MaxClientExpiryChecksPerBlock, func(clientID string) {
+		expiryTime, remaining, ok := k.GetClientExpiry(ctx, clientID)
+		if !ok {
+			return
+		}
+
+		warned := k.hasEmittedExpiryWarning(ctx, clientID)
+		switch {
+		case remaining > threshold:
+			if warned {
+				k.deleteEmittedExpiryWarning(ctx, clientID)
+			}
+		case !warned && k.GetClientStatus(ctx, clientID) == exported.Active:
+			clientType, _, err := types.ParseClientIdentifier(clientID)
+			if err != nil {
+				return
+			}
+
+			k.setEmittedExpiryWarning(ctx, clientID)
+
+			k.Logger(ctx).Info("client approaching expiry", "client-id", clientID, "remaining-trusting-period", remaining.String())
+
+			emitClientExpiryWarningEvent(ctx, clientID, clientType, remaining, expiryTime)
+		}
+	})
+}
+
+// hasEmittedExpiryWarning returns true if an expiry warning event has been emitted for the client.
+func (k *Keeper) hasEmittedExpiryWarning(ctx sdk.Context, clientID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ExpiryWarningClientKey(clientID))
+}
+
+// setEmittedExpiryWarning records that an expiry warning event has been emitted for the client.
+func (k *Keeper) setEmittedExpiryWarning(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ExpiryWarningClientKey(clientID), []byte{byte(1)})
+}
+
+// deleteEmittedExpiryWarning removes the record that an expiry warning event has been emitted for the client.
+func (k *Keeper) deleteEmittedExpiryWarning(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ExpiryWarningClientKey(clientID))
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestGetClientExpiry() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	consensusState, ok := path.EndpointA.GetConsensusState(clientState.LatestHeight).(*ibctm.ConsensusState)
+	suite.Require().True(ok)
+	expExpiryTime := consensusState.Timestamp.Add(clientState.TrustingPeriod)
+
+	ctx := suite.chainA.GetContext()
+	expiryTime, remaining, found := clientKeeper.GetClientExpiry(ctx, path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().True(expExpiryTime.Equal(expiryTime))
+	suite.Require().Equal(expExpiryTime.Sub(ctx.BlockTime()), remaining)
+
+	suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+	_, remaining, found = clientKeeper.GetClientExpiry(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Zero(remaining)
+
+	_, _, found = clientKeeper.GetClientExpiry(suite.chainA.GetContext(), ibctesting.InvalidID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestEmitClientExpiryWarnings() {
+	var (
+		path      *ibctesting.Path
+		threshold time.Duration
+	)
+
+	hasWarningEvent := func(events sdk.Events) bool {
+		for _, event := range events {
+			if event.Type == clienttypes.EventTypeClientExpiryWarning {
+				return true
+			}
+		}
+		return false
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expWarning bool
+	}{
+		{
+			"success: remaining trusting period below threshold",
+			func() {},
+			true,
+		},
+		{
+			"no warning: remaining trusting period above threshold",
+			func() {
+				threshold = 10 * time.Minute
+			},
+			false,
+		},
+		{
+			"no warning: threshold is zero",
+			func() {
+				threshold = 0
+			},
+			false,
+		},
+		{
+			"no warning: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			// move the chain to within 30 minutes of the client expiring
+			threshold = time.Hour
+			suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod - 30*time.Minute)
+
+			tc.malleate()
+
+			params := clienttypes.DefaultParams()
+			params.ClientExpiryWarningThreshold = threshold
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.EmitClientExpiryWarnings(ctx)
+			suite.Require().Equal(tc.expWarning, hasWarningEvent(ctx.EventManager().Events()))
+
+			if tc.expWarning {
+				// the warning is only emitted once
+				ctx = suite.chainA.GetContext()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.EmitClientExpiryWarnings(ctx)
+				suite.Require().False(hasWarningEvent(ctx.EventManager().Events()))
+
+				// updating the client resets the warning
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				ctx = suite.chainA.GetContext()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.EmitClientExpiryWarnings(ctx)
+				suite.Require().False(hasWarningEvent(ctx.EventManager().Events()))
+				suite.Require().False(ctx.KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey)).Has(clienttypes.ExpiryWarningClientKey(path.EndpointA.ClientID)))
+			}
+		})
+	}
+}
@@ -247,6 +247,96 @@ func (k *Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusReq
 	}, nil
 }
 
+// DeletableClients implements the Query/DeletableClients gRPC method
+func (k *Keeper) DeletableClients(c context.Context, req *types.QueryDeletableClientsRequest) (*types.QueryDeletableClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var deletableClients []types.DeletableClient
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.KeyClientStorePrefix)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		// filter any metadata stored under client state key
+		keySplit := strings.Split(string(key), "/")
+		if keySplit[len(keySplit)-1] != "clientState" {
+			return false, nil
+		}
+
+		clientID := keySplit[1]
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return false, err
+		}
+
+		if err := k.validateClientDeletable(ctx, clientID); err != nil {
+			return false, nil
+		}
+
+		if accumulate {
+			deletableClients = append(deletableClients, types.DeletableClient{
+				ClientId:       clientID,
+				Status:         k.GetClientStatus(ctx, clientID).String(),
+				Permissionless: k.IsPermissionlessDeletionAllowed(ctx, clientID),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeletableClientsResponse{
+		DeletableClients: deletableClients,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// ExpiringClients implements the Query/ExpiringClients gRPC method
+func (k *Keeper) ExpiringClients(c context.Context, req *types.QueryExpiringClientsRequest) (*types.QueryExpiringClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Threshold < 0 {
+		return nil, status.Error(codes.InvalidArgument, "threshold cannot be negative")
+	}
+
+	if req.Pagination != nil && len(req.Pagination.Key) != 0 {
+		return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset instead")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	expiringClients := k.GetExpiringClients(ctx, req.Threshold)
+
+	var offset, limit uint64
+	countTotal := false
+	if req.Pagination != nil {
+		offset, limit, countTotal = req.Pagination.Offset, req.Pagination.Limit, req.Pagination.CountTotal
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	total := uint64(len(expiringClients))
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	// the limit is clamped to the number of remaining clients so that start+limit cannot overflow
+	start := min(offset, total)
+	end := start + min(limit, total-start)
+
+	return &types.QueryExpiringClientsResponse{
+		ExpiringClients: expiringClients[start:end],
+		Pagination:      pageRes,
+	}, nil
+}
+
 // ClientParams implements the Query/ClientParams gRPC method
 func (k *Keeper) ClientParams(c context.Context, _ *types.QueryClientParamsRequest) (*types.QueryClientParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -257,6 +347,51 @@ func (k *Keeper) ClientParams(c context.Context, _ *types.QueryClientParamsReque
 	}, nil
 }
 
+// ClientCreationPolicy implements the Query/ClientCreationPolicy gRPC method
+func (k *Keeper) ClientCreationPolicy(c context.Context, req *types.QueryClientCreationPolicyRequest) (*types.QueryClientCreationPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ClientType) == "" {
+		return nil, status.Error(codes.InvalidArgument, "client type cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	policy, found := k.GetParams(ctx).GetClientCreationPolicy(req.ClientType)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client creation policy not found for client type %s", req.ClientType)
+	}
+
+	return &types.QueryClientCreationPolicyResponse{
+		Policy: policy,
+	}, nil
+}
+
+// Counterparty implements the Query/Counterparty gRPC method
+func (k *Keeper) Counterparty(c context.Context, req *types.QueryCounterpartyRequest) (*types.QueryCounterpartyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	counterparty, found := k.GetCounterparty(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrCounterpartyNotFound, req.ClientId).Error(),
+		)
+	}
+
+	return &types.QueryCounterpartyResponse{
+		Counterparty: counterparty,
+	}, nil
+}
+
 // UpgradedClientState implements the Query/UpgradedClientState gRPC method
 func (k *Keeper) UpgradedClientState(c context.Context, req *types.QueryUpgradedClientStateRequest) (*types.QueryUpgradedClientStateResponse, error) {
 	if req == nil {
@@ -330,18 +465,8 @@ func (k *Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMembe
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	clientType, _, err := types.ParseClientIdentifier(req.ClientId)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	denyClients := []string{exported.Localhost, exported.Solomachine}
-	if slices.Contains(denyClients, clientType) {
-		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(types.ErrInvalidClientType, "verify membership is disabled for client types %s", denyClients).Error())
+	if err := validateProofVerificationClientID(req.ClientId, "verify membership"); err != nil {
+		return nil, err
 	}
 
 	if len(req.Proof) == 0 {
@@ -370,13 +495,9 @@ func (k *Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMembe
 		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumed(), "verify membership query")
 	}()
 
-	clientModule, found := k.Route(req.ClientId)
-	if !found {
-		return nil, status.Error(codes.NotFound, req.ClientId)
-	}
-
-	if clientStatus := k.GetClientStatus(ctx, req.ClientId); clientStatus != exported.Active {
-		return nil, status.Error(codes.FailedPrecondition, errorsmod.Wrapf(types.ErrClientNotActive, "cannot verify membership using client (%s) with status %s", req.ClientId, clientStatus).Error())
+	clientModule, err := k.proofVerificationClientModule(ctx, req.ClientId, "verify membership")
+	if err != nil {
+		return nil, err
 	}
 
 	// consume flat gas fee for proof verification queries.
@@ -397,3 +518,184 @@ func (k *Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMembe
 		Success: true,
 	}, nil
 }
+
+// VerifyNonMembership implements the Query/VerifyNonMembership gRPC method
+func (k *Keeper) VerifyNonMembership(c context.Context, req *types.QueryVerifyNonMembershipRequest) (*types.QueryVerifyNonMembershipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateProofVerificationClientID(req.ClientId, "verify non-membership"); err != nil {
+		return nil, err
+	}
+
+	if len(req.Proof) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty proof")
+	}
+
+	if req.ProofHeight.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "proof height must be non-zero")
+	}
+
+	if req.MerklePath.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty merkle path")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// cache the context to ensure clientState.VerifyNonMembership does not change state
+	cachedCtx, _ := ctx.CacheContext()
+
+	// make sure we charge the higher level context even on panic
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumed(), "verify non-membership query")
+	}()
+
+	clientModule, err := k.proofVerificationClientModule(ctx, req.ClientId, "verify non-membership")
+	if err != nil {
+		return nil, err
+	}
+
+	// consume flat gas fee for proof verification queries.
+	// NOTE: consuming gas prior to method invocation also provides protection against recursive calls reaching stack overflow
+	ctx.GasMeter().ConsumeGas(
+		3*ctx.KVGasConfig().ReadCostPerByte*uint64(len(req.Proof)),
+		"verify non-membership query",
+	)
+
+	if err := clientModule.VerifyNonMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath); err != nil {
+		k.Logger(ctx).Debug("proof verification failed", "key", req.MerklePath, "error", err)
+		return &types.QueryVerifyNonMembershipResponse{
+			Success: false,
+		}, nil
+	}
+
+	return &types.QueryVerifyNonMembershipResponse{
+		Success: true,
+	}, nil
+}
+
+// VerifyMembershipBatch implements the Query/VerifyMembershipBatch gRPC method
+func (k *Keeper) VerifyMembershipBatch(c context.Context, req *types.QueryVerifyMembershipBatchRequest) (*types.QueryVerifyMembershipBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateProofVerificationClientID(req.ClientId, "verify membership batch"); err != nil {
+		return nil, err
+	}
+
+	if req.ProofHeight.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "proof height must be non-zero")
+	}
+
+	if len(req.Proofs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty proofs")
+	}
+
+	if len(req.Proofs) > types.MaxVerifyMembershipBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "number of proofs (%d) exceeds maximum batch size (%d)", len(req.Proofs), types.MaxVerifyMembershipBatchSize)
+	}
+
+	for i, proof := range req.Proofs {
+		if len(proof.Proof) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty proof at index %d", i)
+		}
+
+		if proof.MerklePath.Empty() {
+			return nil, status.Errorf(codes.InvalidArgument, "empty merkle path at index %d", i)
+		}
+
+		if proof.NonMembership && len(proof.Value) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "non-empty value for non-membership proof at index %d", i)
+		}
+
+		if !proof.NonMembership && len(proof.Value) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty value at index %d", i)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// cache the context to ensure proof verification does not change state
+	cachedCtx, _ := ctx.CacheContext()
+
+	// make sure we charge the higher level context even on panic
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumed(), "verify membership batch query")
+	}()
+
+	clientModule, err := k.proofVerificationClientModule(ctx, req.ClientId, "verify membership batch")
+	if err != nil {
+		return nil, err
+	}
+
+	// consume flat gas fee for all proofs prior to verification, see VerifyMembership
+	var proofsLen uint64
+	for _, proof := range req.Proofs {
+		proofsLen += uint64(len(proof.Proof))
+	}
+	ctx.GasMeter().ConsumeGas(
+		3*ctx.KVGasConfig().ReadCostPerByte*proofsLen,
+		"verify membership batch query",
+	)
+
+	success := true
+	results := make([]bool, len(req.Proofs))
+	for i, proof := range req.Proofs {
+		var err error
+		if proof.NonMembership {
+			err = clientModule.VerifyNonMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, proof.Proof, proof.MerklePath)
+		} else {
+			err = clientModule.VerifyMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, proof.Proof, proof.MerklePath, proof.Value)
+		}
+
+		if err != nil {
+			k.Logger(ctx).Debug("proof verification failed", "key", proof.MerklePath, "error", err)
+			success = false
+			continue
+		}
+
+		results[i] = true
+	}
+
+	return &types.QueryVerifyMembershipBatchResponse{
+		Success: success,
+		Results: results,
+	}, nil
+}
+
+// validateProofVerificationClientID returns a gRPC error if the client identifier is invalid or if proof
+// verification queries are disabled for its client type.
+func validateProofVerificationClientID(clientID, method string) error {
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denyClients := []string{exported.Localhost, exported.Solomachine}
+	if slices.Contains(denyClients, clientType) {
+		return status.Error(codes.InvalidArgument, errorsmod.Wrapf(types.ErrInvalidClientType, "%s is disabled for client types %s", method, denyClients).Error())
+	}
+
+	return nil
+}
+
+// proofVerificationClientModule returns the light client module routed to by the client identifier, returning a
+// gRPC error if no route exists or the client is not active.
+func (k *Keeper) proofVerificationClientModule(ctx sdk.Context, clientID, method string) (exported.LightClientModule, error) {
+	clientModule, found := k.Route(clientID)
+	if !found {
+		return nil, status.Error(codes.NotFound, clientID)
+	}
+
+	if clientStatus := k.GetClientStatus(ctx, clientID); clientStatus != exported.Active {
+		return nil, status.Error(codes.FailedPrecondition, errorsmod.Wrapf(types.ErrClientNotActive, "cannot %s using client (%s) with status %s", method, clientID, clientStatus).Error())
+	}
+
+	return clientModule, nil
+}
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -603,6 +606,274 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDeletableClients() {
+	var (
+		req                 *types.QueryDeletableClientsRequest
+		expDeletableClients []types.DeletableClient
+	)
+
+	freezeClient := func(path *ibctesting.Path) {
+		clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+		suite.Require().True(ok)
+
+		clientState.FrozenHeight = types.NewHeight(0, 1)
+		path.EndpointA.SetClientState(clientState)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryDeletableClientsRequest{}
+			},
+			true,
+		},
+		{
+			"success: active and in use clients are excluded",
+			func() {
+				activePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				activePath.SetupClients()
+
+				inUsePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				inUsePath.SetupConnections()
+				freezeClient(inUsePath)
+
+				frozenPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				frozenPath.SetupClients()
+				freezeClient(frozenPath)
+
+				expDeletableClients = []types.DeletableClient{
+					{ClientId: frozenPath.EndpointA.ClientID, Status: exported.Frozen.String()},
+				}
+
+				req = &types.QueryDeletableClientsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      3,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: permissionless deletion allowed",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+				freezeClient(path)
+
+				params := types.DefaultParams()
+				params.ClientDeletionGracePeriod = time.Hour
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+				suite.coordinator.IncrementTimeBy(time.Hour)
+
+				expDeletableClients = []types.DeletableClient{
+					{ClientId: path.EndpointA.ClientID, Status: exported.Frozen.String(), Permissionless: true},
+				}
+
+				req = &types.QueryDeletableClientsRequest{}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expDeletableClients = nil
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.DeletableClients(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expDeletableClients, res.DeletableClients)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExpiringClients() {
+	var (
+		req                   *types.QueryExpiringClientsRequest
+		firstPath, secondPath *ibctesting.Path
+		expExpiringClients    []types.ExpiringClient
+		expTotal              uint64
+	)
+
+	expiringClient := func(path *ibctesting.Path) types.ExpiringClient {
+		clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+		suite.Require().True(ok)
+		consensusState, ok := path.EndpointA.GetConsensusState(clientState.LatestHeight).(*ibctm.ConsensusState)
+		suite.Require().True(ok)
+
+		expiryTime := consensusState.Timestamp.Add(clientState.TrustingPeriod)
+		return types.ExpiringClient{
+			ClientId:                path.EndpointA.ClientID,
+			Status:                  exported.Active.String(),
+			RemainingTrustingPeriod: expiryTime.Sub(suite.chainA.GetContext().BlockTime()),
+			ExpiryTime:              expiryTime,
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: clients sorted by remaining trusting period",
+			func() {
+				expExpiringClients = []types.ExpiringClient{expiringClient(firstPath), expiringClient(secondPath)}
+			},
+			nil,
+		},
+		{
+			"success: threshold filters clients",
+			func() {
+				first := expiringClient(firstPath)
+				req.Threshold = first.RemainingTrustingPeriod
+
+				expExpiringClients = []types.ExpiringClient{first}
+			},
+			nil,
+		},
+		{
+			"success: expired client has zero remaining trusting period",
+			func() {
+				clientState, ok := firstPath.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				// the first client expires while the second client, created an hour later, remains active
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod - 30*time.Minute)
+
+				expired := expiringClient(firstPath)
+				expired.Status = exported.Expired.String()
+				expired.RemainingTrustingPeriod = 0
+
+				expExpiringClients = []types.ExpiringClient{expired, expiringClient(secondPath)}
+			},
+			nil,
+		},
+		{
+			"success: frozen client is excluded",
+			func() {
+				clientState, ok := firstPath.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				firstPath.EndpointA.SetClientState(clientState)
+
+				expExpiringClients = []types.ExpiringClient{expiringClient(secondPath)}
+			},
+			nil,
+		},
+		{
+			"success: offset pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}
+
+				expExpiringClients = []types.ExpiringClient{expiringClient(secondPath)}
+				expTotal = 2
+			},
+			nil,
+		},
+		{
+			"success: maximum limit does not overflow",
+			func() {
+				req.Pagination = &query.PageRequest{Offset: 1, Limit: math.MaxUint64}
+
+				expExpiringClients = []types.ExpiringClient{expiringClient(secondPath)}
+			},
+			nil,
+		},
+		{
+			"success: offset exceeds number of clients",
+			func() {
+				req.Pagination = &query.PageRequest{Offset: 5}
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"negative threshold",
+			func() {
+				req.Threshold = -time.Hour
+			},
+			status.Error(codes.InvalidArgument, "threshold cannot be negative"),
+		},
+		{
+			"key based pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Key: []byte("key")}
+			},
+			status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset instead"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expExpiringClients = nil
+			expTotal = 0
+
+			firstPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			firstPath.SetupClients()
+
+			suite.coordinator.IncrementTimeBy(time.Hour)
+
+			secondPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			secondPath.SetupClients()
+
+			req = &types.QueryExpiringClientsRequest{}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.ExpiringClients(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(len(expExpiringClients), len(res.ExpiringClients))
+				for i, expiringClient := range expExpiringClients {
+					suite.Require().Equal(expiringClient.ClientId, res.ExpiringClients[i].ClientId)
+					suite.Require().Equal(expiringClient.Status, res.ExpiringClients[i].Status)
+					suite.Require().Equal(expiringClient.RemainingTrustingPeriod, res.ExpiringClients[i].RemainingTrustingPeriod)
+					suite.Require().True(expiringClient.ExpiryTime.Equal(res.ExpiringClients[i].ExpiryTime))
+				}
+				suite.Require().Equal(expTotal, res.Pagination.Total)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedClientState() {
 	var (
 		req            *types.QueryUpgradedClientStateRequest
@@ -781,13 +1052,13 @@ func (suite *KeeperTestSuite) TestQueryClientParams() {
 	suite.Require().Equal(&expParams, res.Params)
 }
 
-func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
-	const wasmClientID = "08-wasm-0"
+func (suite *KeeperTestSuite) TestQueryClientCreationPolicy() {
+	var req *types.QueryClientCreationPolicyRequest
 
-	var (
-		path *ibctesting.Path
-		req  *types.QueryVerifyMembershipRequest
-	)
+	policy := types.ClientCreationPolicy{
+		ClientType:      exported.Tendermint,
+		AllowedChainIds: []string{suite.chainB.ChainID},
+	}
 
 	testCases := []struct {
 		name     string
@@ -796,25 +1067,7 @@ func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
 	}{
 		{
 			"success",
-			func() {
-				channel := path.EndpointB.GetChannel()
-				bz, err := suite.chainB.Codec.Marshal(&channel)
-				suite.Require().NoError(err)
-
-				channelProof, proofHeight := path.EndpointB.QueryProof(host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
-
-				merklePath := commitmenttypes.NewMerklePath(host.ChannelPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
-				merklePath, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
-				suite.Require().NoError(err)
-
-				req = &types.QueryVerifyMembershipRequest{
-					ClientId:    path.EndpointA.ClientID,
-					Proof:       channelProof,
-					ProofHeight: proofHeight,
-					MerklePath:  merklePath,
-					Value:       bz,
-				}
-			},
+			func() {},
 			nil,
 		},
 		{
@@ -822,49 +1075,203 @@ func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
 			func() {
 				req = nil
 			},
-			errors.New("empty request"),
+			status.Error(codes.InvalidArgument, "empty request"),
 		},
 		{
-			"invalid client ID",
+			"blank client type",
 			func() {
-				req = &types.QueryVerifyMembershipRequest{
-					ClientId: "//invalid_id",
-				}
+				req.ClientType = " "
 			},
-			host.ErrInvalidID,
+			status.Error(codes.InvalidArgument, "client type cannot be blank"),
 		},
 		{
-			"localhost client ID is denied",
+			"policy not found",
 			func() {
-				req = &types.QueryVerifyMembershipRequest{
-					ClientId: exported.LocalhostClientID,
-				}
+				req.ClientType = exported.Solomachine
 			},
-			types.ErrInvalidClientType,
+			status.Error(codes.NotFound, fmt.Sprintf("client creation policy not found for client type %s", exported.Solomachine)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := types.DefaultParams()
+			params.ClientCreationPolicies = []types.ClientCreationPolicy{policy}
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			req = &types.QueryClientCreationPolicyRequest{ClientType: exported.Tendermint}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.ClientCreationPolicy(suite.chainA.GetContext(), req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(policy, res.Policy)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryCounterparty() {
+	var (
+		path *ibctesting.Path
+		req  *types.QueryCounterpartyRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
 		},
 		{
-			"solomachine client ID is denied",
+			"req is nil",
 			func() {
-				req = &types.QueryVerifyMembershipRequest{
-					ClientId: types.FormatClientIdentifier(exported.Solomachine, 1),
-				}
+				req = nil
 			},
-			types.ErrInvalidClientType,
+			status.Error(codes.InvalidArgument, "empty request"),
 		},
 		{
-			"empty proof",
+			"invalid client ID",
 			func() {
-				req = &types.QueryVerifyMembershipRequest{
-					ClientId: ibctesting.FirstClientID,
-					Proof:    []byte{},
-				}
+				req.ClientId = ""
 			},
-			errors.New("empty proof"),
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
 		},
 		{
-			"invalid proof height",
+			"counterparty not found",
 			func() {
-				req = &types.QueryVerifyMembershipRequest{
+				req.ClientId = ibctesting.SecondClientID
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("%s: counterparty not found", ibctesting.SecondClientID)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			path.SetupCounterparties()
+
+			req = &types.QueryCounterpartyRequest{ClientId: path.EndpointA.ClientID}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.Counterparty(suite.chainA.GetContext(), req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				expCounterparty := types.NewCounterparty(path.EndpointB.ClientID, commitmenttypes.NewMerklePath(string(suite.chainB.GetPrefix().Bytes())))
+				suite.Require().Equal(expCounterparty, res.Counterparty)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
+	const wasmClientID = "08-wasm-0"
+
+	var (
+		path *ibctesting.Path
+		req  *types.QueryVerifyMembershipRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				channel := path.EndpointB.GetChannel()
+				bz, err := suite.chainB.Codec.Marshal(&channel)
+				suite.Require().NoError(err)
+
+				channelProof, proofHeight := path.EndpointB.QueryProof(host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+				merklePath := commitmenttypes.NewMerklePath(host.ChannelPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				merklePath, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				req = &types.QueryVerifyMembershipRequest{
+					ClientId:    path.EndpointA.ClientID,
+					Proof:       channelProof,
+					ProofHeight: proofHeight,
+					MerklePath:  merklePath,
+					Value:       bz,
+				}
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = &types.QueryVerifyMembershipRequest{
+					ClientId: "//invalid_id",
+				}
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"localhost client ID is denied",
+			func() {
+				req = &types.QueryVerifyMembershipRequest{
+					ClientId: exported.LocalhostClientID,
+				}
+			},
+			types.ErrInvalidClientType,
+		},
+		{
+			"solomachine client ID is denied",
+			func() {
+				req = &types.QueryVerifyMembershipRequest{
+					ClientId: types.FormatClientIdentifier(exported.Solomachine, 1),
+				}
+			},
+			types.ErrInvalidClientType,
+		},
+		{
+			"empty proof",
+			func() {
+				req = &types.QueryVerifyMembershipRequest{
+					ClientId: ibctesting.FirstClientID,
+					Proof:    []byte{},
+				}
+			},
+			errors.New("empty proof"),
+		},
+		{
+			"invalid proof height",
+			func() {
+				req = &types.QueryVerifyMembershipRequest{
 					ClientId:    ibctesting.FirstClientID,
 					Proof:       []byte{0x01},
 					ProofHeight: types.ZeroHeight(),
@@ -956,3 +1363,336 @@ func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryVerifyNonMembershipProof() {
+	const wasmClientID = "08-wasm-0"
+
+	var (
+		path *ibctesting.Path
+		req  *types.QueryVerifyNonMembershipRequest
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+		expError   error
+	}{
+		{
+			"success",
+			func() {
+				receiptProof, proofHeight := path.EndpointB.QueryProof(host.PacketReceiptKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1))
+
+				merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId:    path.EndpointA.ClientID,
+					Proof:       receiptProof,
+					ProofHeight: proofHeight,
+					MerklePath:  merklePath,
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"verification fails for existing key",
+			func() {
+				channelProof, proofHeight := path.EndpointB.QueryProof(host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+				merklePath := commitmenttypes.NewMerklePath(host.ChannelPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId:    path.EndpointA.ClientID,
+					Proof:       channelProof,
+					ProofHeight: proofHeight,
+					MerklePath:  merklePath,
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+			errors.New("empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId: "//invalid_id",
+				}
+			},
+			false,
+			host.ErrInvalidID,
+		},
+		{
+			"localhost client ID is denied",
+			func() {
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId: exported.LocalhostClientID,
+				}
+			},
+			false,
+			types.ErrInvalidClientType,
+		},
+		{
+			"empty proof",
+			func() {
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId: ibctesting.FirstClientID,
+					Proof:    []byte{},
+				}
+			},
+			false,
+			errors.New("empty proof"),
+		},
+		{
+			"invalid proof height",
+			func() {
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId:    ibctesting.FirstClientID,
+					Proof:       []byte{0x01},
+					ProofHeight: types.ZeroHeight(),
+				}
+			},
+			false,
+			errors.New("proof height must be non-zero"),
+		},
+		{
+			"empty merkle path",
+			func() {
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId:    ibctesting.FirstClientID,
+					Proof:       []byte{0x01},
+					ProofHeight: types.NewHeight(1, 100),
+				}
+			},
+			false,
+			errors.New("empty merkle path"),
+		},
+		{
+			"light client module not found",
+			func() {
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId:    wasmClientID, // use a client type that is not registered
+					Proof:       []byte{0x01},
+					ProofHeight: types.NewHeight(1, 100),
+					MerklePath:  commitmenttypes.NewMerklePath("/ibc", host.ChannelPath(mock.PortID, ibctesting.FirstChannelID)),
+				}
+			},
+			false,
+			errors.New(wasmClientID),
+		},
+		{
+			"client not active",
+			func() {
+				params := types.NewParams("") // disable all clients
+				suite.chainA.GetSimApp().GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				req = &types.QueryVerifyNonMembershipRequest{
+					ClientId:    path.EndpointA.ClientID,
+					Proof:       []byte{0x01},
+					ProofHeight: types.NewHeight(1, 100),
+					MerklePath:  commitmenttypes.NewMerklePath("/ibc", host.ChannelPath(mock.PortID, ibctesting.FirstChannelID)),
+				}
+			},
+			false,
+			types.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			initialGas := ctx.GasMeter().GasConsumed()
+			res, err := suite.chainA.QueryServer.VerifyNonMembership(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSuccess, res.Success)
+
+				gasConsumed := ctx.GasMeter().GasConsumed()
+				suite.Require().Greater(gasConsumed, initialGas, "gas consumed should be greater than initial gas")
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+
+				gasConsumed := ctx.GasMeter().GasConsumed()
+				suite.Require().GreaterOrEqual(gasConsumed, initialGas, "gas consumed should be greater than or equal to initial gas")
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyMembershipBatch() {
+	var req *types.QueryVerifyMembershipBatchRequest
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []bool
+		expError   error
+	}{
+		{
+			"success: membership and non-membership proofs",
+			func() {},
+			[]bool{true, true},
+			nil,
+		},
+		{
+			"partial success: invalid membership value",
+			func() {
+				req.Proofs[0].Value = []byte("invalid value")
+			},
+			[]bool{false, true},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			nil,
+			errors.New("empty request"),
+		},
+		{
+			"solomachine client ID is denied",
+			func() {
+				req.ClientId = types.FormatClientIdentifier(exported.Solomachine, 1)
+			},
+			nil,
+			types.ErrInvalidClientType,
+		},
+		{
+			"invalid proof height",
+			func() {
+				req.ProofHeight = types.ZeroHeight()
+			},
+			nil,
+			errors.New("proof height must be non-zero"),
+		},
+		{
+			"empty proofs",
+			func() {
+				req.Proofs = nil
+			},
+			nil,
+			errors.New("empty proofs"),
+		},
+		{
+			"too many proofs",
+			func() {
+				req.Proofs = make([]types.MembershipProof, types.MaxVerifyMembershipBatchSize+1)
+			},
+			nil,
+			errors.New("exceeds maximum batch size"),
+		},
+		{
+			"empty proof",
+			func() {
+				req.Proofs[1].Proof = nil
+			},
+			nil,
+			errors.New("empty proof at index 1"),
+		},
+		{
+			"empty merkle path",
+			func() {
+				req.Proofs[1].MerklePath = commitmenttypes.MerklePath{}
+			},
+			nil,
+			errors.New("empty merkle path at index 1"),
+		},
+		{
+			"empty value for membership proof",
+			func() {
+				req.Proofs[1].NonMembership = false
+			},
+			nil,
+			errors.New("empty value at index 1"),
+		},
+		{
+			"non-empty value for non-membership proof",
+			func() {
+				req.Proofs[0].NonMembership = true
+			},
+			nil,
+			errors.New("non-empty value for non-membership proof at index 0"),
+		},
+		{
+			"client not active",
+			func() {
+				params := types.NewParams("") // disable all clients
+				suite.chainA.GetSimApp().GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			nil,
+			types.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			channel := path.EndpointB.GetChannel()
+			channelValueBz, err := suite.chainB.Codec.Marshal(&channel)
+			suite.Require().NoError(err)
+
+			channelMerklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ChannelPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)))
+			suite.Require().NoError(err)
+			receiptMerklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.PacketReceiptPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)))
+			suite.Require().NoError(err)
+
+			channelProof, proofHeight := path.EndpointB.QueryProof(host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			receiptProof, _ := path.EndpointB.QueryProof(host.PacketReceiptKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1))
+
+			req = &types.QueryVerifyMembershipBatchRequest{
+				ClientId:    path.EndpointA.ClientID,
+				ProofHeight: proofHeight,
+				Proofs: []types.MembershipProof{
+					{Proof: channelProof, MerklePath: channelMerklePath, Value: channelValueBz},
+					{Proof: receiptProof, MerklePath: receiptMerklePath, NonMembership: true},
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			initialGas := ctx.GasMeter().GasConsumed()
+			res, err := suite.chainA.QueryServer.VerifyMembershipBatch(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res.Results)
+				suite.Require().Equal(!slices.Contains(tc.expResults, false), res.Success)
+
+				gasConsumed := ctx.GasMeter().GasConsumed()
+				suite.Require().Greater(gasConsumed, initialGas, "gas consumed should be greater than initial gas")
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
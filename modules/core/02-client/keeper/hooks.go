@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// CheckExpiredClients notifies the client hooks of any client which has expired since the last check.
+// At most types.MaxClientExpiryChecksPerBlock clients are checked per call. Clients are visited in a round
+// robin fashion, the identifier of the last client checked is stored so that the next call resumes from the
+// following client. Clients whose expiry has been reported are recorded in state so that the hooks are only
+// invoked once per expiry. The check is skipped if no client hooks are set.
+func (k *Keeper) CheckExpiredClients(ctx sdk.Context) {
+	if k.hooks == nil {
+		return
+	}
+
+	k.visitClients(ctx, []byte(types.KeyExpiredClientCheckCursor), types.MaxClientExpiryChecksPerBlock, func(clientID string) {
+		expired := k.GetClientStatus(ctx, clientID) == exported.Expired
+		reported := k.hasReportedExpiry(ctx, clientID)
+
+		switch {
+		case expired && !reported:
+			k.setReportedExpiry(ctx, clientID)
+			k.callHook(ctx, "OnClientExpired", clientID, func(ctx sdk.Context) error {
+				return k.hooks.OnClientExpired(ctx, clientID)
+			})
+		case !expired && reported:
+			// the client is no longer expired, e.g. after being recovered, so a future expiry must be reported again.
+			k.deleteReportedExpiry(ctx, clientID)
+		}
+	})
+}
+
+// onClientFrozen invokes the OnClientFrozen client hook if hooks are set.
+func (k *Keeper) onClientFrozen(ctx sdk.Context, clientID string) {
+	if k.hooks == nil {
+		return
+	}
+
+	k.callHook(ctx, "OnClientFrozen", clientID, func(ctx sdk.Context) error {
+		return k.hooks.OnClientFrozen(ctx, clientID)
+	})
+}
+
+// onClientRecovered invokes the OnClientRecovered client hook if hooks are set.
+func (k *Keeper) onClientRecovered(ctx sdk.Context, subjectClientID, substituteClientID string) {
+	k.deleteReportedExpiry(ctx, subjectClientID)
+
+	if k.hooks == nil {
+		return
+	}
+
+	k.callHook(ctx, "OnClientRecovered", subjectClientID, func(ctx sdk.Context) error {
+		return k.hooks.OnClientRecovered(ctx, subjectClientID, substituteClientID)
+	})
+}
+
+// onClientUpgraded invokes the OnClientUpgraded client hook if hooks are set.
+func (k *Keeper) onClientUpgraded(ctx sdk.Context, clientID string) {
+	if k.hooks == nil {
+		return
+	}
+
+	k.callHook(ctx, "OnClientUpgraded", clientID, func(ctx sdk.Context) error {
+		return k.hooks.OnClientUpgraded(ctx, clientID)
+	})
+}
+
+// callHook executes the provided client hook and logs the error returned by it, the client transition
+// proceeds regardless of the hook result. The client hooks are stored as types.MultiClientHooks, each
+// hook is therefore executed within its own cached context and its panics are recovered.
+func (k *Keeper) callHook(ctx sdk.Context, hookName, clientID string, hook func(sdk.Context) error) {
+	if err := hook(ctx); err != nil {
+		k.Logger(ctx).Error("client hook failed", "hook", hookName, "client-id", clientID, "error", err.Error())
+	}
+}
+
+// hasReportedExpiry returns true if the expiry of the client has been reported to the client hooks.
+func (k *Keeper) hasReportedExpiry(ctx sdk.Context, clientID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ExpiredClientKey(clientID))
+}
+
+// setReportedExpiry records that the expiry of the client has been reported to the client hooks.
+func (k *Keeper) setReportedExpiry(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ExpiredClientKey(clientID), []byte{byte(1)})
+}
+
+// deleteReportedExpiry removes the record that the expiry of the client has been reported.
+func (k *Keeper) deleteReportedExpiry(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ExpiredClientKey(clientID))
+}
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var (
+	_ clienttypes.ClientHooks = (*mockClientHooks)(nil)
+
+	mockHookKey = []byte("mockClientHook")
+)
+
+// mockClientHooks records the client identifiers passed to each hook and writes to the store
+// in order to assert that state changes are discarded when a hook returns an error or panics.
+type mockClientHooks struct {
+	storeKey storetypes.StoreKey
+	key      []byte
+	err      error
+	panic    bool
+
+	frozen    []string
+	expired   []string
+	recovered []string
+	upgraded  []string
+}
+
+func (m *mockClientHooks) OnClientFrozen(ctx sdk.Context, clientID string) error {
+	m.frozen = append(m.frozen, clientID)
+	return m.write(ctx)
+}
+
+func (m *mockClientHooks) OnClientExpired(ctx sdk.Context, clientID string) error {
+	m.expired = append(m.expired, clientID)
+	return m.write(ctx)
+}
+
+func (m *mockClientHooks) OnClientRecovered(ctx sdk.Context, subjectClientID, _ string) error {
+	m.recovered = append(m.recovered, subjectClientID)
+	return m.write(ctx)
+}
+
+func (m *mockClientHooks) OnClientUpgraded(ctx sdk.Context, clientID string) error {
+	m.upgraded = append(m.upgraded, clientID)
+	return m.write(ctx)
+}
+
+func (m *mockClientHooks) write(ctx sdk.Context) error {
+	ctx.KVStore(m.storeKey).Set(m.key, []byte{1})
+	if m.panic {
+		panic("mock client hook panic")
+	}
+
+	return m.err
+}
+
+func (suite *KeeperTestSuite) newMockClientHooks(key []byte, err error) *mockClientHooks {
+	return &mockClientHooks{
+		storeKey: suite.chainA.GetSimApp().GetKey(exported.StoreKey),
+		key:      key,
+		err:      err,
+	}
+}
+
+func (suite *KeeperTestSuite) setMockClientHooks(err error) *mockClientHooks {
+	hooks := suite.newMockClientHooks(mockHookKey, err)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetHooks(hooks)
+
+	return hooks
+}
+
+func (suite *KeeperTestSuite) TestSetHooks() {
+	suite.SetupTest()
+
+	suite.setMockClientHooks(nil)
+	suite.Require().Panics(func() {
+		suite.setMockClientHooks(nil)
+	})
+}
+
+func (suite *KeeperTestSuite) TestCheckExpiredClients() {
+	testCases := []struct {
+		name      string
+		hookErr   error
+		hookPanic bool
+	}{
+		{"success", nil, false},
+		{"hook error discards hook state changes", errors.New("hook failed"), false},
+		{"hook panic is recovered and discards hook state changes", nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			hooks := suite.setMockClientHooks(tc.hookErr)
+			hooks.panic = tc.hookPanic
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+			clientKeeper.CheckExpiredClients(suite.chainA.GetContext())
+			suite.Require().Empty(hooks.expired, "active client must not be reported")
+
+			tmClientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			ctx := suite.chainA.GetContext()
+			clientKeeper.CheckExpiredClients(ctx)
+			suite.Require().Equal([]string{path.EndpointA.ClientID}, hooks.expired)
+			suite.Require().Equal(tc.hookErr == nil && !tc.hookPanic, ctx.KVStore(hooks.storeKey).Has(mockHookKey))
+			suite.Require().True(ctx.KVStore(hooks.storeKey).Has([]byte(clienttypes.KeyExpiredClientCheckCursor)))
+
+			// the expiry is only reported once
+			clientKeeper.CheckExpiredClients(ctx)
+			suite.Require().Equal([]string{path.EndpointA.ClientID}, hooks.expired)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMultiClientHooks() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	okHooks := suite.newMockClientHooks([]byte("okClientHook"), nil)
+	errHooks := suite.newMockClientHooks([]byte("errClientHook"), errors.New("hook failed"))
+	panicHooks := suite.newMockClientHooks([]byte("panicClientHook"), nil)
+	panicHooks.panic = true
+
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetHooks(clienttypes.NewMultiClientHooks(errHooks, panicHooks, okHooks))
+
+	tmClientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckExpiredClients(ctx)
+
+	store := ctx.KVStore(okHooks.storeKey)
+	for _, hooks := range []*mockClientHooks{okHooks, errHooks, panicHooks} {
+		suite.Require().Equal([]string{path.EndpointA.ClientID}, hooks.expired)
+	}
+
+	// only the state changes of the failing hooks are discarded
+	suite.Require().True(store.Has(okHooks.key))
+	suite.Require().False(store.Has(errHooks.key))
+	suite.Require().False(store.Has(panicHooks.key))
+}
+
+func (suite *KeeperTestSuite) TestCheckExpiredClientsWithoutHooks() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	tmClientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckExpiredClients(ctx)
+	suite.Require().False(ctx.KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey)).Has(clienttypes.ExpiredClientKey(path.EndpointA.ClientID)))
+}
+
+func (suite *KeeperTestSuite) TestOnClientFrozenHook() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	hooks := suite.setMockClientHooks(nil)
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	trustedHeight := clientState.LatestHeight
+
+	// store an intermediate consensus state with a timestamp in the future to break time monotonicity
+	incrementedClientHeight, ok := clientState.LatestHeight.Increment().(clienttypes.Height)
+	suite.Require().True(ok)
+	intermediateConsState := &ibctm.ConsensusState{
+		Timestamp:          suite.coordinator.CurrentTime.Add(2 * time.Hour),
+		NextValidatorsHash: suite.chainB.Vals.Hash(),
+	}
+	path.EndpointA.SetConsensusState(intermediateConsState, incrementedClientHeight)
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	ibctm.SetIterationKey(clientStore, incrementedClientHeight)
+
+	clientState.LatestHeight = incrementedClientHeight
+	path.EndpointA.SetClientState(clientState)
+
+	header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{path.EndpointA.ClientID}, hooks.frozen)
+}
+
+func (suite *KeeperTestSuite) TestOnClientRecoveredHook() {
+	suite.SetupTest()
+
+	subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	subjectPath.SetupClients()
+	subject := subjectPath.EndpointA.ClientID
+
+	substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	substitutePath.SetupClients()
+	suite.Require().NoError(substitutePath.EndpointA.UpdateClient())
+
+	tmClientState, ok := subjectPath.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+	tmClientState.FrozenHeight = tmClientState.LatestHeight
+	subjectPath.EndpointA.SetClientState(tmClientState)
+
+	hooks := suite.setMockClientHooks(nil)
+
+	err := suite.chainA.App.GetIBCKeeper().ClientKeeper.RecoverClient(suite.chainA.GetContext(), subject, substitutePath.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{subject}, hooks.recovered)
+	suite.Require().Empty(hooks.frozen)
+}
@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
@@ -30,6 +31,7 @@ type Keeper struct {
 	consensusHost  types.ConsensusHost
 	legacySubspace types.ParamSubspace
 	upgradeKeeper  types.UpgradeKeeper
+	hooks          types.MultiClientHooks
 }
 
 // NewKeeper creates a new NewKeeper instance
@@ -92,6 +94,23 @@ func (k *Keeper) SetConsensusHost(consensusHost types.ConsensusHost) {
 	k.consensusHost = consensusHost
 }
 
+// SetHooks sets the client hooks which are notified of client status transitions.
+// It panics if the hooks have already been set, multiple hooks may be combined
+// using types.MultiClientHooks.
+func (k *Keeper) SetHooks(hooks types.ClientHooks) *Keeper {
+	if k.hooks != nil {
+		panic(errors.New("cannot set client hooks twice"))
+	}
+
+	multiHooks, ok := hooks.(types.MultiClientHooks)
+	if !ok {
+		multiHooks = types.NewMultiClientHooks(hooks)
+	}
+
+	k.hooks = multiHooks
+	return k
+}
+
 // GenerateClientIdentifier returns the next client identifier.
 func (k *Keeper) GenerateClientIdentifier(ctx sdk.Context, clientType string) string {
 	nextClientSeq := k.GetNextClientSequence(ctx)
@@ -385,6 +404,36 @@ func (k *Keeper) ClientStore(ctx sdk.Context, clientID string) storetypes.KVStor
 	return prefix.NewStore(ctx.KVStore(k.storeKey), clientPrefix)
 }
 
+// getOpenClientConnection returns the identifier of the first connection referencing the client which is
+// in the INIT, TRYOPEN or OPEN state.
+func (k *Keeper) getOpenClientConnection(ctx sdk.Context, clientID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ClientConnectionsKey(clientID))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	var clientPaths connectiontypes.ClientPaths
+	k.cdc.MustUnmarshal(bz, &clientPaths)
+
+	for _, connectionID := range clientPaths.Paths {
+		bz := store.Get(host.ConnectionKey(connectionID))
+		if len(bz) == 0 {
+			continue
+		}
+
+		var connection connectiontypes.ConnectionEnd
+		k.cdc.MustUnmarshal(bz, &connection)
+
+		switch connection.State {
+		case connectiontypes.INIT, connectiontypes.TRYOPEN, connectiontypes.OPEN:
+			return connectionID, true
+		}
+	}
+
+	return "", false
+}
+
 // GetClientStatus returns the status for a client state  given a client identifier. If the client type is not in the allowed
 // clients param field, Unauthorized is returned, otherwise the client state status is returned.
 func (k *Keeper) GetClientStatus(ctx sdk.Context, clientID string) exported.Status {
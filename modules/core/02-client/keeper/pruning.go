@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// PruneExpiredConsensusStates prunes expired consensus states of clients whose light client module implements
+// the exported.ConsensusStatePruningModule interface. At most MaxConsensusStatesPrunedPerBlock consensus states are
+// pruned and at most MaxConsensusStatesPrunedPerBlock clients are visited per call. Clients are visited in a round
+// robin fashion, the identifier of the last client visited is stored so that the next call resumes from the
+// following client. Errors returned by light client modules are logged and their state changes are discarded.
+func (k *Keeper) PruneExpiredConsensusStates(ctx sdk.Context) {
+	maxPruned := k.GetParams(ctx).MaxConsensusStatesPrunedPerBlock
+	if maxPruned == 0 {
+		return
+	}
+
+	cursor := k.getConsensusStatePruningCursor(ctx)
+
+	var firstClientID string
+	budget := maxPruned
+	for visited := uint64(0); visited < maxPruned && budget > 0; visited++ {
+		clientID, found := k.nextClientID(ctx, cursor)
+		if !found || clientID == firstClientID {
+			break
+		}
+
+		if firstClientID == "" {
+			firstClientID = clientID
+		}
+		cursor = clientID
+
+		if clientID == exported.LocalhostClientID {
+			continue
+		}
+
+		clientModule, found := k.router.GetRoute(clientID)
+		if !found {
+			continue
+		}
+
+		if _, ok := clientModule.(exported.ConsensusStatePruningModule); !ok {
+			continue
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		pruned, err := k.PruneConsensusStates(cacheCtx, clientID, budget)
+		if err != nil {
+			k.Logger(ctx).Error("failed to prune consensus states", "client-id", clientID, "error", err.Error())
+			continue
+		}
+
+		writeFn()
+		budget -= min(pruned, budget)
+	}
+
+	if cursor != "" {
+		k.setConsensusStatePruningCursor(ctx, cursor)
+	}
+}
+
+// nextClientID returns the identifier of the first client stored after the client with the provided identifier,
+// wrapping around to the first client in the store. False is returned if no clients exist.
+func (k *Keeper) nextClientID(ctx sdk.Context, clientID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	clientsPrefix := []byte(fmt.Sprintf("%s/", host.KeyClientStorePrefix))
+
+	start := clientsPrefix
+	if clientID != "" {
+		start = storetypes.PrefixEndBytes([]byte(fmt.Sprintf("%s%s/", clientsPrefix, clientID)))
+	}
+
+	if nextClientID, found := firstClientIDInRange(store, start, storetypes.PrefixEndBytes(clientsPrefix)); found {
+		return nextClientID, true
+	}
+
+	return firstClientIDInRange(store, clientsPrefix, storetypes.PrefixEndBytes(clientsPrefix))
+}
+
+// visitClients invokes the callback for at most limit clients, excluding the localhost client, starting after
+// the client identifier stored under the provided cursor key. Clients are visited in a round robin fashion and
+// each client is visited at most once per call. The identifier of the last client visited is stored under the
+// cursor key so that the next call resumes from the following client.
+func (k *Keeper) visitClients(ctx sdk.Context, cursorKey []byte, limit uint64, cb func(clientID string)) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := string(store.Get(cursorKey))
+
+	var firstClientID string
+	for visited := uint64(0); visited < limit; visited++ {
+		clientID, found := k.nextClientID(ctx, cursor)
+		if !found || clientID == firstClientID {
+			break
+		}
+
+		if firstClientID == "" {
+			firstClientID = clientID
+		}
+		cursor = clientID
+
+		if clientID == exported.LocalhostClientID {
+			continue
+		}
+
+		cb(clientID)
+	}
+
+	if cursor != "" {
+		store.Set(cursorKey, []byte(cursor))
+	}
+}
+
+// firstClientIDInRange returns the client identifier parsed from the first key in the client store range [start, end).
+func firstClientIDInRange(store storetypes.KVStore, start, end []byte) (string, bool) {
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return "", false
+	}
+
+	// keys in the client store are in the format "clients/<clientID>/<path>"
+	keySplit := strings.SplitN(string(iterator.Key()), "/", 3)
+	if len(keySplit) < 3 {
+		return "", false
+	}
+
+	return keySplit[1], true
+}
+
+// getConsensusStatePruningCursor returns the identifier of the last client visited when pruning consensus states.
+func (k *Keeper) getConsensusStatePruningCursor(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get([]byte(types.KeyConsensusStatePruningCursor)))
+}
+
+// setConsensusStatePruningCursor stores the identifier of the last client visited when pruning consensus states.
+func (k *Keeper) setConsensusStatePruningCursor(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyConsensusStatePruningCursor), []byte(clientID))
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// setupClientWithExpiredConsensusState creates a client on chainA whose first consensus state is expired
+// while its latest consensus state is still within the trusting period.
+func (suite *KeeperTestSuite) setupClientWithExpiredConsensusState() *ibctesting.Path {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	tmClientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+
+	suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod / 2)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod/2 + time.Minute)
+
+	return path
+}
+
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	var (
+		clientID string
+		limit    uint64
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned uint64
+		expErr    error
+	}{
+		{
+			"success",
+			func() {},
+			1,
+			nil,
+		},
+		{
+			"failure: client does not exist",
+			func() {
+				clientID = ibctesting.SecondClientID
+			},
+			0,
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: light client module does not support pruning",
+			func() {
+				clientID = exported.LocalhostClientID
+			},
+			0,
+			clienttypes.ErrPruningNotSupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := suite.setupClientWithExpiredConsensusState()
+			clientID = path.EndpointA.ClientID
+			limit = 10
+
+			tc.malleate()
+
+			pruned, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneConsensusStates(suite.chainA.GetContext(), clientID, limit)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+
+				// the latest consensus state is never pruned
+				latestHeight := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientLatestHeight(suite.chainA.GetContext(), clientID)
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, latestHeight)
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredConsensusStatesInBeginBlock() {
+	suite.SetupTest()
+
+	pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathA.SetupClients()
+	pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathB.SetupClients()
+
+	initialHeightA := pathA.EndpointA.GetClientLatestHeight()
+	initialHeightB := pathB.EndpointA.GetClientLatestHeight()
+
+	tmClientState, ok := pathA.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+
+	// expire the initial consensus states of both clients while keeping their latest consensus states active
+	suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod / 2)
+	suite.Require().NoError(pathA.EndpointA.UpdateClient())
+	suite.Require().NoError(pathB.EndpointA.UpdateClient())
+	suite.coordinator.IncrementTimeBy(tmClientState.TrustingPeriod/2 + time.Minute)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	hasConsensusState := func(clientID string, height exported.Height) bool {
+		_, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, height)
+		return found
+	}
+
+	// pruning is disabled by default
+	clientKeeper.PruneExpiredConsensusStates(suite.chainA.GetContext())
+	suite.Require().True(hasConsensusState(pathA.EndpointA.ClientID, initialHeightA))
+	suite.Require().True(hasConsensusState(pathB.EndpointA.ClientID, initialHeightB))
+
+	params := clienttypes.DefaultParams()
+	params.MaxConsensusStatesPrunedPerBlock = 1
+	clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// a single client is visited per call
+	clientKeeper.PruneExpiredConsensusStates(suite.chainA.GetContext())
+	suite.Require().False(hasConsensusState(pathA.EndpointA.ClientID, initialHeightA))
+	suite.Require().True(hasConsensusState(pathB.EndpointA.ClientID, initialHeightB))
+
+	// the next call resumes from the following client
+	clientKeeper.PruneExpiredConsensusStates(suite.chainA.GetContext())
+	suite.Require().False(hasConsensusState(pathB.EndpointA.ClientID, initialHeightB))
+
+	// latest consensus states are never pruned
+	suite.Require().True(hasConsensusState(pathA.EndpointA.ClientID, pathA.EndpointA.GetClientLatestHeight()))
+	suite.Require().True(hasConsensusState(pathB.EndpointA.ClientID, pathB.EndpointA.GetClientLatestHeight()))
+}
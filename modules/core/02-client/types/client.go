@@ -5,9 +5,12 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -28,6 +31,23 @@ type ConsensusHost interface {
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 }
 
+// TrustingPeriodClientState defines an optional interface which may be implemented by client states
+// that expire once the trusting period has elapsed since the timestamp of the latest consensus state.
+type TrustingPeriodClientState interface {
+	exported.ClientState
+	GetTrustingPeriod() time.Duration
+}
+
+// CreationPolicyClientState defines an optional interface which may be implemented by client states in order
+// to be evaluated against the chain identifier, trusting period and trust level constraints of client creation
+// policies.
+type CreationPolicyClientState interface {
+	TrustingPeriodClientState
+	GetChainID() string
+	GetUnbondingPeriod() time.Duration
+	GetTrustLevel() cmtmath.Fraction
+}
+
 // NewIdentifiedClientState creates a new IdentifiedClientState instance
 func NewIdentifiedClientState(clientID string, clientState exported.ClientState) IdentifiedClientState {
 	msg, ok := clientState.(proto.Message)
@@ -10,15 +10,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types2 "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	_go "github.com/cosmos/ics23/go"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -249,6 +255,19 @@ type Params struct {
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// client_deletion_grace_period defines the duration after the latest consensus state timestamp of an
+	// expired or frozen client after which any account may delete the client. If zero, only the authority
+	// may delete clients.
+	ClientDeletionGracePeriod time.Duration `protobuf:"bytes,2,opt,name=client_deletion_grace_period,json=clientDeletionGracePeriod,proto3,stdduration" json:"client_deletion_grace_period"`
+	// client_expiry_warning_threshold defines the remaining trusting period below which an event is emitted
+	// at BeginBlock warning that the client is about to expire. If zero, no warning events are emitted.
+	ClientExpiryWarningThreshold time.Duration `protobuf:"bytes,3,opt,name=client_expiry_warning_threshold,json=clientExpiryWarningThreshold,proto3,stdduration" json:"client_expiry_warning_threshold"`
+	// max_consensus_states_pruned_per_block defines the maximum number of expired consensus states pruned
+	// across all clients at BeginBlock. If zero, consensus states are not pruned at BeginBlock.
+	MaxConsensusStatesPrunedPerBlock uint64 `protobuf:"varint,4,opt,name=max_consensus_states_pruned_per_block,json=maxConsensusStatesPrunedPerBlock,proto3" json:"max_consensus_states_pruned_per_block,omitempty"`
+	// client_creation_policies defines the policies evaluated when creating clients of the client types they
+	// apply to, in addition to the allowed_clients list. At most one policy may be defined per client type.
+	ClientCreationPolicies []ClientCreationPolicy `protobuf:"bytes,5,rep,name=client_creation_policies,json=clientCreationPolicies,proto3" json:"client_creation_policies"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -291,6 +310,204 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetClientDeletionGracePeriod() time.Duration {
+	if m != nil {
+		return m.ClientDeletionGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetClientExpiryWarningThreshold() time.Duration {
+	if m != nil {
+		return m.ClientExpiryWarningThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConsensusStatesPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxConsensusStatesPrunedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetClientCreationPolicies() []ClientCreationPolicy {
+	if m != nil {
+		return m.ClientCreationPolicies
+	}
+	return nil
+}
+
+// ClientCreationPolicy defines the constraints which must be satisfied in order to create a client of
+// the given client type. Empty fields impose no constraint.
+type ClientCreationPolicy struct {
+	// client type the policy applies to
+	ClientType string `protobuf:"bytes,1,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// allowed_creators defines the addresses which may create clients of the client type. If empty,
+	// any address may create clients.
+	AllowedCreators []string `protobuf:"bytes,2,rep,name=allowed_creators,json=allowedCreators,proto3" json:"allowed_creators,omitempty"`
+	// allowed_chain_ids defines the chain identifiers which clients of the client type may track. If
+	// empty, clients may track any chain.
+	AllowedChainIds []string `protobuf:"bytes,3,rep,name=allowed_chain_ids,json=allowedChainIds,proto3" json:"allowed_chain_ids,omitempty"`
+	// max_trusting_period_ratio defines the maximum ratio of the trusting period to the unbonding period
+	// of the client state, as a decimal string (e.g. "0.67").
+	MaxTrustingPeriodRatio string `protobuf:"bytes,4,opt,name=max_trusting_period_ratio,json=maxTrustingPeriodRatio,proto3" json:"max_trusting_period_ratio,omitempty"`
+	// min_trust_level defines the minimum trust level of the client state, as a decimal string (e.g. "0.333").
+	MinTrustLevel string `protobuf:"bytes,5,opt,name=min_trust_level,json=minTrustLevel,proto3" json:"min_trust_level,omitempty"`
+}
+
+func (m *ClientCreationPolicy) Reset()         { *m = ClientCreationPolicy{} }
+func (m *ClientCreationPolicy) String() string { return proto.CompactTextString(m) }
+func (*ClientCreationPolicy) ProtoMessage()    {}
+func (*ClientCreationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{5}
+}
+func (m *ClientCreationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientCreationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientCreationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientCreationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientCreationPolicy.Merge(m, src)
+}
+func (m *ClientCreationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientCreationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientCreationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientCreationPolicy proto.InternalMessageInfo
+
+func (m *ClientCreationPolicy) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *ClientCreationPolicy) GetAllowedCreators() []string {
+	if m != nil {
+		return m.AllowedCreators
+	}
+	return nil
+}
+
+func (m *ClientCreationPolicy) GetAllowedChainIds() []string {
+	if m != nil {
+		return m.AllowedChainIds
+	}
+	return nil
+}
+
+func (m *ClientCreationPolicy) GetMaxTrustingPeriodRatio() string {
+	if m != nil {
+		return m.MaxTrustingPeriodRatio
+	}
+	return ""
+}
+
+func (m *ClientCreationPolicy) GetMinTrustLevel() string {
+	if m != nil {
+		return m.MinTrustLevel
+	}
+	return ""
+}
+
+// RecoveryOverrides defines optional overrides of the client state parameters of a client recovered
+// using a substitute client. Unset fields are not overridden. The light client module of the subject
+// client validates which overrides are supported and whether the recovered client state is valid.
+type RecoveryOverrides struct {
+	// trusting period of the recovered client
+	TrustingPeriod *time.Duration `protobuf:"bytes,1,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period,omitempty"`
+	// unbonding period of the recovered client
+	UnbondingPeriod *time.Duration `protobuf:"bytes,2,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period,omitempty"`
+	// numerator of the trust level of the recovered client, must be set together with the denominator
+	TrustLevelNumerator uint64 `protobuf:"varint,3,opt,name=trust_level_numerator,json=trustLevelNumerator,proto3" json:"trust_level_numerator,omitempty"`
+	// denominator of the trust level of the recovered client, must be set together with the numerator
+	TrustLevelDenominator uint64 `protobuf:"varint,4,opt,name=trust_level_denominator,json=trustLevelDenominator,proto3" json:"trust_level_denominator,omitempty"`
+	// proof specs of the recovered client
+	ProofSpecs []*_go.ProofSpec `protobuf:"bytes,5,rep,name=proof_specs,json=proofSpecs,proto3" json:"proof_specs,omitempty"`
+}
+
+func (m *RecoveryOverrides) Reset()         { *m = RecoveryOverrides{} }
+func (m *RecoveryOverrides) String() string { return proto.CompactTextString(m) }
+func (*RecoveryOverrides) ProtoMessage()    {}
+func (*RecoveryOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{6}
+}
+func (m *RecoveryOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryOverrides.Merge(m, src)
+}
+func (m *RecoveryOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryOverrides proto.InternalMessageInfo
+
+func (m *RecoveryOverrides) GetTrustingPeriod() *time.Duration {
+	if m != nil {
+		return m.TrustingPeriod
+	}
+	return nil
+}
+
+func (m *RecoveryOverrides) GetUnbondingPeriod() *time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return nil
+}
+
+func (m *RecoveryOverrides) GetTrustLevelNumerator() uint64 {
+	if m != nil {
+		return m.TrustLevelNumerator
+	}
+	return 0
+}
+
+func (m *RecoveryOverrides) GetTrustLevelDenominator() uint64 {
+	if m != nil {
+		return m.TrustLevelDenominator
+	}
+	return 0
+}
+
+func (m *RecoveryOverrides) GetProofSpecs() []*_go.ProofSpec {
+	if m != nil {
+		return m.ProofSpecs
+	}
+	return nil
+}
+
 // ClientUpdateProposal is a legacy governance proposal. If it passes, the substitute
 // client's latest consensus state is copied over to the subject client. The proposal
 // handler may fail if the subject and the substitute do not match in client and
@@ -315,7 +532,7 @@ func (m *ClientUpdateProposal) Reset()         { *m = ClientUpdateProposal{} }
 func (m *ClientUpdateProposal) String() string { return proto.CompactTextString(m) }
 func (*ClientUpdateProposal) ProtoMessage()    {}
 func (*ClientUpdateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{7}
 }
 func (m *ClientUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +583,7 @@ type UpgradeProposal struct {
 func (m *UpgradeProposal) Reset()      { *m = UpgradeProposal{} }
 func (*UpgradeProposal) ProtoMessage() {}
 func (*UpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{8}
 }
 func (m *UpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,63 +612,153 @@ func (m *UpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpgradeProposal proto.InternalMessageInfo
 
+// Counterparty defines the counterparty of a client, allowing packets to be routed by client identifier between
+// a pair of clients without a connection or channel.
+type Counterparty struct {
+	// client identifier of the counterparty client on the counterparty chain
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// merkle path prefix under which the counterparty chain stores IBC commitments
+	MerklePathPrefix types2.MerklePath `protobuf:"bytes,2,opt,name=merkle_path_prefix,json=merklePathPrefix,proto3" json:"merkle_path_prefix"`
+}
+
+func (m *Counterparty) Reset()         { *m = Counterparty{} }
+func (m *Counterparty) String() string { return proto.CompactTextString(m) }
+func (*Counterparty) ProtoMessage()    {}
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{9}
+}
+func (m *Counterparty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Counterparty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Counterparty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Counterparty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Counterparty.Merge(m, src)
+}
+func (m *Counterparty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Counterparty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Counterparty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Counterparty proto.InternalMessageInfo
+
+func (m *Counterparty) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *Counterparty) GetMerklePathPrefix() types2.MerklePath {
+	if m != nil {
+		return m.MerklePathPrefix
+	}
+	return types2.MerklePath{}
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
+	proto.RegisterType((*ClientCreationPolicy)(nil), "ibc.core.client.v1.ClientCreationPolicy")
+	proto.RegisterType((*RecoveryOverrides)(nil), "ibc.core.client.v1.RecoveryOverrides")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
+	proto.RegisterType((*Counterparty)(nil), "ibc.core.client.v1.Counterparty")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xa6, 0x31, 0x34, 0x13, 0x69, 0x74, 0x4d, 0x61, 0x4d, 0x4b, 0x36, 0x2c, 0x05, 0x73,
-	0x68, 0x77, 0x4d, 0x04, 0x2d, 0x01, 0x0f, 0xa6, 0x97, 0xf6, 0x22, 0x75, 0xa5, 0x08, 0x82, 0x84,
-	0xfd, 0x98, 0x6e, 0xa6, 0xec, 0xce, 0x2c, 0x3b, 0xb3, 0x91, 0xfc, 0x03, 0x8f, 0x8a, 0x17, 0xc1,
-	0x4b, 0x7f, 0x84, 0x3f, 0xa2, 0x78, 0xea, 0xd1, 0x53, 0x90, 0xf6, 0xe2, 0xb9, 0xbf, 0x40, 0x76,
-	0x3e, 0x6c, 0x63, 0x5a, 0x15, 0xbc, 0xcd, 0xfb, 0xcc, 0x33, 0xef, 0xfb, 0xcc, 0x33, 0xf3, 0xbe,
-	0xc0, 0x44, 0x7e, 0xe0, 0x04, 0x24, 0x83, 0x4e, 0x10, 0x23, 0x88, 0x99, 0x33, 0xe9, 0xc9, 0x95,
-	0x9d, 0x66, 0x84, 0x11, 0x5d, 0x47, 0x7e, 0x60, 0x17, 0x04, 0x5b, 0xc2, 0x93, 0x5e, 0x6b, 0x23,
-	0x20, 0x34, 0x21, 0xd4, 0xc9, 0xd3, 0x28, 0xf3, 0x42, 0xe8, 0x4c, 0x7a, 0x3e, 0x64, 0x5e, 0x4f,
-	0xc5, 0xe2, 0x64, 0xeb, 0xbe, 0x60, 0x8d, 0x78, 0xe4, 0x88, 0x40, 0x6e, 0x35, 0x23, 0x12, 0x11,
-	0x81, 0x17, 0x2b, 0x75, 0x20, 0x22, 0x24, 0x8a, 0xa1, 0xc3, 0x23, 0x3f, 0x3f, 0x74, 0x3c, 0x3c,
-	0x15, 0x5b, 0x56, 0x02, 0x56, 0xf7, 0x42, 0x88, 0x19, 0x3a, 0x44, 0x30, 0xdc, 0xe1, 0x42, 0x5e,
-	0x32, 0x8f, 0x41, 0x7d, 0x0d, 0xd4, 0x84, 0xae, 0x11, 0x0a, 0x0d, 0xad, 0xa3, 0x75, 0x6b, 0xee,
-	0xb2, 0x00, 0xf6, 0x42, 0xfd, 0x09, 0xb8, 0x2d, 0x37, 0x69, 0x41, 0x36, 0xca, 0x1d, 0xad, 0x5b,
-	0xef, 0x37, 0x6d, 0x51, 0xc7, 0x56, 0x75, 0xec, 0x67, 0x78, 0xea, 0xd6, 0x83, 0xcb, 0xac, 0xd6,
-	0x47, 0x0d, 0x18, 0x3b, 0x04, 0x53, 0x88, 0x69, 0x4e, 0x39, 0xf4, 0x0a, 0xb1, 0xf1, 0x2e, 0x44,
-	0xd1, 0x98, 0xe9, 0xdb, 0xa0, 0x3a, 0xe6, 0x2b, 0x5e, 0xaf, 0xde, 0x6f, 0xd9, 0x8b, 0x16, 0xd9,
-	0x82, 0x3b, 0xac, 0x9c, 0xcc, 0xcc, 0x92, 0x2b, 0xf9, 0xfa, 0x53, 0xd0, 0x08, 0x54, 0xd6, 0x7f,
-	0x90, 0xb4, 0x12, 0xcc, 0x49, 0x28, 0x54, 0xad, 0x8a, 0xbb, 0xcf, 0x6b, 0xa3, 0x7f, 0x76, 0xe1,
-	0x0d, 0xb8, 0xf3, 0x5b, 0x55, 0x6a, 0x94, 0x3b, 0x4b, 0xdd, 0x7a, 0x7f, 0xf3, 0x3a, 0xe5, 0x37,
-	0xdd, 0x5b, 0xde, 0xa5, 0x31, 0x2f, 0x8a, 0x5a, 0x21, 0xa8, 0x4a, 0x63, 0x1e, 0x80, 0x46, 0x06,
-	0x27, 0x88, 0x22, 0x82, 0x47, 0x38, 0x4f, 0x7c, 0x98, 0x71, 0x2d, 0x15, 0x77, 0x45, 0xc1, 0xcf,
-	0x39, 0x3a, 0x47, 0x94, 0x56, 0x96, 0xe7, 0x89, 0x22, 0xe3, 0x60, 0xf9, 0xdd, 0xb1, 0x59, 0xfa,
-	0x74, 0x6c, 0x96, 0xac, 0x1e, 0xa8, 0xee, 0x7b, 0x99, 0x97, 0xd0, 0xe2, 0xb0, 0x17, 0xc7, 0xe4,
-	0x2d, 0x0c, 0x47, 0x42, 0x34, 0x35, 0xb4, 0xce, 0x52, 0xb7, 0xe6, 0xae, 0x48, 0x58, 0x58, 0x44,
-	0xad, 0x0f, 0x65, 0xd0, 0x14, 0xeb, 0x83, 0x34, 0xf4, 0x18, 0xdc, 0xcf, 0x48, 0x4a, 0xa8, 0x17,
-	0xeb, 0x4d, 0x70, 0x8b, 0x21, 0x16, 0x43, 0xe9, 0x94, 0x08, 0xf4, 0x0e, 0xa8, 0x87, 0x90, 0x06,
-	0x19, 0x4a, 0x19, 0x22, 0x98, 0x0b, 0xaa, 0xb9, 0x57, 0x21, 0x7d, 0x17, 0xdc, 0xa5, 0xb9, 0x7f,
-	0x04, 0x03, 0x36, 0xba, 0x74, 0x7b, 0xa9, 0xe0, 0x0d, 0xd7, 0x2f, 0x66, 0xa6, 0x31, 0xf5, 0x92,
-	0x78, 0x60, 0x2d, 0x50, 0x2c, 0xb7, 0x21, 0xb1, 0x1d, 0xf5, 0x24, 0x2f, 0x40, 0x93, 0xe6, 0x3e,
-	0x65, 0x88, 0xe5, 0x0c, 0x5e, 0x49, 0x56, 0xe1, 0xc9, 0xcc, 0x8b, 0x99, 0xb9, 0xf6, 0x2b, 0xd9,
-	0x02, 0xcb, 0x72, 0xf5, 0x4b, 0x58, 0xa5, 0x1c, 0x6c, 0x14, 0x56, 0x7d, 0xfd, 0xb2, 0xd5, 0x92,
-	0x8d, 0x16, 0x91, 0x89, 0x2d, 0xfb, 0xb2, 0x78, 0x52, 0x06, 0x31, 0x33, 0x34, 0xeb, 0x73, 0x19,
-	0x34, 0x0e, 0x44, 0x97, 0xfe, 0xb7, 0x1d, 0x8f, 0x41, 0x25, 0x8d, 0x3d, 0xcc, 0x1d, 0xa8, 0xf7,
-	0xd7, 0x6d, 0x59, 0x58, 0x0d, 0x01, 0x55, 0x7c, 0x3f, 0xf6, 0xb0, 0xfc, 0x3b, 0x9c, 0xaf, 0x1f,
-	0x81, 0x55, 0xc9, 0x51, 0x2f, 0x28, 0x7b, 0xa1, 0x72, 0x73, 0x2f, 0x0c, 0x3b, 0x17, 0x33, 0x73,
-	0x5d, 0x78, 0x72, 0xed, 0x61, 0xcb, 0xbd, 0xa7, 0xf0, 0x2b, 0xe3, 0x61, 0xb0, 0xa9, 0x3e, 0xd0,
-	0x8f, 0x63, 0x53, 0xfb, 0x9b, 0x3b, 0x43, 0xf7, 0xe4, 0xac, 0xad, 0x9d, 0x9e, 0xb5, 0xb5, 0xef,
-	0x67, 0x6d, 0xed, 0xfd, 0x79, 0xbb, 0x74, 0x7a, 0xde, 0x2e, 0x7d, 0x3b, 0x6f, 0x97, 0x5e, 0x6f,
-	0x47, 0x88, 0x8d, 0x73, 0xdf, 0x0e, 0x48, 0x22, 0x27, 0x99, 0x83, 0xfc, 0x60, 0x2b, 0x22, 0xce,
-	0x64, 0xdb, 0x49, 0x48, 0x98, 0xc7, 0x90, 0x8a, 0x31, 0xfa, 0xb0, 0xbf, 0x25, 0x27, 0x29, 0x9b,
-	0xa6, 0x90, 0xfa, 0x55, 0x7e, 0x8d, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x80, 0x51,
-	0x2a, 0x69, 0x05, 0x00, 0x00,
+	// 1176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x3a, 0x6e, 0x14, 0x8f, 0x4b, 0x9c, 0x4c, 0x93, 0xb2, 0x49, 0x23, 0xaf, 0xb5, 0x2a,
+	0x34, 0xa0, 0xc6, 0x26, 0xae, 0x54, 0x42, 0x10, 0x07, 0x9c, 0x20, 0x12, 0x04, 0xad, 0xd9, 0xa6,
+	0x54, 0x42, 0x42, 0xab, 0xfd, 0x98, 0xd8, 0x93, 0xee, 0xee, 0xac, 0x66, 0x66, 0xdd, 0xf8, 0xcc,
+	0x85, 0x23, 0x88, 0x4b, 0x25, 0x2e, 0xf9, 0x11, 0x9c, 0x39, 0x57, 0x9c, 0x2a, 0x4e, 0x9c, 0x02,
+	0x4a, 0x2e, 0x5c, 0xc9, 0x2f, 0x40, 0xf3, 0xb1, 0xfe, 0x48, 0xd2, 0x36, 0x12, 0xb7, 0x9d, 0xf7,
+	0xe3, 0x99, 0xf7, 0x7d, 0xe6, 0xd9, 0x79, 0x07, 0x58, 0xd8, 0x0f, 0x9a, 0x01, 0xa1, 0xa8, 0x19,
+	0x44, 0x18, 0x25, 0xbc, 0xd9, 0x5f, 0xd7, 0x5f, 0x8d, 0x94, 0x12, 0x4e, 0x20, 0xc4, 0x7e, 0xd0,
+	0x10, 0x01, 0x0d, 0x6d, 0xee, 0xaf, 0x2f, 0xaf, 0x04, 0x84, 0xc5, 0x84, 0x35, 0x71, 0xc0, 0x5a,
+	0xf7, 0x44, 0x46, 0x4a, 0x09, 0xd9, 0x67, 0x2a, 0x63, 0xf9, 0xb6, 0xf6, 0x66, 0x69, 0x97, 0x7a,
+	0x21, 0x6a, 0xf6, 0xd7, 0x7d, 0xc4, 0xbd, 0xf5, 0x7c, 0xad, 0xa3, 0x96, 0x54, 0x94, 0x2b, 0x57,
+	0x4d, 0xb5, 0xd0, 0xae, 0x85, 0x2e, 0xe9, 0x12, 0x65, 0x17, 0x5f, 0x79, 0x42, 0x97, 0x90, 0x6e,
+	0x84, 0x9a, 0x72, 0xe5, 0x67, 0xfb, 0x4d, 0x2f, 0x19, 0x68, 0x57, 0xed, 0xbc, 0x2b, 0xcc, 0xa8,
+	0xc7, 0x31, 0x49, 0xb4, 0xff, 0xce, 0xa8, 0x49, 0x12, 0xc7, 0x98, 0xc7, 0x79, 0xa3, 0xc3, 0x95,
+	0x0a, 0xb4, 0x63, 0xb0, 0xb8, 0x1b, 0xa2, 0x84, 0xe3, 0x7d, 0x8c, 0xc2, 0x2d, 0xd9, 0xef, 0x23,
+	0xee, 0x71, 0x04, 0x6f, 0x81, 0xb2, 0x6a, 0xdf, 0xc5, 0xa1, 0x69, 0xd4, 0x8d, 0xd5, 0xb2, 0x33,
+	0xa3, 0x0c, 0xbb, 0x21, 0xfc, 0x10, 0x5c, 0xd7, 0x4e, 0x26, 0x82, 0xcd, 0x62, 0xdd, 0x58, 0xad,
+	0xb4, 0x16, 0x1a, 0xaa, 0xaa, 0x46, 0x5e, 0x55, 0xe3, 0xd3, 0x64, 0xe0, 0x54, 0x82, 0x11, 0xaa,
+	0xfd, 0xb3, 0x01, 0xcc, 0x2d, 0x92, 0x30, 0x94, 0xb0, 0x8c, 0x49, 0xd3, 0x13, 0xcc, 0x7b, 0x3b,
+	0x08, 0x77, 0x7b, 0x1c, 0x6e, 0x80, 0xe9, 0x9e, 0xfc, 0x92, 0xfb, 0x55, 0x5a, 0xcb, 0x8d, 0x8b,
+	0x27, 0xd1, 0x50, 0xb1, 0xed, 0xd2, 0x8b, 0x63, 0xab, 0xe0, 0xe8, 0x78, 0xf8, 0x09, 0xa8, 0x06,
+	0x39, 0xea, 0x15, 0x4a, 0x9a, 0x0d, 0x26, 0x4a, 0x10, 0x55, 0x2d, 0xaa, 0xde, 0x27, 0x6b, 0x63,
+	0xaf, 0x67, 0xe1, 0x3b, 0x30, 0x77, 0x6e, 0x57, 0x66, 0x16, 0xeb, 0x53, 0xab, 0x95, 0xd6, 0xdd,
+	0xcb, 0x2a, 0x7f, 0x55, 0xdf, 0xba, 0x97, 0xea, 0x64, 0x51, 0xcc, 0x0e, 0xc1, 0xb4, 0x26, 0xe6,
+	0x0e, 0xa8, 0x52, 0xd4, 0xc7, 0x0c, 0x93, 0xc4, 0x4d, 0xb2, 0xd8, 0x47, 0x54, 0xd6, 0x52, 0x72,
+	0x66, 0x73, 0xf3, 0x03, 0x69, 0x9d, 0x08, 0xd4, 0x54, 0x16, 0x27, 0x03, 0x15, 0xe2, 0xe6, 0xcc,
+	0x0f, 0x47, 0x56, 0xe1, 0xf9, 0x91, 0x55, 0xb0, 0x7f, 0x9b, 0x02, 0xd3, 0x1d, 0x8f, 0x7a, 0x31,
+	0x13, 0xd9, 0x5e, 0x14, 0x91, 0x67, 0x28, 0x74, 0x55, 0xd5, 0xcc, 0x34, 0xea, 0x53, 0xab, 0x65,
+	0x67, 0x56, 0x9b, 0x15, 0x47, 0x0c, 0x86, 0x60, 0x45, 0xb3, 0x12, 0xa2, 0x08, 0x09, 0xd9, 0xb9,
+	0x5d, 0xea, 0x05, 0xc8, 0x4d, 0x11, 0xc5, 0x24, 0xd4, 0xdc, 0x2f, 0x5d, 0xe0, 0x7e, 0x5b, 0x8b,
+	0xb4, 0x3d, 0x23, 0x3a, 0x7e, 0xfe, 0x97, 0x65, 0x38, 0x4b, 0x0a, 0x68, 0x5b, 0xe3, 0x7c, 0x2e,
+	0x60, 0x3a, 0x12, 0x05, 0x1e, 0x00, 0x4b, 0xef, 0x82, 0x0e, 0x53, 0x4c, 0x07, 0xee, 0x33, 0x8f,
+	0x26, 0x38, 0xe9, 0xba, 0xbc, 0x47, 0x11, 0xeb, 0x91, 0x28, 0x34, 0xa7, 0xae, 0xbe, 0x91, 0xae,
+	0xf8, 0x33, 0x09, 0xf5, 0x44, 0x21, 0xed, 0xe5, 0x40, 0xf0, 0x21, 0x78, 0x27, 0xf6, 0x0e, 0xdd,
+	0xf3, 0xc7, 0xe9, 0xa6, 0x34, 0x4b, 0x50, 0x28, 0xfa, 0x72, 0xfd, 0x88, 0x04, 0x4f, 0xcd, 0x92,
+	0xa4, 0xb3, 0x1e, 0x7b, 0x87, 0xe7, 0xa4, 0xd2, 0x91, 0x91, 0x1d, 0x44, 0xdb, 0x22, 0x0e, 0xf6,
+	0x80, 0xa9, 0x8b, 0x0f, 0x28, 0x92, 0xb5, 0xb8, 0x29, 0x89, 0x70, 0x80, 0x11, 0x33, 0xaf, 0x49,
+	0x8d, 0xac, 0x5e, 0xaa, 0x11, 0xa5, 0x42, 0x9d, 0xd2, 0x11, 0x19, 0x03, 0xad, 0x8f, 0x9b, 0xc1,
+	0x45, 0x1f, 0x46, 0xcc, 0xfe, 0xd7, 0x00, 0x0b, 0x97, 0xa5, 0x41, 0x0b, 0xe8, 0x5f, 0xcf, 0xe5,
+	0x83, 0x14, 0x69, 0xf5, 0x02, 0x65, 0xda, 0x1b, 0xa4, 0x08, 0xbe, 0x07, 0xe6, 0x86, 0xe7, 0x2d,
+	0x52, 0x09, 0x55, 0xfa, 0x2d, 0x3b, 0xb9, 0x0e, 0xb6, 0xb4, 0x19, 0xbe, 0x0f, 0xe6, 0x87, 0xa1,
+	0x3d, 0x0f, 0x27, 0x2e, 0x0e, 0x99, 0x39, 0x35, 0x19, 0x2b, 0xec, 0xbb, 0x21, 0x83, 0x1f, 0x81,
+	0x25, 0xc1, 0x25, 0xa7, 0x19, 0xe3, 0xe2, 0xb8, 0x94, 0x28, 0x5c, 0x79, 0x22, 0x92, 0xbf, 0xb2,
+	0x73, 0x33, 0xf6, 0x0e, 0xf7, 0xb4, 0x5f, 0x9d, 0xb6, 0x23, 0xbc, 0xf0, 0x5d, 0x50, 0x8d, 0x71,
+	0xa2, 0x52, 0xdd, 0x08, 0xf5, 0x51, 0x64, 0x5e, 0x93, 0x09, 0x6f, 0xc5, 0x38, 0x91, 0x09, 0x5f,
+	0x0a, 0xa3, 0xfd, 0x47, 0x11, 0xcc, 0x3b, 0x28, 0x20, 0x7d, 0x44, 0x07, 0x0f, 0xfb, 0x88, 0x52,
+	0x1c, 0x22, 0x06, 0x77, 0x40, 0xf5, 0xdc, 0xa6, 0xa6, 0xf1, 0x26, 0x81, 0x94, 0xa4, 0x38, 0x66,
+	0xf9, 0x44, 0x31, 0xf0, 0x0b, 0x30, 0x97, 0x25, 0x3e, 0x49, 0xc2, 0x31, 0xa8, 0xe2, 0xd5, 0xa0,
+	0xaa, 0xc3, 0x44, 0x8d, 0xd5, 0x02, 0x8b, 0x63, 0xfd, 0x88, 0xff, 0x17, 0x51, 0x41, 0xaa, 0x14,
+	0x6f, 0xc9, 0xb9, 0xc1, 0x87, 0x6d, 0x3d, 0xc8, 0x5d, 0xf0, 0x3e, 0x78, 0x7b, 0x3c, 0x27, 0x44,
+	0x09, 0x89, 0x71, 0x22, 0xb3, 0x94, 0x00, 0x17, 0x47, 0x59, 0xdb, 0x23, 0x27, 0xfc, 0x18, 0x54,
+	0xe4, 0x60, 0x72, 0x59, 0x8a, 0x82, 0x5c, 0x68, 0xcb, 0x0d, 0x3d, 0x6b, 0xe4, 0xf0, 0x12, 0x2a,
+	0xeb, 0x88, 0x98, 0x47, 0x29, 0x0a, 0x1c, 0x90, 0xe6, 0x9f, 0xcc, 0xfe, 0xa9, 0x98, 0x0b, 0xe9,
+	0x71, 0x1a, 0x7a, 0x1c, 0x75, 0x28, 0x49, 0x09, 0xf3, 0x22, 0xb8, 0x00, 0xae, 0x71, 0xcc, 0xa3,
+	0x5c, 0x42, 0x6a, 0x01, 0xeb, 0xa0, 0x12, 0x22, 0x16, 0x50, 0x9c, 0x8a, 0xee, 0x25, 0x3d, 0x65,
+	0x67, 0xdc, 0x04, 0x77, 0xc0, 0x3c, 0xcb, 0xfc, 0x03, 0x14, 0x70, 0x77, 0x74, 0x89, 0x8a, 0xae,
+	0xcb, 0xed, 0x95, 0xb3, 0x63, 0xcb, 0x1c, 0x78, 0x71, 0xb4, 0x69, 0x5f, 0x08, 0xb1, 0x9d, 0xaa,
+	0xb6, 0x6d, 0xe5, 0x37, 0xed, 0xd7, 0x60, 0x81, 0x65, 0x3e, 0xe3, 0x98, 0x67, 0x1c, 0x8d, 0x81,
+	0x49, 0x35, 0xb5, 0xad, 0xb3, 0x63, 0xeb, 0xd6, 0x10, 0xec, 0x42, 0x94, 0xed, 0xc0, 0x91, 0x39,
+	0x87, 0xdc, 0xbc, 0x2d, 0x6e, 0xc0, 0xdf, 0x7f, 0x5d, 0x5b, 0xd6, 0xe4, 0x74, 0x49, 0xbf, 0xa1,
+	0xe7, 0xb6, 0xb8, 0xa9, 0x39, 0x4a, 0xb8, 0x69, 0xd8, 0xbf, 0x14, 0x41, 0xf5, 0xb1, 0x9a, 0xe2,
+	0xff, 0x9b, 0x8e, 0xfb, 0xa0, 0x94, 0x46, 0x5e, 0xa2, 0x2f, 0xad, 0x95, 0xfc, 0x54, 0xf2, 0x47,
+	0x42, 0xbe, 0x79, 0x27, 0xf2, 0x12, 0xfd, 0xcb, 0xcb, 0x78, 0x78, 0x00, 0x16, 0x75, 0x4c, 0x7e,
+	0x2f, 0xeb, 0x11, 0x57, 0x7a, 0xf5, 0x88, 0x6b, 0xd7, 0xcf, 0x8e, 0xad, 0x15, 0xc5, 0xc9, 0xa5,
+	0xc9, 0xb6, 0x73, 0x23, 0xb7, 0x8f, 0x4d, 0xfd, 0xcd, 0xbb, 0xf9, 0x5c, 0xf8, 0xe7, 0xc8, 0x32,
+	0xde, 0xc8, 0xce, 0xf7, 0x06, 0xb8, 0xbe, 0x45, 0xb2, 0x84, 0x23, 0x9a, 0x7a, 0x94, 0x0f, 0x5e,
+	0x3f, 0x2e, 0xbf, 0x01, 0x30, 0x46, 0xf4, 0x69, 0x84, 0xdc, 0xd4, 0xe3, 0x3d, 0x37, 0xa5, 0x68,
+	0x1f, 0x1f, 0xea, 0xdf, 0xca, 0x1e, 0xbb, 0x0c, 0x47, 0x4f, 0x94, 0xfe, 0x7a, 0xe3, 0x2b, 0x99,
+	0xd1, 0xf1, 0x78, 0x4f, 0x73, 0x32, 0x17, 0x0f, 0x2d, 0x1d, 0x89, 0xd0, 0x76, 0x5e, 0x9c, 0xd4,
+	0x8c, 0x97, 0x27, 0x35, 0xe3, 0xef, 0x93, 0x9a, 0xf1, 0xe3, 0x69, 0xad, 0xf0, 0xf2, 0xb4, 0x56,
+	0xf8, 0xf3, 0xb4, 0x56, 0xf8, 0x76, 0xa3, 0x8b, 0x79, 0x2f, 0xf3, 0x05, 0x64, 0x33, 0x7f, 0xc0,
+	0xf9, 0xc1, 0x5a, 0x97, 0x34, 0xfb, 0x1b, 0xcd, 0x98, 0x84, 0x59, 0x84, 0x98, 0x7a, 0x25, 0x7d,
+	0xd0, 0x5a, 0xd3, 0xaf, 0x41, 0x71, 0x57, 0x32, 0x7f, 0x5a, 0x92, 0x79, 0xef, 0xbf, 0x01, 0x00,
+	0x1c, 0xd2, 0x5e, 0x87, 0x2d, 0x0a, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -671,6 +978,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientCreationPolicies) > 0 {
+		for iNdEx := len(m.ClientCreationPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientCreationPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxConsensusStatesPrunedPerBlock != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MaxConsensusStatesPrunedPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientExpiryWarningThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningThreshold):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintClient(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientDeletionGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientDeletionGracePeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintClient(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -683,6 +1025,135 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientCreationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientCreationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientCreationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinTrustLevel) > 0 {
+		i -= len(m.MinTrustLevel)
+		copy(dAtA[i:], m.MinTrustLevel)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.MinTrustLevel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MaxTrustingPeriodRatio) > 0 {
+		i -= len(m.MaxTrustingPeriodRatio)
+		copy(dAtA[i:], m.MaxTrustingPeriodRatio)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.MaxTrustingPeriodRatio)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AllowedChainIds) > 0 {
+		for iNdEx := len(m.AllowedChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChainIds[iNdEx])
+			copy(dAtA[i:], m.AllowedChainIds[iNdEx])
+			i = encodeVarintClient(dAtA, i, uint64(len(m.AllowedChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedCreators) > 0 {
+		for iNdEx := len(m.AllowedCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCreators[iNdEx])
+			copy(dAtA[i:], m.AllowedCreators[iNdEx])
+			i = encodeVarintClient(dAtA, i, uint64(len(m.AllowedCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofSpecs) > 0 {
+		for iNdEx := len(m.ProofSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TrustLevelDenominator != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.TrustLevelDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TrustLevelNumerator != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.TrustLevelNumerator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UnbondingPeriod != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UnbondingPeriod):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintClient(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TrustingPeriod != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TrustingPeriod):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintClient(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -793,25 +1264,65 @@ func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
-	offset -= sovClient(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Counterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IdentifiedClientState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
+
+func (m *Counterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MerklePathPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClient(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IdentifiedClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
 		n += 1 + l + sovClient(uint64(l))
 	}
 	if m.ClientState != nil {
@@ -882,6 +1393,81 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientDeletionGracePeriod)
+	n += 1 + l + sovClient(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningThreshold)
+	n += 1 + l + sovClient(uint64(l))
+	if m.MaxConsensusStatesPrunedPerBlock != 0 {
+		n += 1 + sovClient(uint64(m.MaxConsensusStatesPrunedPerBlock))
+	}
+	if len(m.ClientCreationPolicies) > 0 {
+		for _, e := range m.ClientCreationPolicies {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientCreationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if len(m.AllowedCreators) > 0 {
+		for _, s := range m.AllowedCreators {
+			l = len(s)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.AllowedChainIds) > 0 {
+		for _, s := range m.AllowedChainIds {
+			l = len(s)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	l = len(m.MaxTrustingPeriodRatio)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.MinTrustLevel)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *RecoveryOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrustingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TrustingPeriod)
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.UnbondingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UnbondingPeriod)
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.TrustLevelNumerator != 0 {
+		n += 1 + sovClient(uint64(m.TrustLevelNumerator))
+	}
+	if m.TrustLevelDenominator != 0 {
+		n += 1 + sovClient(uint64(m.TrustLevelDenominator))
+	}
+	if len(m.ProofSpecs) > 0 {
+		for _, e := range m.ProofSpecs {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -933,6 +1519,21 @@ func (m *UpgradeProposal) Size() (n int) {
 	return n
 }
 
+func (m *Counterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.MerklePathPrefix.Size()
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
 func sovClient(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1207,7 +1808,508 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Height) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Height: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDeletionGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientDeletionGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiryWarningThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientExpiryWarningThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsensusStatesPrunedPerBlock", wireType)
+			}
+			m.MaxConsensusStatesPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsensusStatesPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCreationPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCreationPolicies = append(m.ClientCreationPolicies, ClientCreationPolicy{})
+			if err := m.ClientCreationPolicies[len(m.ClientCreationPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientCreationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientCreationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientCreationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCreators = append(m.AllowedCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChainIds = append(m.AllowedChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrustingPeriodRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1235,13 +2337,13 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.MaxTrustingPeriodRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTrustLevel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1251,25 +2353,23 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClient
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
-			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MinTrustLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1292,7 +2392,7 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Height) Unmarshal(dAtA []byte) error {
+func (m *RecoveryOverrides) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1315,17 +2415,17 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Height: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecoveryOverrides: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecoveryOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
 			}
-			m.RevisionNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1335,16 +2435,33 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevisionNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrustingPeriod == nil {
+				m.TrustingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
-			m.RevisionHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1354,66 +2471,71 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevisionHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClient(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthClient
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthClient
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClient
+			if m.UnbondingPeriod == nil {
+				m.UnbondingPeriod = new(time.Duration)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustLevelNumerator", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TrustLevelNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustLevelNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustLevelDenominator", wireType)
+			}
+			m.TrustLevelDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustLevelDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSpecs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1423,23 +2545,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthClient
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
+			m.ProofSpecs = append(m.ProofSpecs, &_go.ProofSpec{})
+			if err := m.ProofSpecs[len(m.ProofSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1823,6 +2947,121 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Counterparty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counterparty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePathPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerklePathPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClient(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -44,6 +44,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpgradeClient{},
 		&MsgSubmitMisbehaviour{},
 		&MsgRecoverClient{},
+		&MsgDeleteClient{},
+		&MsgPruneConsensusStates{},
+		&MsgProvideCounterparty{},
 		&MsgIBCSoftwareUpgrade{},
 		&MsgUpdateParams{},
 	)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewCounterparty creates a new Counterparty instance
+func NewCounterparty(clientID string, merklePathPrefix commitmenttypes.MerklePath) Counterparty {
+	return Counterparty{
+		ClientId:         clientID,
+		MerklePathPrefix: merklePathPrefix,
+	}
+}
+
+// Validate performs basic validation of the counterparty.
+func (c Counterparty) Validate() error {
+	if err := host.ClientIdentifierValidator(c.ClientId); err != nil {
+		return errorsmod.Wrap(ErrInvalidCounterparty, err.Error())
+	}
+
+	if c.MerklePathPrefix.Empty() {
+		return errorsmod.Wrap(ErrInvalidCounterparty, "merkle path prefix cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Validate performs basic validation of the client creation policy.
+func (p ClientCreationPolicy) Validate() error {
+	if strings.TrimSpace(p.ClientType) == "" {
+		return fmt.Errorf("client creation policy client type cannot be blank")
+	}
+
+	foundCreators := make(map[string]bool, len(p.AllowedCreators))
+	for _, creator := range p.AllowedCreators {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return fmt.Errorf("invalid allowed creator %s for client type %s: %w", creator, p.ClientType, err)
+		}
+		if foundCreators[creator] {
+			return fmt.Errorf("duplicate allowed creator %s for client type %s", creator, p.ClientType)
+		}
+		foundCreators[creator] = true
+	}
+
+	foundChainIDs := make(map[string]bool, len(p.AllowedChainIds))
+	for i, chainID := range p.AllowedChainIds {
+		if strings.TrimSpace(chainID) == "" {
+			return fmt.Errorf("allowed chain ID %d for client type %s cannot be blank", i, p.ClientType)
+		}
+		if foundChainIDs[chainID] {
+			return fmt.Errorf("duplicate allowed chain ID %s for client type %s", chainID, p.ClientType)
+		}
+		foundChainIDs[chainID] = true
+	}
+
+	if p.MaxTrustingPeriodRatio != "" {
+		ratio, err := sdkmath.LegacyNewDecFromStr(p.MaxTrustingPeriodRatio)
+		if err != nil {
+			return fmt.Errorf("invalid max trusting period ratio for client type %s: %w", p.ClientType, err)
+		}
+		if !ratio.IsPositive() || ratio.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("max trusting period ratio for client type %s must be within (0, 1]: %s", p.ClientType, ratio)
+		}
+	}
+
+	if p.MinTrustLevel != "" {
+		trustLevel, err := sdkmath.LegacyNewDecFromStr(p.MinTrustLevel)
+		if err != nil {
+			return fmt.Errorf("invalid min trust level for client type %s: %w", p.ClientType, err)
+		}
+		if !trustLevel.IsPositive() || trustLevel.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("min trust level for client type %s must be within (0, 1]: %s", p.ClientType, trustLevel)
+		}
+	}
+
+	return nil
+}
+
+// HasClientStateConstraints returns true if the policy constrains fields of the client state, in which case
+// the client state must be decoded in order to evaluate the policy.
+func (p ClientCreationPolicy) HasClientStateConstraints() bool {
+	return len(p.AllowedChainIds) > 0 || p.MaxTrustingPeriodRatio != "" || p.MinTrustLevel != ""
+}
+
+// VerifyCreator returns an error if the policy restricts client creation to a set of creators which does not
+// contain the provided creator.
+func (p ClientCreationPolicy) VerifyCreator(creator string) error {
+	if len(p.AllowedCreators) == 0 || slices.Contains(p.AllowedCreators, creator) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(ErrClientCreationPolicyViolation, "creator %s is not allowed to create clients of type %s", creator, p.ClientType)
+}
+
+// VerifyClientState returns an error if the client state does not satisfy the chain identifier, trusting period
+// and trust level constraints of the policy. Client states which do not implement CreationPolicyClientState are
+// rejected if the policy defines any such constraint.
+func (p ClientCreationPolicy) VerifyClientState(clientState exported.ClientState) error {
+	if !p.HasClientStateConstraints() {
+		return nil
+	}
+
+	policyClientState, ok := clientState.(CreationPolicyClientState)
+	if !ok {
+		return errorsmod.Wrapf(ErrClientCreationPolicyViolation, "client state %T cannot be evaluated against the creation policy for client type %s", clientState, p.ClientType)
+	}
+
+	if len(p.AllowedChainIds) > 0 && !slices.Contains(p.AllowedChainIds, policyClientState.GetChainID()) {
+		return errorsmod.Wrapf(ErrClientCreationPolicyViolation, "chain ID %s is not allowed for clients of type %s", policyClientState.GetChainID(), p.ClientType)
+	}
+
+	if p.MaxTrustingPeriodRatio != "" {
+		maxRatio, err := sdkmath.LegacyNewDecFromStr(p.MaxTrustingPeriodRatio)
+		if err != nil {
+			return err
+		}
+
+		unbondingPeriod := policyClientState.GetUnbondingPeriod()
+		if unbondingPeriod <= 0 {
+			return errorsmod.Wrapf(ErrClientCreationPolicyViolation, "unbonding period must be positive: %s", unbondingPeriod)
+		}
+
+		ratio := sdkmath.LegacyNewDec(int64(policyClientState.GetTrustingPeriod())).QuoInt64(int64(unbondingPeriod))
+		if ratio.GT(maxRatio) {
+			return errorsmod.Wrapf(ErrClientCreationPolicyViolation, "trusting period to unbonding period ratio %s exceeds maximum %s", ratio, maxRatio)
+		}
+	}
+
+	if p.MinTrustLevel != "" {
+		minTrustLevel, err := sdkmath.LegacyNewDecFromStr(p.MinTrustLevel)
+		if err != nil {
+			return err
+		}
+
+		trustLevel := policyClientState.GetTrustLevel()
+		if trustLevel.Denominator == 0 {
+			return errorsmod.Wrap(ErrClientCreationPolicyViolation, "trust level denominator cannot be zero")
+		}
+
+		trustLevelDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(trustLevel.Numerator)).Quo(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(trustLevel.Denominator)))
+		if trustLevelDec.LT(minTrustLevel) {
+			return errorsmod.Wrapf(ErrClientCreationPolicyViolation, "trust level %s is below minimum %s", trustLevelDec, minTrustLevel)
+		}
+	}
+
+	return nil
+}
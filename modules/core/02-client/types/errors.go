@@ -38,4 +38,11 @@ var (
 	ErrFailedNonMembershipVerification        = errorsmod.Register(SubModuleName, 31, "non-membership verification failed")
 	ErrRouteNotFound                          = errorsmod.Register(SubModuleName, 32, "light client module route not found")
 	ErrClientTypeNotSupported                 = errorsmod.Register(SubModuleName, 33, "client type not supported")
+	ErrClientInUse                            = errorsmod.Register(SubModuleName, 34, "client is referenced by an open connection")
+	ErrClientNotDeletable                     = errorsmod.Register(SubModuleName, 35, "client cannot be deleted")
+	ErrPruningNotSupported                    = errorsmod.Register(SubModuleName, 36, "consensus state pruning not supported")
+	ErrClientCreationPolicyViolation          = errorsmod.Register(SubModuleName, 37, "client creation policy violation")
+	ErrInvalidCounterparty                    = errorsmod.Register(SubModuleName, 38, "invalid counterparty")
+	ErrCounterpartyNotFound                   = errorsmod.Register(SubModuleName, 39, "counterparty not found")
+	ErrRedundantUpdate                        = errorsmod.Register(SubModuleName, 40, "client update messages are redundant")
 )
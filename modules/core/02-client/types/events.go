@@ -16,6 +16,11 @@ const (
 	AttributeKeyUpgradeStore      = "upgrade_store"
 	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
 	AttributeKeyUpgradePlanTitle  = "title"
+
+	AttributeKeyRemainingTrustingPeriod = "remaining_trusting_period"
+	AttributeKeyExpiryTime              = "expiry_time"
+	AttributeKeyConsensusStatesPruned   = "consensus_states_pruned"
+	AttributeKeyCounterpartyClientID    = "counterparty_client_id"
 )
 
 // IBC client events vars
@@ -25,8 +30,12 @@ var (
 	EventTypeUpgradeClient              = "upgrade_client"
 	EventTypeSubmitMisbehaviour         = "client_misbehaviour"
 	EventTypeRecoverClient              = "recover_client"
+	EventTypeDeleteClient               = "delete_client"
+	EventTypePruneConsensusStates       = "prune_consensus_states"
+	EventTypeProvideCounterparty        = "provide_counterparty"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypeClientExpiryWarning        = "client_expiry_warning"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxClientExpiryChecksPerBlock is the maximum number of clients whose expiry is checked at BeginBlock.
+const MaxClientExpiryChecksPerBlock uint64 = 100
+
+// ClientHooks defines the interface applications may implement in order to be notified
+// of client status transitions. Hooks are executed within a cached context, if a hook
+// returns an error its state changes are discarded and the error is logged, but the
+// client transition which triggered it is not reverted.
+type ClientHooks interface {
+	// OnClientFrozen is called when misbehaviour submitted in UpdateClient freezes a client.
+	OnClientFrozen(ctx sdk.Context, clientID string) error
+	// OnClientExpired is called from BeginBlock when a client is first detected to be expired.
+	OnClientExpired(ctx sdk.Context, clientID string) error
+	// OnClientRecovered is called when the subject client has been recovered using the substitute client.
+	OnClientRecovered(ctx sdk.Context, subjectClientID, substituteClientID string) error
+	// OnClientUpgraded is called when a client has been upgraded to a new client state.
+	OnClientUpgraded(ctx sdk.Context, clientID string) error
+}
+
+var _ ClientHooks = MultiClientHooks{}
+
+// MultiClientHooks combines multiple ClientHooks, all hook functions are run in sequence. Each hook is
+// executed within its own cached context, such that a failing hook does not discard the state changes
+// of the other hooks.
+type MultiClientHooks []ClientHooks
+
+// NewMultiClientHooks creates a new MultiClientHooks instance.
+func NewMultiClientHooks(hooks ...ClientHooks) MultiClientHooks {
+	return hooks
+}
+
+// OnClientFrozen implements ClientHooks.
+func (mh MultiClientHooks) OnClientFrozen(ctx sdk.Context, clientID string) error {
+	var errs []error
+	for _, h := range mh {
+		errs = append(errs, runHook(ctx, func(ctx sdk.Context) error { return h.OnClientFrozen(ctx, clientID) }))
+	}
+
+	return errors.Join(errs...)
+}
+
+// OnClientExpired implements ClientHooks.
+func (mh MultiClientHooks) OnClientExpired(ctx sdk.Context, clientID string) error {
+	var errs []error
+	for _, h := range mh {
+		errs = append(errs, runHook(ctx, func(ctx sdk.Context) error { return h.OnClientExpired(ctx, clientID) }))
+	}
+
+	return errors.Join(errs...)
+}
+
+// OnClientRecovered implements ClientHooks.
+func (mh MultiClientHooks) OnClientRecovered(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	var errs []error
+	for _, h := range mh {
+		errs = append(errs, runHook(ctx, func(ctx sdk.Context) error { return h.OnClientRecovered(ctx, subjectClientID, substituteClientID) }))
+	}
+
+	return errors.Join(errs...)
+}
+
+// OnClientUpgraded implements ClientHooks.
+func (mh MultiClientHooks) OnClientUpgraded(ctx sdk.Context, clientID string) error {
+	var errs []error
+	for _, h := range mh {
+		errs = append(errs, runHook(ctx, func(ctx sdk.Context) error { return h.OnClientUpgraded(ctx, clientID) }))
+	}
+
+	return errors.Join(errs...)
+}
+
+// runHook executes the hook within a cached context whose state changes are only committed if the hook
+// succeeds. Panics raised by the hook are recovered and returned as errors, except for out of gas panics.
+func runHook(ctx sdk.Context, hook func(sdk.Context) error) (err error) {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = fmt.Errorf("client hook panicked: %v", r)
+		}
+	}()
+
+	if err := hook(cacheCtx); err != nil {
+		return err
+	}
+
+	writeFn()
+	return nil
+}
@@ -29,11 +29,50 @@ const (
 	// ParamsKey is the store key for the IBC client parameters
 	ParamsKey = "clientParams"
 
+	// KeyExpiredClientPrefix is the key prefix used to record clients for which the
+	// expiry of the client has already been reported to the client hooks.
+	KeyExpiredClientPrefix = "expiredClients"
+
+	// KeyExpiryWarningClientPrefix is the key prefix used to record clients for which an
+	// expiry warning event has already been emitted.
+	KeyExpiryWarningClientPrefix = "expiryWarningClients"
+
+	// KeyExpiredClientCheckCursor is the key used to store the identifier of the last client
+	// whose expiry was checked at BeginBlock.
+	KeyExpiredClientCheckCursor = "expiredClientCheckCursor"
+
+	// KeyExpiryWarningCheckCursor is the key used to store the identifier of the last client
+	// checked for an expiry warning at BeginBlock.
+	KeyExpiryWarningCheckCursor = "expiryWarningCheckCursor"
+
+	// KeyConsensusStatePruningCursor is the key used to store the identifier of the last client
+	// whose consensus states were pruned at BeginBlock.
+	KeyConsensusStatePruningCursor = "consensusStatePruningCursor"
+
+	// KeyClientCreator is the key used to store the address of the creator of a client in the client store,
+	// until the counterparty of the client is provided.
+	KeyClientCreator = "creator"
+
+	// KeyCounterparty is the key used to store the counterparty of a client in the client store.
+	KeyCounterparty = "counterparty"
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
 )
 
+// ExpiredClientKey returns the store key used to record that the expiry of the client
+// with the provided identifier has been reported to the client hooks.
+func ExpiredClientKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyExpiredClientPrefix, clientID))
+}
+
+// ExpiryWarningClientKey returns the store key used to record that an expiry warning event
+// has been emitted for the client with the provided identifier.
+func ExpiryWarningClientKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyExpiryWarningClientPrefix, clientID))
+}
+
 // FormatClientIdentifier returns the client identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatClientIdentifier(clientType string, sequence uint64) string {
@@ -20,6 +20,9 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
+	_ sdk.Msg = (*MsgDeleteClient)(nil)
+	_ sdk.Msg = (*MsgPruneConsensusStates)(nil)
+	_ sdk.Msg = (*MsgProvideCounterparty)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
@@ -28,6 +31,9 @@ var (
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgDeleteClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneConsensusStates)(nil)
+	_ sdk.HasValidateBasic = (*MsgProvideCounterparty)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
@@ -244,6 +250,14 @@ func NewMsgRecoverClient(signer, subjectClientID, substituteClientID string) *Ms
 	}
 }
 
+// NewMsgRecoverClientWithOverrides creates a new MsgRecoverClient instance which overrides the provided
+// client state parameters of the recovered client.
+func NewMsgRecoverClientWithOverrides(signer, subjectClientID, substituteClientID string, overrides *RecoveryOverrides) *MsgRecoverClient {
+	msg := NewMsgRecoverClient(signer, subjectClientID, substituteClientID)
+	msg.Overrides = overrides
+	return msg
+}
+
 // ValidateBasic performs basic checks on a MsgRecoverClient.
 func (msg *MsgRecoverClient) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
@@ -262,9 +276,94 @@ func (msg *MsgRecoverClient) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidSubstitute, "subject and substitute clients must be different")
 	}
 
+	if msg.Overrides != nil {
+		return msg.Overrides.ValidateBasic()
+	}
+
+	return nil
+}
+
+// NewMsgDeleteClient creates a new MsgDeleteClient instance
+func NewMsgDeleteClient(clientID, signer string) *MsgDeleteClient {
+	return &MsgDeleteClient{
+		ClientId: clientID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgDeleteClient.
+func (msg *MsgDeleteClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.ClientId == exported.LocalhostClientID {
+		return errorsmod.Wrapf(ErrInvalidClientType, "cannot delete client: %s", exported.LocalhostClientID)
+	}
+
+	return nil
+}
+
+// NewMsgPruneConsensusStates creates a new MsgPruneConsensusStates instance
+func NewMsgPruneConsensusStates(clientID string, limit uint64, signer string) *MsgPruneConsensusStates {
+	return &MsgPruneConsensusStates{
+		ClientId: clientID,
+		Limit:    limit,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPruneConsensusStates.
+func (msg *MsgPruneConsensusStates) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.ClientId == exported.LocalhostClientID {
+		return errorsmod.Wrapf(ErrInvalidClientType, "cannot prune consensus states of client: %s", exported.LocalhostClientID)
+	}
+
+	if msg.Limit == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "limit must be greater than zero")
+	}
+
 	return nil
 }
 
+// NewMsgProvideCounterparty creates a new MsgProvideCounterparty instance
+func NewMsgProvideCounterparty(clientID string, counterparty Counterparty, signer string) *MsgProvideCounterparty {
+	return &MsgProvideCounterparty{
+		ClientId:     clientID,
+		Counterparty: counterparty,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgProvideCounterparty.
+func (msg *MsgProvideCounterparty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.ClientId == exported.LocalhostClientID {
+		return errorsmod.Wrapf(ErrInvalidClientType, "cannot provide counterparty for client: %s", exported.LocalhostClientID)
+	}
+
+	return msg.Counterparty.Validate()
+}
+
 // NewMsgIBCSoftwareUpgrade creates a new MsgIBCSoftwareUpgrade instance
 func NewMsgIBCSoftwareUpgrade(signer string, plan upgradetypes.Plan, upgradedClientState exported.ClientState) (*MsgIBCSoftwareUpgrade, error) {
 	anyClient, err := PackClientState(upgradedClientState)
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	ics23 "github.com/cosmos/ics23/go"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/stretchr/testify/require"
 	testifysuite "github.com/stretchr/testify/suite"
@@ -665,6 +666,72 @@ func (suite *TypesTestSuite) TestMsgRecoverClientValidateBasic() {
 			},
 			types.ErrInvalidSubstitute,
 		},
+		{
+			"success: valid overrides",
+			func() {
+				trustingPeriod, unbondingPeriod := ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod
+				msg.Overrides = &types.RecoveryOverrides{
+					TrustingPeriod:        &trustingPeriod,
+					UnbondingPeriod:       &unbondingPeriod,
+					TrustLevelNumerator:   1,
+					TrustLevelDenominator: 3,
+					ProofSpecs:            commitmenttypes.GetSDKSpecs(),
+				}
+			},
+			nil,
+		},
+		{
+			"success: empty overrides",
+			func() {
+				msg.Overrides = &types.RecoveryOverrides{}
+			},
+			nil,
+		},
+		{
+			"failure: trusting period override is not positive",
+			func() {
+				trustingPeriod := time.Duration(0)
+				msg.Overrides = &types.RecoveryOverrides{TrustingPeriod: &trustingPeriod}
+			},
+			types.ErrInvalidRecoveryClient,
+		},
+		{
+			"failure: unbonding period override is not positive",
+			func() {
+				unbondingPeriod := -time.Second
+				msg.Overrides = &types.RecoveryOverrides{UnbondingPeriod: &unbondingPeriod}
+			},
+			types.ErrInvalidRecoveryClient,
+		},
+		{
+			"failure: trusting period override is not less than unbonding period override",
+			func() {
+				trustingPeriod, unbondingPeriod := ibctesting.UnbondingPeriod, ibctesting.UnbondingPeriod
+				msg.Overrides = &types.RecoveryOverrides{TrustingPeriod: &trustingPeriod, UnbondingPeriod: &unbondingPeriod}
+			},
+			types.ErrInvalidRecoveryClient,
+		},
+		{
+			"failure: trust level override denominator is not set",
+			func() {
+				msg.Overrides = &types.RecoveryOverrides{TrustLevelNumerator: 1}
+			},
+			types.ErrInvalidRecoveryClient,
+		},
+		{
+			"failure: trust level override is greater than 1",
+			func() {
+				msg.Overrides = &types.RecoveryOverrides{TrustLevelNumerator: 4, TrustLevelDenominator: 3}
+			},
+			types.ErrInvalidRecoveryClient,
+		},
+		{
+			"failure: nil proof spec override",
+			func() {
+				msg.Overrides = &types.RecoveryOverrides{ProofSpecs: []*ics23.ProofSpec{nil}}
+			},
+			types.ErrInvalidRecoveryClient,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -687,6 +754,184 @@ func (suite *TypesTestSuite) TestMsgRecoverClientValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgDeleteClientValidateBasic() {
+	var msg *types.MsgDeleteClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: localhost client ID",
+			func() {
+				msg.ClientId = exported.LocalhostClientID
+			},
+			types.ErrInvalidClientType,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgDeleteClient(ibctesting.FirstClientID, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgPruneConsensusStatesValidateBasic() {
+	var msg *types.MsgPruneConsensusStates
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer, client identifier and limit",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: localhost client ID",
+			func() {
+				msg.ClientId = exported.LocalhostClientID
+			},
+			types.ErrInvalidClientType,
+		},
+		{
+			"failure: zero limit",
+			func() {
+				msg.Limit = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgPruneConsensusStates(ibctesting.FirstClientID, 10, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgProvideCounterpartyValidateBasic() {
+	var msg *types.MsgProvideCounterparty
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer, client identifier and counterparty",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: localhost client ID",
+			func() {
+				msg.ClientId = exported.LocalhostClientID
+			},
+			types.ErrInvalidClientType,
+		},
+		{
+			"failure: invalid counterparty client ID",
+			func() {
+				msg.Counterparty.ClientId = ""
+			},
+			types.ErrInvalidCounterparty,
+		},
+		{
+			"failure: empty counterparty merkle path prefix",
+			func() {
+				msg.Counterparty.MerklePathPrefix = commitmenttypes.NewMerklePath()
+			},
+			types.ErrInvalidCounterparty,
+		},
+	}
+
+	for _, tc := range testCases {
+		counterparty := types.NewCounterparty(ibctesting.SecondClientID, commitmenttypes.NewMerklePath("ibc"))
+		msg = types.NewMsgProvideCounterparty(ibctesting.FirstClientID, counterparty, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
 // TestMsgRecoverClientGetSigners tests GetSigners for MsgRecoverClient
 func TestMsgRecoverClientGetSigners(t *testing.T) {
 	testCases := []struct {
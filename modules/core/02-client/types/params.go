@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 )
 
 // Maximum length of the allowed clients list
@@ -27,7 +28,40 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if p.ClientDeletionGracePeriod < 0 {
+		return fmt.Errorf("client deletion grace period cannot be negative: %s", p.ClientDeletionGracePeriod)
+	}
+
+	if p.ClientExpiryWarningThreshold < 0 {
+		return fmt.Errorf("client expiry warning threshold cannot be negative: %s", p.ClientExpiryWarningThreshold)
+	}
+
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	return validateClientCreationPolicies(p.ClientCreationPolicies)
+}
+
+// IsPermissionlessDeletionAllowed returns true if the client deletion grace period is set and has passed
+// since the provided timestamp of the latest consensus state of a client.
+func (p Params) IsPermissionlessDeletionAllowed(latestTimestamp, blockTime time.Time) bool {
+	if p.ClientDeletionGracePeriod == 0 {
+		return false
+	}
+
+	return !blockTime.Before(latestTimestamp.Add(p.ClientDeletionGracePeriod))
+}
+
+// GetClientCreationPolicy returns the client creation policy configured for the given client type, if any.
+func (p Params) GetClientCreationPolicy(clientType string) (ClientCreationPolicy, bool) {
+	for _, policy := range p.ClientCreationPolicies {
+		if policy.ClientType == clientType {
+			return policy, true
+		}
+	}
+
+	return ClientCreationPolicy{}, false
 }
 
 // IsAllowedClient checks if the given client type is registered on the allowlist.
@@ -70,3 +104,24 @@ func validateClients(clients []string) error {
 
 	return nil
 }
+
+// validateClientCreationPolicies checks that every client creation policy is valid and that there is at most
+// one policy per client type.
+func validateClientCreationPolicies(policies []ClientCreationPolicy) error {
+	if len(policies) > MaxAllowedClientsLength {
+		return fmt.Errorf("client creation policies length must not exceed %d items", MaxAllowedClientsLength)
+	}
+
+	foundClients := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if foundClients[policy.ClientType] {
+			return fmt.Errorf("duplicate client creation policy for client type: %s", policy.ClientType)
+		}
+		foundClients[policy.ClientType] = true
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -30,6 +31,14 @@ func TestIsAllowedClient(t *testing.T) {
 }
 
 func TestValidateParams(t *testing.T) {
+	validPolicy := ClientCreationPolicy{
+		ClientType:             exported.Tendermint,
+		AllowedCreators:        []string{"cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"},
+		AllowedChainIds:        []string{"testchain-1"},
+		MaxTrustingPeriodRatio: "0.67",
+		MinTrustLevel:          "0.333",
+	}
+
 	testCases := []struct {
 		name    string
 		params  Params
@@ -41,6 +50,18 @@ func TestValidateParams(t *testing.T) {
 		{"duplicate clients", NewParams(exported.Tendermint, exported.Tendermint), false},
 		{"allow all clients plus valid client", NewParams(AllowAllClients, exported.Tendermint), false},
 		{"too many allowed clients", NewParams(make([]string, MaxAllowedClientsLength+1)...), false},
+		{"client deletion grace period set", Params{AllowedClients: DefaultAllowedClients, ClientDeletionGracePeriod: time.Hour}, true},
+		{"negative client deletion grace period", Params{AllowedClients: DefaultAllowedClients, ClientDeletionGracePeriod: -time.Hour}, false},
+		{"client expiry warning threshold set", Params{AllowedClients: DefaultAllowedClients, ClientExpiryWarningThreshold: time.Hour}, true},
+		{"negative client expiry warning threshold", Params{AllowedClients: DefaultAllowedClients, ClientExpiryWarningThreshold: -time.Hour}, false},
+		{"client creation policy set", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{validPolicy}}, true},
+		{"duplicate client creation policies", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{validPolicy, validPolicy}}, false},
+		{"client creation policy with blank client type", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{{ClientType: " "}}}, false},
+		{"client creation policy with invalid creator", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{{ClientType: exported.Tendermint, AllowedCreators: []string{"invalid"}}}}, false},
+		{"client creation policy with blank chain ID", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{{ClientType: exported.Tendermint, AllowedChainIds: []string{""}}}}, false},
+		{"client creation policy with invalid max trusting period ratio", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{{ClientType: exported.Tendermint, MaxTrustingPeriodRatio: "invalid"}}}, false},
+		{"client creation policy with max trusting period ratio greater than one", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{{ClientType: exported.Tendermint, MaxTrustingPeriodRatio: "1.5"}}}, false},
+		{"client creation policy with zero min trust level", Params{AllowedClients: DefaultAllowedClients, ClientCreationPolicies: []ClientCreationPolicy{{ClientType: exported.Tendermint, MinTrustLevel: "0"}}}, false},
 	}
 
 	for _, tc := range testCases {
@@ -54,3 +75,26 @@ func TestValidateParams(t *testing.T) {
 		}
 	}
 }
+
+func TestIsPermissionlessDeletionAllowed(t *testing.T) {
+	latestTimestamp := time.Unix(1_000_000, 0)
+
+	testCases := []struct {
+		name        string
+		gracePeriod time.Duration
+		blockTime   time.Time
+		expPass     bool
+	}{
+		{"grace period has passed", time.Hour, latestTimestamp.Add(2 * time.Hour), true},
+		{"grace period has passed exactly", time.Hour, latestTimestamp.Add(time.Hour), true},
+		{"grace period has not passed", time.Hour, latestTimestamp.Add(time.Minute), false},
+		{"grace period is not set", 0, latestTimestamp.Add(2 * time.Hour), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		params := Params{AllowedClients: DefaultAllowedClients, ClientDeletionGracePeriod: tc.gracePeriod}
+		require.Equal(t, tc.expPass, params.IsPermissionlessDeletionAllowed(latestTimestamp, tc.blockTime), tc.name)
+	}
+}
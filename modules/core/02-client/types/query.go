@@ -6,6 +6,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+// MaxVerifyMembershipBatchSize is the maximum number of proofs which may be verified in a single
+// Query/VerifyMembershipBatch request.
+const MaxVerifyMembershipBatchSize = 100
+
 var (
 	_ codectypes.UnpackInterfacesMessage = (*QueryClientStateResponse)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*QueryClientStatesResponse)(nil)
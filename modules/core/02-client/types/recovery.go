@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecoveryOverridesModule is an optional interface which may be implemented by light client modules in order
+// to support recovering clients with overrides of the client state parameters copied from the substitute.
+type RecoveryOverridesModule interface {
+	// RecoverClientWithOverrides must verify that the provided substitute may be used to update the subject client
+	// once the overrides have been applied, and must validate the resulting client state of the subject client.
+	// It must return an error if any of the overrides is not supported by the light client.
+	RecoverClientWithOverrides(ctx sdk.Context, clientID, substituteClientID string, overrides *RecoveryOverrides) error
+}
+
+// IsEmpty returns true if the overrides are nil or none of their fields are set.
+func (o *RecoveryOverrides) IsEmpty() bool {
+	return o == nil || (o.TrustingPeriod == nil && o.UnbondingPeriod == nil &&
+		o.TrustLevelNumerator == 0 && o.TrustLevelDenominator == 0 && len(o.ProofSpecs) == 0)
+}
+
+// HasTrustLevel returns true if the trust level is overridden.
+func (o RecoveryOverrides) HasTrustLevel() bool {
+	return o.TrustLevelNumerator != 0 || o.TrustLevelDenominator != 0
+}
+
+// ValidateBasic performs stateless validation of the overrides. Validation of the recovered client state
+// is performed by the light client module.
+func (o RecoveryOverrides) ValidateBasic() error {
+	if o.TrustingPeriod != nil && *o.TrustingPeriod <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRecoveryClient, "trusting period override must be positive, got %s", o.TrustingPeriod)
+	}
+
+	if o.UnbondingPeriod != nil && *o.UnbondingPeriod <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRecoveryClient, "unbonding period override must be positive, got %s", o.UnbondingPeriod)
+	}
+
+	if o.TrustingPeriod != nil && o.UnbondingPeriod != nil && *o.TrustingPeriod >= *o.UnbondingPeriod {
+		return errorsmod.Wrapf(ErrInvalidRecoveryClient, "trusting period override (%s) must be less than unbonding period override (%s)", o.TrustingPeriod, o.UnbondingPeriod)
+	}
+
+	if o.HasTrustLevel() {
+		if o.TrustLevelNumerator == 0 || o.TrustLevelDenominator == 0 {
+			return errorsmod.Wrap(ErrInvalidRecoveryClient, "trust level override numerator and denominator must both be set")
+		}
+
+		if o.TrustLevelNumerator > o.TrustLevelDenominator {
+			return errorsmod.Wrapf(ErrInvalidRecoveryClient, "trust level override %d/%d must not be greater than 1", o.TrustLevelNumerator, o.TrustLevelDenominator)
+		}
+	}
+
+	for i, spec := range o.ProofSpecs {
+		if spec == nil {
+			return errorsmod.Wrapf(ErrInvalidRecoveryClient, "proof spec override cannot be nil at index: %d", i)
+		}
+	}
+
+	return nil
+}
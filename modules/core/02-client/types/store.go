@@ -36,3 +36,18 @@ func (s storeProvider) ClientStore(ctx sdk.Context, clientID string) storetypes.
 func (s storeProvider) ClientModuleStore(ctx sdk.Context, clientType string) storetypes.KVStore {
 	return prefix.NewStore(ctx.KVStore(s.storeKey), host.PrefixedClientStoreKey([]byte(clientType)))
 }
+
+// DeleteClientStore removes all keys stored in the provided client store.
+func DeleteClientStore(clientStore storetypes.KVStore) {
+	iterator := clientStore.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		clientStore.Delete(key)
+	}
+}
@@ -40,6 +40,9 @@ type MsgCreateClient struct {
 	ConsensusState *types.Any `protobuf:"bytes,2,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
 	// signer address
 	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// if true, the signer is stored as the creator of the client, authorizing it to provide
+	// the counterparty of the client using MsgProvideCounterparty.
+	AllowCounterparty bool `protobuf:"varint,4,opt,name=allow_counterparty,json=allowCounterparty,proto3" json:"allow_counterparty,omitempty"`
 }
 
 func (m *MsgCreateClient) Reset()         { *m = MsgCreateClient{} }
@@ -375,6 +378,8 @@ type MsgRecoverClient struct {
 	SubstituteClientId string `protobuf:"bytes,2,opt,name=substitute_client_id,json=substituteClientId,proto3" json:"substitute_client_id,omitempty"`
 	// signer address
 	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// optional overrides of the client state parameters of the recovered client
+	Overrides *RecoveryOverrides `protobuf:"bytes,4,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *MsgRecoverClient) Reset()         { *m = MsgRecoverClient{} }
@@ -447,6 +452,255 @@ func (m *MsgRecoverClientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRecoverClientResponse proto.InternalMessageInfo
 
+// MsgDeleteClient defines the message used to delete an expired or frozen client and all of its
+// associated state.
+type MsgDeleteClient struct {
+	// the client identifier for the client to be deleted
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgDeleteClient) Reset()         { *m = MsgDeleteClient{} }
+func (m *MsgDeleteClient) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteClient) ProtoMessage()    {}
+func (*MsgDeleteClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{10}
+}
+func (m *MsgDeleteClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteClient.Merge(m, src)
+}
+func (m *MsgDeleteClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteClient proto.InternalMessageInfo
+
+// MsgDeleteClientResponse defines the Msg/DeleteClient response type.
+type MsgDeleteClientResponse struct {
+}
+
+func (m *MsgDeleteClientResponse) Reset()         { *m = MsgDeleteClientResponse{} }
+func (m *MsgDeleteClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteClientResponse) ProtoMessage()    {}
+func (*MsgDeleteClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{11}
+}
+func (m *MsgDeleteClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteClientResponse.Merge(m, src)
+}
+func (m *MsgDeleteClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteClientResponse proto.InternalMessageInfo
+
+// MsgPruneConsensusStates defines the message used to prune expired consensus states of a client.
+type MsgPruneConsensusStates struct {
+	// the client identifier for the client whose consensus states should be pruned
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the maximum number of consensus states to prune
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneConsensusStates) Reset()         { *m = MsgPruneConsensusStates{} }
+func (m *MsgPruneConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStates) ProtoMessage()    {}
+func (*MsgPruneConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{12}
+}
+func (m *MsgPruneConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStates.Merge(m, src)
+}
+func (m *MsgPruneConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStates proto.InternalMessageInfo
+
+// MsgPruneConsensusStatesResponse defines the Msg/PruneConsensusStates response type.
+type MsgPruneConsensusStatesResponse struct {
+	// the number of consensus states pruned
+	ConsensusStatesPruned uint64 `protobuf:"varint,1,opt,name=consensus_states_pruned,json=consensusStatesPruned,proto3" json:"consensus_states_pruned,omitempty"`
+}
+
+func (m *MsgPruneConsensusStatesResponse) Reset()         { *m = MsgPruneConsensusStatesResponse{} }
+func (m *MsgPruneConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgPruneConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{13}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgPruneConsensusStatesResponse) GetConsensusStatesPruned() uint64 {
+	if m != nil {
+		return m.ConsensusStatesPruned
+	}
+	return 0
+}
+
+// MsgProvideCounterparty defines the message used to register the counterparty of a client. It may only be
+// submitted by the creator of the client, once.
+type MsgProvideCounterparty struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// counterparty of the client
+	Counterparty Counterparty `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgProvideCounterparty) Reset()         { *m = MsgProvideCounterparty{} }
+func (m *MsgProvideCounterparty) String() string { return proto.CompactTextString(m) }
+func (*MsgProvideCounterparty) ProtoMessage()    {}
+func (*MsgProvideCounterparty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{14}
+}
+func (m *MsgProvideCounterparty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProvideCounterparty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProvideCounterparty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProvideCounterparty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProvideCounterparty.Merge(m, src)
+}
+func (m *MsgProvideCounterparty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProvideCounterparty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProvideCounterparty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProvideCounterparty proto.InternalMessageInfo
+
+// MsgProvideCounterpartyResponse defines the Msg/ProvideCounterparty response type.
+type MsgProvideCounterpartyResponse struct {
+}
+
+func (m *MsgProvideCounterpartyResponse) Reset()         { *m = MsgProvideCounterpartyResponse{} }
+func (m *MsgProvideCounterpartyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProvideCounterpartyResponse) ProtoMessage()    {}
+func (*MsgProvideCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{15}
+}
+func (m *MsgProvideCounterpartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProvideCounterpartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProvideCounterpartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProvideCounterpartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProvideCounterpartyResponse.Merge(m, src)
+}
+func (m *MsgProvideCounterpartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProvideCounterpartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProvideCounterpartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProvideCounterpartyResponse proto.InternalMessageInfo
+
 // MsgIBCSoftwareUpgrade defines the message used to schedule an upgrade of an IBC client using a v1 governance proposal
 type MsgIBCSoftwareUpgrade struct {
 	Plan types1.Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
@@ -467,7 +721,7 @@ func (m *MsgIBCSoftwareUpgrade) Reset()         { *m = MsgIBCSoftwareUpgrade{} }
 func (m *MsgIBCSoftwareUpgrade) String() string { return proto.CompactTextString(m) }
 func (*MsgIBCSoftwareUpgrade) ProtoMessage()    {}
 func (*MsgIBCSoftwareUpgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{10}
+	return fileDescriptor_cb5dc4651eb49a04, []int{16}
 }
 func (m *MsgIBCSoftwareUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +779,7 @@ func (m *MsgIBCSoftwareUpgradeResponse) Reset()         { *m = MsgIBCSoftwareUpg
 func (m *MsgIBCSoftwareUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIBCSoftwareUpgradeResponse) ProtoMessage()    {}
 func (*MsgIBCSoftwareUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{11}
+	return fileDescriptor_cb5dc4651eb49a04, []int{17}
 }
 func (m *MsgIBCSoftwareUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -568,7 +822,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{12}
+	return fileDescriptor_cb5dc4651eb49a04, []int{18}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +859,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{13}
+	return fileDescriptor_cb5dc4651eb49a04, []int{19}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,6 +899,12 @@ func init() {
 	proto.RegisterType((*MsgSubmitMisbehaviourResponse)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviourResponse")
 	proto.RegisterType((*MsgRecoverClient)(nil), "ibc.core.client.v1.MsgRecoverClient")
 	proto.RegisterType((*MsgRecoverClientResponse)(nil), "ibc.core.client.v1.MsgRecoverClientResponse")
+	proto.RegisterType((*MsgDeleteClient)(nil), "ibc.core.client.v1.MsgDeleteClient")
+	proto.RegisterType((*MsgDeleteClientResponse)(nil), "ibc.core.client.v1.MsgDeleteClientResponse")
+	proto.RegisterType((*MsgPruneConsensusStates)(nil), "ibc.core.client.v1.MsgPruneConsensusStates")
+	proto.RegisterType((*MsgPruneConsensusStatesResponse)(nil), "ibc.core.client.v1.MsgPruneConsensusStatesResponse")
+	proto.RegisterType((*MsgProvideCounterparty)(nil), "ibc.core.client.v1.MsgProvideCounterparty")
+	proto.RegisterType((*MsgProvideCounterpartyResponse)(nil), "ibc.core.client.v1.MsgProvideCounterpartyResponse")
 	proto.RegisterType((*MsgIBCSoftwareUpgrade)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgrade")
 	proto.RegisterType((*MsgIBCSoftwareUpgradeResponse)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.client.v1.MsgUpdateParams")
@@ -654,58 +914,71 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0x8e, 0xd3, 0x36, 0xfa, 0xf5, 0x9a, 0x36, 0xbf, 0x1e, 0x29, 0x4d, 0x5d, 0x9a, 0x54, 0xa1,
-	0x43, 0x29, 0xd4, 0x6e, 0x8a, 0x04, 0x11, 0x88, 0xa1, 0xcd, 0x42, 0x87, 0x48, 0x95, 0x2b, 0x16,
-	0x96, 0x60, 0x3b, 0x97, 0xab, 0x51, 0xec, 0xb3, 0x7c, 0xe7, 0x40, 0x37, 0xc4, 0xc4, 0xc8, 0xc0,
-	0xc2, 0xc6, 0x9f, 0x50, 0xf1, 0x07, 0xb0, 0x21, 0x75, 0xec, 0xc8, 0x84, 0x50, 0x3b, 0x54, 0xe2,
-	0xaf, 0x40, 0xf6, 0x5d, 0x52, 0xdb, 0x89, 0x43, 0x2a, 0xb6, 0xd8, 0xef, 0x7b, 0xf7, 0xbe, 0xef,
-	0xdd, 0x7b, 0x5f, 0x0c, 0x56, 0x2d, 0xc3, 0x54, 0x4d, 0xe2, 0x21, 0xd5, 0xec, 0x5a, 0xc8, 0x61,
-	0x6a, 0xaf, 0xa6, 0xb2, 0xb7, 0x8a, 0xeb, 0x11, 0x46, 0x20, 0xb4, 0x0c, 0x53, 0x09, 0x82, 0x0a,
-	0x0f, 0x2a, 0xbd, 0x9a, 0xbc, 0x6c, 0x12, 0x6a, 0x13, 0xaa, 0xda, 0x14, 0x07, 0x58, 0x9b, 0x62,
-	0x0e, 0x96, 0x37, 0x44, 0xc0, 0x77, 0xb1, 0xa7, 0xb7, 0x91, 0xda, 0xab, 0x19, 0x88, 0xe9, 0xb5,
-	0xfe, 0xb3, 0x40, 0x15, 0x31, 0xc1, 0x24, 0xfc, 0xa9, 0x06, 0xbf, 0xc4, 0xdb, 0x15, 0x4c, 0x08,
-	0xee, 0x22, 0x35, 0x7c, 0x32, 0xfc, 0x8e, 0xaa, 0x3b, 0x27, 0x22, 0x54, 0x19, 0x41, 0x50, 0xb0,
-	0x09, 0x01, 0xd5, 0xaf, 0x12, 0x28, 0x34, 0x29, 0x6e, 0x78, 0x48, 0x67, 0xa8, 0x11, 0x46, 0xe0,
-	0x63, 0x90, 0xe7, 0x98, 0x16, 0x65, 0x3a, 0x43, 0x25, 0x69, 0x5d, 0xda, 0x9c, 0xdb, 0x2d, 0x2a,
-	0xbc, 0x8c, 0xd2, 0x2f, 0xa3, 0xec, 0x39, 0x27, 0xda, 0x1c, 0x47, 0x1e, 0x05, 0x40, 0xf8, 0x0c,
-	0x14, 0x4c, 0xe2, 0x50, 0xe4, 0x50, 0x9f, 0x8a, 0xdc, 0xec, 0x98, 0xdc, 0x85, 0x01, 0x98, 0xa7,
-	0xdf, 0x06, 0x39, 0x6a, 0x61, 0x07, 0x79, 0xa5, 0xa9, 0x75, 0x69, 0x73, 0x56, 0x13, 0x4f, 0x4f,
-	0x0a, 0x1f, 0xbe, 0x54, 0x32, 0xef, 0xaf, 0x4e, 0xb7, 0xc4, 0x8b, 0xea, 0x0a, 0x58, 0x4e, 0x70,
-	0xd6, 0x10, 0x75, 0x83, 0xc3, 0xaa, 0x9f, 0xb8, 0x9e, 0x17, 0x6e, 0xfb, 0x5a, 0xcf, 0x2a, 0x98,
-	0x15, 0x7a, 0xac, 0x76, 0x28, 0x66, 0x56, 0xfb, 0x8f, 0xbf, 0x38, 0x68, 0xc3, 0xa7, 0x60, 0x41,
-	0x04, 0x6d, 0x44, 0xa9, 0x8e, 0xc7, 0x53, 0x9e, 0xe7, 0xd8, 0x26, 0x87, 0xde, 0x94, 0x71, 0x94,
-	0xd5, 0x80, 0xf1, 0xf7, 0x2c, 0xf8, 0x3f, 0x8c, 0x85, 0x17, 0x3d, 0x09, 0xe5, 0xe4, 0xfd, 0x64,
-	0xff, 0xe1, 0x7e, 0xa6, 0x6e, 0x70, 0x3f, 0x3b, 0xa0, 0xe8, 0x7a, 0x84, 0x74, 0x5a, 0x62, 0x28,
-	0x5b, 0xfc, 0xec, 0xd2, 0xf4, 0xba, 0xb4, 0x99, 0xd7, 0x60, 0x18, 0x8b, 0xcb, 0xd8, 0x03, 0x6b,
-	0x89, 0x8c, 0x44, 0xf9, 0x99, 0x30, 0x55, 0x8e, 0xa5, 0xa6, 0x0d, 0x45, 0x6e, 0x7c, 0x8b, 0x65,
-	0x50, 0x4a, 0xb6, 0x71, 0xd0, 0xe3, 0xcf, 0x12, 0x58, 0x6a, 0x52, 0x7c, 0xe4, 0x1b, 0xb6, 0xc5,
-	0x9a, 0x16, 0x35, 0xd0, 0xb1, 0xde, 0xb3, 0x88, 0xef, 0x8d, 0x6f, 0x74, 0x1d, 0xe4, 0xed, 0x08,
-	0x78, 0x6c, 0xa3, 0x63, 0xc8, 0xd4, 0xc1, 0x58, 0x4c, 0xb0, 0x2e, 0x49, 0xd5, 0x0a, 0x58, 0x1b,
-	0x49, 0x2d, 0x4a, 0x3e, 0x18, 0x10, 0x0d, 0x99, 0xa4, 0x87, 0x3c, 0xd1, 0xd9, 0x2d, 0xb0, 0x48,
-	0x7d, 0xe3, 0x35, 0x32, 0x59, 0x2b, 0xc9, 0xbf, 0x20, 0x02, 0x8d, 0xbe, 0x8c, 0x1d, 0x50, 0xa4,
-	0xbe, 0x41, 0x99, 0xc5, 0x7c, 0x86, 0x22, 0xf0, 0x6c, 0x08, 0x87, 0xd7, 0xb1, 0x41, 0xc6, 0xc4,
-	0x73, 0xcd, 0x9b, 0x1e, 0xa3, 0x36, 0xe0, 0xfd, 0x8d, 0x37, 0xfd, 0x60, 0xbf, 0x71, 0x44, 0x3a,
-	0xec, 0x8d, 0xee, 0x21, 0x71, 0x39, 0xf0, 0x11, 0x98, 0x76, 0xbb, 0xba, 0x23, 0x8c, 0xe5, 0x8e,
-	0xc2, 0xbd, 0x4f, 0xe9, 0x7b, 0x9d, 0xf0, 0x3e, 0xe5, 0xb0, 0xab, 0x3b, 0xfb, 0xd3, 0x67, 0x3f,
-	0x2b, 0x19, 0x2d, 0xc4, 0xc3, 0xe7, 0x60, 0x49, 0x60, 0xda, 0xad, 0x89, 0x37, 0xe0, 0x56, 0x3f,
-	0xa5, 0x11, 0xd9, 0x84, 0x34, 0x81, 0x73, 0x51, 0x71, 0xfc, 0x66, 0x86, 0xf9, 0x0f, 0x14, 0xb2,
-	0x88, 0xd7, 0x1c, 0xea, 0x9e, 0x6e, 0xd3, 0xc8, 0xc1, 0x52, 0xf4, 0x60, 0x58, 0x07, 0x39, 0x37,
-	0x44, 0x08, 0xae, 0xb2, 0x32, 0xfc, 0xef, 0xa0, 0xf0, 0x33, 0x84, 0x64, 0x81, 0x1f, 0xef, 0x25,
-	0x3c, 0xa3, 0x4f, 0x68, 0xf7, 0xf7, 0x0c, 0x98, 0x6a, 0x52, 0x0c, 0x5f, 0x81, 0x7c, 0xcc, 0xd1,
-	0xef, 0x8e, 0xaa, 0x96, 0xb0, 0x50, 0xf9, 0xfe, 0x04, 0xa0, 0x7e, 0xa5, 0xa0, 0x42, 0xcc, 0x63,
-	0xd3, 0x2a, 0x44, 0x41, 0xa9, 0x15, 0x46, 0xf9, 0x22, 0x34, 0xc1, 0x7c, 0xdc, 0x4c, 0x36, 0x52,
-	0xb3, 0x23, 0x28, 0xf9, 0xc1, 0x24, 0xa8, 0x41, 0x11, 0x0f, 0xc0, 0x11, 0xa6, 0x70, 0x2f, 0xe5,
-	0x8c, 0x61, 0xa8, 0x5c, 0x9b, 0x18, 0x1a, 0x15, 0x16, 0xdf, 0xe5, 0x34, 0x61, 0x31, 0x54, 0xaa,
-	0xb0, 0x91, 0xcb, 0x17, 0x08, 0x1b, 0xb1, 0x78, 0x69, 0xc2, 0x86, 0xa1, 0xa9, 0xc2, 0xd2, 0xd7,
-	0x01, 0x76, 0x00, 0x8c, 0xde, 0xa4, 0xd8, 0x88, 0xf1, 0x93, 0xc1, 0x41, 0x7f, 0x99, 0x8c, 0xf8,
-	0x94, 0xcb, 0x33, 0xef, 0xae, 0x4e, 0xb7, 0xa4, 0x7d, 0xed, 0xec, 0xa2, 0x2c, 0x9d, 0x5f, 0x94,
-	0xa5, 0x5f, 0x17, 0x65, 0xe9, 0xe3, 0x65, 0x39, 0x73, 0x7e, 0x59, 0xce, 0xfc, 0xb8, 0x2c, 0x67,
-	0x5e, 0xd6, 0xb1, 0xc5, 0x8e, 0x7d, 0x43, 0x31, 0x89, 0xad, 0x8a, 0xef, 0x2a, 0xcb, 0x30, 0xb7,
-	0x31, 0x51, 0x7b, 0x75, 0xd5, 0x26, 0x6d, 0xbf, 0x8b, 0x28, 0xff, 0x2a, 0xda, 0xd9, 0xdd, 0x16,
-	0x1f, 0x46, 0xec, 0xc4, 0x45, 0xd4, 0xc8, 0x85, 0xd6, 0xf1, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa3, 0x68, 0xc1, 0x10, 0xd9, 0x09, 0x00, 0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0xc0, 0x4d, 0x59, 0x36, 0xec, 0x67, 0x25, 0xae, 0x19, 0x39, 0x56, 0x98, 0x46, 0x12, 0xd4,
+	0x14, 0x70, 0xed, 0x98, 0xb4, 0x14, 0x20, 0x35, 0x5a, 0x74, 0x88, 0xd5, 0xa1, 0x29, 0x20, 0xd4,
+	0xa0, 0x51, 0x14, 0xed, 0xa2, 0x90, 0xd4, 0x99, 0x61, 0x21, 0xf2, 0x08, 0xde, 0x51, 0x89, 0xb6,
+	0xa2, 0x53, 0xc7, 0x0e, 0x5d, 0xba, 0x75, 0xee, 0x94, 0x2f, 0xd0, 0x6e, 0x05, 0x32, 0x66, 0xec,
+	0x14, 0xb4, 0xf6, 0x90, 0xaf, 0x51, 0xe8, 0xee, 0x44, 0x1f, 0x29, 0x52, 0x60, 0xd0, 0x4d, 0xe4,
+	0xfb, 0xbd, 0x7f, 0xf7, 0xee, 0xbd, 0x27, 0xc2, 0x5d, 0xcf, 0x76, 0x0c, 0x07, 0x47, 0xc8, 0x70,
+	0xc6, 0x1e, 0x0a, 0xa8, 0x31, 0xe9, 0x1a, 0xf4, 0x85, 0x1e, 0x46, 0x98, 0x62, 0x55, 0xf5, 0x6c,
+	0x47, 0x9f, 0x09, 0x75, 0x2e, 0xd4, 0x27, 0x5d, 0x6d, 0xcf, 0xc1, 0xc4, 0xc7, 0xc4, 0xf0, 0x89,
+	0x3b, 0x63, 0x7d, 0xe2, 0x72, 0x58, 0xbb, 0x2f, 0x04, 0x71, 0xe8, 0x46, 0xd6, 0x08, 0x19, 0x93,
+	0xae, 0x8d, 0xa8, 0xd5, 0x9d, 0x3f, 0x0b, 0xaa, 0xee, 0x62, 0x17, 0xb3, 0x9f, 0xc6, 0xec, 0x97,
+	0x78, 0x7b, 0xc7, 0xc5, 0xd8, 0x1d, 0x23, 0x83, 0x3d, 0xd9, 0xf1, 0x85, 0x61, 0x05, 0x53, 0x21,
+	0x6a, 0xe5, 0x04, 0x28, 0xa2, 0x61, 0x40, 0xe7, 0x5f, 0x05, 0xb6, 0x07, 0xc4, 0xed, 0x47, 0xc8,
+	0xa2, 0xa8, 0xcf, 0x24, 0xea, 0xc7, 0x50, 0xe3, 0xcc, 0x90, 0x50, 0x8b, 0xa2, 0x86, 0xd2, 0x56,
+	0xf6, 0xb7, 0x7a, 0x75, 0x9d, 0xbb, 0xd1, 0xe7, 0x6e, 0xf4, 0xc7, 0xc1, 0xd4, 0xdc, 0xe2, 0xe4,
+	0xf9, 0x0c, 0x54, 0x3f, 0x83, 0x6d, 0x07, 0x07, 0x04, 0x05, 0x24, 0x26, 0x42, 0xb7, 0xb2, 0x44,
+	0xf7, 0x66, 0x02, 0x73, 0xf5, 0xdb, 0xb0, 0x4e, 0x3c, 0x37, 0x40, 0x51, 0x63, 0xb5, 0xad, 0xec,
+	0x6f, 0x9a, 0xe2, 0x49, 0x3d, 0x02, 0xd5, 0x1a, 0x8f, 0xf1, 0xf3, 0xa1, 0x83, 0xe3, 0x80, 0xa2,
+	0x28, 0xb4, 0x22, 0x3a, 0x6d, 0x54, 0xdb, 0xca, 0xfe, 0x86, 0xb9, 0xc3, 0x24, 0x7d, 0x49, 0xf0,
+	0xc9, 0xf6, 0x4f, 0xbf, 0xb5, 0x56, 0x7e, 0x7c, 0xfb, 0xf2, 0x40, 0xe8, 0x77, 0xee, 0xc0, 0x5e,
+	0x26, 0x45, 0x13, 0x91, 0x70, 0xe6, 0xbb, 0xf3, 0x0b, 0x4f, 0xff, 0xeb, 0x70, 0x74, 0x9d, 0xfe,
+	0x5d, 0xd8, 0x14, 0xe9, 0x7b, 0x23, 0x96, 0xfb, 0xa6, 0xb9, 0xc1, 0x5f, 0x3c, 0x19, 0xa9, 0x9f,
+	0xc2, 0x4d, 0x21, 0xf4, 0x11, 0x21, 0x96, 0xbb, 0x3c, 0xc3, 0x1b, 0x9c, 0x1d, 0x70, 0xb4, 0x28,
+	0xc1, 0xa2, 0x88, 0xe5, 0xa8, 0x92, 0x88, 0xff, 0xaa, 0xc0, 0x7b, 0x4c, 0xc6, 0xee, 0x45, 0x99,
+	0x90, 0xb3, 0xe5, 0xac, 0xfc, 0x8f, 0x72, 0xae, 0xbe, 0x43, 0x39, 0x8f, 0xa1, 0x1e, 0x46, 0x18,
+	0x5f, 0x0c, 0xc5, 0x1d, 0x1e, 0x72, 0xdb, 0xac, 0x70, 0x35, 0x53, 0x65, 0xb2, 0x74, 0x1a, 0x8f,
+	0xe1, 0x5e, 0x46, 0x23, 0xe3, 0x7e, 0x8d, 0xa9, 0x6a, 0x29, 0xd5, 0xa2, 0x3b, 0xb4, 0xbe, 0xfc,
+	0x88, 0x35, 0x68, 0x64, 0x8f, 0x31, 0x39, 0xe3, 0x5f, 0x15, 0xd8, 0x1d, 0x10, 0xf7, 0x3c, 0xb6,
+	0x7d, 0x8f, 0x0e, 0x3c, 0x62, 0xa3, 0x67, 0xd6, 0xc4, 0xc3, 0x71, 0xb4, 0xfc, 0xa0, 0x4f, 0xa0,
+	0xe6, 0x4b, 0xf0, 0xd2, 0x83, 0x4e, 0x91, 0x85, 0x17, 0x63, 0x27, 0x13, 0x75, 0x43, 0xe9, 0xb4,
+	0xe0, 0x5e, 0x6e, 0x68, 0x49, 0xf0, 0x6f, 0x14, 0x76, 0x41, 0x4c, 0xe4, 0xe0, 0x09, 0x8a, 0xc4,
+	0xc9, 0x1e, 0xc0, 0x0e, 0x89, 0xed, 0xef, 0x91, 0x43, 0x87, 0xd9, 0xf8, 0xb7, 0x85, 0xa0, 0x3f,
+	0x4f, 0xe3, 0x18, 0xea, 0x24, 0xb6, 0x09, 0xf5, 0x68, 0x4c, 0x91, 0x84, 0x57, 0x18, 0xae, 0x5e,
+	0xcb, 0x12, 0x8d, 0xa2, 0xc6, 0xed, 0xc3, 0xe6, 0x2c, 0x86, 0xc8, 0x1b, 0x21, 0xc2, 0xca, 0xbe,
+	0xd5, 0xfb, 0x50, 0x5f, 0x9c, 0x8a, 0xba, 0x88, 0x75, 0xfa, 0xd5, 0x1c, 0x36, 0xaf, 0xf5, 0x8a,
+	0x2a, 0x97, 0xca, 0x2f, 0x49, 0xfe, 0x1b, 0xd6, 0xce, 0x9f, 0xa3, 0x31, 0x2a, 0xd7, 0xce, 0xd7,
+	0x91, 0x57, 0xca, 0x74, 0xa4, 0x6c, 0x38, 0xf1, 0x19, 0x33, 0xd1, 0x59, 0x14, 0x07, 0x99, 0xcb,
+	0x48, 0x96, 0xfb, 0xae, 0xc3, 0xda, 0xd8, 0xf3, 0x3d, 0xca, 0x5c, 0x57, 0x4d, 0xfe, 0x50, 0x7e,
+	0x46, 0x7c, 0x0b, 0xad, 0x02, 0xb7, 0xf3, 0xc8, 0xd4, 0x47, 0xb0, 0x97, 0xe9, 0x20, 0x32, 0x0c,
+	0x67, 0x0a, 0x3c, 0x98, 0xaa, 0xb9, 0x9b, 0x6e, 0x59, 0xc2, 0xac, 0x8d, 0x3a, 0xbf, 0x2b, 0x70,
+	0x9b, 0xd9, 0xc6, 0x13, 0x6f, 0x84, 0xe4, 0xe1, 0xba, 0x3c, 0xa3, 0x2f, 0xa1, 0x96, 0x1a, 0xd1,
+	0xbc, 0x01, 0xda, 0x79, 0x25, 0x97, 0x8d, 0x9e, 0x56, 0x5f, 0xbd, 0x69, 0xad, 0x98, 0x29, 0xdd,
+	0xf2, 0xe7, 0xd0, 0x86, 0x66, 0x7e, 0xac, 0x49, 0x81, 0xfe, 0xe4, 0xed, 0xfc, 0xe4, 0xb4, 0x7f,
+	0x8e, 0x2f, 0xe8, 0x73, 0x2b, 0x42, 0xa2, 0xed, 0xd5, 0x47, 0x50, 0x0d, 0xc7, 0x56, 0x20, 0x36,
+	0xdc, 0xfb, 0x3a, 0x5f, 0xc2, 0xfa, 0x7c, 0xe9, 0x8a, 0x25, 0xac, 0x9f, 0x8d, 0xad, 0x40, 0x04,
+	0xc9, 0x78, 0xf5, 0x0b, 0xd8, 0x15, 0xcc, 0x68, 0x58, 0x7a, 0xb6, 0xde, 0x9a, 0xab, 0xf4, 0xa5,
+	0x19, 0x5b, 0x94, 0xe6, 0x96, 0x9c, 0x22, 0xef, 0xf9, 0xc5, 0xf8, 0x93, 0x0c, 0xa9, 0xb4, 0xc5,
+	0xce, 0xac, 0xc8, 0xf2, 0x89, 0x64, 0x58, 0x49, 0xf5, 0xe4, 0x09, 0xac, 0x87, 0x8c, 0x10, 0xb1,
+	0x6a, 0x79, 0xd5, 0xe1, 0x36, 0x44, 0xca, 0x82, 0x5f, 0xbe, 0xa5, 0xb8, 0xc6, 0x3c, 0xa0, 0xde,
+	0x1f, 0x1b, 0xb0, 0x3a, 0x20, 0xae, 0xfa, 0x14, 0x6a, 0xa9, 0xbf, 0x16, 0x1f, 0xe4, 0x79, 0xcb,
+	0x2c, 0x67, 0xed, 0xb0, 0x04, 0x94, 0xdc, 0xf1, 0xa7, 0x50, 0x4b, 0x6d, 0xef, 0x22, 0x0f, 0x32,
+	0xa4, 0x1d, 0x96, 0x80, 0x12, 0x0f, 0x0e, 0xdc, 0x48, 0xaf, 0xa9, 0xfb, 0x85, 0xda, 0x12, 0xa5,
+	0x3d, 0x28, 0x43, 0x25, 0x4e, 0x22, 0x50, 0x73, 0xd6, 0xcd, 0x47, 0x05, 0x36, 0x16, 0x51, 0xad,
+	0x5b, 0x1a, 0x95, 0x13, 0x4b, 0x6f, 0x89, 0xa2, 0xc4, 0x52, 0x94, 0xf6, 0xa0, 0x0c, 0x25, 0xd7,
+	0x27, 0x35, 0x8e, 0x8b, 0xea, 0x23, 0x43, 0xda, 0x61, 0x09, 0x28, 0xf1, 0xf0, 0x02, 0xea, 0xb9,
+	0xc3, 0xb7, 0xc8, 0x48, 0x1e, 0xac, 0x3d, 0x7c, 0x07, 0x38, 0xf1, 0x1c, 0xc3, 0xad, 0xbc, 0x19,
+	0x79, 0x50, 0x68, 0x6b, 0x81, 0xd5, 0x7a, 0xe5, 0x59, 0xf9, 0xae, 0xe4, 0xcc, 0xb2, 0xa2, 0xbb,
+	0xb2, 0x88, 0x6a, 0xdd, 0xd2, 0x68, 0xe2, 0xf3, 0x02, 0x54, 0xb9, 0x39, 0xc4, 0x90, 0x59, 0xde,
+	0x6c, 0x1c, 0xd2, 0x0e, 0x4b, 0x40, 0x73, 0x3f, 0xda, 0xda, 0x0f, 0x6f, 0x5f, 0x1e, 0x28, 0xa7,
+	0xe6, 0xab, 0xcb, 0xa6, 0xf2, 0xfa, 0xb2, 0xa9, 0xfc, 0x73, 0xd9, 0x54, 0x7e, 0xbe, 0x6a, 0xae,
+	0xbc, 0xbe, 0x6a, 0xae, 0xfc, 0x7d, 0xd5, 0x5c, 0xf9, 0xee, 0xc4, 0xf5, 0xe8, 0xb3, 0xd8, 0xd6,
+	0x1d, 0xec, 0x1b, 0xe2, 0x9b, 0xc9, 0xb3, 0x9d, 0x23, 0x17, 0x1b, 0x93, 0x13, 0xc3, 0xc7, 0xa3,
+	0x78, 0x8c, 0x08, 0xff, 0xe2, 0x39, 0xee, 0x1d, 0x89, 0x8f, 0x1e, 0x3a, 0x0d, 0x11, 0xb1, 0xd7,
+	0xd9, 0x34, 0x7e, 0xf8, 0xdf, 0x00, 0x20, 0x77, 0x5f, 0xae, 0xb5, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -730,6 +1003,12 @@ type MsgClient interface {
 	SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitMisbehaviourResponse, error)
 	// RecoverClient defines a rpc handler method for MsgRecoverClient.
 	RecoverClient(ctx context.Context, in *MsgRecoverClient, opts ...grpc.CallOption) (*MsgRecoverClientResponse, error)
+	// DeleteClient defines a rpc handler method for MsgDeleteClient.
+	DeleteClient(ctx context.Context, in *MsgDeleteClient, opts ...grpc.CallOption) (*MsgDeleteClientResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error)
+	// ProvideCounterparty defines a rpc handler method for MsgProvideCounterparty.
+	ProvideCounterparty(ctx context.Context, in *MsgProvideCounterparty, opts ...grpc.CallOption) (*MsgProvideCounterpartyResponse, error)
 	// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 	IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
@@ -789,6 +1068,33 @@ func (c *msgClient) RecoverClient(ctx context.Context, in *MsgRecoverClient, opt
 	return out, nil
 }
 
+func (c *msgClient) DeleteClient(ctx context.Context, in *MsgDeleteClient, opts ...grpc.CallOption) (*MsgDeleteClientResponse, error) {
+	out := new(MsgDeleteClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/DeleteClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error) {
+	out := new(MsgPruneConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/PruneConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProvideCounterparty(ctx context.Context, in *MsgProvideCounterparty, opts ...grpc.CallOption) (*MsgProvideCounterpartyResponse, error) {
+	out := new(MsgProvideCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/ProvideCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error) {
 	out := new(MsgIBCSoftwareUpgradeResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/IBCSoftwareUpgrade", in, out, opts...)
@@ -819,6 +1125,12 @@ type MsgServer interface {
 	SubmitMisbehaviour(context.Context, *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error)
 	// RecoverClient defines a rpc handler method for MsgRecoverClient.
 	RecoverClient(context.Context, *MsgRecoverClient) (*MsgRecoverClientResponse, error)
+	// DeleteClient defines a rpc handler method for MsgDeleteClient.
+	DeleteClient(context.Context, *MsgDeleteClient) (*MsgDeleteClientResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(context.Context, *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error)
+	// ProvideCounterparty defines a rpc handler method for MsgProvideCounterparty.
+	ProvideCounterparty(context.Context, *MsgProvideCounterparty) (*MsgProvideCounterpartyResponse, error)
 	// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 	IBCSoftwareUpgrade(context.Context, *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
@@ -844,6 +1156,15 @@ func (*UnimplementedMsgServer) SubmitMisbehaviour(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) RecoverClient(ctx context.Context, req *MsgRecoverClient) (*MsgRecoverClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverClient not implemented")
 }
+func (*UnimplementedMsgServer) DeleteClient(ctx context.Context, req *MsgDeleteClient) (*MsgDeleteClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClient not implemented")
+}
+func (*UnimplementedMsgServer) PruneConsensusStates(ctx context.Context, req *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneConsensusStates not implemented")
+}
+func (*UnimplementedMsgServer) ProvideCounterparty(ctx context.Context, req *MsgProvideCounterparty) (*MsgProvideCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvideCounterparty not implemented")
+}
 func (*UnimplementedMsgServer) IBCSoftwareUpgrade(ctx context.Context, req *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IBCSoftwareUpgrade not implemented")
 }
@@ -945,6 +1266,60 @@ func _Msg_RecoverClient_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeleteClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/DeleteClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteClient(ctx, req.(*MsgDeleteClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/PruneConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneConsensusStates(ctx, req.(*MsgPruneConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProvideCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProvideCounterparty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProvideCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/ProvideCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProvideCounterparty(ctx, req.(*MsgProvideCounterparty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_IBCSoftwareUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgIBCSoftwareUpgrade)
 	if err := dec(in); err != nil {
@@ -1005,6 +1380,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecoverClient",
 			Handler:    _Msg_RecoverClient_Handler,
 		},
+		{
+			MethodName: "DeleteClient",
+			Handler:    _Msg_DeleteClient_Handler,
+		},
+		{
+			MethodName: "PruneConsensusStates",
+			Handler:    _Msg_PruneConsensusStates_Handler,
+		},
+		{
+			MethodName: "ProvideCounterparty",
+			Handler:    _Msg_ProvideCounterparty_Handler,
+		},
 		{
 			MethodName: "IBCSoftwareUpgrade",
 			Handler:    _Msg_IBCSoftwareUpgrade_Handler,
@@ -1038,6 +1425,16 @@ func (m *MsgCreateClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowCounterparty {
+		i--
+		if m.AllowCounterparty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -1357,6 +1754,18 @@ func (m *MsgRecoverClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Overrides != nil {
+		{
+			size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -1404,7 +1813,7 @@ func (m *MsgRecoverClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgIBCSoftwareUpgrade) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteClient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1414,12 +1823,12 @@ func (m *MsgIBCSoftwareUpgrade) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgIBCSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteClient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgIBCSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1429,29 +1838,229 @@ func (m *MsgIBCSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.UpgradedClientState != nil {
-		{
-			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
 		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusStatesPruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConsensusStatesPruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvideCounterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvideCounterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvideCounterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProvideCounterpartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProvideCounterpartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProvideCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIBCSoftwareUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIBCSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIBCSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpgradedClientState != nil {
+		{
+			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1571,6 +2180,9 @@ func (m *MsgCreateClient) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.AllowCounterparty {
+		n += 2
+	}
 	return n
 }
 
@@ -1703,6 +2315,10 @@ func (m *MsgRecoverClient) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Overrides != nil {
+		l = m.Overrides.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1715,16 +2331,14 @@ func (m *MsgRecoverClientResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgIBCSoftwareUpgrade) Size() (n int) {
+func (m *MsgDeleteClient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Plan.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.UpgradedClientState != nil {
-		l = m.UpgradedClientState.Size()
+	l = len(m.ClientId)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Signer)
@@ -1734,7 +2348,7 @@ func (m *MsgIBCSoftwareUpgrade) Size() (n int) {
 	return n
 }
 
-func (m *MsgIBCSoftwareUpgradeResponse) Size() (n int) {
+func (m *MsgDeleteClientResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1743,53 +2357,141 @@ func (m *MsgIBCSoftwareUpgradeResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgPruneConsensusStates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *MsgPruneConsensusStatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ConsensusStatesPruned != 0 {
+		n += 1 + sovTx(uint64(m.ConsensusStatesPruned))
+	}
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgProvideCounterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgProvideCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
+
+func (m *MsgIBCSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradedClientState != nil {
+		l = m.UpgradedClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIBCSoftwareUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
 				break
 			}
 		}
@@ -1906,6 +2608,26 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowCounterparty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowCounterparty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2070,7 +2792,511 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 			if m.ClientMessage == nil {
 				m.ClientMessage = &types.Any{}
 			}
-			if err := m.ClientMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClientMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgradeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgradeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUpgradeClient = append(m.ProofUpgradeClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgradeClient == nil {
+				m.ProofUpgradeClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeConsensusState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUpgradeConsensusState = append(m.ProofUpgradeConsensusState[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgradeConsensusState == nil {
+				m.ProofUpgradeConsensusState = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgradeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgradeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMisbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Misbehaviour == nil {
+				m.Misbehaviour = &types.Any{}
+			}
+			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2127,7 +3353,7 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2150,10 +3376,10 @@ func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2177,7 +3403,7 @@ func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2200,15 +3426,15 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgradeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgradeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2236,13 +3462,13 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.SubjectClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2252,33 +3478,29 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2288,33 +3510,29 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2324,31 +3542,133 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUpgradeClient = append(m.ProofUpgradeClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgradeClient == nil {
-				m.ProofUpgradeClient = []byte{}
+			if m.Overrides == nil {
+				m.Overrides = &RecoveryOverrides{}
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgradeConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2358,27 +3678,25 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUpgradeConsensusState = append(m.ProofUpgradeConsensusState[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgradeConsensusState == nil {
-				m.ProofUpgradeConsensusState = []byte{}
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2431,7 +3749,7 @@ func (m *MsgUpgradeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2454,10 +3772,10 @@ func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgradeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgradeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2481,7 +3799,7 @@ func (m *MsgUpgradeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneConsensusStates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,10 +3822,10 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviour: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneConsensusStates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2543,10 +3861,10 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2556,28 +3874,11 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Misbehaviour == nil {
-				m.Misbehaviour = &types.Any{}
-			}
-			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -2631,7 +3932,7 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2654,12 +3955,31 @@ func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStatesPruned", wireType)
+			}
+			m.ConsensusStatesPruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusStatesPruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2681,7 +4001,7 @@ func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecoverClient) Unmarshal(dAtA []byte) error {
+func (m *MsgProvideCounterparty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2704,15 +4024,15 @@ func (m *MsgRecoverClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecoverClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProvideCounterparty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecoverClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProvideCounterparty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2740,13 +4060,13 @@ func (m *MsgRecoverClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubjectClientId = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2756,23 +4076,24 @@ func (m *MsgRecoverClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2827,7 +4148,7 @@ func (m *MsgRecoverClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecoverClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProvideCounterpartyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2850,10 +4171,10 @@ func (m *MsgRecoverClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecoverClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProvideCounterpartyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecoverClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProvideCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3,6 +3,8 @@ package cli
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
+
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 )
 
@@ -19,8 +21,28 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryConnections(),
 		GetCmdQueryConnection(),
 		GetCmdQueryClientConnections(),
+		GetCmdQueryConnectionUpgrade(),
+		GetCmdQueryConnectionUpgradeError(),
 		GetCmdConnectionParams(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd returns a CLI command handler for all x/ibc connection transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.SubModuleName,
+		Short:                      "IBC connection transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		newSubmitCloseInitProposalCmd(),
+		newSubmitUpgradeInitProposalCmd(),
+	)
+
+	return txCmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -21,7 +22,7 @@ func GetCmdQueryConnections() *cobra.Command {
 		Use:     "connections",
 		Short:   "Query all connections",
 		Long:    "Query all connections ends from a chain",
-		Example: fmt.Sprintf("%s query %s %s connections", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Example: fmt.Sprintf("%s query %s %s connections --state CLOSED", version.AppName, ibcexported.ModuleName, types.SubModuleName),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -35,8 +36,19 @@ func GetCmdQueryConnections() *cobra.Command {
 				return err
 			}
 
+			stateStr, err := cmd.Flags().GetString(flagState)
+			if err != nil {
+				return err
+			}
+
+			state, err := parseState(stateStr)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryConnectionsRequest{
 				Pagination: pageReq,
+				State:      state,
 			}
 
 			res, err := queryClient.Connections(cmd.Context(), req)
@@ -48,6 +60,7 @@ func GetCmdQueryConnections() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagState, "", "Only return connections in the given state (INIT, TRYOPEN, OPEN or CLOSED)")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "connection ends")
 
@@ -118,6 +131,70 @@ func GetCmdQueryClientConnections() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryConnectionUpgrade defines the command to query a connection upgrade attempt
+func GetCmdQueryConnectionUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade [connection-id]",
+		Short:   "Query the upgrade attempt of a connection",
+		Long:    "Query the upgrade attempt of a connection",
+		Example: fmt.Sprintf("%s query %s %s upgrade [connection-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			connectionID := args[0]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryConnectionUpgrade(clientCtx, connectionID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(res.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConnectionUpgradeError defines the command to query the upgrade error receipt of a connection
+func GetCmdQueryConnectionUpgradeError() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-error [connection-id]",
+		Short:   "Query the upgrade error receipt of a connection",
+		Long:    "Query the upgrade error receipt of a connection",
+		Example: fmt.Sprintf("%s query %s %s upgrade-error [connection-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			connectionID := args[0]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := utils.QueryConnectionUpgradeError(clientCtx, connectionID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(res.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdConnectionParams returns the command handler for ibc connection parameter querying.
 func GetCmdConnectionParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -142,3 +219,23 @@ func GetCmdConnectionParams() *cobra.Command {
 
 	return cmd
 }
+
+// parseState parses a connection state from either its full (e.g. STATE_CLOSED) or short (e.g. CLOSED) name.
+// An empty string is parsed as UNINITIALIZED, which matches connections in any state.
+func parseState(state string) (types.State, error) {
+	if state == "" {
+		return types.UNINITIALIZED, nil
+	}
+
+	state = strings.ToUpper(state)
+	if !strings.HasPrefix(state, "STATE_") {
+		state = "STATE_" + state
+	}
+
+	value, ok := types.State_value[state]
+	if !ok {
+		return types.UNINITIALIZED, fmt.Errorf("invalid connection state: %s", state)
+	}
+
+	return types.State(value), nil
+}
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/client/utils"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+const (
+	flagAuthority          = "authority"
+	flagState              = "state"
+	flagFeatures           = "features"
+	flagCounterpartyPrefix = "counterparty-prefix"
+)
+
+// newSubmitCloseInitProposalCmd defines the command to submit a governance proposal to close a connection.
+func newSubmitCloseInitProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-init [connection-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "close an IBC connection",
+		Long: `Submit a governance proposal to close an IBC connection along with an initial deposit.
+		All channels built upon the connection must be closed. Once the proposal has been executed,
+		a relayer may close the counterparty connection end with MsgConnectionCloseConfirm.`,
+		Example: fmt.Sprintf("%s tx %s %s close-init connection-0 --title \"close connection\" --summary \"close connection-0\" --deposit 10stake", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(flagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			msg := types.NewMsgConnectionCloseInit(args[0], authority)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgConnectionCloseInit{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create close connection proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "The address of the connection module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// newSubmitUpgradeInitProposalCmd defines the command to submit a governance proposal to upgrade a connection.
+func newSubmitUpgradeInitProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-init [connection-id] [delay-period] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "upgrade an IBC connection",
+		Long: `Submit a governance proposal to upgrade the delay period (in nanoseconds) of an IBC connection along with an initial deposit.
+		The supported channel orderings and the counterparty commitment prefix may optionally be changed using flags.
+		The current connection end is queried to populate any upgrade fields which are not provided.`,
+		Example: fmt.Sprintf("%s tx %s %s upgrade-init connection-0 60000000000 --features ORDER_ORDERED,ORDER_UNORDERED --title \"upgrade connection\" --summary \"upgrade connection-0\" --deposit 10stake", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			delayPeriod, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid delay period: %w", err)
+			}
+
+			connRes, err := utils.QueryConnection(clientCtx, connectionID, false)
+			if err != nil {
+				return err
+			}
+
+			connection := connRes.Connection
+			if len(connection.Versions) != 1 {
+				return fmt.Errorf("expected a single negotiated version for connection %s, got %d", connectionID, len(connection.Versions))
+			}
+
+			version := connection.Versions[0]
+			features, _ := cmd.Flags().GetStringSlice(flagFeatures)
+			if len(features) != 0 {
+				version = types.NewVersion(version.Identifier, features)
+			}
+
+			counterpartyPrefix := connection.Counterparty.Prefix
+			if prefix, _ := cmd.Flags().GetString(flagCounterpartyPrefix); prefix != "" {
+				counterpartyPrefix = commitmenttypes.NewMerklePrefix([]byte(prefix))
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(flagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			upgradeFields := types.NewUpgradeFields(version, delayPeriod, counterpartyPrefix)
+			msg := types.NewMsgConnectionUpgradeInit(connectionID, upgradeFields, authority)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgConnectionUpgradeInit{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create upgrade connection proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "The address of the connection module authority (defaults to gov)")
+	cmd.Flags().StringSlice(flagFeatures, nil, "The channel orderings supported by the upgraded connection (defaults to the current features)")
+	cmd.Flags().String(flagCounterpartyPrefix, "", "The commitment prefix of the counterparty chain (defaults to the current prefix)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
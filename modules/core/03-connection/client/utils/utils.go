@@ -61,6 +61,88 @@ func queryConnectionABCI(clientCtx client.Context, connectionID string) (*types.
 	return types.NewQueryConnectionResponse(connection, proofBz, proofHeight), nil
 }
 
+// QueryConnectionUpgrade returns the upgrade attempt of a connection.
+// If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
+// it uses the gRPC query client.
+func QueryConnectionUpgrade(
+	clientCtx client.Context, connectionID string, prove bool,
+) (*types.QueryConnectionUpgradeResponse, error) {
+	if prove {
+		return queryConnectionUpgradeABCI(clientCtx, connectionID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryConnectionUpgradeRequest{
+		ConnectionId: connectionID,
+	}
+
+	return queryClient.ConnectionUpgrade(context.Background(), req)
+}
+
+func queryConnectionUpgradeABCI(clientCtx client.Context, connectionID string) (*types.QueryConnectionUpgradeResponse, error) {
+	key := host.ConnectionUpgradeKey(connectionID)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if upgrade exists
+	if len(value) == 0 {
+		return nil, errorsmod.Wrap(types.ErrUpgradeNotFound, connectionID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var upgrade types.Upgrade
+	if err := cdc.Unmarshal(value, &upgrade); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryConnectionUpgradeResponse(upgrade, proofBz, proofHeight), nil
+}
+
+// QueryConnectionUpgradeError returns the upgrade error receipt of a connection.
+// If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
+// it uses the gRPC query client.
+func QueryConnectionUpgradeError(
+	clientCtx client.Context, connectionID string, prove bool,
+) (*types.QueryConnectionUpgradeErrorResponse, error) {
+	if prove {
+		return queryConnectionUpgradeErrorABCI(clientCtx, connectionID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryConnectionUpgradeErrorRequest{
+		ConnectionId: connectionID,
+	}
+
+	return queryClient.ConnectionUpgradeError(context.Background(), req)
+}
+
+func queryConnectionUpgradeErrorABCI(clientCtx client.Context, connectionID string) (*types.QueryConnectionUpgradeErrorResponse, error) {
+	key := host.ConnectionUpgradeErrorKey(connectionID)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if upgrade error exists
+	if len(value) == 0 {
+		return nil, errorsmod.Wrap(types.ErrUpgradeErrorNotFound, connectionID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var errorReceipt types.ErrorReceipt
+	if err := cdc.Unmarshal(value, &errorReceipt); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryConnectionUpgradeErrorResponse(errorReceipt, proofBz, proofHeight), nil
+}
+
 // QueryClientConnections queries the connection paths registered for a particular client.
 // If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
 // it uses the gRPC query client.
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
@@ -72,3 +74,165 @@ func emitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connec
 		),
 	})
 }
+
+// emitConnectionCloseInitEvent emits a connection close init event
+func emitConnectionCloseInitEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionCloseInit,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionCloseConfirmEvent emits a connection close confirm event
+func emitConnectionCloseConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionCloseConfirm,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeInitEvent emits a connection upgrade init event
+func emitConnectionUpgradeInitEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeInit,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Fields.Version.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, fmt.Sprintf("%d", upgrade.Fields.DelayPeriod)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeTryEvent emits a connection upgrade try event
+func emitConnectionUpgradeTryEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeTry,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Fields.Version.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, fmt.Sprintf("%d", upgrade.Fields.DelayPeriod)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutTimestamp, fmt.Sprintf("%d", upgrade.TimeoutTimestamp)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeAckEvent emits a connection upgrade ack event
+func emitConnectionUpgradeAckEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, counterpartyUpgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeAck,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, counterpartyUpgrade.Fields.Version.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, fmt.Sprintf("%d", counterpartyUpgrade.Fields.DelayPeriod)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeConfirmEvent emits a connection upgrade confirm event
+func emitConnectionUpgradeConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeConfirm,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeTimeoutEvent emits a connection upgrade timeout event
+func emitConnectionUpgradeTimeoutEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeTimeout,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeCancelEvent emits a connection upgrade cancel event
+func emitConnectionUpgradeCancelEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeCancel,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeErrorEvent emits a connection upgrade error event
+func emitConnectionUpgradeErrorEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgradeError *types.UpgradeError) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeError,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", upgradeError.GetErrorReceipt().Sequence)),
+			// NOTE: this error is expected to be unredacted and may contain non-deterministic information
+			sdk.NewAttribute(types.AttributeKeyUpgradeErrorReceipt, upgradeError.Error()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
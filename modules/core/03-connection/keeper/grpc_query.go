@@ -55,20 +55,27 @@ func (k *Keeper) Connections(c context.Context, req *types.QueryConnectionsReque
 	var connections []*types.IdentifiedConnection
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyConnectionPrefix))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		var result types.ConnectionEnd
 		if err := k.cdc.Unmarshal(value, &result); err != nil {
-			return err
+			return false, err
+		}
+
+		if req.State != types.UNINITIALIZED && result.State != req.State {
+			return false, nil
 		}
 
 		connectionID, err := host.ParseConnectionPath(string(key))
 		if err != nil {
-			return err
+			return false, err
+		}
+
+		if accumulate {
+			identifiedConnection := types.NewIdentifiedConnection(connectionID, result)
+			connections = append(connections, &identifiedConnection)
 		}
 
-		identifiedConnection := types.NewIdentifiedConnection(connectionID, result)
-		connections = append(connections, &identifiedConnection)
-		return nil
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
@@ -178,6 +185,64 @@ func (k *Keeper) ConnectionConsensusState(c context.Context, req *types.QueryCon
 	return types.NewQueryConnectionConsensusStateResponse(connection.ClientId, anyConsensusState, height, nil, proofHeight), nil
 }
 
+// ConnectionUpgrade implements the Query/ConnectionUpgrade gRPC method
+func (k *Keeper) ConnectionUpgrade(c context.Context, req *types.QueryConnectionUpgradeRequest) (*types.QueryConnectionUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetConnection(ctx, req.ConnectionId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrConnectionNotFound, req.ConnectionId).Error(),
+		)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	return types.NewQueryConnectionUpgradeResponse(upgrade, nil, clienttypes.GetSelfHeight(ctx)), nil
+}
+
+// ConnectionUpgradeError implements the Query/ConnectionUpgradeError gRPC method
+func (k *Keeper) ConnectionUpgradeError(c context.Context, req *types.QueryConnectionUpgradeErrorRequest) (*types.QueryConnectionUpgradeErrorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetConnection(ctx, req.ConnectionId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrConnectionNotFound, req.ConnectionId).Error(),
+		)
+	}
+
+	errorReceipt, found := k.GetUpgradeErrorReceipt(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeErrorNotFound, "connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	return types.NewQueryConnectionUpgradeErrorResponse(errorReceipt, nil, clienttypes.GetSelfHeight(ctx)), nil
+}
+
 // ConnectionParams implements the Query/ConnectionParams gRPC method.
 func (k *Keeper) ConnectionParams(c context.Context, req *types.QueryConnectionParamsRequest) (*types.QueryConnectionParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -155,6 +155,32 @@ func (suite *KeeperTestSuite) TestQueryConnections() {
 			},
 			true,
 		},
+		{
+			"success: filter by state",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetupConnections()
+				path2.SetupConnections()
+
+				err := path1.EndpointA.ConnCloseInit()
+				suite.Require().NoError(err)
+
+				err = path1.EndpointB.ConnCloseConfirm()
+				suite.Require().NoError(err)
+
+				counterparty1 := types.NewCounterparty(path1.EndpointB.ClientID, path1.EndpointB.ConnectionID, suite.chainB.GetPrefix())
+				conn1 := types.NewConnectionEnd(types.CLOSED, path1.EndpointA.ClientID, counterparty1, types.GetCompatibleVersions(), 0)
+				iconn1 := types.NewIdentifiedConnection(path1.EndpointA.ConnectionID, conn1)
+
+				expConnections = []*types.IdentifiedConnection{&iconn1}
+
+				req = &types.QueryConnectionsRequest{
+					State: types.CLOSED,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -457,6 +483,180 @@ func (suite *KeeperTestSuite) TestQueryConnectionConsensusState() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConnectionUpgrade() {
+	var (
+		req        *types.QueryConnectionUpgradeRequest
+		expUpgrade types.Upgrade
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connectionID",
+			func() {
+				req = &types.QueryConnectionUpgradeRequest{}
+			},
+			false,
+		},
+		{
+			"connection not found",
+			func() {
+				req = &types.QueryConnectionUpgradeRequest{
+					ConnectionId: ibctesting.InvalidID,
+				}
+			},
+			false,
+		},
+		{
+			"upgrade not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				req = &types.QueryConnectionUpgradeRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = 500
+				err := path.EndpointA.ConnUpgradeInit()
+				suite.Require().NoError(err)
+
+				expUpgrade = types.NewUpgrade(path.EndpointA.GetProposedConnectionUpgrade(), 0)
+
+				req = &types.QueryConnectionUpgradeRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.ConnectionUpgrade(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expUpgrade, res.Upgrade)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryConnectionUpgradeError() {
+	var (
+		req             *types.QueryConnectionUpgradeErrorRequest
+		expErrorReceipt types.ErrorReceipt
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connectionID",
+			func() {
+				req = &types.QueryConnectionUpgradeErrorRequest{}
+			},
+			false,
+		},
+		{
+			"connection not found",
+			func() {
+				req = &types.QueryConnectionUpgradeErrorRequest{
+					ConnectionId: ibctesting.InvalidID,
+				}
+			},
+			false,
+		},
+		{
+			"error receipt not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				req = &types.QueryConnectionUpgradeErrorRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				upgradeError := types.NewUpgradeError(1, types.ErrInvalidUpgrade)
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.WriteErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgradeError)
+				expErrorReceipt = upgradeError.GetErrorReceipt()
+
+				req = &types.QueryConnectionUpgradeErrorRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.ConnectionUpgradeError(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expErrorReceipt, res.ErrorReceipt)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConnectionParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
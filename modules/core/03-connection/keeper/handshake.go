@@ -296,3 +296,93 @@ func (k *Keeper) ConnOpenConfirm(
 
 	return nil
 }
+
+// ConnCloseInit is called by the authority to close a connection on chain A. The connection may be closed in
+// any state other than CLOSED, provided that all channels built upon it are CLOSED. Once closed, the
+// connection can no longer be used to open channels.
+func (k *Keeper) ConnCloseInit(ctx sdk.Context, connectionID string) error {
+	if connectionID == exported.LocalhostConnectionID {
+		return errorsmod.Wrap(types.ErrInvalidConnection, "the localhost connection cannot be closed")
+	}
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State == types.CLOSED {
+		return errorsmod.Wrap(types.ErrInvalidConnectionState, "connection is already CLOSED")
+	}
+
+	if portID, channelID, found := k.getNonClosedConnectionChannel(ctx, connectionID); found {
+		return errorsmod.Wrapf(types.ErrConnectionInUse, "connection (%s) is used by channel (%s, %s)", connectionID, portID, channelID)
+	}
+
+	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", connection.State.String(), "new-state", types.CLOSED.String())
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "close-init")
+
+	connection.State = types.CLOSED
+	k.SetConnection(ctx, connectionID, connection)
+
+	// an outstanding upgrade can no longer complete on a CLOSED connection
+	k.deleteUpgradeInfo(ctx, connectionID)
+
+	emitConnectionCloseInitEvent(ctx, connectionID, connection)
+
+	return nil
+}
+
+// ConnCloseConfirm confirms closing of a connection on chain B, after the connection
+// has been closed on chain A (this code is executed on chain B). The connection must be
+// OPEN and all channels built upon it must be CLOSED.
+//
+// NOTE: Identifiers are checked on msg validation.
+func (k *Keeper) ConnCloseConfirm(
+	ctx sdk.Context,
+	connectionID string,
+	initProof []byte, // proof that connection was closed on ChainA during ConnCloseInit
+	proofHeight exported.Height, // height that relayer constructed proofInit
+	counterpartyUpgradeSequence uint64,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(
+			types.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connection.State.String(),
+		)
+	}
+
+	if portID, channelID, found := k.getNonClosedConnectionChannel(ctx, connectionID); found {
+		return errorsmod.Wrapf(types.ErrConnectionInUse, "connection (%s) is used by channel (%s, %s)", connectionID, portID, channelID)
+	}
+
+	prefix := k.GetCommitmentPrefix()
+	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes()))
+	expectedConnection := types.NewConnectionEnd(types.CLOSED, connection.Counterparty.ClientId, expectedCounterparty, connection.Versions, connection.DelayPeriod)
+	expectedConnection.UpgradeSequence = counterpartyUpgradeSequence
+
+	// Check that connection on ChainA is closed
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, initProof, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return err
+	}
+
+	// Update ChainB's connection to Closed
+	connection.State = types.CLOSED
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteUpgradeInfo(ctx, connectionID)
+	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", types.OPEN.String(), "new-state", types.CLOSED.String())
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "close-confirm")
+
+	emitConnectionCloseConfirmEvent(ctx, connectionID, connection)
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
@@ -597,3 +599,129 @@ func (suite *KeeperTestSuite) TestConnOpenConfirm() {
 		})
 	}
 }
+
+// TestConnCloseInit - chainA closes (CLOSED state) a connection with chainB
+func (suite *KeeperTestSuite) TestConnCloseInit() {
+	var path *ibctesting.Path
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"success: connection in INIT", func() {
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+		}, nil},
+		{"success: channel on connection is closed", func() {
+			path.CreateChannels()
+
+			err := path.EndpointA.SetChannelState(channeltypes.CLOSED)
+			suite.Require().NoError(err)
+		}, nil},
+		{"connection not found", func() {
+			path.EndpointA.ConnectionID = ibctesting.InvalidID
+		}, types.ErrConnectionNotFound},
+		{"localhost connection", func() {
+			path.EndpointA.ConnectionID = exported.LocalhostConnectionID
+		}, types.ErrInvalidConnection},
+		{"connection already closed", func() {
+			path.EndpointA.UpdateConnection(func(connection *types.ConnectionEnd) { connection.State = types.CLOSED })
+		}, types.ErrInvalidConnectionState},
+		{"channel on connection is not closed", func() {
+			path.CreateChannels()
+		}, types.ErrConnectionInUse},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnCloseInit(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Equal(types.CLOSED, connection.State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestConnCloseConfirm - chainB closes (CLOSED state) a connection with chainA
+// after verifying that chainA has CLOSED its end of the connection.
+func (suite *KeeperTestSuite) TestConnCloseConfirm() {
+	var path *ibctesting.Path
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"connection not found", func() {
+			path.EndpointB.ConnectionID = ibctesting.InvalidID
+		}, types.ErrConnectionNotFound},
+		{"connection state is not OPEN", func() {
+			path.EndpointB.UpdateConnection(func(connection *types.ConnectionEnd) { connection.State = types.CLOSED })
+		}, types.ErrInvalidConnectionState},
+		{"channel on connection is not closed", func() {
+			path.EndpointB.SetChannel(channeltypes.NewChannel(
+				channeltypes.OPEN, channeltypes.UNORDERED,
+				channeltypes.NewCounterparty(ibctesting.MockPort, ibctesting.FirstChannelID),
+				[]string{path.EndpointB.ConnectionID}, ibctesting.DefaultChannelVersion,
+			))
+		}, types.ErrConnectionInUse},
+		{"connection state verification failed", func() {
+			// chainA's connection is OPEN
+			path.EndpointA.UpdateConnection(func(connection *types.ConnectionEnd) { connection.State = types.OPEN })
+			suite.coordinator.CommitBlock(suite.chainA)
+		}, commitmenttypes.ErrInvalidProof},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := path.EndpointA.ConnCloseInit()
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// ensure client is up to date to receive proof
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			connectionKey := host.ConnectionKey(path.EndpointA.ConnectionID)
+			initProof, proofHeight := suite.chainA.QueryProof(connectionKey)
+
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnCloseConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, initProof, proofHeight, path.EndpointA.GetConnection().UpgradeSequence,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointB.GetConnection()
+				suite.Require().Equal(types.CLOSED, connection.State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
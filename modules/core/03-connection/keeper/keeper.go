@@ -12,6 +12,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -175,6 +176,53 @@ func (k *Keeper) GetAllConnections(ctx sdk.Context) (connections []types.Identif
 	return connections
 }
 
+// getNonClosedConnectionChannel returns the port and channel identifiers of a channel which is built upon the
+// provided connection and is not CLOSED, if any exists.
+func (k *Keeper) getNonClosedConnectionChannel(ctx sdk.Context, connectionID string) (string, string, bool) {
+	var portID, channelID string
+	k.iterateConnectionChannels(ctx, connectionID, func(chanPortID, chanID string, channel channeltypes.Channel) bool {
+		if channel.State == channeltypes.CLOSED {
+			return false
+		}
+
+		portID, channelID = chanPortID, chanID
+		return true
+	})
+
+	return portID, channelID, portID != ""
+}
+
+// iterateConnectionChannels iterates over all channels which are built upon the provided connection and invokes the
+// callback for each of them. Iteration stops when the callback returns true. The channels are looked up in the index
+// of the channels built upon a connection maintained by the channel keeper, so the cost of the iteration grows with
+// the number of channels of the connection rather than with the number of channels of the chain. Only the first
+// connection hop of a channel is a connection of this chain: the later hops of a multi-hop channel are connections
+// of the intermediate chains and are not considered.
+func (k *Keeper) iterateConnectionChannels(ctx sdk.Context, connectionID string, cb func(portID, channelID string, channel channeltypes.Channel) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, host.ConnectionChannelsPrefixKey(connectionID))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(iterator.Value())
+		if len(bz) == 0 {
+			continue
+		}
+
+		var channel channeltypes.Channel
+		k.cdc.MustUnmarshal(bz, &channel)
+
+		if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != connectionID {
+			continue
+		}
+
+		portID, channelID := host.MustParseChannelPath(string(iterator.Value()))
+		if cb(portID, channelID, channel) {
+			break
+		}
+	}
+}
+
 // CreateSentinelLocalhostConnection creates and sets the sentinel localhost connection end in the IBC store.
 func (k *Keeper) CreateSentinelLocalhostConnection(ctx sdk.Context) {
 	counterparty := types.NewCounterparty(exported.LocalhostClientID, exported.LocalhostConnectionID, commitmenttypes.NewMerklePrefix(k.GetCommitmentPrefix().Bytes()))
@@ -220,3 +268,96 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	bz := k.cdc.MustMarshal(&params)
 	store.Set([]byte(types.ParamsKey), bz)
 }
+
+// GetUpgradeErrorReceipt returns the upgrade error receipt for the provided connection identifier.
+func (k *Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, connectionID string) (types.ErrorReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionUpgradeErrorKey(connectionID))
+	if bz == nil {
+		return types.ErrorReceipt{}, false
+	}
+
+	var errorReceipt types.ErrorReceipt
+	k.cdc.MustUnmarshal(bz, &errorReceipt)
+
+	return errorReceipt, true
+}
+
+// setUpgradeErrorReceipt sets the provided error receipt in store using the connection identifier.
+func (k *Keeper) setUpgradeErrorReceipt(ctx sdk.Context, connectionID string, errorReceipt types.ErrorReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&errorReceipt)
+	store.Set(host.ConnectionUpgradeErrorKey(connectionID), bz)
+}
+
+// hasUpgrade returns true if a proposed upgrade exists in store
+func (k *Keeper) hasUpgrade(ctx sdk.Context, connectionID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ConnectionUpgradeKey(connectionID))
+}
+
+// GetUpgrade returns the proposed upgrade for the provided connection identifier.
+func (k *Keeper) GetUpgrade(ctx sdk.Context, connectionID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionUpgradeKey(connectionID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetUpgrade sets the proposed upgrade using the provided connection identifier.
+func (k *Keeper) SetUpgrade(ctx sdk.Context, connectionID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ConnectionUpgradeKey(connectionID), bz)
+}
+
+// deleteUpgrade deletes the upgrade for the provided connection identifier.
+func (k *Keeper) deleteUpgrade(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ConnectionUpgradeKey(connectionID))
+}
+
+// hasCounterpartyUpgrade returns true if a counterparty upgrade exists in store
+func (k *Keeper) hasCounterpartyUpgrade(ctx sdk.Context, connectionID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ConnectionCounterpartyUpgradeKey(connectionID))
+}
+
+// GetCounterpartyUpgrade gets the counterparty upgrade from the store.
+func (k *Keeper) GetCounterpartyUpgrade(ctx sdk.Context, connectionID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionCounterpartyUpgradeKey(connectionID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetCounterpartyUpgrade sets the counterparty upgrade in the store.
+func (k *Keeper) SetCounterpartyUpgrade(ctx sdk.Context, connectionID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ConnectionCounterpartyUpgradeKey(connectionID), bz)
+}
+
+// deleteCounterpartyUpgrade deletes the counterparty upgrade in the store.
+func (k *Keeper) deleteCounterpartyUpgrade(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ConnectionCounterpartyUpgradeKey(connectionID))
+}
+
+// deleteUpgradeInfo deletes all auxiliary upgrade information.
+func (k *Keeper) deleteUpgradeInfo(ctx sdk.Context, connectionID string) {
+	k.deleteUpgrade(ctx, connectionID)
+	k.deleteCounterpartyUpgrade(ctx, connectionID)
+}
@@ -30,6 +30,8 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	var params types.Params
 	m.keeper.legacySubspace.GetParamSet(ctx, &params)
+	// the connection upgrade timeout has never been managed by x/params
+	params.UpgradeTimeout = uint64(types.DefaultUpgradeTimeout)
 	if err := params.Validate(); err != nil {
 		return err
 	}
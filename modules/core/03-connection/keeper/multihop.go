@@ -0,0 +1,338 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// multihopConsensusState is implemented by the consensus states stored on intermediate chains
+// of a multi-hop channel. The commitment root is used to verify the proofs of the next chain.
+type multihopConsensusState interface {
+	exported.ConsensusState
+
+	GetRoot() exported.Root
+}
+
+// multihopClientState is implemented by the client states stored on intermediate chains of a multi-hop channel.
+// The client of every connection hop must be neither frozen nor expired.
+type multihopClientState interface {
+	exported.ClientState
+
+	IsFrozen() bool
+	IsExpired(latestTimestamp, now time.Time) bool
+}
+
+// VerifyMultihopMembership verifies a multi-hop proof of the value stored under the given path on
+// the chain at the end of the connection hops. The provided connection end is the first connection hop.
+func (k *Keeper) VerifyMultihopMembership(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	path string,
+	value []byte,
+) error {
+	keyProof, root, merklePath, err := k.verifyHopProofs(ctx, connection, height, proof, connectionHops, path)
+	if err != nil {
+		return err
+	}
+
+	if err := keyProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, value); err != nil {
+		return errorsmod.Wrapf(err, "failed multi-hop membership verification of key (%s)", path)
+	}
+
+	return nil
+}
+
+// VerifyMultihopNonMembership verifies a multi-hop proof of the absence of a value under the given
+// path on the chain at the end of the connection hops. The provided connection end is the first connection hop.
+func (k *Keeper) VerifyMultihopNonMembership(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	path string,
+) error {
+	keyProof, root, merklePath, err := k.verifyHopProofs(ctx, connection, height, proof, connectionHops, path)
+	if err != nil {
+		return err
+	}
+
+	if err := keyProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, merklePath); err != nil {
+		return errorsmod.Wrapf(err, "failed multi-hop non-membership verification of key (%s)", path)
+	}
+
+	return nil
+}
+
+// GetMultihopCounterpartyConnectionHops returns the connection hops of the channel end on the chain at the end
+// of the connection hops, using the counterparty connection identifiers of the connection ends of the multi-hop proof.
+// The connection ends are only trusted once the multi-hop proof has been verified.
+func (k *Keeper) GetMultihopCounterpartyConnectionHops(connection types.ConnectionEnd, proof []byte, connectionHops []string) ([]string, error) {
+	multihopProof, err := k.unmarshalMultihopProof(proof, connectionHops)
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyHops := make([]string, len(connectionHops))
+	counterpartyHops[len(counterpartyHops)-1] = connection.Counterparty.ConnectionId
+	for i, hop := range multihopProof.HopProofs {
+		counterpartyHops[len(counterpartyHops)-2-i] = hop.Connection.Counterparty.ConnectionId
+	}
+
+	return counterpartyHops, nil
+}
+
+// GetMultihopConnectionEnds returns the connection ends of the intermediate chains of the multi-hop proof, ordered
+// from the chain tracked by the first connection hop. The connection ends are only trusted once the multi-hop proof
+// has been verified.
+func (k *Keeper) GetMultihopConnectionEnds(proof []byte, connectionHops []string) ([]types.ConnectionEnd, error) {
+	multihopProof, err := k.unmarshalMultihopProof(proof, connectionHops)
+	if err != nil {
+		return nil, err
+	}
+
+	connections := make([]types.ConnectionEnd, len(multihopProof.HopProofs))
+	for i, hop := range multihopProof.HopProofs {
+		connections[i] = hop.Connection
+	}
+
+	return connections, nil
+}
+
+// GetMultihopTimestampAtHeight returns the height and the timestamp of the consensus state of the chain at the
+// end of the connection hops, as stored on the last intermediate chain of the multi-hop proof. The consensus state
+// is only trusted once the multi-hop proof has been verified.
+func (k *Keeper) GetMultihopTimestampAtHeight(proof []byte, connectionHops []string) (exported.Height, uint64, error) {
+	multihopProof, err := k.unmarshalMultihopProof(proof, connectionHops)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	lastHop := multihopProof.HopProofs[len(multihopProof.HopProofs)-1]
+	consensusState, err := k.unpackMultihopConsensusState(lastHop)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lastHop.ConsensusHeight, consensusState.GetTimestamp(), nil
+}
+
+// verifyHopProofs verifies the connection ends, client states and consensus states of the intermediate chains of a
+// multi-hop proof. The first intermediate chain is verified by the client of the provided connection end and every
+// following chain against the commitment root of the consensus state proven on the previous chain. The client of
+// every intermediate connection hop must be neither frozen nor expired. It returns the key proof together with the
+// commitment root and the prefixed path of the key on the chain at the end of the connection hops.
+func (k *Keeper) verifyHopProofs(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	path string,
+) (commitmenttypes.MerkleProof, exported.Root, commitmenttypes.MerklePath, error) {
+	multihopProof, err := k.unmarshalMultihopProof(proof, connectionHops)
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+	}
+
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	// the delay period of a multi-hop channel is the largest delay period of its connections
+	delayConnection := connection
+	for _, hop := range multihopProof.HopProofs {
+		if hop.Connection.DelayPeriod > delayConnection.DelayPeriod {
+			delayConnection = hop.Connection
+		}
+	}
+
+	timeDelay := delayConnection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, delayConnection)
+
+	var root exported.Root
+	prefix := connection.Counterparty.Prefix
+	for i, hop := range multihopProof.HopProofs {
+		connectionID := connectionHops[i+1]
+		if hop.Connection.State != types.OPEN {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "connection (%s) is not OPEN (got %s)", connectionID, hop.Connection.State)
+		}
+
+		// the status of the client is checked before verifying the proofs, an unproven client state can only cause a failure
+		consensusState, err := k.unpackMultihopConsensusState(hop)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+		}
+
+		if err := k.checkMultihopClientState(ctx, hop, consensusState); err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(err, "hop %d", i)
+		}
+
+		connectionPath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.ConnectionPath(connectionID)))
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+		}
+
+		connectionBz, err := k.cdc.Marshal(&hop.Connection)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+		}
+
+		consensusPath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.FullConsensusStatePath(hop.Connection.ClientId, hop.ConsensusHeight)))
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+		}
+
+		consensusBz, err := k.cdc.Marshal(hop.ConsensusState)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+		}
+
+		clientStatePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.FullClientStatePath(hop.Connection.ClientId)))
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+		}
+
+		clientStateBz, err := k.cdc.Marshal(hop.ClientState)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+		}
+
+		if i == 0 {
+			if err := clientModule.VerifyMembership(ctx, clientID, height, timeDelay, blockDelay, hop.ProofConnection, connectionPath, connectionBz); err != nil {
+				return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(err, "failed connection state verification of hop %d for client (%s)", i, clientID)
+			}
+
+			if err := clientModule.VerifyMembership(ctx, clientID, height, timeDelay, blockDelay, hop.ProofConsensus, consensusPath, consensusBz); err != nil {
+				return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(err, "failed consensus state verification of hop %d for client (%s)", i, clientID)
+			}
+
+			if err := clientModule.VerifyMembership(ctx, clientID, height, timeDelay, blockDelay, hop.ProofClientState, clientStatePath, clientStateBz); err != nil {
+				return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(err, "failed client state verification of hop %d for client (%s)", i, clientID)
+			}
+		} else {
+			if err := k.verifyHopMembership(root, hop.ProofConnection, connectionPath, connectionBz); err != nil {
+				return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(err, "failed connection state verification of hop %d", i)
+			}
+
+			if err := k.verifyHopMembership(root, hop.ProofConsensus, consensusPath, consensusBz); err != nil {
+				return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(err, "failed consensus state verification of hop %d", i)
+			}
+
+			if err := k.verifyHopMembership(root, hop.ProofClientState, clientStatePath, clientStateBz); err != nil {
+				return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(err, "failed client state verification of hop %d", i)
+			}
+		}
+
+		// the next chain is proven using the root of its consensus state and the commitment prefix of its connection end
+		root = consensusState.GetRoot()
+		prefix = hop.Connection.Counterparty.Prefix
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, err
+	}
+
+	var keyProof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(multihopProof.KeyProof, &keyProof); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, commitmenttypes.MerklePath{}, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal key proof: %v", err)
+	}
+
+	return keyProof, root, merklePath, nil
+}
+
+// verifyHopMembership verifies a merkle proof of a value stored on an intermediate chain against the commitment root
+// proven on the previous chain. Intermediate chains are expected to use the SDK proof specs.
+func (k *Keeper) verifyHopMembership(root exported.Root, proof []byte, merklePath commitmenttypes.MerklePath, value []byte) error {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof: %v", err)
+	}
+
+	return merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, value)
+}
+
+// unpackMultihopConsensusState unpacks the consensus state of the hop proof.
+func (k *Keeper) unpackMultihopConsensusState(hop types.HopProof) (multihopConsensusState, error) {
+	var consensusState exported.ConsensusState
+	if err := k.cdc.UnpackAny(hop.ConsensusState, &consensusState); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "failed to unpack consensus state: %v", err)
+	}
+
+	multihopConsensusState, ok := consensusState.(multihopConsensusState)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "consensus state of type %T does not provide a commitment root", consensusState)
+	}
+
+	return multihopConsensusState, nil
+}
+
+// checkMultihopClientState returns an error if the client of the connection end of the hop proof is frozen or
+// expired. The latest consensus state of the client is not part of the hop proof, the client is considered expired
+// if the trusting period has passed since the proven consensus state, which is never more recent than the latest one.
+func (k *Keeper) checkMultihopClientState(ctx sdk.Context, hop types.HopProof, consensusState multihopConsensusState) error {
+	var clientState exported.ClientState
+	if err := k.cdc.UnpackAny(hop.ClientState, &clientState); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidMultihopProof, "failed to unpack client state: %v", err)
+	}
+
+	multihopClientState, ok := clientState.(multihopClientState)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidMultihopProof, "client state of type %T does not provide its status", clientState)
+	}
+
+	clientID := hop.Connection.ClientId
+	if multihopClientState.IsFrozen() {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, exported.Frozen)
+	}
+
+	if multihopClientState.IsExpired(time.Unix(0, int64(consensusState.GetTimestamp())), ctx.BlockTime()) {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, exported.Expired)
+	}
+
+	return nil
+}
+
+// unmarshalMultihopProof unmarshals the multi-hop proof and checks that it contains a hop proof
+// for every connection hop after the first one.
+func (k *Keeper) unmarshalMultihopProof(proof []byte, connectionHops []string) (types.MultihopProof, error) {
+	var multihopProof types.MultihopProof
+	if err := k.cdc.Unmarshal(proof, &multihopProof); err != nil {
+		return types.MultihopProof{}, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "failed to unmarshal multi-hop proof: %v", err)
+	}
+
+	if len(multihopProof.HopProofs) != len(connectionHops)-1 || len(multihopProof.HopProofs) == 0 {
+		return types.MultihopProof{}, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "expected %d hop proofs, got %d", len(connectionHops)-1, len(multihopProof.HopProofs))
+	}
+
+	for i, hop := range multihopProof.HopProofs {
+		if hop.ConsensusState == nil {
+			return types.MultihopProof{}, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "consensus state of hop %d cannot be empty", i)
+		}
+
+		if hop.ClientState == nil {
+			return types.MultihopProof{}, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "client state of hop %d cannot be empty", i)
+		}
+	}
+
+	return multihopProof, nil
+}
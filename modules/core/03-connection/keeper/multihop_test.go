@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// TestVerifyMultihopMembership verifies a multi-hop proof of the connection end of the last connection hop
+// stored on chainC, routed from chainA over the connections of chainB.
+func (suite *KeeperTestSuite) TestVerifyMultihopMembership() {
+	var (
+		path           *ibctesting.MultihopPath
+		connectionHops []string
+		value          []byte
+		proof          []byte
+	)
+
+	// malleateHopClientState replaces the client state of the intermediate connection hop in the multi-hop proof
+	malleateHopClientState := func(malleate func(clientState *ibctm.ClientState)) {
+		var multihopProof types.MultihopProof
+		suite.Require().NoError(path.EndpointA.Chain.Codec.Unmarshal(proof, &multihopProof))
+
+		clientState, ok := path.Paths[1].EndpointA.GetClientState().(*ibctm.ClientState)
+		suite.Require().True(ok)
+		malleate(clientState)
+
+		anyClientState, err := clienttypes.PackClientState(clientState)
+		suite.Require().NoError(err)
+		multihopProof.HopProofs[0].ClientState = anyClientState
+
+		proof, err = path.EndpointA.Chain.Codec.Marshal(&multihopProof)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{
+			"value does not match the proof",
+			func() {
+				value = []byte("invalid value")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"hop proofs do not match the connection hops",
+			func() {
+				connectionHops = append(connectionHops, ibctesting.FirstConnectionID)
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"client of the intermediate connection hop is frozen",
+			func() {
+				malleateHopClientState(func(clientState *ibctm.ClientState) {
+					clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				})
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"client of the intermediate connection hop is expired",
+			func() {
+				malleateHopClientState(func(clientState *ibctm.ClientState) {
+					clientState.TrustingPeriod = time.Nanosecond
+				})
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"client state of the intermediate connection hop does not match the proof",
+			func() {
+				malleateHopClientState(func(clientState *ibctm.ClientState) {
+					clientState.MaxClockDrift++
+				})
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			coordinator := ibctesting.NewCoordinator(suite.T(), 3)
+			chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+
+			path = ibctesting.NewMultihopPath(chainA, coordinator.GetChain(ibctesting.GetChainID(2)), coordinator.GetChain(ibctesting.GetChainID(3)))
+			path.SetupConnections()
+
+			lastHop := path.EndpointB.Hops[0]
+			connection := lastHop.GetConnection()
+			bz, err := lastHop.Chain.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+			value = bz
+
+			var proofHeight clienttypes.Height
+			proof, proofHeight = path.EndpointB.QueryProof(host.ConnectionKey(lastHop.ConnectionID))
+			connectionHops = path.EndpointA.ConnectionHops()
+
+			tc.malleate()
+
+			connectionKeeper := chainA.App.GetIBCKeeper().ConnectionKeeper
+			firstHop := path.EndpointA.Hops[0].GetConnection()
+
+			err = connectionKeeper.VerifyMultihopMembership(chainA.GetContext(), firstHop, proofHeight, proof, connectionHops, host.ConnectionPath(lastHop.ConnectionID), value)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				counterpartyHops, err := connectionKeeper.GetMultihopCounterpartyConnectionHops(firstHop, proof, connectionHops)
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointB.ConnectionHops(), counterpartyHops)
+
+				consensusHeight, timestamp, err := connectionKeeper.GetMultihopTimestampAtHeight(proof, connectionHops)
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.Paths[1].EndpointA.GetClientLatestHeight(), consensusHeight)
+				suite.Require().Equal(path.Paths[1].EndpointA.GetConsensusState(consensusHeight.(clienttypes.Height)).GetTimestamp(), timestamp)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
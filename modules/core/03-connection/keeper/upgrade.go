@@ -0,0 +1,652 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ConnUpgradeInit is called by the authority to initiate a connection upgrade handshake with
+// the counterparty chain. The connection upgrade handshake allows the delay period, the version
+// (and thus the supported channel orderings) and the counterparty commitment prefix of an OPEN
+// connection to be changed without opening a new connection.
+func (k *Keeper) ConnUpgradeInit(ctx sdk.Context, connectionID string, upgradeFields types.UpgradeFields) (types.Upgrade, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.Upgrade{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if reflect.DeepEqual(upgradeFields, extractUpgradeFields(connection)) {
+		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidUpgrade, "existing connection end is identical to proposed upgrade connection end: got %s", upgradeFields.String())
+	}
+
+	if err := k.validateUpgradeFields(ctx, connectionID, upgradeFields); err != nil {
+		return types.Upgrade{}, err
+	}
+
+	// NOTE: the Upgrade returned here is intentionally not fully populated. The timeout remains unset
+	// until the upgrade has been accepted by ConnUpgradeTry.
+	return types.NewUpgrade(upgradeFields, 0), nil
+}
+
+// WriteUpgradeInitConnection writes a connection which has successfully passed the UpgradeInit handshake step.
+// An event is emitted for the handshake step.
+func (k *Keeper) WriteUpgradeInitConnection(ctx sdk.Context, connectionID string, upgrade types.Upgrade) (types.ConnectionEnd, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-init")
+
+	connection, upgrade := k.initUpgrade(ctx, connectionID, upgrade)
+
+	emitConnectionUpgradeInitEvent(ctx, connectionID, connection, upgrade)
+
+	return connection, upgrade
+}
+
+// ConnUpgradeTry is called by a relayer to accept the first step of a connection upgrade handshake initiated
+// on the counterparty chain. If this function is successful, the proposed upgrade will be returned. If the
+// upgrade fails, an UpgradeError is returned and the upgrade must be aborted.
+func (k *Keeper) ConnUpgradeTry(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgradeFields types.UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	connectionProof,
+	upgradeProof []byte,
+	proofHeight exported.Height,
+) (types.ConnectionEnd, types.Upgrade, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	// construct expected counterparty connection from information in state
+	// only the counterpartyUpgradeSequence is provided by the relayer
+	counterpartyConnection := k.expectedCounterpartyConnection(connectionID, connection, counterpartyUpgradeSequence)
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof,
+		connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	var (
+		upgrade                 types.Upgrade
+		isCrossingHello         bool
+		expectedUpgradeSequence uint64
+	)
+
+	upgrade, isCrossingHello = k.GetUpgrade(ctx, connectionID)
+	if isCrossingHello {
+		expectedUpgradeSequence = connection.UpgradeSequence
+	} else {
+		// at the end of the TRY step, the current upgrade sequence will be incremented in the non-crossing
+		// hello case due to initialising the upgrade, we should use this expected upgrade sequence for
+		// sequence mismatch comparison
+		expectedUpgradeSequence = connection.UpgradeSequence + 1
+	}
+
+	if counterpartyUpgradeSequence < expectedUpgradeSequence {
+		// The counterparty upgrade is outdated. An error receipt is written for our current sequence
+		// which forces the counterparty to cancel their upgrade and to move to the same sequence.
+		return connection, upgrade, types.NewUpgradeError(expectedUpgradeSequence-1, errorsmod.Wrapf(
+			types.ErrInvalidUpgradeSequence, "counterparty upgrade sequence < current upgrade sequence (%d < %d)", counterpartyUpgradeSequence, connection.UpgradeSequence,
+		))
+	}
+
+	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
+	if err := k.VerifyConnectionUpgrade(
+		ctx, connection, proofHeight, upgradeProof,
+		connection.Counterparty.ConnectionId,
+		types.NewUpgrade(counterpartyUpgradeFields, 0),
+	); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	// NOTE: if an upgrade exists (crossing hellos) then use existing upgrade fields
+	// otherwise, initialise an upgrade using the counterparty proposed version and delay period.
+	// The counterparty prefix may only be changed by the authority in ConnUpgradeInit.
+	if !isCrossingHello {
+		proposedUpgradeFields := types.NewUpgradeFields(counterpartyUpgradeFields.Version, counterpartyUpgradeFields.DelayPeriod, connection.Counterparty.Prefix)
+		if err := k.validateUpgradeFields(ctx, connectionID, proposedUpgradeFields); err != nil {
+			return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to initialize upgrade")
+		}
+
+		connection, upgrade = k.initUpgrade(ctx, connectionID, types.NewUpgrade(proposedUpgradeFields, 0))
+	}
+
+	if err := checkForUpgradeCompatibility(upgrade.Fields, counterpartyUpgradeFields); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed upgrade compatibility check")
+	}
+
+	// if the counterparty sequence is greater than the current sequence, we fast-forward to the counterparty sequence.
+	if counterpartyUpgradeSequence > connection.UpgradeSequence {
+		connection.UpgradeSequence = counterpartyUpgradeSequence
+		k.SetConnection(ctx, connectionID, connection)
+	}
+
+	upgrade.TimeoutTimestamp = k.getAbsoluteUpgradeTimeout(ctx)
+
+	return connection, upgrade, nil
+}
+
+// WriteUpgradeTryConnection writes the upgrade and the counterparty upgrade to state after successfully passing
+// the UpgradeTry handshake step. An event is emitted for the handshake step.
+func (k *Keeper) WriteUpgradeTryConnection(ctx sdk.Context, connectionID string, upgrade types.Upgrade, counterpartyUpgradeFields types.UpgradeFields) (types.ConnectionEnd, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-try")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when updating connection state in successful ConnUpgradeTry step, connectionID: %s", connectionID))
+	}
+
+	k.SetUpgrade(ctx, connectionID, upgrade)
+	k.SetCounterpartyUpgrade(ctx, connectionID, types.NewUpgrade(counterpartyUpgradeFields, 0))
+
+	k.Logger(ctx).Info("connection upgrade accepted", "connection-id", connectionID, "upgrade-sequence", fmt.Sprintf("%d", connection.UpgradeSequence))
+
+	emitConnectionUpgradeTryEvent(ctx, connectionID, connection, upgrade)
+
+	return connection, upgrade
+}
+
+// ConnUpgradeAck is called by a relayer on the chain which initialised the upgrade, once the counterparty
+// has accepted the upgrade in ConnUpgradeTry. It verifies that the counterparty upgrade is compatible with
+// the upgrade proposed by this chain and that the counterparty upgrade timeout has not elapsed.
+// If the upgrade fails, an UpgradeError is returned and the upgrade must be aborted.
+func (k *Keeper) ConnUpgradeAck(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgrade types.Upgrade,
+	connectionProof,
+	upgradeProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	// if we have cancelled our upgrade after performing UpgradeInit
+	// or UpgradeTry, the lack of a stored upgrade will prevent us from
+	// continuing the upgrade handshake
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	counterpartyConnection := k.expectedCounterpartyConnection(connectionID, connection, connection.UpgradeSequence)
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof,
+		connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	// verifies the proof that the counterparty has accepted the upgrade in UpgradeTry, the upgrade timeout is only set in UpgradeTry.
+	if err := k.VerifyConnectionUpgrade(
+		ctx, connection, proofHeight, upgradeProof,
+		connection.Counterparty.ConnectionId, counterpartyUpgrade,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	if err := checkForUpgradeCompatibility(upgrade.Fields, counterpartyUpgrade.Fields); err != nil {
+		return types.NewUpgradeError(connection.UpgradeSequence, err)
+	}
+
+	if selfTimestamp := uint64(ctx.BlockTime().UnixNano()); selfTimestamp >= counterpartyUpgrade.TimeoutTimestamp {
+		return types.NewUpgradeError(connection.UpgradeSequence, errorsmod.Wrapf(
+			types.ErrUpgradeTimeout, "counterparty upgrade timeout elapsed: current timestamp (%d) >= timeout timestamp (%d)", selfTimestamp, counterpartyUpgrade.TimeoutTimestamp,
+		))
+	}
+
+	return nil
+}
+
+// WriteUpgradeAckConnection writes the agreed upon upgrade fields to the connection after successfully passing
+// the UpgradeAck handshake step. An event is emitted for the handshake step.
+func (k *Keeper) WriteUpgradeAckConnection(ctx sdk.Context, connectionID string, counterpartyUpgrade types.Upgrade) types.ConnectionEnd {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-ack")
+
+	connection := k.writeUpgradeOpenConnection(ctx, connectionID)
+
+	emitConnectionUpgradeAckEvent(ctx, connectionID, connection, counterpartyUpgrade)
+
+	return connection
+}
+
+// ConnUpgradeConfirm is called by a relayer on the chain which accepted the upgrade in ConnUpgradeTry,
+// once the counterparty has upgraded its connection end in ConnUpgradeAck.
+func (k *Keeper) ConnUpgradeConfirm(
+	ctx sdk.Context,
+	connectionID string,
+	connectionProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve counterparty connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	// the counterparty connection end is expected to have been upgraded to the agreed upon version and delay period
+	counterpartyConnection := types.ConnectionEnd{
+		State:           types.OPEN,
+		ClientId:        connection.Counterparty.ClientId,
+		Versions:        []*types.Version{upgrade.Fields.Version},
+		Counterparty:    types.NewCounterparty(connection.ClientId, connectionID, counterpartyUpgrade.Fields.CounterpartyPrefix),
+		DelayPeriod:     upgrade.Fields.DelayPeriod,
+		UpgradeSequence: connection.UpgradeSequence,
+	}
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof,
+		connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	return nil
+}
+
+// WriteUpgradeConfirmConnection writes the agreed upon upgrade fields to the connection after successfully passing
+// the UpgradeConfirm handshake step. An event is emitted for the handshake step.
+func (k *Keeper) WriteUpgradeConfirmConnection(ctx sdk.Context, connectionID string) types.ConnectionEnd {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-confirm")
+
+	connection := k.writeUpgradeOpenConnection(ctx, connectionID)
+
+	emitConnectionUpgradeConfirmEvent(ctx, connectionID, connection)
+
+	return connection
+}
+
+// ConnUpgradeCancel is called by the msg server to prove that an error receipt was written on the counterparty
+// which constitutes a valid situation where the upgrade should be cancelled. An error is returned if sufficient evidence
+// for cancelling the upgrade has not been provided.
+func (k *Keeper) ConnUpgradeCancel(ctx sdk.Context, connectionID string, errorReceipt types.ErrorReceipt, errorReceiptProof []byte, proofHeight exported.Height) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection ID (%s)", connectionID)
+	}
+
+	// an error receipt proof must be provided.
+	if len(errorReceiptProof) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty error receipt proof unless the sender is authorized to cancel upgrades AND the upgrade has not been accepted")
+	}
+
+	// REPLAY PROTECTION: Once the upgrade has been accepted in UpgradeTry the counterparty may complete the upgrade at
+	// any time, after which it may initiate and cancel a new upgrade at a greater sequence. The error receipt sequence
+	// MUST therefore match the current upgrade sequence in this case, so that an accepted upgrade cannot be cancelled
+	// using an error receipt of a subsequent upgrade attempt.
+	if isAccepted(upgrade) && errorReceipt.Sequence != connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be equal to current upgrade sequence (%d) when the upgrade has been accepted", errorReceipt.Sequence, connection.UpgradeSequence)
+	}
+
+	if errorReceipt.Sequence < connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than or equal to current upgrade sequence (%d)", errorReceipt.Sequence, connection.UpgradeSequence)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if err := k.VerifyConnectionUpgradeError(
+		ctx, connection, proofHeight, errorReceiptProof,
+		connection.Counterparty.ConnectionId, errorReceipt,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty error receipt")
+	}
+
+	return nil
+}
+
+// WriteUpgradeCancelConnection restores a connection which has cancelled its upgrade. Auxiliary upgrade state is
+// deleted and an error receipt is written for the provided sequence. An event is emitted for the handshake step.
+func (k *Keeper) WriteUpgradeCancelConnection(ctx sdk.Context, connectionID string, sequence uint64) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-cancel")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when cancelling connection upgrade, connectionID: %s", connectionID))
+	}
+
+	connection = k.restoreConnection(ctx, connectionID, sequence, connection)
+	k.WriteErrorReceipt(ctx, connectionID, types.NewUpgradeError(sequence, types.ErrInvalidUpgrade))
+
+	k.Logger(ctx).Info("connection upgrade cancelled", "connection-id", connectionID, "upgrade-sequence", fmt.Sprintf("%d", sequence))
+
+	emitConnectionUpgradeCancelEvent(ctx, connectionID, connection)
+}
+
+// ConnUpgradeTimeout times out an outstanding upgrade which has been accepted in ConnUpgradeTry.
+// This should be used when the counterparty chain has not completed the upgrade within the timeout
+// set when the upgrade was accepted.
+func (k *Keeper) ConnUpgradeTimeout(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyConnection types.ConnectionEnd,
+	counterpartyConnectionProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection ID (%s)", connectionID)
+	}
+
+	if !isAccepted(upgrade) {
+		return errorsmod.Wrap(types.ErrUpgradeTimeoutFailed, "upgrade has not been accepted and has no timeout")
+	}
+
+	proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connection.ClientId, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	// proof must be from a height after the timeout has elapsed.
+	if proofTimestamp < upgrade.TimeoutTimestamp {
+		return errorsmod.Wrapf(types.ErrUpgradeTimeoutFailed, "upgrade timeout not reached: proof timestamp (%d) < timeout timestamp (%d)", proofTimestamp, upgrade.TimeoutTimestamp)
+	}
+
+	if counterpartyConnection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidCounterparty, "expected %s, got %s", types.OPEN, counterpartyConnection.State)
+	}
+
+	if counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, connectionID); found {
+		upgradeAlreadyComplete := len(counterpartyConnection.Versions) == 1 &&
+			reflect.DeepEqual(counterpartyConnection.Versions[0], upgrade.Fields.Version) &&
+			counterpartyConnection.DelayPeriod == upgrade.Fields.DelayPeriod &&
+			reflect.DeepEqual(counterpartyConnection.Counterparty.Prefix, counterpartyUpgrade.Fields.CounterpartyPrefix)
+		if upgradeAlreadyComplete {
+			// counterparty has already successfully upgraded so we cannot timeout
+			return errorsmod.Wrap(types.ErrUpgradeTimeoutFailed, "counterparty connection is already upgraded")
+		}
+	}
+
+	if counterpartyConnection.UpgradeSequence < connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "counterparty connection upgrade sequence (%d) must be greater than or equal to current upgrade sequence (%d)", counterpartyConnection.UpgradeSequence, connection.UpgradeSequence)
+	}
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, counterpartyConnectionProof,
+		connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	return nil
+}
+
+// WriteUpgradeTimeoutConnection restores the connection to its state before the upgrade was proposed once
+// the upgrade has timed out. Auxiliary upgrade state is deleted and an error receipt is written.
+// An event is emitted for the handshake step.
+func (k *Keeper) WriteUpgradeTimeoutConnection(ctx sdk.Context, connectionID string) types.ConnectionEnd {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-timeout")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when timing out connection upgrade, connectionID: %s", connectionID))
+	}
+
+	connection = k.restoreConnection(ctx, connectionID, connection.UpgradeSequence, connection)
+	k.WriteErrorReceipt(ctx, connectionID, types.NewUpgradeError(connection.UpgradeSequence, types.ErrUpgradeTimeout))
+
+	k.Logger(ctx).Info("connection upgrade timed out", "connection-id", connectionID, "upgrade-sequence", fmt.Sprintf("%d", connection.UpgradeSequence))
+
+	emitConnectionUpgradeTimeoutEvent(ctx, connectionID, connection)
+
+	return connection
+}
+
+// MustAbortUpgrade will restore the connection to its pre-upgrade state so that the upgrade is aborted.
+// Any unnecessary state is deleted and an error receipt is written.
+// This function is expected to always succeed, a panic will occur if an error occurs.
+func (k *Keeper) MustAbortUpgrade(ctx sdk.Context, connectionID string, err error) {
+	if err := k.abortUpgrade(ctx, connectionID, err); err != nil {
+		panic(err)
+	}
+}
+
+// abortUpgrade will restore the connection to its pre-upgrade state so that the upgrade is aborted.
+// All upgrade information associated with the upgrade attempt is deleted and an upgrade error
+// receipt is written for that upgrade attempt. This prevents the upgrade handshake from continuing
+// on our side and provides proof for the counterparty to safely abort the upgrade.
+func (k *Keeper) abortUpgrade(ctx sdk.Context, connectionID string, err error) error {
+	if err == nil {
+		return errorsmod.Wrap(types.ErrInvalidUpgradeError, "cannot abort upgrade handshake with nil error")
+	}
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	upgradeError, ok := err.(*types.UpgradeError)
+	if !ok {
+		upgradeError = types.NewUpgradeError(connection.UpgradeSequence, err)
+	}
+
+	k.restoreConnection(ctx, connectionID, connection.UpgradeSequence, connection)
+	k.WriteErrorReceipt(ctx, connectionID, upgradeError)
+
+	return nil
+}
+
+// WriteErrorReceipt will write an error receipt from the provided UpgradeError.
+func (k *Keeper) WriteErrorReceipt(ctx sdk.Context, connectionID string, upgradeError *types.UpgradeError) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(errorsmod.Wrap(types.ErrConnectionNotFound, connectionID))
+	}
+
+	errorReceiptToWrite := upgradeError.GetErrorReceipt()
+
+	existingErrorReceipt, found := k.GetUpgradeErrorReceipt(ctx, connectionID)
+	if found && existingErrorReceipt.Sequence >= errorReceiptToWrite.Sequence {
+		panic(errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than existing error receipt sequence (%d)", errorReceiptToWrite.Sequence, existingErrorReceipt.Sequence))
+	}
+
+	// Ensure that no upgrade attempt exists for the same sequence we are
+	// writing an error receipt for. This could lead to divergent behaviour
+	// on the counterparty.
+	if connection.UpgradeSequence <= errorReceiptToWrite.Sequence {
+		if k.hasUpgrade(ctx, connectionID) || k.hasCounterpartyUpgrade(ctx, connectionID) {
+			panic(errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "attempting to write error receipt at sequence (%d) while upgrade information exists at the same sequence", errorReceiptToWrite.Sequence))
+		}
+	}
+
+	k.setUpgradeErrorReceipt(ctx, connectionID, errorReceiptToWrite)
+	emitConnectionUpgradeErrorEvent(ctx, connectionID, connection, upgradeError)
+}
+
+// initUpgrade invalidates any previous upgrade, increments the upgrade sequence of the connection and
+// stores the provided upgrade.
+func (k *Keeper) initUpgrade(ctx sdk.Context, connectionID string, upgrade types.Upgrade) (types.ConnectionEnd, types.Upgrade) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when initialising connection upgrade, connectionID: %s", connectionID))
+	}
+
+	if k.hasUpgrade(ctx, connectionID) {
+		// invalidating previous upgrade
+		k.deleteUpgradeInfo(ctx, connectionID)
+		k.WriteErrorReceipt(ctx, connectionID, types.NewUpgradeError(connection.UpgradeSequence, types.ErrInvalidUpgrade))
+	}
+
+	connection.UpgradeSequence++
+
+	k.SetConnection(ctx, connectionID, connection)
+	k.SetUpgrade(ctx, connectionID, upgrade)
+
+	k.Logger(ctx).Info("connection upgrade initialised", "connection-id", connectionID, "upgrade-sequence", fmt.Sprintf("%d", connection.UpgradeSequence))
+
+	return connection, upgrade
+}
+
+// writeUpgradeOpenConnection switches the connection fields to the upgrade fields and deletes all upgrade
+// information which is no longer required.
+func (k *Keeper) writeUpgradeOpenConnection(ctx sdk.Context, connectionID string) types.ConnectionEnd {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when upgrading connection, connectionID: %s", connectionID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find upgrade when upgrading connection, connectionID: %s", connectionID))
+	}
+
+	connection.Versions = []*types.Version{upgrade.Fields.Version}
+	connection.DelayPeriod = upgrade.Fields.DelayPeriod
+	connection.Counterparty.Prefix = upgrade.Fields.CounterpartyPrefix
+
+	k.SetConnection(ctx, connectionID, connection)
+
+	// delete state associated with upgrade which is no longer required.
+	k.deleteUpgradeInfo(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection upgraded", "connection-id", connectionID, "upgrade-sequence", fmt.Sprintf("%d", connection.UpgradeSequence))
+
+	return connection
+}
+
+// restoreConnection deletes all upgrade information of the connection and sets its upgrade sequence so that the upgrade is aborted.
+// When an upgrade attempt is aborted, the upgrade information must be deleted. This prevents us
+// from continuing an upgrade handshake after we cancel an upgrade attempt.
+func (k *Keeper) restoreConnection(ctx sdk.Context, connectionID string, upgradeSequence uint64, connection types.ConnectionEnd) types.ConnectionEnd {
+	connection.UpgradeSequence = upgradeSequence
+
+	k.SetConnection(ctx, connectionID, connection)
+
+	// delete state associated with upgrade which is no longer required.
+	k.deleteUpgradeInfo(ctx, connectionID)
+
+	return connection
+}
+
+// expectedCounterpartyConnection returns the counterparty connection end as expected from the information in
+// state before the connection has been upgraded, using the provided upgrade sequence.
+func (k *Keeper) expectedCounterpartyConnection(connectionID string, connection types.ConnectionEnd, upgradeSequence uint64) types.ConnectionEnd {
+	prefix := k.GetCommitmentPrefix()
+	return types.ConnectionEnd{
+		State:           types.OPEN,
+		ClientId:        connection.Counterparty.ClientId,
+		Versions:        connection.Versions,
+		Counterparty:    types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes())),
+		DelayPeriod:     connection.DelayPeriod,
+		UpgradeSequence: upgradeSequence,
+	}
+}
+
+// getAbsoluteUpgradeTimeout returns the absolute timeout timestamp for an upgrade accepted in the current block.
+func (k *Keeper) getAbsoluteUpgradeTimeout(ctx sdk.Context) uint64 {
+	upgradeTimeout := k.GetParams(ctx).UpgradeTimeout
+	if upgradeTimeout == 0 {
+		upgradeTimeout = uint64(types.DefaultUpgradeTimeout)
+	}
+
+	return uint64(ctx.BlockTime().UnixNano()) + upgradeTimeout
+}
+
+// validateUpgradeFields validates the proposed upgrade fields against the existing connection.
+// It returns an error if the proposed version is not supported by this chain or if it does not
+// support the ordering of a channel which is built upon the connection and is not CLOSED.
+func (k *Keeper) validateUpgradeFields(ctx sdk.Context, connectionID string, proposedUpgrade types.UpgradeFields) error {
+	if connectionID == exported.LocalhostConnectionID {
+		return errorsmod.Wrap(types.ErrInvalidUpgrade, "the localhost connection cannot be upgraded")
+	}
+
+	if !types.IsSupportedVersion(types.GetCompatibleVersions(), proposedUpgrade.Version) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "proposed upgrade version %s is not supported", proposedUpgrade.Version)
+	}
+
+	var err error
+	k.iterateConnectionChannels(ctx, connectionID, func(portID, channelID string, channel channeltypes.Channel) bool {
+		if channel.State == channeltypes.CLOSED || types.VerifySupportedFeature(proposedUpgrade.Version, channel.Ordering.String()) {
+			return false
+		}
+
+		err = errorsmod.Wrapf(
+			types.ErrInvalidVersion, "proposed upgrade version %s does not support the ordering (%s) of channel (%s, %s)",
+			proposedUpgrade.Version, channel.Ordering, portID, channelID,
+		)
+		return true
+	})
+
+	return err
+}
+
+// checkForUpgradeCompatibility checks that the proposed upgrade fields are compatible with the counterparty upgrade fields.
+func checkForUpgradeCompatibility(upgradeFields, counterpartyUpgradeFields types.UpgradeFields) error {
+	if !reflect.DeepEqual(upgradeFields.Version, counterpartyUpgradeFields.Version) {
+		return errorsmod.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade version (%s) to match counterparty upgrade version (%s)", upgradeFields.Version, counterpartyUpgradeFields.Version)
+	}
+
+	if upgradeFields.DelayPeriod != counterpartyUpgradeFields.DelayPeriod {
+		return errorsmod.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade delay period (%d) to match counterparty upgrade delay period (%d)", upgradeFields.DelayPeriod, counterpartyUpgradeFields.DelayPeriod)
+	}
+
+	return nil
+}
+
+// extractUpgradeFields returns the upgrade fields from the provided connection.
+func extractUpgradeFields(connection types.ConnectionEnd) types.UpgradeFields {
+	var version *types.Version
+	if len(connection.Versions) == 1 {
+		version = connection.Versions[0]
+	}
+
+	return types.NewUpgradeFields(version, connection.DelayPeriod, connection.Counterparty.Prefix)
+}
+
+// isAccepted returns true if the upgrade has been accepted in UpgradeTry, which sets the upgrade timeout.
+func isAccepted(upgrade types.Upgrade) bool {
+	return upgrade.TimeoutTimestamp != 0
+}
@@ -0,0 +1,773 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// upgradeDelayPeriod is the delay period proposed by the connection upgrades in these tests.
+var upgradeDelayPeriod = uint64(time.Hour.Nanoseconds())
+
+func (suite *KeeperTestSuite) TestConnUpgradeInit() {
+	var (
+		path          *ibctesting.Path
+		upgradeFields types.UpgradeFields
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: upgrade feature set",
+			func() {
+				upgradeFields.Version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+			},
+			nil,
+		},
+		{
+			"success: previous upgrade is invalidated",
+			func() {
+				err := path.EndpointA.ConnUpgradeInit()
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection is not OPEN",
+			func() {
+				path.EndpointA.UpdateConnection(func(connection *types.ConnectionEnd) { connection.State = types.TRYOPEN })
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"proposed upgrade fields are identical to the existing connection",
+			func() {
+				upgradeFields.DelayPeriod = path.EndpointA.GetConnection().DelayPeriod
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"proposed version is not supported",
+			func() {
+				upgradeFields.Version = types.NewVersion("2", []string{"ORDER_UNORDERED"})
+			},
+			types.ErrInvalidVersion,
+		},
+		{
+			"proposed version does not support the ordering of an existing channel",
+			func() {
+				path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+				path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+				path.CreateChannels()
+
+				upgradeFields.Version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+			},
+			types.ErrInvalidVersion,
+		},
+		{
+			"success: proposed version does not support the ordering of a closed channel",
+			func() {
+				path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+				path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+				path.CreateChannels()
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+				upgradeFields.Version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+			},
+			nil,
+		},
+		{
+			"localhost connection cannot be upgraded",
+			func() {
+				path.EndpointA.ConnectionID = exported.LocalhostConnectionID
+			},
+			types.ErrInvalidUpgrade,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+			upgradeFields = path.EndpointA.GetProposedConnectionUpgrade()
+
+			tc.malleate()
+
+			upgrade, err := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.ConnUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgradeFields,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(upgradeFields, upgrade.Fields)
+				suite.Require().Zero(upgrade.TimeoutTimestamp)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWriteUpgradeInitConnection() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+
+	connection := path.EndpointA.GetConnection()
+	suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+	suite.Require().Equal(ibctesting.DefaultDelayPeriod, connection.DelayPeriod, "delay period must not change before the upgrade completes")
+
+	upgrade, found := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.GetProposedConnectionUpgrade(), upgrade.Fields)
+
+	// initialising a new upgrade invalidates the outstanding upgrade
+	path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = 2 * upgradeDelayPeriod
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+
+	suite.Require().Equal(uint64(2), path.EndpointA.GetConnection().UpgradeSequence)
+
+	errorReceipt, found := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTry() {
+	var (
+		path                *ibctesting.Path
+		counterpartyUpgrade types.Upgrade
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: crossing hellos",
+			func() {
+				path.EndpointB.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+				err := path.EndpointB.ConnUpgradeInit()
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"success: upgrade sequence is fast forwarded to counterparty upgrade sequence",
+			func() {
+				path.EndpointA.UpdateConnection(func(connection *types.ConnectionEnd) { connection.UpgradeSequence = 5 })
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection is not OPEN",
+			func() {
+				path.EndpointB.UpdateConnection(func(connection *types.ConnectionEnd) { connection.State = types.TRYOPEN })
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"counterparty connection proof verification failed",
+			func() {
+				// the counterparty connection is expected to have the same delay period as the connection on chainB
+				path.EndpointB.UpdateConnection(func(connection *types.ConnectionEnd) { connection.DelayPeriod = upgradeDelayPeriod })
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"counterparty upgrade proof verification failed",
+			func() {
+				counterpartyUpgrade.Fields.DelayPeriod = 2 * upgradeDelayPeriod
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"counterparty upgrade sequence is outdated",
+			func() {
+				path.EndpointB.UpdateConnection(func(connection *types.ConnectionEnd) { connection.UpgradeSequence = 5 })
+			},
+			types.NewUpgradeError(5, types.ErrInvalidUpgradeSequence),
+		},
+		{
+			"crossing hellos: incompatible delay period",
+			func() {
+				path.EndpointB.ConnectionConfig.ProposedUpgrade.DelayPeriod = 2 * upgradeDelayPeriod
+				err := path.EndpointB.ConnUpgradeInit()
+				suite.Require().NoError(err)
+			},
+			types.ErrIncompatibleCounterpartyUpgrade,
+		},
+		{
+			"proposed version does not support the ordering of an existing channel",
+			func() {
+				path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+				path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+				path.CreateChannels()
+
+				// chainA proposes a version which does not support the ordering of the channel on chainB
+				counterpartyUpgrade.Fields.Version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+				suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			types.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+			err := path.EndpointA.ConnUpgradeInit()
+			suite.Require().NoError(err)
+
+			var found bool
+			counterpartyUpgrade, found = suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			// ensure clients are up to date to receive valid proofs
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			connectionProof, upgradeProof, proofHeight := path.EndpointA.QueryConnectionUpgradeProof()
+
+			_, upgrade, err := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.ConnUpgradeTry(
+				suite.chainB.GetContext(),
+				path.EndpointB.ConnectionID,
+				counterpartyUpgrade.Fields,
+				path.EndpointA.GetConnection().UpgradeSequence,
+				connectionProof,
+				upgradeProof,
+				proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(counterpartyUpgrade.Fields.DelayPeriod, upgrade.Fields.DelayPeriod)
+				suite.Require().Equal(counterpartyUpgrade.Fields.Version, upgrade.Fields.Version)
+				suite.Require().NotZero(upgrade.TimeoutTimestamp)
+				suite.Require().Equal(path.EndpointA.GetConnection().UpgradeSequence, path.EndpointB.GetConnection().UpgradeSequence)
+			} else {
+				suite.assertUpgradeError(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeAck() {
+	var (
+		path                *ibctesting.Path
+		counterpartyUpgrade types.Upgrade
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection is not OPEN",
+			func() {
+				path.EndpointA.UpdateConnection(func(connection *types.ConnectionEnd) { connection.State = types.TRYOPEN })
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(host.ConnectionUpgradeKey(path.EndpointA.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"counterparty connection proof verification failed",
+			func() {
+				path.EndpointA.UpdateConnection(func(connection *types.ConnectionEnd) { connection.UpgradeSequence = 10 })
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"counterparty upgrade proof verification failed",
+			func() {
+				counterpartyUpgrade.TimeoutTimestamp++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"incompatible upgrades",
+			func() {
+				upgrade, found := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.Fields.DelayPeriod = 2 * upgradeDelayPeriod
+				suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+			},
+			types.NewUpgradeError(1, types.ErrIncompatibleCounterpartyUpgrade),
+		},
+		{
+			"counterparty upgrade timeout has elapsed",
+			func() {
+				suite.coordinator.IncrementTimeBy(types.DefaultUpgradeTimeout + time.Second)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			types.NewUpgradeError(1, types.ErrUpgradeTimeout),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			var found bool
+			counterpartyUpgrade, found = suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
+
+			connectionProof, upgradeProof, proofHeight := path.EndpointB.QueryConnectionUpgradeProof()
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.ConnUpgradeAck(
+				suite.chainA.GetContext(),
+				path.EndpointA.ConnectionID,
+				counterpartyUpgrade,
+				connectionProof,
+				upgradeProof,
+				proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.WriteUpgradeAckConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade)
+				suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal([]*types.Version{counterpartyUpgrade.Fields.Version}, connection.Versions)
+
+				_, found := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.assertUpgradeError(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeConfirm() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(host.ConnectionUpgradeKey(path.EndpointB.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"counterparty upgrade not found",
+			func() {
+				store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(host.ConnectionCounterpartyUpgradeKey(path.EndpointB.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"counterparty connection has not been upgraded",
+			func() {
+				upgrade, found := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.Fields.DelayPeriod = 2 * upgradeDelayPeriod
+				suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			connectionProof, proofHeight := path.EndpointA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+
+			tc.malleate()
+
+			err := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.ConnUpgradeConfirm(
+				suite.chainB.GetContext(),
+				path.EndpointB.ConnectionID,
+				connectionProof,
+				proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.WriteUpgradeConfirmConnection(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(path.EndpointA.GetConnection().Versions, connection.Versions)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeCancel() {
+	var (
+		path         *ibctesting.Path
+		errorReceipt types.ErrorReceipt
+		proof        []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(host.ConnectionUpgradeKey(path.EndpointA.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"empty error receipt proof",
+			func() {
+				proof = nil
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"error receipt sequence is less than the current upgrade sequence",
+			func() {
+				path.EndpointA.UpdateConnection(func(connection *types.ConnectionEnd) { connection.UpgradeSequence = errorReceipt.Sequence + 1 })
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"error receipt sequence does not match the current upgrade sequence of an accepted upgrade",
+			func() {
+				upgrade, found := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = 1000
+				suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+
+				errorReceipt.Sequence++
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"error receipt proof verification failed",
+			func() {
+				errorReceipt.Message = "invalid message"
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+
+			// the try step fails on chainB due to an outdated upgrade sequence, which writes an error receipt
+			path.EndpointB.UpdateConnection(func(connection *types.ConnectionEnd) { connection.UpgradeSequence = 2 })
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+			var found bool
+			errorReceipt, found = suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(2), errorReceipt.Sequence)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			var proofHeight exported.Height
+			proof, proofHeight = path.EndpointB.QueryProof(host.ConnectionUpgradeErrorKey(path.EndpointB.ConnectionID))
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.ConnUpgradeCancel(
+				suite.chainA.GetContext(),
+				path.EndpointA.ConnectionID,
+				errorReceipt,
+				proof,
+				proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.WriteUpgradeCancelConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, errorReceipt.Sequence)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Equal(errorReceipt.Sequence, connection.UpgradeSequence)
+				suite.Require().Equal(ibctesting.DefaultDelayPeriod, connection.DelayPeriod)
+
+				_, found := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTimeout() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(host.ConnectionUpgradeKey(path.EndpointB.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"upgrade has not been accepted",
+			func() {
+				upgrade, found := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = 0
+				suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade)
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+		{
+			"upgrade timeout has not been reached",
+			func() {
+				upgrade, found := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+				suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade)
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+		{
+			"counterparty upgrade sequence is less than the current upgrade sequence",
+			func() {
+				path.EndpointB.UpdateConnection(func(connection *types.ConnectionEnd) { connection.UpgradeSequence = 5 })
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+			// advance the time of chainA beyond the upgrade timeout
+			suite.coordinator.IncrementTimeBy(types.DefaultUpgradeTimeout + time.Second)
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			connectionProof, proofHeight := path.EndpointA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+
+			tc.malleate()
+
+			err := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.ConnUpgradeTimeout(
+				suite.chainB.GetContext(),
+				path.EndpointB.ConnectionID,
+				path.EndpointA.GetConnection(),
+				connectionProof,
+				proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.WriteUpgradeTimeoutConnection(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().Equal(ibctesting.DefaultDelayPeriod, connection.DelayPeriod)
+
+				errorReceipt, found := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(connection.UpgradeSequence, errorReceipt.Sequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestConnectionUpgradeHandshake tests a full connection upgrade handshake followed by sending a packet
+// over a channel built upon the upgraded connection.
+func (suite *KeeperTestSuite) TestConnectionUpgradeHandshake() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = upgradeDelayPeriod
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ConnUpgradeConfirm())
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		connection := endpoint.GetConnection()
+		suite.Require().Equal(types.OPEN, connection.State)
+		suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+		suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+
+		_, found := endpoint.Chain.GetSimApp().IBCKeeper.ConnectionKeeper.GetUpgrade(endpoint.Chain.GetContext(), endpoint.ConnectionID)
+		suite.Require().False(found)
+	}
+
+	// the channel built upon the connection can still be used after the upgrade
+	_, err := path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+}
+
+// assertUpgradeError asserts that the provided error matches the expected error. If the expected error is an
+// UpgradeError, the upgrade sequence of the error receipt must match.
+func (suite *KeeperTestSuite) assertUpgradeError(actualError, expError error) {
+	suite.Require().Error(actualError)
+
+	if expUpgradeError, ok := expError.(*types.UpgradeError); ok {
+		upgradeError, ok := actualError.(*types.UpgradeError)
+		suite.Require().True(ok)
+		suite.Require().Equal(expUpgradeError.GetErrorReceipt(), upgradeError.GetErrorReceipt())
+	}
+
+	suite.Require().ErrorIs(actualError, expError)
+}
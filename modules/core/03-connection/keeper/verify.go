@@ -312,6 +312,49 @@ func (k *Keeper) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (k *Keeper) VerifyPacketReceipt(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	// get time and block delays
+	timeDelay := connection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, merklePath, receipt,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (k *Keeper) VerifyNextSequenceRecv(
@@ -354,6 +397,48 @@ func (k *Keeper) VerifyNextSequenceRecv(
 	return nil
 }
 
+// VerifyCommitmentStartSequence verifies a proof of the commitment start sequence of the
+// specified channel at the specified port.
+func (k *Keeper) VerifyCommitmentStartSequence(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	commitmentStartSequence uint64,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	// get time and block delays
+	timeDelay := connection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.CommitmentStartSequencePath(portID, channelID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, merklePath, sdk.Uint64ToBigEndian(commitmentStartSequence),
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed commitment start sequence verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyChannelUpgradeError verifies a proof of the provided upgrade error receipt.
 func (k *Keeper) VerifyChannelUpgradeError(
 	ctx sdk.Context,
@@ -438,6 +523,153 @@ func (k *Keeper) VerifyChannelUpgrade(
 	return nil
 }
 
+// VerifyConnectionUpgradeError verifies a proof of the provided connection upgrade error receipt.
+func (k *Keeper) VerifyConnectionUpgradeError(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	connectionID string,
+	errorReceipt types.ErrorReceipt,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ConnectionUpgradeErrorPath(connectionID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&errorReceipt)
+	if err != nil {
+		return err
+	}
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed upgrade error receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyConnectionUpgrade verifies the proof that a particular proposed connection upgrade has been stored in the upgrade path.
+func (k *Keeper) VerifyConnectionUpgrade(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	proofHeight exported.Height,
+	upgradeProof []byte,
+	connectionID string,
+	upgrade types.Upgrade,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ConnectionUpgradePath(connectionID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&upgrade)
+	if err != nil {
+		return err
+	}
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, proofHeight,
+		0, 0, // skip delay period checks for non-packet processing verification
+		upgradeProof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed upgrade verification for client (%s) on connection (%s)", clientID, connectionID)
+	}
+
+	return nil
+}
+
+// VerifyPacketBatchMembership verifies a proof of the value stored under the given path on the counterparty of the
+// connection. Unlike the other verification functions it does not check the status of the client, which must have
+// been checked to be active by the caller. It allows verifying the proofs of a batch of packets relayed at the same
+// proof height while checking the client status once per batch.
+func (k *Keeper) VerifyPacketBatchMembership(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	path string,
+	value []byte,
+) error {
+	clientID := connection.ClientId
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return err
+	}
+
+	if err := clientModule.VerifyMembership(
+		ctx, clientID, height,
+		connection.DelayPeriod, k.getBlockDelay(ctx, connection),
+		proof, merklePath, value,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed membership verification of path (%s) for client (%s)", path, clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketBatchNonMembership verifies a proof of the absence of a value under the given path on the counterparty
+// of the connection. Like VerifyPacketBatchMembership, it does not check the status of the client.
+func (k *Keeper) VerifyPacketBatchNonMembership(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	path string,
+) error {
+	clientID := connection.ClientId
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return err
+	}
+
+	if err := clientModule.VerifyNonMembership(
+		ctx, clientID, height,
+		connection.DelayPeriod, k.getBlockDelay(ctx, connection),
+		proof, merklePath,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed non-membership verification of path (%s) for client (%s)", path, clientID)
+	}
+
+	return nil
+}
+
 // getBlockDelay calculates the block delay period from the time delay of the connection
 // and the maximum expected time per block.
 func (k *Keeper) getBlockDelay(ctx sdk.Context, connection types.ConnectionEnd) uint64 {
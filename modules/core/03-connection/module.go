@@ -13,6 +13,11 @@ func Name() string {
 	return types.SubModuleName
 }
 
+// GetTxCmd returns the root tx command for IBC connections.
+func GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
 // GetQueryCmd returns the root query command for the IBC connections.
 func GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
@@ -17,6 +17,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConnectionOpenAck{},
 		&MsgConnectionOpenConfirm{},
 		&MsgUpdateParams{},
+		&MsgConnectionCloseInit{},
+		&MsgConnectionCloseConfirm{},
+		&MsgConnectionUpgradeInit{},
+		&MsgConnectionUpgradeTry{},
+		&MsgConnectionUpgradeAck{},
+		&MsgConnectionUpgradeConfirm{},
+		&MsgConnectionUpgradeTimeout{},
+		&MsgConnectionUpgradeCancel{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -38,6 +38,36 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgConnectionOpenConfirm{}),
 			true,
 		},
+		{
+			"success: MsgConnectionUpgradeInit",
+			sdk.MsgTypeURL(&types.MsgConnectionUpgradeInit{}),
+			true,
+		},
+		{
+			"success: MsgConnectionUpgradeTry",
+			sdk.MsgTypeURL(&types.MsgConnectionUpgradeTry{}),
+			true,
+		},
+		{
+			"success: MsgConnectionUpgradeAck",
+			sdk.MsgTypeURL(&types.MsgConnectionUpgradeAck{}),
+			true,
+		},
+		{
+			"success: MsgConnectionUpgradeConfirm",
+			sdk.MsgTypeURL(&types.MsgConnectionUpgradeConfirm{}),
+			true,
+		},
+		{
+			"success: MsgConnectionUpgradeTimeout",
+			sdk.MsgTypeURL(&types.MsgConnectionUpgradeTimeout{}),
+			true,
+		},
+		{
+			"success: MsgConnectionUpgradeCancel",
+			sdk.MsgTypeURL(&types.MsgConnectionUpgradeCancel{}),
+			true,
+		},
 		{
 			"success: MsgUpdateParams",
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
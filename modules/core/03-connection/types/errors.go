@@ -6,14 +6,24 @@ import (
 
 // IBC connection sentinel errors
 var (
-	ErrConnectionExists              = errorsmod.Register(SubModuleName, 2, "connection already exists")
-	ErrConnectionNotFound            = errorsmod.Register(SubModuleName, 3, "connection not found")
-	ErrClientConnectionPathsNotFound = errorsmod.Register(SubModuleName, 4, "light client connection paths not found")
-	ErrConnectionPath                = errorsmod.Register(SubModuleName, 5, "connection path is not associated to the given light client")
-	ErrInvalidConnectionState        = errorsmod.Register(SubModuleName, 6, "invalid connection state")
-	ErrInvalidCounterparty           = errorsmod.Register(SubModuleName, 7, "invalid counterparty connection")
-	ErrInvalidConnection             = errorsmod.Register(SubModuleName, 8, "invalid connection")
-	ErrInvalidVersion                = errorsmod.Register(SubModuleName, 9, "invalid connection version")
-	ErrVersionNegotiationFailed      = errorsmod.Register(SubModuleName, 10, "connection version negotiation failed")
-	ErrInvalidConnectionIdentifier   = errorsmod.Register(SubModuleName, 11, "invalid connection identifier")
+	ErrConnectionExists                = errorsmod.Register(SubModuleName, 2, "connection already exists")
+	ErrConnectionNotFound              = errorsmod.Register(SubModuleName, 3, "connection not found")
+	ErrClientConnectionPathsNotFound   = errorsmod.Register(SubModuleName, 4, "light client connection paths not found")
+	ErrConnectionPath                  = errorsmod.Register(SubModuleName, 5, "connection path is not associated to the given light client")
+	ErrInvalidConnectionState          = errorsmod.Register(SubModuleName, 6, "invalid connection state")
+	ErrInvalidCounterparty             = errorsmod.Register(SubModuleName, 7, "invalid counterparty connection")
+	ErrInvalidConnection               = errorsmod.Register(SubModuleName, 8, "invalid connection")
+	ErrInvalidVersion                  = errorsmod.Register(SubModuleName, 9, "invalid connection version")
+	ErrVersionNegotiationFailed        = errorsmod.Register(SubModuleName, 10, "connection version negotiation failed")
+	ErrInvalidConnectionIdentifier     = errorsmod.Register(SubModuleName, 11, "invalid connection identifier")
+	ErrConnectionInUse                 = errorsmod.Register(SubModuleName, 12, "connection is referenced by a channel which is not closed")
+	ErrInvalidUpgrade                  = errorsmod.Register(SubModuleName, 13, "invalid connection upgrade")
+	ErrUpgradeNotFound                 = errorsmod.Register(SubModuleName, 14, "connection upgrade not found")
+	ErrUpgradeErrorNotFound            = errorsmod.Register(SubModuleName, 15, "connection upgrade error receipt not found")
+	ErrInvalidUpgradeSequence          = errorsmod.Register(SubModuleName, 16, "invalid connection upgrade sequence")
+	ErrIncompatibleCounterpartyUpgrade = errorsmod.Register(SubModuleName, 17, "incompatible counterparty connection upgrade")
+	ErrInvalidUpgradeError             = errorsmod.Register(SubModuleName, 18, "invalid connection upgrade error")
+	ErrUpgradeTimeout                  = errorsmod.Register(SubModuleName, 19, "connection upgrade timed-out")
+	ErrUpgradeTimeoutFailed            = errorsmod.Register(SubModuleName, 20, "connection upgrade timeout failed")
+	ErrInvalidMultihopProof            = errorsmod.Register(SubModuleName, 21, "invalid multi-hop proof")
 )
@@ -249,6 +249,216 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenConfirm() {
 	}
 }
 
+func (suite *MsgTestSuite) TestNewMsgConnectionCloseInit() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionCloseInit
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionCloseInit("test/conn1", signer), false},
+		{"empty signer", types.NewMsgConnectionCloseInit(connectionID, ""), false},
+		{"success", types.NewMsgConnectionCloseInit(connectionID, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionCloseConfirm() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionCloseConfirm
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionCloseConfirm("test/conn1", suite.proof, clientHeight, signer, 0), false},
+		{"empty proofInit", types.NewMsgConnectionCloseConfirm(connectionID, emptyProof, clientHeight, signer, 0), false},
+		{"empty signer", types.NewMsgConnectionCloseConfirm(connectionID, suite.proof, clientHeight, "", 0), false},
+		{"success", types.NewMsgConnectionCloseConfirm(connectionID, suite.proof, clientHeight, signer, 0), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeInit() {
+	upgradeFields := types.NewUpgradeFields(ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("prefix")))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeInit
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeInit("test/conn1", upgradeFields, signer), false},
+		{"invalid version", types.NewMsgConnectionUpgradeInit(connectionID, types.NewUpgradeFields(&types.Version{}, 500, upgradeFields.CounterpartyPrefix), signer), false},
+		{"empty counterparty prefix", types.NewMsgConnectionUpgradeInit(connectionID, types.NewUpgradeFields(ibctesting.ConnectionVersion, 500, emptyPrefix), signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeInit(connectionID, upgradeFields, ""), false},
+		{"success", types.NewMsgConnectionUpgradeInit(connectionID, upgradeFields, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTry() {
+	upgradeFields := types.NewUpgradeFields(ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("prefix")))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTry
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTry("test/conn1", upgradeFields, 1, suite.proof, suite.proof, clientHeight, signer), false},
+		{"zero counterparty upgrade sequence", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 0, suite.proof, suite.proof, clientHeight, signer), false},
+		{"invalid counterparty upgrade fields", types.NewMsgConnectionUpgradeTry(connectionID, types.UpgradeFields{}, 1, suite.proof, suite.proof, clientHeight, signer), false},
+		{"empty proofConnection", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, emptyProof, suite.proof, clientHeight, signer), false},
+		{"empty proofUpgrade", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, suite.proof, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, suite.proof, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, suite.proof, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeAck() {
+	upgrade := types.NewUpgrade(types.NewUpgradeFields(ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("prefix"))), 1000)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeAck
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeAck("test/conn1", upgrade, suite.proof, suite.proof, clientHeight, signer), false},
+		{"zero counterparty upgrade timeout", types.NewMsgConnectionUpgradeAck(connectionID, types.NewUpgrade(upgrade.Fields, 0), suite.proof, suite.proof, clientHeight, signer), false},
+		{"empty proofConnection", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, emptyProof, suite.proof, clientHeight, signer), false},
+		{"empty proofUpgrade", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, suite.proof, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, suite.proof, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, suite.proof, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeConfirm() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeConfirm
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeConfirm("test/conn1", suite.proof, clientHeight, signer), false},
+		{"empty proofConnection", types.NewMsgConnectionUpgradeConfirm(connectionID, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTimeout() {
+	counterparty := types.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("prefix")))
+	openConnection := types.NewConnectionEnd(types.OPEN, clientID, counterparty, []*types.Version{ibctesting.ConnectionVersion}, 500)
+	initConnection := types.NewConnectionEnd(types.INIT, clientID, counterparty, []*types.Version{ibctesting.ConnectionVersion}, 500)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTimeout
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTimeout("test/conn1", openConnection, suite.proof, clientHeight, signer), false},
+		{"empty proofConnection", types.NewMsgConnectionUpgradeTimeout(connectionID, openConnection, emptyProof, clientHeight, signer), false},
+		{"counterparty connection not OPEN", types.NewMsgConnectionUpgradeTimeout(connectionID, initConnection, suite.proof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTimeout(connectionID, openConnection, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTimeout(connectionID, openConnection, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeCancel() {
+	errorReceipt := types.ErrorReceipt{Sequence: 1, Message: "error"}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeCancel
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeCancel("test/conn1", errorReceipt, suite.proof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, suite.proof, clientHeight, ""), false},
+		{"success: empty proofErrorReceipt", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, emptyProof, clientHeight, signer), true},
+		{"success", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func (suite *MsgTestSuite) TestMsgUpdateParamsValidateBasic() {
 	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
@@ -0,0 +1,857 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/connection/v1/multihop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultihopProof defines the proof of a key stored on the chain at the end of the connection hops
+// of a multi-hop channel, as described in ICS-33. The intermediate chains are proven one after
+// another, starting from the root of the consensus state of the client of the first connection hop.
+type MultihopProof struct {
+	// proofs of the intermediate chains, ordered from the chain tracked by the first connection hop
+	// towards the chain at the end of the connection hops.
+	HopProofs []HopProof `protobuf:"bytes,1,rep,name=hop_proofs,json=hopProofs,proto3" json:"hop_proofs"`
+	// proof of the key on the chain at the end of the connection hops, verified against the root of
+	// the consensus state proven by the last hop proof.
+	KeyProof []byte `protobuf:"bytes,2,opt,name=key_proof,json=keyProof,proto3" json:"key_proof,omitempty"`
+}
+
+func (m *MultihopProof) Reset()         { *m = MultihopProof{} }
+func (m *MultihopProof) String() string { return proto.CompactTextString(m) }
+func (*MultihopProof) ProtoMessage()    {}
+func (*MultihopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ba5298bc7a3929, []int{0}
+}
+func (m *MultihopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultihopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultihopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultihopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultihopProof.Merge(m, src)
+}
+func (m *MultihopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultihopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultihopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultihopProof proto.InternalMessageInfo
+
+// HopProof defines the proofs of the connection end used by the next connection hop, of the client
+// state of its client and of the consensus state of the next chain, all stored on an intermediate
+// chain of a multi-hop channel.
+type HopProof struct {
+	// the connection end of the connection hop on the intermediate chain.
+	Connection      ConnectionEnd `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection"`
+	ProofConnection []byte        `protobuf:"bytes,2,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	// the consensus state of the next chain stored by the client of the connection end.
+	ConsensusState  *types.Any    `protobuf:"bytes,3,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	ConsensusHeight types1.Height `protobuf:"bytes,4,opt,name=consensus_height,json=consensusHeight,proto3" json:"consensus_height"`
+	ProofConsensus  []byte        `protobuf:"bytes,5,opt,name=proof_consensus,json=proofConsensus,proto3" json:"proof_consensus,omitempty"`
+	// the client state of the client of the connection end, used to check that the client is neither
+	// frozen nor expired.
+	ClientState      *types.Any `protobuf:"bytes,6,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty"`
+	ProofClientState []byte     `protobuf:"bytes,7,opt,name=proof_client_state,json=proofClientState,proto3" json:"proof_client_state,omitempty"`
+}
+
+func (m *HopProof) Reset()         { *m = HopProof{} }
+func (m *HopProof) String() string { return proto.CompactTextString(m) }
+func (*HopProof) ProtoMessage()    {}
+func (*HopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ba5298bc7a3929, []int{1}
+}
+func (m *HopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopProof.Merge(m, src)
+}
+func (m *HopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *HopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopProof proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MultihopProof)(nil), "ibc.core.connection.v1.MultihopProof")
+	proto.RegisterType((*HopProof)(nil), "ibc.core.connection.v1.HopProof")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/connection/v1/multihop.proto", fileDescriptor_45ba5298bc7a3929)
+}
+
+var fileDescriptor_45ba5298bc7a3929 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6a, 0x13, 0x41,
+	0x1c, 0xc7, 0x77, 0x4d, 0xac, 0xed, 0xa4, 0x36, 0x65, 0x28, 0xb2, 0x46, 0xd8, 0x84, 0x42, 0x69,
+	0x04, 0x3b, 0x63, 0xdb, 0x83, 0x22, 0x7a, 0xb0, 0xa5, 0x20, 0x14, 0x41, 0x22, 0x78, 0xf0, 0x12,
+	0xba, 0xd3, 0xe9, 0xee, 0xd0, 0xdd, 0xf9, 0x2d, 0x99, 0xd9, 0xc0, 0xbe, 0x81, 0x47, 0x1f, 0xc1,
+	0x83, 0x0f, 0xd3, 0x63, 0x8f, 0x9e, 0x44, 0x92, 0x17, 0x91, 0x9d, 0x99, 0xcd, 0xee, 0xc1, 0x78,
+	0x9b, 0x3f, 0xdf, 0x3f, 0x1f, 0x7e, 0x33, 0xe8, 0x40, 0x44, 0x8c, 0x32, 0x98, 0x71, 0xca, 0x40,
+	0x4a, 0xce, 0xb4, 0x00, 0x49, 0xe7, 0xc7, 0x34, 0x2b, 0x52, 0x2d, 0x12, 0xc8, 0x49, 0x3e, 0x03,
+	0x0d, 0xf8, 0x89, 0x88, 0x18, 0xa9, 0x64, 0xa4, 0x91, 0x91, 0xf9, 0xf1, 0x60, 0x2f, 0x86, 0x18,
+	0x8c, 0x84, 0x56, 0x2b, 0xab, 0x1e, 0x3c, 0x8d, 0x01, 0xe2, 0x94, 0x53, 0xb3, 0x8b, 0x8a, 0x1b,
+	0x7a, 0x25, 0x4b, 0x77, 0x35, 0x6c, 0xfa, 0x52, 0xc1, 0xa5, 0xae, 0xba, 0xec, 0xca, 0x09, 0x0e,
+	0xd7, 0x00, 0xb5, 0x7a, 0x8d, 0x70, 0xbf, 0x44, 0x8f, 0x3f, 0x3a, 0xc8, 0x4f, 0x33, 0x80, 0x1b,
+	0x7c, 0x81, 0x50, 0x02, 0xf9, 0x34, 0xaf, 0x36, 0x2a, 0xf0, 0x47, 0x9d, 0x71, 0xef, 0x64, 0x44,
+	0xfe, 0x0d, 0x4e, 0x3e, 0x38, 0xd7, 0x59, 0xf7, 0xee, 0xf7, 0xd0, 0x9b, 0x6c, 0xd5, 0x29, 0x0a,
+	0x3f, 0x43, 0x5b, 0xb7, 0xbc, 0xb4, 0x31, 0xc1, 0x83, 0x91, 0x3f, 0xde, 0x9e, 0x6c, 0xde, 0xf2,
+	0xd2, 0xdc, 0xbe, 0xe9, 0x7e, 0xfb, 0x31, 0xf4, 0xf6, 0x7f, 0x76, 0xd0, 0x66, 0x1d, 0x80, 0x2f,
+	0x11, 0x6a, 0xa2, 0x03, 0x7f, 0xe4, 0x8f, 0x7b, 0x27, 0x07, 0xeb, 0x6a, 0xcf, 0x57, 0xbb, 0x0b,
+	0x79, 0xed, 0xba, 0x5b, 0x76, 0xfc, 0x1c, 0xed, 0x9a, 0xe2, 0x69, 0x2b, 0xd2, 0x32, 0xf4, 0xcd,
+	0x79, 0xe3, 0xc7, 0xef, 0x50, 0x9f, 0x81, 0x54, 0x5c, 0xaa, 0x42, 0x4d, 0x95, 0xbe, 0xd2, 0x3c,
+	0xe8, 0x98, 0xf2, 0x3d, 0x62, 0xc7, 0x4f, 0xea, 0xf1, 0x93, 0xf7, 0xb2, 0x9c, 0xec, 0xac, 0xc4,
+	0x9f, 0x2b, 0x2d, 0xbe, 0x44, 0xbb, 0x8d, 0x3d, 0xe1, 0x22, 0x4e, 0x74, 0xd0, 0x35, 0xfe, 0x41,
+	0x0b, 0xde, 0xbe, 0x4c, 0x35, 0x2f, 0xa3, 0x70, 0xc4, 0x4d, 0xb1, 0x3d, 0xc6, 0x87, 0xa8, 0xbf,
+	0xc2, 0xb6, 0x17, 0xc1, 0x43, 0x43, 0xbd, 0x53, 0x53, 0xdb, 0x53, 0xfc, 0x0a, 0x6d, 0xdb, 0x4c,
+	0x47, 0xbc, 0xf1, 0x1f, 0xe2, 0x9e, 0x55, 0x5a, 0xdc, 0x17, 0x08, 0xbb, 0x86, 0xb6, 0xfd, 0x91,
+	0x29, 0xb1, 0x23, 0x3b, 0x6f, 0xd4, 0xf6, 0x99, 0xce, 0xbe, 0xdc, 0x2d, 0x42, 0xff, 0x7e, 0x11,
+	0xfa, 0x7f, 0x16, 0xa1, 0xff, 0x7d, 0x19, 0x7a, 0xf7, 0xcb, 0xd0, 0xfb, 0xb5, 0x0c, 0xbd, 0xaf,
+	0x6f, 0x63, 0xa1, 0x93, 0x22, 0x22, 0x0c, 0x32, 0xca, 0x40, 0x65, 0xa0, 0xa8, 0x88, 0xd8, 0x51,
+	0x0c, 0x74, 0xfe, 0x9a, 0x66, 0x70, 0x5d, 0xa4, 0x5c, 0xd9, 0x4f, 0xf8, 0xf2, 0xf4, 0xa8, 0xf5,
+	0x0f, 0x75, 0x99, 0x73, 0x15, 0x6d, 0x18, 0xcc, 0xd3, 0xbf, 0x03, 0x00, 0x8d, 0x7d, 0x21, 0xfb,
+	0x3c, 0x03, 0x00, 0x00,
+}
+
+func (m *MultihopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultihopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultihopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyProof) > 0 {
+		i -= len(m.KeyProof)
+		copy(dAtA[i:], m.KeyProof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.KeyProof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HopProofs) > 0 {
+		for iNdEx := len(m.HopProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HopProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultihop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofClientState) > 0 {
+		i -= len(m.ProofClientState)
+		copy(dAtA[i:], m.ProofClientState)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ProofClientState)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultihop(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultihop(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultihop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultihop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultihopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HopProofs) > 0 {
+		for _, e := range m.HopProofs {
+			l = e.Size()
+			n += 1 + l + sovMultihop(uint64(l))
+		}
+	}
+	l = len(m.KeyProof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	return n
+}
+
+func (m *HopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Connection.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = len(m.ProofClientState)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	return n
+}
+
+func sovMultihop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultihop(x uint64) (n int) {
+	return sovMultihop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultihopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultihopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultihopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopProofs = append(m.HopProofs, HopProof{})
+			if err := m.HopProofs[len(m.HopProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyProof = append(m.KeyProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyProof == nil {
+				m.KeyProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClientState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClientState = append(m.ProofClientState[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClientState == nil {
+				m.ProofClientState = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultihop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultihop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultihop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultihop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultihop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultihop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultihop = fmt.Errorf("proto: unexpected end of group")
+)
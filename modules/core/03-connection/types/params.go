@@ -5,13 +5,19 @@ import (
 	"time"
 )
 
-// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
-const DefaultTimePerBlock = 30 * time.Second
+const (
+	// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
+	DefaultTimePerBlock = 30 * time.Second
+	// DefaultUpgradeTimeout is the default value for the relative timeout of connection upgrades (in nanoseconds).
+	DefaultUpgradeTimeout = 10 * time.Minute
+)
 
 // NewParams creates a new parameter configuration for the ibc connection module
+// using the default upgrade timeout.
 func NewParams(timePerBlock uint64) Params {
 	return Params{
 		MaxExpectedTimePerBlock: timePerBlock,
+		UpgradeTimeout:          uint64(DefaultUpgradeTimeout),
 	}
 }
 
@@ -69,3 +69,21 @@ func NewQueryConnectionConsensusStateResponse(clientID string, anyConsensusState
 func (qccsr QueryConnectionConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qccsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// NewQueryConnectionUpgradeResponse creates a new QueryConnectionUpgradeResponse instance
+func NewQueryConnectionUpgradeResponse(upgrade Upgrade, proof []byte, height clienttypes.Height) *QueryConnectionUpgradeResponse {
+	return &QueryConnectionUpgradeResponse{
+		Upgrade:     upgrade,
+		Proof:       proof,
+		ProofHeight: height,
+	}
+}
+
+// NewQueryConnectionUpgradeErrorResponse creates a new QueryConnectionUpgradeErrorResponse instance
+func NewQueryConnectionUpgradeErrorResponse(errorReceipt ErrorReceipt, proof []byte, height clienttypes.Height) *QueryConnectionUpgradeErrorResponse {
+	return &QueryConnectionUpgradeErrorResponse{
+		ErrorReceipt: errorReceipt,
+		Proof:        proof,
+		ProofHeight:  height,
+	}
+}
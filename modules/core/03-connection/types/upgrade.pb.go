@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/connection/v1/upgrade.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Upgrade is a verifiable type which contains the relevant information
+// for an attempted connection upgrade. It provides the proposed changes to the
+// connection end and the timeout for this upgrade attempt. The timeout is set
+// once the upgrade has been accepted by the UpgradeTry step.
+type Upgrade struct {
+	Fields           UpgradeFields `protobuf:"bytes,1,opt,name=fields,proto3" json:"fields"`
+	TimeoutTimestamp uint64        `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *Upgrade) Reset()         { *m = Upgrade{} }
+func (m *Upgrade) String() string { return proto.CompactTextString(m) }
+func (*Upgrade) ProtoMessage()    {}
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2662dd11fb8ae2, []int{0}
+}
+func (m *Upgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Upgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Upgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Upgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Upgrade.Merge(m, src)
+}
+func (m *Upgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Upgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Upgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Upgrade proto.InternalMessageInfo
+
+// UpgradeFields are the fields in a connection end which may be changed
+// during a connection upgrade.
+type UpgradeFields struct {
+	// the connection version, which determines the channel orderings supported by the connection.
+	Version *Version `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the delay period of the connection.
+	DelayPeriod uint64 `protobuf:"varint,2,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// commitment merkle prefix of the counterparty chain.
+	CounterpartyPrefix types.MerklePrefix `protobuf:"bytes,3,opt,name=counterparty_prefix,json=counterpartyPrefix,proto3" json:"counterparty_prefix"`
+}
+
+func (m *UpgradeFields) Reset()         { *m = UpgradeFields{} }
+func (m *UpgradeFields) String() string { return proto.CompactTextString(m) }
+func (*UpgradeFields) ProtoMessage()    {}
+func (*UpgradeFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2662dd11fb8ae2, []int{1}
+}
+func (m *UpgradeFields) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeFields.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeFields.Merge(m, src)
+}
+func (m *UpgradeFields) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeFields proto.InternalMessageInfo
+
+// ErrorReceipt defines a type which encapsulates the upgrade sequence and error associated with the
+// connection upgrade handshake failure. When a connection upgrade handshake is aborted both chains are
+// expected to increment to the next sequence.
+type ErrorReceipt struct {
+	// the connection upgrade sequence
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the error message detailing the cause of failure
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ErrorReceipt) Reset()         { *m = ErrorReceipt{} }
+func (m *ErrorReceipt) String() string { return proto.CompactTextString(m) }
+func (*ErrorReceipt) ProtoMessage()    {}
+func (*ErrorReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2662dd11fb8ae2, []int{2}
+}
+func (m *ErrorReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorReceipt.Merge(m, src)
+}
+func (m *ErrorReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorReceipt proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Upgrade)(nil), "ibc.core.connection.v1.Upgrade")
+	proto.RegisterType((*UpgradeFields)(nil), "ibc.core.connection.v1.UpgradeFields")
+	proto.RegisterType((*ErrorReceipt)(nil), "ibc.core.connection.v1.ErrorReceipt")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/connection/v1/upgrade.proto", fileDescriptor_5d2662dd11fb8ae2)
+}
+
+var fileDescriptor_5d2662dd11fb8ae2 = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x33, 0xba, 0x74, 0x75, 0x5a, 0x41, 0x47, 0x91, 0x65, 0x0f, 0xd9, 0x5a, 0x2a, 0x16,
+	0xa4, 0x19, 0xd7, 0x5e, 0x54, 0x3c, 0x55, 0xf4, 0x20, 0x08, 0x25, 0x68, 0x0f, 0x7a, 0x58, 0x92,
+	0xc9, 0x6b, 0x1c, 0xcc, 0xe4, 0x8d, 0x33, 0x93, 0xe0, 0x1e, 0xbd, 0x79, 0xf4, 0x23, 0xf8, 0x71,
+	0x7a, 0xdc, 0xa3, 0x27, 0x91, 0xdd, 0x2f, 0x22, 0x99, 0x64, 0xdb, 0x08, 0xdb, 0x53, 0xf2, 0xde,
+	0xfc, 0xe6, 0xff, 0xff, 0xbf, 0xe1, 0xd1, 0x7d, 0x99, 0x0a, 0x2e, 0xd0, 0x00, 0x17, 0x58, 0x96,
+	0x20, 0x9c, 0xc4, 0x92, 0xd7, 0x53, 0x5e, 0xe9, 0xdc, 0x24, 0x19, 0x44, 0xda, 0xa0, 0x43, 0x76,
+	0x5f, 0xa6, 0x22, 0x6a, 0xa8, 0xe8, 0x92, 0x8a, 0xea, 0xe9, 0xf8, 0x5e, 0x8e, 0x39, 0x7a, 0x84,
+	0x37, 0x7f, 0x2d, 0x3d, 0x7e, 0xd4, 0xd3, 0x54, 0x4a, 0x3a, 0x05, 0xa5, 0x6b, 0x34, 0x2f, 0xab,
+	0x0d, 0x60, 0xdf, 0xbc, 0x67, 0xe2, 0xc1, 0xbd, 0xef, 0x84, 0x0e, 0x3f, 0xb4, 0x89, 0xd8, 0x2b,
+	0xba, 0x75, 0x26, 0xa1, 0xc8, 0xec, 0x88, 0xec, 0x92, 0x83, 0xed, 0xa7, 0x0f, 0xa3, 0xcd, 0xe1,
+	0xa2, 0xee, 0xc2, 0x1b, 0x0f, 0x1f, 0x0f, 0xce, 0xff, 0x4c, 0x82, 0xb8, 0xbb, 0xca, 0x1e, 0xd3,
+	0x3b, 0x4e, 0x2a, 0xc0, 0xca, 0xcd, 0x9a, 0xaf, 0x75, 0x89, 0xd2, 0xa3, 0x6b, 0xbb, 0xe4, 0x60,
+	0x10, 0xdf, 0xee, 0x0e, 0xde, 0xaf, 0xfb, 0x2f, 0x06, 0x3f, 0x7e, 0x4d, 0x82, 0xbd, 0x05, 0xa1,
+	0xb7, 0xfe, 0x93, 0x64, 0xcf, 0xe9, 0xb0, 0x06, 0x63, 0x25, 0x96, 0x5d, 0x94, 0xc9, 0x55, 0x51,
+	0x4e, 0x5b, 0x2c, 0x5e, 0xf3, 0xec, 0x01, 0xdd, 0xc9, 0xa0, 0x48, 0xe6, 0x33, 0x0d, 0x46, 0x62,
+	0xd6, 0x59, 0x6f, 0xfb, 0xde, 0x89, 0x6f, 0xb1, 0x4f, 0xf4, 0xae, 0xc0, 0xaa, 0x74, 0x60, 0x74,
+	0x62, 0xdc, 0x7c, 0xa6, 0x0d, 0x9c, 0xc9, 0x6f, 0xa3, 0xeb, 0xde, 0x69, 0xbf, 0xef, 0x74, 0xf1,
+	0xaa, 0xf5, 0x34, 0x7a, 0x07, 0xe6, 0x4b, 0x01, 0x27, 0x9e, 0xed, 0x66, 0x66, 0x7d, 0x99, 0xf6,
+	0xa4, 0x1b, 0xe9, 0x2d, 0xdd, 0x79, 0x6d, 0x0c, 0x9a, 0x18, 0x04, 0x48, 0xed, 0xd8, 0x98, 0xde,
+	0xb0, 0xf0, 0xb5, 0x82, 0x52, 0x80, 0x9f, 0x68, 0x10, 0x5f, 0xd4, 0x6c, 0x44, 0x87, 0x0a, 0xac,
+	0x4d, 0x72, 0xf0, 0x61, 0x6f, 0xc6, 0xeb, 0xb2, 0xd5, 0x3a, 0x3e, 0x3d, 0x5f, 0x86, 0x64, 0xb1,
+	0x0c, 0xc9, 0xdf, 0x65, 0x48, 0x7e, 0xae, 0xc2, 0x60, 0xb1, 0x0a, 0x83, 0xdf, 0xab, 0x30, 0xf8,
+	0xf8, 0x32, 0x97, 0xee, 0x73, 0x95, 0x36, 0x41, 0xb9, 0x40, 0xab, 0xd0, 0x72, 0x99, 0x8a, 0xc3,
+	0x1c, 0x79, 0xfd, 0x8c, 0x2b, 0xcc, 0xaa, 0x02, 0x6c, 0xbb, 0x05, 0x4f, 0x8e, 0x0e, 0x7b, 0x8b,
+	0xe0, 0xe6, 0x1a, 0x6c, 0xba, 0xe5, 0x37, 0xe0, 0xe8, 0xdf, 0x00, 0xc8, 0x02, 0xf4, 0x74, 0xa9,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CounterpartyPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DelayPeriod != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpgrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fields.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovUpgrade(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *UpgradeFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovUpgrade(uint64(m.DelayPeriod))
+	}
+	l = m.CounterpartyPrefix.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	return n
+}
+
+func (m *ErrorReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovUpgrade(uint64(m.Sequence))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func sovUpgrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpgrade(x uint64) (n int) {
+	return sovUpgrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUpgrade(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUpgrade
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUpgrade
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUpgrade
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUpgrade        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUpgrade          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUpgrade = fmt.Errorf("proto: unexpected end of group")
+)
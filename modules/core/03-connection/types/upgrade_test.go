@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestUpgradeValidateBasic(t *testing.T) {
+	var upgrade types.Upgrade
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid version",
+			func() {
+				upgrade.Fields.Version = &types.Version{}
+			},
+			false,
+		},
+		{
+			"empty counterparty prefix",
+			func() {
+				upgrade.Fields.CounterpartyPrefix = emptyPrefix
+			},
+			false,
+		},
+		{
+			"timeout timestamp is zero",
+			func() {
+				upgrade.TimeoutTimestamp = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			upgrade = types.NewUpgrade(types.NewUpgradeFields(ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("prefix"))), 1000)
+
+			tc.malleate()
+
+			err := upgrade.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestUpgradeError(t *testing.T) {
+	upgradeError := types.NewUpgradeError(10, types.ErrInvalidUpgrade)
+
+	require.True(t, errors.Is(upgradeError, types.ErrInvalidUpgrade))
+	require.True(t, types.IsUpgradeError(upgradeError))
+	require.True(t, types.IsUpgradeError(errorsmod.Wrap(upgradeError, "wrapped upgrade error")))
+	require.True(t, types.IsUpgradeError(fmt.Errorf("%w", upgradeError)))
+	require.False(t, types.IsUpgradeError(types.ErrInvalidUpgrade))
+	require.False(t, types.IsUpgradeError(nil))
+
+	errorReceipt := upgradeError.GetErrorReceipt()
+	require.Equal(t, uint64(10), errorReceipt.Sequence)
+	// the error receipt message must not contain the underlying (possibly non-deterministic) error
+	require.NotContains(t, errorReceipt.Message, types.ErrInvalidUpgrade.Error())
+}
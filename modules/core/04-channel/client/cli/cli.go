@@ -34,6 +34,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUpgradeError(),
 		GetCmdQueryUpgrade(),
 		GetCmdChannelParams(),
+		GetCmdQueryChannelPaused(),
+		GetCmdQueryPausedChannels(),
+		GetCmdQueryPacket(),
+		GetCmdQueryPackets(),
+		GetCmdQueryPacketStatus(),
+		GetCmdQueryChannelUpgradeSchedule(),
+		GetCmdQueryChannelUpgradeSchedules(),
 	)
 
 	return queryCmd
@@ -52,6 +59,10 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		newUpgradeChannelsTxCmd(),
 		newPruneAcknowledgementsTxCmd(),
+		newSubmitPauseProposalCmd(),
+		newSubmitResumeProposalCmd(),
+		newSubmitForceCloseProposalCmd(),
+		newScheduleUpgradesTxCmd(),
 	)
 
 	return txCmd
@@ -16,7 +16,8 @@ import (
 )
 
 const (
-	flagSequences = "sequences"
+	flagSequences   = "sequences"
+	flagDestination = "destination"
 )
 
 // GetCmdQueryChannels defines the command to query all the channels ends
@@ -584,3 +585,294 @@ func GetCmdChannelParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryChannelPaused defines the command to query whether a channel is paused.
+func GetCmdQueryChannelPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused [port-id] [channel-id]",
+		Short:   "Query whether a channel is paused",
+		Long:    "Query whether a channel is paused and the block height at which it was paused",
+		Example: fmt.Sprintf("%s query %s %s paused [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelPausedRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelPaused(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPausedChannels defines the command to query all the paused channels.
+func GetCmdQueryPausedChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-channels",
+		Short:   "Query all paused channels",
+		Long:    "Query all paused channels and the block heights at which they were paused",
+		Example: fmt.Sprintf("%s query %s %s paused-channels", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPausedChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PausedChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "paused channels")
+
+	return cmd
+}
+
+// GetCmdQueryPacket defines the command to query a packet stored in full
+func GetCmdQueryPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet [port-id] [channel-id] [sequence]",
+		Short: "Query an in-flight packet",
+		Long:  "Query an in-flight packet sent on a port for which packet data storage is enabled",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet [port-id] [channel-id] [sequence]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.Packet(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPackets defines the command to query all the packets stored in full for a channel
+func GetCmdQueryPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets [port-id] [channel-id]",
+		Short:   "Query all in-flight packets associated with a channel",
+		Long:    "Query all in-flight packets sent on a channel whose port has packet data storage enabled",
+		Example: fmt.Sprintf("%s query %s %s packets [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Packets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets")
+
+	return cmd
+}
+
+// GetCmdQueryPacketStatus defines the command to query the status of a packet
+func GetCmdQueryPacketStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-status [port-id] [channel-id] [sequence]",
+		Short: "Query the status of a packet",
+		Long: `Query the status of a packet (in-flight, received, acknowledged, timed-out, acknowledged-or-timed-out,
+timed-out-eligible, pruned or not-received) together with the relevant heights, which are only stored for the packet data ports. By default the port and channel identify the source of a packet sent from the queried chain,
+use the destination flag to query a packet received on the queried chain.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-status [port-id] [channel-id] [sequence] --%s", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagDestination,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			destination, err := cmd.Flags().GetBool(flagDestination)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketStatusRequest{
+				PortId:      args[0],
+				ChannelId:   args[1],
+				Sequence:    seq,
+				Destination: destination,
+			}
+
+			res, err := queryClient.PacketStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagDestination, false, "query the status of a packet received on the given port and channel")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelUpgradeSchedule defines the command to query a channel upgrade schedule.
+func GetCmdQueryChannelUpgradeSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-schedule [schedule-id]",
+		Short:   "Query a channel upgrade schedule",
+		Long:    "Query a channel upgrade schedule and the status of the channel upgrades it initiated",
+		Example: fmt.Sprintf("%s query %s %s upgrade-schedule [schedule-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelUpgradeScheduleRequest{
+				ScheduleId: scheduleID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelUpgradeSchedule(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled channel upgrades")
+
+	return cmd
+}
+
+// GetCmdQueryChannelUpgradeSchedules defines the command to query all the channel upgrade schedules.
+func GetCmdQueryChannelUpgradeSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-schedules",
+		Short:   "Query all channel upgrade schedules",
+		Long:    "Query all channel upgrade schedules and the number of channel upgrades they initiated",
+		Example: fmt.Sprintf("%s query %s %s upgrade-schedules", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelUpgradeSchedulesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelUpgradeSchedules(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel upgrade schedules")
+
+	return cmd
+}
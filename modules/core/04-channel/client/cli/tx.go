@@ -13,18 +13,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 const (
-	flagJSON        = "json"
-	flagPortPattern = "port-pattern"
-	flagExpedited   = "expedited"
-	flagChannelIDs  = "channel-ids"
+	flagJSON           = "json"
+	flagPortPattern    = "port-pattern"
+	flagExpedited      = "expedited"
+	flagChannelIDs     = "channel-ids"
+	flagAuthority      = "authority"
+	flagPortID         = "port-id"
+	flagConnectionID   = "connection-id"
+	flagChannelVersion = "channel-version"
 )
 
 // newPruneAcknowledgementsTxCmd returns the command to create a new MsgPruneAcknowledgements transaction
@@ -149,6 +155,179 @@ func newUpgradeChannelsTxCmd() *cobra.Command {
 	return cmd
 }
 
+// newSubmitPauseProposalCmd defines the command to submit a governance proposal to pause a channel.
+func newSubmitPauseProposalCmd() *cobra.Command {
+	return newSubmitChannelProposalCmd(
+		"pause",
+		"pause an IBC channel",
+		`Submit a governance proposal to pause an IBC channel along with an initial deposit.
+		Packets can neither be sent nor received on a paused channel, while acknowledgements and
+		timeouts of in-flight packets are still processed.`,
+		func(portID, channelID, authority string) channelProposalMsg {
+			return types.NewMsgChannelPause(portID, channelID, authority)
+		},
+	)
+}
+
+// newSubmitResumeProposalCmd defines the command to submit a governance proposal to resume a paused channel.
+func newSubmitResumeProposalCmd() *cobra.Command {
+	return newSubmitChannelProposalCmd(
+		"resume",
+		"resume a paused IBC channel",
+		`Submit a governance proposal to resume a paused IBC channel along with an initial deposit.`,
+		func(portID, channelID, authority string) channelProposalMsg {
+			return types.NewMsgChannelResume(portID, channelID, authority)
+		},
+	)
+}
+
+// newSubmitForceCloseProposalCmd defines the command to submit a governance proposal to force close a channel.
+func newSubmitForceCloseProposalCmd() *cobra.Command {
+	return newSubmitChannelProposalCmd(
+		"force-close",
+		"force close an IBC channel",
+		`Submit a governance proposal to close an IBC channel without the consent of the application bound to it,
+		along with an initial deposit. Once the proposal has been executed, a relayer may close the counterparty
+		channel end with MsgChannelCloseConfirm.`,
+		func(portID, channelID, authority string) channelProposalMsg {
+			return types.NewMsgChannelForceClose(portID, channelID, authority)
+		},
+	)
+}
+
+// channelProposalMsg is a message which may be submitted in a governance proposal for a channel.
+type channelProposalMsg interface {
+	sdk.Msg
+	ValidateBasic() error
+}
+
+// newSubmitChannelProposalCmd returns a command to submit a governance proposal containing the message
+// constructed by newMsg for the channel given as arguments.
+func newSubmitChannelProposalCmd(use, short, long string, newMsg func(portID, channelID, authority string) channelProposalMsg) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [port-id] [channel-id] [flags]", use),
+		Args:    cobra.ExactArgs(2),
+		Short:   short,
+		Long:    long,
+		Example: fmt.Sprintf("%s tx %s %s %s transfer channel-0 --title \"%s channel\" --summary \"%s channel-0\" --deposit 10stake", version.AppName, ibcexported.ModuleName, types.SubModuleName, use, use, use),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, err := readAuthority(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := newMsg(args[0], args[1], authority)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", msg, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create %s channel proposal message: %w", use, err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "The address of the channel module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// newScheduleUpgradesTxCmd defines the command to submit a governance proposal to schedule channel upgrades.
+func newScheduleUpgradesTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-upgrades [height] [upgrade-version] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "schedule the upgrade of IBC channels",
+		Long: `Submit a governance proposal to schedule the upgrade of all open channels matching the port, connection
+		and version filters, along with an initial deposit. The upgrade handshakes are initiated at the beginning of
+		the given block height. Channels are not filtered on the fields for which no flag is provided.`,
+		Example: fmt.Sprintf(`%s tx %s %s schedule-upgrades 1000 "{\"fee_version\":\"ics29-1\",\"app_version\":\"ics20-1\"}" --port-id transfer --title "upgrade transfer channels" --summary "add fee middleware to transfer channels" --deposit 10stake`, version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, err := readAuthority(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, _ := cmd.Flags().GetString(flagPortID)
+			connectionID, _ := cmd.Flags().GetString(flagConnectionID)
+			channelVersion, _ := cmd.Flags().GetString(flagChannelVersion)
+
+			msg := types.NewMsgScheduleChannelUpgrades(height, portID, connectionID, channelVersion, args[1], authority)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", msg, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create schedule channel upgrades proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "The address of the channel module authority (defaults to gov)")
+	cmd.Flags().String(flagPortID, "", "The port ID of the channels to upgrade")
+	cmd.Flags().String(flagConnectionID, "", "The connection ID of the channels to upgrade")
+	cmd.Flags().String(flagChannelVersion, "", "The current version of the channels to upgrade")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// readAuthority returns the authority address provided with the authority flag, which defaults to the gov module account.
+func readAuthority(cmd *cobra.Command) (string, error) {
+	authority, _ := cmd.Flags().GetString(flagAuthority)
+	if authority == "" {
+		return sdk.AccAddress(address.Module(govtypes.ModuleName)).String(), nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return "", fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	return authority, nil
+}
+
 // getChannelIDs returns a slice of channel IDs based on a comma separated string of channel IDs.
 func getChannelIDs(commaSeparatedList string) []string {
 	if strings.TrimSpace(commaSeparatedList) == "" {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // InitGenesis initializes the ibc channel submodule's state from a provided genesis
@@ -19,6 +20,7 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
+		k.SetConnectionChannel(ctx, channel.PortId, channel.ChannelId, ch.ConnectionHops)
 	}
 	for _, ack := range gs.Acknowledgements {
 		k.SetPacketAcknowledgement(ctx, ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
@@ -38,20 +40,52 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, pc := range gs.PausedChannels {
+		k.SetChannelPauseHeight(ctx, pc.PortId, pc.ChannelId, pc.Height)
+	}
+	for _, packet := range gs.Packets {
+		k.SetPacket(ctx, packet)
+	}
+	for _, sh := range gs.SendHeights {
+		k.SetPacketSendHeight(ctx, sh.PortId, sh.ChannelId, sh.Sequence, sh.Height)
+	}
+	for _, rh := range gs.RecvHeights {
+		k.SetPacketRecvHeight(ctx, rh.PortId, rh.ChannelId, rh.Sequence, rh.Height)
+	}
+	for _, th := range gs.TimeoutHeights {
+		k.SetPacketTimeoutHeight(ctx, th.PortId, th.ChannelId, th.Sequence, th.Height)
+	}
+	for _, schedule := range gs.UpgradeSchedules {
+		k.SetChannelUpgradeSchedule(ctx, schedule)
+	}
+	for _, upgrade := range gs.ScheduledChannelUpgrades {
+		k.SetScheduledChannelUpgrade(ctx, upgrade)
+	}
+	if gs.NextChannelUpgradeScheduleId != 0 {
+		k.SetNextChannelUpgradeScheduleID(ctx, gs.NextChannelUpgradeScheduleId)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
-		Params:              k.GetParams(ctx),
+		Channels:                     k.GetAllChannels(ctx),
+		Acknowledgements:             k.GetAllPacketAcks(ctx),
+		Commitments:                  k.GetAllPacketCommitments(ctx),
+		Receipts:                     k.GetAllPacketReceipts(ctx),
+		SendSequences:                k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:                k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:                 k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:          k.GetNextChannelSequence(ctx),
+		Params:                       k.GetParams(ctx),
+		PausedChannels:               k.GetAllPausedChannels(ctx),
+		Packets:                      k.GetAllPackets(ctx),
+		SendHeights:                  k.GetAllPacketHeights(ctx, host.KeySendHeightPrefix),
+		RecvHeights:                  k.GetAllPacketHeights(ctx, host.KeyRecvHeightPrefix),
+		TimeoutHeights:               k.GetAllPacketHeights(ctx, host.KeyTimeoutHeightPrefix),
+		UpgradeSchedules:             k.GetAllChannelUpgradeSchedules(ctx),
+		ScheduledChannelUpgrades:     k.GetAllScheduledChannelUpgrades(ctx),
+		NextChannelUpgradeScheduleId: k.GetNextChannelUpgradeScheduleID(ctx),
 	}
 }
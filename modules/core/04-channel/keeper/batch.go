@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// PacketBatch holds the state shared by the packets relayed on the same channel end, such as the packets of a
+// MsgRecvPackets, MsgAcknowledgements or MsgTimeouts. The channel end, its connection end, the status of the client
+// of the connection and the counterparty timestamp at the proof height are looked up when first needed and reused
+// for the following packets of the batch. The channel end is kept up to date with the state transitions caused by
+// the packets of the batch, and must be invalidated once application callbacks, which may modify it, are executed.
+type PacketBatch struct {
+	portID    string
+	channelID string
+
+	channel      *types.Channel
+	connectionID string
+	connection   *connectiontypes.ConnectionEnd
+	clientActive bool
+
+	// the counterparty timestamp is only cached for channels with a single connection hop
+	timestampHeight exported.Height
+	timestamp       uint64
+}
+
+// NewPacketBatch creates a new PacketBatch for the packets relayed on the given channel end of this chain.
+func NewPacketBatch(portID, channelID string) *PacketBatch {
+	return &PacketBatch{
+		portID:    portID,
+		channelID: channelID,
+	}
+}
+
+// InvalidateChannel clears the cached channel end of the batch, so that it is read again from the store by the
+// next packet of the batch. It must be called after executing an application callback for a packet of the batch,
+// since the application may have modified the channel end, for example by closing it. It is a no-op on a nil batch.
+func (b *PacketBatch) InvalidateChannel() {
+	if b != nil {
+		b.channel = nil
+	}
+}
+
+// validatePacketChannel returns an error if the given channel end of a packet is not the channel end of the batch.
+func (b *PacketBatch) validatePacketChannel(portID, channelID string) error {
+	if portID != b.portID || channelID != b.channelID {
+		return errorsmod.Wrapf(
+			types.ErrInvalidPacket,
+			"packet port ID (%s) and channel ID (%s) do not match the batch port ID (%s) and channel ID (%s)", portID, channelID, b.portID, b.channelID,
+		)
+	}
+
+	return nil
+}
+
+// GetPacketBatchChannel returns the channel end of the batch. The channel end is only read from the store the first
+// time it is needed or after it has been modified by an upgrade abort.
+func (k *Keeper) GetPacketBatchChannel(ctx sdk.Context, batch *PacketBatch) (types.Channel, bool) {
+	if batch.channel == nil {
+		channel, found := k.GetChannel(ctx, batch.portID, batch.channelID)
+		if !found {
+			return types.Channel{}, false
+		}
+
+		batch.channel = &channel
+	}
+
+	return *batch.channel, true
+}
+
+// setPacketBatchChannel stores the channel end of the batch and updates the cached channel end.
+func (k *Keeper) setPacketBatchChannel(ctx sdk.Context, batch *PacketBatch, channel types.Channel) {
+	k.SetChannel(ctx, batch.portID, batch.channelID, channel)
+	batch.channel = &channel
+}
+
+// abortPacketBatchUpgrade aborts the upgrade of the channel end of the batch. The channel end is restored to its
+// pre-upgrade parameters, so it is read again from the store by the next packet of the batch.
+func (k *Keeper) abortPacketBatchUpgrade(ctx sdk.Context, batch *PacketBatch, upgradeError error) {
+	k.MustAbortUpgrade(ctx, batch.portID, batch.channelID, upgradeError)
+	batch.InvalidateChannel()
+}
+
+// getPacketBatchConnection returns the connection end of the first connection hop of the channel end of the batch.
+func (k *Keeper) getPacketBatchConnection(ctx sdk.Context, batch *PacketBatch, connectionID string) (connectiontypes.ConnectionEnd, bool) {
+	if batch.connection == nil || batch.connectionID != connectionID {
+		connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+		if !found {
+			return connectiontypes.ConnectionEnd{}, false
+		}
+
+		batch.connectionID, batch.connection = connectionID, &connection
+		batch.clientActive = false
+	}
+
+	return *batch.connection, true
+}
+
+// checkPacketBatchClient returns an error if the client of the connection of the batch is not active. The status of
+// the client is only checked once per batch, since it cannot change while the packets of the batch are relayed.
+func (k *Keeper) checkPacketBatchClient(ctx sdk.Context, batch *PacketBatch, clientID string) error {
+	if batch.clientActive {
+		return nil
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	batch.clientActive = true
+	return nil
+}
+
+// getPacketBatchCounterpartyTimestampAtHeight returns the height and timestamp of the counterparty chain at which the
+// proof is verified. For channels with a single connection hop, the timestamp is only looked up once per proof height.
+func (k *Keeper) getPacketBatchCounterpartyTimestampAtHeight(
+	ctx sdk.Context,
+	batch *PacketBatch,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proof []byte,
+	proofHeight exported.Height,
+) (clienttypes.Height, uint64, error) {
+	if isMultihop(connectionHops) {
+		return k.getCounterpartyTimestampAtHeight(ctx, connectionHops, connectionEnd, proof, proofHeight)
+	}
+
+	if batch.timestampHeight == nil || !batch.timestampHeight.EQ(proofHeight) {
+		timestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connectionEnd.ClientId, proofHeight)
+		if err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		batch.timestampHeight, batch.timestamp = proofHeight, timestamp
+	}
+
+	return proofHeight.(clienttypes.Height), batch.timestamp, nil
+}
+
+// verifyPacketBatchMembership verifies a proof of the value stored under the given path on the counterparty channel
+// end of the batch. Multi-hop proofs are verified by the connection keeper starting from the client of the first
+// connection hop.
+func (k *Keeper) verifyPacketBatchMembership(
+	ctx sdk.Context,
+	batch *PacketBatch,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+	path string,
+	value []byte,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, path, value)
+	}
+
+	if err := k.checkPacketBatchClient(ctx, batch, connectionEnd.ClientId); err != nil {
+		return err
+	}
+
+	return k.connectionKeeper.VerifyPacketBatchMembership(ctx, connectionEnd, proofHeight, proof, path, value)
+}
+
+// verifyPacketBatchNonMembership verifies a proof of the absence of a value under the given path on the counterparty
+// channel end of the batch.
+func (k *Keeper) verifyPacketBatchNonMembership(
+	ctx sdk.Context,
+	batch *PacketBatch,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+	path string,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopNonMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, path)
+	}
+
+	if err := k.checkPacketBatchClient(ctx, batch, connectionEnd.ClientId); err != nil {
+		return err
+	}
+
+	return k.connectionKeeper.VerifyPacketBatchNonMembership(ctx, connectionEnd, proofHeight, proof, path)
+}
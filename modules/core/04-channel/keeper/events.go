@@ -119,6 +119,77 @@ func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID stri
 	})
 }
 
+// emitChannelPauseEvent emits a channel pause event
+func emitChannelPauseEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelPause,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitChannelResumeEvent emits a channel resume event
+func emitChannelResumeEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelResume,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitChannelUpgradeScheduleExecutedEvent emits a channel upgrade schedule executed event
+func emitChannelUpgradeScheduleExecutedEvent(ctx sdk.Context, schedule types.ChannelUpgradeSchedule) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelUpgradeScheduleExecuted,
+			sdk.NewAttribute(types.AttributeKeyUpgradeScheduleID, fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute(types.AttributeKeyVersion, schedule.UpgradeVersion),
+			sdk.NewAttribute(types.AttributeKeyUpgradesInitiated, fmt.Sprintf("%d", schedule.UpgradesInitiated)),
+			sdk.NewAttribute(types.AttributeKeyUpgradesFailed, fmt.Sprintf("%d", schedule.UpgradesFailed)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitScheduledChannelUpgradeCancelledEvent emits a scheduled channel upgrade cancelled event
+func emitScheduledChannelUpgradeCancelledEvent(ctx sdk.Context, upgrade types.ScheduledChannelUpgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduledChannelUpgradeCancelled,
+			sdk.NewAttribute(types.AttributeKeyUpgradeScheduleID, fmt.Sprintf("%d", upgrade.ScheduleId)),
+			sdk.NewAttribute(types.AttributeKeyPortID, upgrade.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, upgrade.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", upgrade.UpgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyErrorReceipt, upgrade.Error),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitSendPacketEvent emits an event with packet data along with other packet information for relayer
 // to pick up and relay to other chain
 func emitSendPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, timeoutHeight exported.Height) {
@@ -454,7 +454,7 @@ func (k *Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceived
 				unreceivedSequences = append(unreceivedSequences, seq)
 			}
 		}
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
@@ -675,3 +675,253 @@ func (k *Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsR
 		Params: &params,
 	}, nil
 }
+
+// ChannelPaused implements the Query/ChannelPaused gRPC method
+func (k *Keeper) ChannelPaused(c context.Context, req *types.QueryChannelPausedRequest) (*types.QueryChannelPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	height, found := k.GetChannelPauseHeight(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryChannelPausedResponse{
+		Paused: found,
+		Height: height,
+	}, nil
+}
+
+// PausedChannels implements the Query/PausedChannels gRPC method
+func (k *Keeper) PausedChannels(c context.Context, req *types.QueryPausedChannelsRequest) (*types.QueryPausedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pausedChannels []types.PausedChannel
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyChannelPausedPrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, channelID, err := host.ParseChannelPath(string(key))
+		if err != nil {
+			return err
+		}
+
+		pausedChannels = append(pausedChannels, types.PausedChannel{
+			PortId:    portID,
+			ChannelId: channelID,
+			Height:    sdk.BigEndianToUint64(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPausedChannelsResponse{
+		PausedChannels: pausedChannels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// Packet implements the Query/Packet gRPC method
+func (k *Keeper) Packet(c context.Context, req *types.QueryPacketRequest) (*types.QueryPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.hasChannelOrCounterparty(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	packet, found := k.GetPacket(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, "packet not found")
+	}
+
+	return &types.QueryPacketResponse{
+		Packet: packet,
+	}, nil
+}
+
+// Packets implements the Query/Packets gRPC method
+func (k *Keeper) Packets(c context.Context, req *types.QueryPacketsRequest) (*types.QueryPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.hasChannelOrCounterparty(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	var packets []types.Packet
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.PacketDataPrefixPath(req.PortId, req.ChannelId)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var packet types.Packet
+		if err := k.cdc.Unmarshal(value, &packet); err != nil {
+			return err
+		}
+
+		packets = append(packets, packet)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketsResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// PacketStatus implements the Query/PacketStatus gRPC method
+func (k *Keeper) PacketStatus(c context.Context, req *types.QueryPacketStatusRequest) (*types.QueryPacketStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		if !k.hasChannelOrCounterparty(ctx, req.PortId, req.ChannelId) {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+			)
+		}
+
+		// packets of IBC version 2 are routed by client identifier without a channel end and are unordered
+		channel = types.Channel{State: types.OPEN, Ordering: types.UNORDERED}
+	}
+
+	var res *types.QueryPacketStatusResponse
+	if req.Destination {
+		res = k.receivedPacketStatus(ctx, req.PortId, req.ChannelId, req.Sequence, channel)
+	} else {
+		nextSequenceSend, _ := k.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+		if req.Sequence >= nextSequenceSend {
+			return nil, status.Errorf(codes.NotFound, "packet with sequence %d has not been sent", req.Sequence)
+		}
+
+		res = k.sentPacketStatus(ctx, req.PortId, req.ChannelId, req.Sequence, channel)
+	}
+
+	res.Height = clienttypes.GetSelfHeight(ctx)
+	return res, nil
+}
+
+// ChannelUpgradeSchedule implements the Query/ChannelUpgradeSchedule gRPC method
+func (k *Keeper) ChannelUpgradeSchedule(c context.Context, req *types.QueryChannelUpgradeScheduleRequest) (*types.QueryChannelUpgradeScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, found := k.GetChannelUpgradeSchedule(ctx, req.ScheduleId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeScheduleNotFound, "schedule ID (%d)", req.ScheduleId).Error(),
+		)
+	}
+
+	var upgrades []types.ScheduledChannelUpgrade
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.ChannelUpgradeScheduleUpgradesPrefixKey(req.ScheduleId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var upgrade types.ScheduledChannelUpgrade
+		if err := k.cdc.Unmarshal(value, &upgrade); err != nil {
+			return err
+		}
+
+		upgrades = append(upgrades, upgrade)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelUpgradeScheduleResponse{
+		Schedule:   schedule,
+		Upgrades:   upgrades,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ChannelUpgradeSchedules implements the Query/ChannelUpgradeSchedules gRPC method
+func (k *Keeper) ChannelUpgradeSchedules(c context.Context, req *types.QueryChannelUpgradeSchedulesRequest) (*types.QueryChannelUpgradeSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var schedules []types.ChannelUpgradeSchedule
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyChannelUpgradeSchedulePrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var schedule types.ChannelUpgradeSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelUpgradeSchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
@@ -1942,3 +1942,906 @@ func (suite *KeeperTestSuite) TestQueryChannelParams() {
 	res, _ := suite.chainA.QueryServer.ChannelParams(ctx, &types.QueryChannelParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryChannelPaused() {
+	var (
+		req  *types.QueryChannelPausedRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPaused bool
+		expPass   bool
+	}{
+		{
+			"success: channel is paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanPause(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			true,
+			true,
+		},
+		{
+			"success: channel is not paused",
+			func() {},
+			false,
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			req = &types.QueryChannelPausedRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.ChannelPaused(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.expPaused, res.Paused)
+
+				if tc.expPaused {
+					suite.Require().Equal(uint64(ctx.BlockHeight()), res.Height)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPausedChannels() {
+	var (
+		req         *types.QueryPausedChannelsRequest
+		expChannels []types.PausedChannel
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no paused channels",
+			func() {
+				expChannels = nil
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.Setup()
+
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2.Setup()
+
+				expChannels = nil
+				for _, path := range []*ibctesting.Path{path1, path2} {
+					ctx := suite.chainA.GetContext()
+					err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanPause(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+					suite.Require().NoError(err)
+
+					expChannels = append(expChannels, types.PausedChannel{
+						PortId:    path.EndpointA.ChannelConfig.PortID,
+						ChannelId: path.EndpointA.ChannelID,
+						Height:    uint64(ctx.BlockHeight()),
+					})
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryPausedChannelsRequest{
+				Pagination: &query.PageRequest{
+					Key:        nil,
+					Limit:      2,
+					CountTotal: true,
+				},
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.PausedChannels(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expChannels, res.PausedChannels)
+				suite.Require().Equal(uint64(len(expChannels)), res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacket() {
+	var (
+		req       *types.QueryPacketRequest
+		expPacket types.Packet
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPacketRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = &types.QueryPacketRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPacketRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"packet not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				req = &types.QueryPacketRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expPacket = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacket(suite.chainA.GetContext(), expPacket)
+
+				req = &types.QueryPacketRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			true,
+		},
+		{
+			"success: packet routed by client identifier",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+				path.SetupCounterparties()
+
+				expPacket = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, clienttypes.NewHeight(1, 100), 0)
+				expPacket.ProtocolVersion = types.IBC_VERSION_2
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacket(suite.chainA.GetContext(), expPacket)
+
+				req = &types.QueryPacketRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ClientID,
+					Sequence:  1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.Packet(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPacket, res.Packet)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPackets() {
+	var (
+		req        *types.QueryPacketsRequest
+		expPackets []types.Packet
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryPacketsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPacketsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expPackets = make([]types.Packet, 9)
+
+				for i := uint64(0); i < 9; i++ {
+					packet := types.NewPacket(ibctesting.MockPacketData, i+1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacket(suite.chainA.GetContext(), packet)
+					expPackets[i] = packet
+				}
+
+				req = &types.QueryPacketsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      11,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: packets routed by client identifier",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+				path.SetupCounterparties()
+
+				expPackets = make([]types.Packet, 3)
+
+				for i := uint64(0); i < 3; i++ {
+					packet := types.NewPacket(ibctesting.MockPacketData, i+1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, clienttypes.NewHeight(1, 100), 0)
+					packet.ProtocolVersion = types.IBC_VERSION_2
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacket(suite.chainA.GetContext(), packet)
+					expPackets[i] = packet
+				}
+
+				req = &types.QueryPacketsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ClientID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      11,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.Packets(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPackets, res.Packets)
+				suite.Require().Equal(uint64(len(expPackets)), res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketStatus() {
+	var (
+		path              *ibctesting.Path
+		req               *types.QueryPacketStatusRequest
+		expStatus         types.PacketStatus
+		expTimeoutHeight  clienttypes.Height
+		expSendHeight     uint64
+		expRecvHeight     uint64
+		expTimedOutHeight uint64
+	)
+
+	// sendPacket sends a packet from chainA with the given timeout height and returns its sequence.
+	// The send height is only stored if packet data storage is enabled for the mock port.
+	sendPacket := func(timeoutHeight clienttypes.Height) uint64 {
+		if suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsPacketDataStored(suite.chainA.GetContext(), ibctesting.MockPort) {
+			expSendHeight = uint64(suite.chainA.GetContext().BlockHeight())
+		}
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+		return sequence
+	}
+
+	// enablePacketDataStorage enables packet data storage for the mock port on chainA.
+	enablePacketDataStorage := func() {
+		params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+		params.PacketDataPorts = []string{ibctesting.MockPort}
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req.Sequence = 0
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"packet has not been sent",
+			func() {},
+			false,
+		},
+		{
+			"success: sent packet in flight",
+			func() {
+				req.Sequence = sendPacket(defaultTimeoutHeight)
+				expStatus = types.IN_FLIGHT
+			},
+			true,
+		},
+		{
+			"success: sent packet in flight, packet stored in full",
+			func() {
+				enablePacketDataStorage()
+				req.Sequence = sendPacket(defaultTimeoutHeight)
+				expStatus = types.IN_FLIGHT
+				expTimeoutHeight = defaultTimeoutHeight
+			},
+			true,
+		},
+		{
+			"success: sent packet acknowledged",
+			func() {
+				enablePacketDataStorage()
+				req.Sequence = sendPacket(defaultTimeoutHeight)
+				packet := types.NewPacket(ibctesting.MockPacketData, req.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				expStatus = types.ACKNOWLEDGED
+				expSendHeight = 0
+			},
+			true,
+		},
+		{
+			"success: sent packet acknowledged, packet data storage disabled",
+			func() {
+				req.Sequence = sendPacket(defaultTimeoutHeight)
+				packet := types.NewPacket(ibctesting.MockPacketData, req.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				expStatus = types.ACKNOWLEDGED_OR_TIMED_OUT
+			},
+			true,
+		},
+		{
+			"success: sent packet timed out",
+			func() {
+				enablePacketDataStorage()
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+				req.Sequence = sendPacket(timeoutHeight)
+
+				suite.coordinator.CommitNBlocks(suite.chainB, 2)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				packet := types.NewPacket(ibctesting.MockPacketData, req.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				expTimedOutHeight = uint64(suite.chainA.GetContext().BlockHeight())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				expStatus = types.TIMED_OUT
+				expSendHeight = 0
+			},
+			true,
+		},
+		{
+			"success: sent packet timed out, packet data storage disabled",
+			func() {
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+				req.Sequence = sendPacket(timeoutHeight)
+
+				suite.coordinator.CommitNBlocks(suite.chainB, 2)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				packet := types.NewPacket(ibctesting.MockPacketData, req.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				expStatus = types.ACKNOWLEDGED_OR_TIMED_OUT
+			},
+			true,
+		},
+		{
+			"success: sent packet timed out, timeout height pruned",
+			func() {
+				enablePacketDataStorage()
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+				req.Sequence = sendPacket(timeoutHeight)
+
+				suite.coordinator.CommitNBlocks(suite.chainB, 2)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				packet := types.NewPacket(ibctesting.MockPacketData, req.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxPacketsPrunedPerBlock = 100
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketState(suite.chainA.GetContext())
+
+				expStatus = types.ACKNOWLEDGED_OR_TIMED_OUT
+				expSendHeight = 0
+			},
+			true,
+		},
+		{
+			"success: sent packet routed by client identifier in flight",
+			func() {
+				path.SetupCounterparties()
+				enablePacketDataStorage()
+
+				expSendHeight = uint64(suite.chainA.GetContext().BlockHeight())
+				sequence, err := path.EndpointA.SendPacketWithClient(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				req.ChannelId = path.EndpointA.ClientID
+				req.Sequence = sequence
+				expStatus = types.IN_FLIGHT
+				expTimeoutHeight = defaultTimeoutHeight
+			},
+			true,
+		},
+		{
+			"success: sent packet timed out eligible, timeout elapsed on counterparty",
+			func() {
+				enablePacketDataStorage()
+				expTimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+				req.Sequence = sendPacket(expTimeoutHeight)
+
+				suite.coordinator.CommitNBlocks(suite.chainB, 2)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				expStatus = types.TIMED_OUT_ELIGIBLE
+			},
+			true,
+		},
+		{
+			"success: sent packet timed out eligible, channel closed",
+			func() {
+				req.Sequence = sendPacket(defaultTimeoutHeight)
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+
+				expStatus = types.TIMED_OUT_ELIGIBLE
+			},
+			true,
+		},
+		{
+			"success: packet not received",
+			func() {
+				req.Destination = true
+				expStatus = types.NOT_RECEIVED
+			},
+			true,
+		},
+		{
+			"success: packet not received on ordered channel",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.Ordering = types.ORDERED })
+
+				req.Destination = true
+				expStatus = types.NOT_RECEIVED
+			},
+			true,
+		},
+		{
+			"success: received packet",
+			func() {
+				req.Destination = true
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence)
+				expStatus = types.RECEIVED
+			},
+			true,
+		},
+		{
+			"success: received packet on ordered channel",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.Ordering = types.ORDERED })
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence+1)
+
+				req.Destination = true
+				expStatus = types.RECEIVED
+			},
+			true,
+		},
+		{
+			"success: received packet acknowledged",
+			func() {
+				req.Destination = true
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence, []byte("hash"))
+				expStatus = types.ACKNOWLEDGED
+			},
+			true,
+		},
+		{
+			"success: received packet relayed and acknowledged",
+			func() {
+				sequence, err := path.EndpointB.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, 0)
+				enablePacketDataStorage()
+				expRecvHeight = uint64(suite.chainA.GetContext().BlockHeight())
+				suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+
+				req.Destination = true
+				req.Sequence = sequence
+				expStatus = types.ACKNOWLEDGED
+			},
+			true,
+		},
+		{
+			"success: received packet timed out eligible, timeout receipt written",
+			func() {
+				req.Destination = true
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetTimeoutReceipt(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence)
+				expStatus = types.TIMED_OUT_ELIGIBLE
+			},
+			true,
+		},
+		{
+			"success: received packet pruned",
+			func() {
+				req.Destination = true
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence+1)
+				expStatus = types.PRUNED
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			expTimeoutHeight = clienttypes.ZeroHeight()
+			expSendHeight, expRecvHeight, expTimedOutHeight = 0, 0, 0
+			req = &types.QueryPacketStatusRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.PacketStatus(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.Status)
+				suite.Require().Equal(expTimeoutHeight, res.TimeoutHeight)
+				suite.Require().Equal(expSendHeight, res.SendHeight)
+				suite.Require().Equal(expRecvHeight, res.RecvHeight)
+				suite.Require().Equal(expTimedOutHeight, res.TimedOutHeight)
+				suite.Require().Equal(clienttypes.GetSelfHeight(ctx), res.Height)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelUpgradeSchedule() {
+	var (
+		req         *types.QueryChannelUpgradeScheduleRequest
+		expSchedule types.ChannelUpgradeSchedule
+		expUpgrades []types.ScheduledChannelUpgrade
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"schedule not found",
+			func() {
+				req = &types.QueryChannelUpgradeScheduleRequest{
+					ScheduleId: 1,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				expSchedule = types.NewChannelUpgradeSchedule(100, ibctesting.MockPort, "", mock.Version, mock.UpgradeVersion)
+				expSchedule.Id = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), expSchedule)
+
+				req = &types.QueryChannelUpgradeScheduleRequest{
+					ScheduleId: expSchedule.Id,
+				}
+			},
+			true,
+		},
+		{
+			"success: with scheduled channel upgrades",
+			func() {
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+				expSchedule = types.NewChannelUpgradeSchedule(100, ibctesting.MockPort, "", mock.Version, mock.UpgradeVersion)
+				expSchedule.Id = channelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), expSchedule)
+
+				expUpgrades = []types.ScheduledChannelUpgrade{
+					types.NewScheduledChannelUpgrade(expSchedule.Id, ibctesting.MockPort, ibctesting.FirstChannelID, 1),
+					types.NewFailedScheduledChannelUpgrade(expSchedule.Id, ibctesting.MockPort, "channel-1", types.ErrInvalidUpgrade),
+				}
+				for _, upgrade := range expUpgrades {
+					channelKeeper.SetScheduledChannelUpgrade(suite.chainA.GetContext(), upgrade)
+				}
+
+				// upgrades of other schedules are not returned
+				channelKeeper.SetScheduledChannelUpgrade(suite.chainA.GetContext(), types.NewScheduledChannelUpgrade(expSchedule.Id+1, ibctesting.MockPort, ibctesting.FirstChannelID, 1))
+
+				req = &types.QueryChannelUpgradeScheduleRequest{
+					ScheduleId: expSchedule.Id,
+					Pagination: &query.PageRequest{
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expUpgrades = nil
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.ChannelUpgradeSchedule(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSchedule, res.Schedule)
+				suite.Require().Equal(expUpgrades, res.Upgrades)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelUpgradeSchedules() {
+	var (
+		req          *types.QueryChannelUpgradeSchedulesRequest
+		expSchedules []types.ChannelUpgradeSchedule
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no channel upgrade schedules",
+			func() {
+				expSchedules = nil
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expSchedules = nil
+				for _, upgradeVersion := range []string{mock.UpgradeVersion, mock.Version} {
+					schedule := types.NewChannelUpgradeSchedule(100, ibctesting.MockPort, "", "", upgradeVersion)
+					schedule.Id = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), schedule)
+
+					expSchedules = append(expSchedules, schedule)
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryChannelUpgradeSchedulesRequest{
+				Pagination: &query.PageRequest{
+					Key:        nil,
+					Limit:      2,
+					CountTotal: true,
+				},
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.ChannelUpgradeSchedules(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSchedules, res.Schedules)
+				suite.Require().Equal(uint64(len(expSchedules)), res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -35,6 +35,10 @@ func (k *Keeper) ChanOpenInit(
 		return "", nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, connectionHops[0])
 	}
 
+	if connectionEnd.State == connectiontypes.CLOSED {
+		return "", nil, errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection (%s) is CLOSED", connectionHops[0])
+	}
+
 	getVersions := connectionEnd.Versions
 	if len(getVersions) != 1 {
 		return "", nil, errorsmod.Wrapf(
@@ -47,7 +51,7 @@ func (k *Keeper) ChanOpenInit(
 	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.String()) {
 		return "", nil, errorsmod.Wrapf(
 			connectiontypes.ErrInvalidVersion,
-			"connection version %s does not support channel ordering: %s",
+			"connection version %s does not support channel ordering: %s, the connection must first be upgraded to a version supporting it",
 			getVersions[0], order.String(),
 		)
 	}
@@ -84,6 +88,7 @@ func (k *Keeper) WriteOpenInitChannel(
 ) {
 	channel := types.NewChannel(types.INIT, order, counterparty, connectionHops, version)
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetConnectionChannel(ctx, portID, channelID, connectionHops)
 
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
@@ -109,11 +114,6 @@ func (k *Keeper) ChanOpenTry(
 	initProof []byte,
 	proofHeight exported.Height,
 ) (string, *capabilitytypes.Capability, error) {
-	// connection hops only supports a single connection
-	if len(connectionHops) != 1 {
-		return "", nil, errorsmod.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
-	}
-
 	// generate a new channel
 	channelID := k.GenerateChannelIdentifier(ctx)
 
@@ -142,12 +142,15 @@ func (k *Keeper) ChanOpenTry(
 	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.String()) {
 		return "", nil, errorsmod.Wrapf(
 			connectiontypes.ErrInvalidVersion,
-			"connection version %s does not support channel ordering: %s",
+			"connection version %s does not support channel ordering: %s, the connection must first be upgraded to a version supporting it",
 			getVersions[0], order.String(),
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(connectionHops, connectionEnd, initProof)
+	if err != nil {
+		return "", nil, err
+	}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
 	// (i.e self)
@@ -157,17 +160,19 @@ func (k *Keeper) ChanOpenTry(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, initProof,
+	if err := k.verifyChannelState(
+		ctx, connectionHops, connectionEnd, proofHeight, initProof,
 		counterparty.PortId, counterparty.ChannelId, expectedChannel,
 	); err != nil {
 		return "", nil, err
 	}
 
-	var (
-		capKey *capabilitytypes.Capability
-		err    error
-	)
+	// the connection of every intermediate connection hop must support the channel ordering
+	if err := k.verifyMultihopOrdering(connectionHops, initProof, order); err != nil {
+		return "", nil, err
+	}
+
+	var capKey *capabilitytypes.Capability
 
 	capKey, err = k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
 	if err != nil {
@@ -196,6 +201,7 @@ func (k *Keeper) WriteOpenTryChannel(
 	channel := types.NewChannel(types.TRYOPEN, order, counterparty, connectionHops, version)
 
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetConnectionChannel(ctx, portID, channelID, connectionHops)
 
 	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.UNINITIALIZED.String(), "new-state", types.TRYOPEN.String())
 
@@ -238,7 +244,10 @@ func (k *Keeper) ChanOpenAck(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, tryProof)
+	if err != nil {
+		return err
+	}
 
 	// counterparty of the counterparty channel end (i.e self)
 	expectedCounterparty := types.NewCounterparty(portID, channelID)
@@ -247,10 +256,17 @@ func (k *Keeper) ChanOpenAck(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	return k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, tryProof,
+	if err := k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, tryProof,
 		channel.Counterparty.PortId, counterpartyChannelID,
-		expectedChannel)
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	// the intermediate connection hops are only known once the counterparty has proven them,
+	// their connections must support the channel ordering
+	return k.verifyMultihopOrdering(channel.ConnectionHops, tryProof, channel.Ordering)
 }
 
 // WriteOpenAckChannel writes an updated channel state for the successful OpenAck handshake step.
@@ -314,7 +330,10 @@ func (k *Keeper) ChanOpenConfirm(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, ackProof)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
@@ -324,8 +343,8 @@ func (k *Keeper) ChanOpenConfirm(
 
 	// NOTE: If the counterparty has initialized an upgrade in the same block as performing the
 	// ACK handshake step, this channel end will be incapable of opening.
-	return k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, ackProof,
+	return k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, ackProof,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel)
 }
@@ -435,7 +454,10 @@ func (k *Keeper) ChanCloseConfirm(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, initProof)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.Channel{
@@ -447,8 +469,8 @@ func (k *Keeper) ChanCloseConfirm(
 		UpgradeSequence: counterpartyUpgradeSequence,
 	}
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, initProof,
+	if err := k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, initProof,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
@@ -76,6 +76,20 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, true},
+		{
+			msg:     "connection is closed",
+			expPass: false,
+			malleate: func() {
+				expErrorMsgSubstring = "is CLOSED"
+				path.SetupConnections()
+
+				path.EndpointA.UpdateConnection(func(c *connectiontypes.ConnectionEnd) { c.State = connectiontypes.CLOSED })
+
+				features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+				suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+				portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+			},
+		},
 		{
 			msg:     "unauthorized client",
 			expPass: false,
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -75,6 +77,18 @@ func (k *Keeper) HasChannel(ctx sdk.Context, portID, channelID string) bool {
 	return store.Has(host.ChannelKey(portID, channelID))
 }
 
+// hasChannelOrCounterparty returns true if the channel with the given identifiers exists in state, or if the
+// channel identifier is the identifier of a client with a registered counterparty, under which the packets of
+// IBC version 2 are stored.
+func (k *Keeper) hasChannelOrCounterparty(ctx sdk.Context, portID, channelID string) bool {
+	if k.HasChannel(ctx, portID, channelID) {
+		return true
+	}
+
+	_, found := k.clientKeeper.GetCounterparty(ctx, channelID)
+	return found
+}
+
 // GetChannel returns a channel with a particular identifier binded to a specific port
 func (k *Keeper) GetChannel(ctx sdk.Context, portID, channelID string) (types.Channel, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -95,6 +109,29 @@ func (k *Keeper) SetChannel(ctx sdk.Context, portID, channelID string, channel t
 	store.Set(host.ChannelKey(portID, channelID), bz)
 }
 
+// SetConnectionChannel indexes the channel under the connection of its first connection hop, so that the channels
+// built upon a connection are found without iterating over all channels. The channel store key is stored as value.
+// The later connection hops of a multi-hop channel are connections of the intermediate chains and are not indexed.
+func (k *Keeper) SetConnectionChannel(ctx sdk.Context, portID, channelID string, connectionHops []string) {
+	if len(connectionHops) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ConnectionChannelKey(connectionHops[0], portID, channelID), host.ChannelKey(portID, channelID))
+}
+
+// deleteConnectionChannel removes the channel from the index of the channels built upon the connection of its first
+// connection hop.
+func (k *Keeper) deleteConnectionChannel(ctx sdk.Context, portID, channelID string, connectionHops []string) {
+	if len(connectionHops) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ConnectionChannelKey(connectionHops[0], portID, channelID))
+}
+
 // GetAppVersion gets the version for the specified channel.
 func (k *Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
@@ -194,10 +231,19 @@ func (k *Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, seq
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
-// deletePacketReceipt deletes a packet receipt from the store
+// SetTimeoutReceipt sets a timeout receipt to the store. It is written in place of a packet
+// receipt for packets received after their timeout elapsed on ORDERED_ALLOW_TIMEOUT channels.
+func (k *Keeper) SetTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt)
+}
+
+// deletePacketReceipt deletes a packet receipt from the store, together with the block height
+// at which the packet was received
 func (k *Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
+	store.Delete(host.PacketRecvHeightKey(portID, channelID, sequence))
 }
 
 // GetPacketCommitment gets the packet commitment hash from the store
@@ -219,9 +265,121 @@ func (k *Keeper) SetPacketCommitment(ctx sdk.Context, portID, channelID string,
 	store.Set(host.PacketCommitmentKey(portID, channelID, sequence), commitmentHash)
 }
 
-func (k *Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
+// DeletePacketCommitment deletes the packet commitment hash from the store, together with the
+// packet if it has been stored in full and the block height at which it was sent.
+func (k *Keeper) DeletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
+	store.Delete(host.PacketDataKey(portID, channelID, sequence))
+	store.Delete(host.PacketSendHeightKey(portID, channelID, sequence))
+}
+
+// GetPacket gets a packet stored in full from the store
+func (k *Keeper) GetPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketDataKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.Packet{}, false
+	}
+
+	var packet types.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetPacket stores a packet in full until its packet commitment is deleted
+func (k *Keeper) SetPacket(ctx sdk.Context, packet types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(host.PacketDataKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), bz)
+}
+
+// GetAllPackets returns all the packets stored in full.
+func (k *Keeper) GetAllPackets(ctx sdk.Context) (packets []types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyPacketDataPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// GetPacketSendHeight returns the block height at which the packet was sent. It is only stored for the packet
+// data ports, until the packet commitment is deleted.
+func (k *Keeper) GetPacketSendHeight(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	return k.getPacketHeight(ctx, host.PacketSendHeightKey(portID, channelID, sequence))
+}
+
+// SetPacketSendHeight stores the block height at which the packet was sent.
+func (k *Keeper) SetPacketSendHeight(ctx sdk.Context, portID, channelID string, sequence, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketSendHeightKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(height))
+}
+
+// GetPacketRecvHeight returns the block height at which the packet was received. It is only stored for the packet
+// data ports, until the packet receipt and acknowledgement are pruned.
+func (k *Keeper) GetPacketRecvHeight(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	return k.getPacketHeight(ctx, host.PacketRecvHeightKey(portID, channelID, sequence))
+}
+
+// SetPacketRecvHeight stores the block height at which the packet was received.
+func (k *Keeper) SetPacketRecvHeight(ctx sdk.Context, portID, channelID string, sequence, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketRecvHeightKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(height))
+}
+
+// GetPacketTimeoutHeight returns the block height at which the packet was timed out on this chain. It is only
+// stored for the packet data ports, until the commitment start sequence of the channel is advanced past the packet.
+func (k *Keeper) GetPacketTimeoutHeight(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	return k.getPacketHeight(ctx, host.PacketTimeoutHeightKey(portID, channelID, sequence))
+}
+
+// SetPacketTimeoutHeight stores the block height at which the packet was timed out on this chain, so that
+// timed out packets can be told apart from acknowledged packets once their packet commitment is deleted.
+func (k *Keeper) SetPacketTimeoutHeight(ctx sdk.Context, portID, channelID string, sequence, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketTimeoutHeightKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(height))
+}
+
+// deletePacketTimeoutHeight deletes the block height at which the packet was timed out on this chain.
+func (k *Keeper) deletePacketTimeoutHeight(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketTimeoutHeightKey(portID, channelID, sequence))
+}
+
+// getPacketHeight returns the block height stored under the given key.
+func (k *Keeper) getPacketHeight(ctx sdk.Context, key []byte) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// GetAllPacketHeights returns all the block heights stored under the given prefix, which is one of
+// the send, receive or timeout height prefixes.
+func (k *Keeper) GetAllPacketHeights(ctx sdk.Context, keyPrefix string) (heights []types.PacketHeight) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(keyPrefix))
+	k.iterateHashes(ctx, iterator, func(portID, channelID string, sequence uint64, bz []byte) bool {
+		heights = append(heights, types.NewPacketHeight(portID, channelID, sequence, sdk.BigEndianToUint64(bz)))
+		return false
+	})
+	return heights
+}
+
+// IsPacketDataStored returns true if the packets sent on the given port are stored in full, as configured by the
+// packet data ports of the channel params. The block heights at which packets are sent, received and timed out are
+// only stored for these ports as well.
+func (k *Keeper) IsPacketDataStored(ctx sdk.Context, portID string) bool {
+	return slices.Contains(k.GetParams(ctx).PacketDataPorts, portID)
 }
 
 // SetPacketAcknowledgement sets the packet ack hash to the store
@@ -246,10 +404,12 @@ func (k *Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID str
 	return store.Has(host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
 
-// deletePacketAcknowledgement deletes the packet ack hash from the store
+// deletePacketAcknowledgement deletes the packet ack hash from the store, together with the block height
+// at which the packet was received
 func (k *Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+	store.Delete(host.PacketRecvHeightKey(portID, channelID, sequence))
 }
 
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
@@ -436,6 +596,34 @@ func (k *Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedCha
 	return channels
 }
 
+// GetChannelsFrom returns at most limit channels in store order, starting from the channel stored under the given
+// store key, or from the first channel if the key is empty. The store key of the next channel is returned, or nil if
+// there are no channels left.
+func (k *Keeper) GetChannelsFrom(ctx sdk.Context, startKey []byte, limit int) (channels []types.IdentifiedChannel, nextKey []byte) {
+	channelPrefix := []byte(host.KeyChannelEndPrefix)
+	if len(startKey) == 0 {
+		startKey = channelPrefix
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(startKey, storetypes.PrefixEndBytes(channelPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		if len(channels) >= limit {
+			return channels, bytes.Clone(iterator.Key())
+		}
+
+		var channel types.Channel
+		k.cdc.MustUnmarshal(iterator.Value(), &channel)
+
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		channels = append(channels, types.NewIdentifiedChannel(portID, channelID, channel))
+	}
+
+	return channels, nil
+}
+
 // GetChannelClientState returns the associated client state with its ID, from a port and channel identifier.
 func (k *Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
@@ -644,8 +832,9 @@ func (k *Keeper) setRecvStartSequence(ctx sdk.Context, portID, channelID string,
 
 // GetRecvStartSequence gets a channel's recv start sequence from the store.
 // The recv start sequence will be set to the counterparty's next sequence send
-// upon a successful channel upgrade. It will be used for replay protection of
-// historical packets and as the upper bound for pruning stale packet receives.
+// upon a successful channel upgrade, or to the counterparty's commitment start
+// sequence when packet receipts are pruned. It will be used for replay protection
+// of historical packets and as the upper bound for pruning stale packet receives.
 func (k *Keeper) GetRecvStartSequence(ctx sdk.Context, portID, channelID string) (uint64, bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(host.RecvStartSequenceKey(portID, channelID))
@@ -680,8 +869,71 @@ func (k *Keeper) HasPruningSequenceStart(ctx sdk.Context, portID, channelID stri
 	return store.Has(host.PruningSequenceStartKey(portID, channelID))
 }
 
+// setCommitmentStartSequence sets a channel's commitment start sequence to the store.
+func (k *Keeper) setCommitmentStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.CommitmentStartSequenceKey(portID, channelID), bz)
+}
+
+// GetCommitmentStartSequence gets a channel's commitment start sequence from the store.
+// All packets sent on the channel with a sequence lower than the commitment start sequence
+// have been acknowledged or timed out. It is advanced at BeginBlock and proven by the
+// counterparty in order to prune its packet receipts.
+func (k *Keeper) GetCommitmentStartSequence(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.CommitmentStartSequenceKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// GetChannelPauseHeight returns the block height at which the channel was paused by the authority.
+func (k *Keeper) GetChannelPauseHeight(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelPausedKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// IsChannelPaused returns true if the channel has been paused by the authority.
+func (k *Keeper) IsChannelPaused(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ChannelPausedKey(portID, channelID))
+}
+
+// SetChannelPauseHeight stores the block height at which the channel was paused by the authority.
+func (k *Keeper) SetChannelPauseHeight(ctx sdk.Context, portID, channelID string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ChannelPausedKey(portID, channelID), sdk.Uint64ToBigEndian(height))
+}
+
+// GetAllPausedChannels returns all the channels paused by the authority along with the block height at which
+// they were paused.
+func (k *Keeper) GetAllPausedChannels(ctx sdk.Context) (pausedChannels []types.PausedChannel) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyChannelPausedPrefix))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, height uint64) bool {
+		pausedChannels = append(pausedChannels, types.PausedChannel{PortId: portID, ChannelId: channelID, Height: height})
+		return false
+	})
+	return pausedChannels
+}
+
+// deleteChannelPauseHeight deletes the pause height of the channel, resuming the channel.
+func (k *Keeper) deleteChannelPauseHeight(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelPausedKey(portID, channelID))
+}
+
 // PruneAcknowledgements prunes packet acknowledgements and receipts that have a sequence number less than pruning sequence end.
-// The number of packet acks/receipts pruned is bounded by the limit. Pruning can only occur after a channel has been upgraded.
+// The number of packet acks/receipts pruned is bounded by the limit. Pruning can only occur after a channel has been upgraded
+// or after the recv start sequence has been advanced by PrunePacketReceipts.
 //
 // Pruning sequence start keeps track of the packet ack/receipt that can be pruned next. When it reaches pruningSequenceEnd,
 // pruning is complete.
@@ -170,6 +170,28 @@ func containsAll(expected, actual []types.IdentifiedChannel) bool {
 
 // TestGetAllChannels creates multiple channels on chain A through various connections
 // and tests their retrieval. 2 channels are on connA0 and 1 channel is on connA1
+// TestGetChannelsFrom verifies that the channels are returned in batches of the given limit.
+func (suite *KeeperTestSuite) TestGetChannelsFrom() {
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	expChannels := []types.IdentifiedChannel{
+		types.NewIdentifiedChannel(ibctesting.MockPort, "channel-0", types.Channel{}),
+		types.NewIdentifiedChannel(ibctesting.MockPort, "channel-1", types.Channel{}),
+		types.NewIdentifiedChannel(ibctesting.MockPort, "channel-2", types.Channel{}),
+	}
+	for _, ch := range expChannels {
+		channelKeeper.SetChannel(suite.chainA.GetContext(), ch.PortId, ch.ChannelId, types.Channel{})
+	}
+
+	channels, nextKey := channelKeeper.GetChannelsFrom(suite.chainA.GetContext(), nil, 2)
+	suite.Require().Equal(expChannels[:2], channels)
+	suite.Require().Equal(host.ChannelKey(ibctesting.MockPort, "channel-2"), nextKey)
+
+	channels, nextKey = channelKeeper.GetChannelsFrom(suite.chainA.GetContext(), nextKey, 2)
+	suite.Require().Equal(expChannels[2:], channels)
+	suite.Require().Nil(nextKey)
+}
+
 func (suite *KeeperTestSuite) TestGetAllChannels() {
 	path := ibctesting.NewPath(suite.chainA, suite.chainB)
 	path.Setup()
@@ -315,9 +337,18 @@ func (suite *KeeperTestSuite) TestGetAllPacketState() {
 	comm3 := types.NewPacketState(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 1, []byte("hash"))
 	comm4 := types.NewPacketState(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 2, []byte("hash"))
 
+	// channel 0 and channel 1 packets stored in full
+	packet1 := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+	packet2 := types.NewPacket(ibctesting.MockPacketData, 1, path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, path1.EndpointB.ChannelConfig.PortID, path1.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
 	expAcks := []types.PacketState{ack1, ack2, ack3}
 	expReceipts := []types.PacketState{rec1, rec2, rec3, rec4}
 	expCommitments := []types.PacketState{comm1, comm2, comm3, comm4}
+	expPackets := []types.Packet{packet1, packet2}
+	expSendHeights := []types.PacketHeight{
+		types.NewPacketHeight(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, 10),
+		types.NewPacketHeight(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 1, 11),
+	}
 
 	ctxA := suite.chainA.GetContext()
 
@@ -336,9 +367,21 @@ func (suite *KeeperTestSuite) TestGetAllPacketState() {
 		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(ctxA, comm.PortId, comm.ChannelId, comm.Sequence, comm.Data)
 	}
 
+	// set packets
+	for _, packet := range expPackets {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacket(ctxA, packet)
+	}
+
+	// set packet send heights
+	for _, sh := range expSendHeights {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketSendHeight(ctxA, sh.PortId, sh.ChannelId, sh.Sequence, sh.Height)
+	}
+
 	acks := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(ctxA)
 	receipts := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(ctxA)
 	commitments := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitments(ctxA)
+	packets := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPackets(ctxA)
+	sendHeights := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketHeights(ctxA, host.KeySendHeightPrefix)
 
 	suite.Require().Len(acks, len(expAcks))
 	suite.Require().Len(commitments, len(expCommitments))
@@ -347,6 +390,8 @@ func (suite *KeeperTestSuite) TestGetAllPacketState() {
 	suite.Require().Equal(expAcks, acks)
 	suite.Require().Equal(expReceipts, receipts)
 	suite.Require().Equal(expCommitments, commitments)
+	suite.Require().Equal(expPackets, packets)
+	suite.Require().Equal(expSendHeights, sendHeights)
 }
 
 // TestSetSequence verifies that the keeper correctly sets the sequence counters.
@@ -23,3 +23,14 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated ibc channel params")
 	return nil
 }
+
+// MigrateConnectionChannels indexes the existing channels under the connection of their first connection hop.
+func (m Migrator) MigrateConnectionChannels(ctx sdk.Context) error {
+	m.keeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		m.keeper.SetConnectionChannel(ctx, channel.PortId, channel.ChannelId, channel.ConnectionHops)
+		return false
+	})
+
+	m.keeper.Logger(ctx).Info("successfully migrated ibc connection channels index")
+	return nil
+}
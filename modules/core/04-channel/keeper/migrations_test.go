@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 // TestMigrateDefaultParams tests the migration for the channel params
@@ -32,3 +35,26 @@ func (suite *KeeperTestSuite) TestMigrateDefaultParams() {
 		})
 	}
 }
+
+// TestMigrateConnectionChannels tests the migration indexing the existing channels under their connection
+func (suite *KeeperTestSuite) TestMigrateConnectionChannels() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper
+
+	// channels stored before the index was introduced are not indexed
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctesting.MockPort, ibctesting.FirstChannelID), []string{path.EndpointA.ConnectionID}, ibctesting.DefaultChannelVersion)
+	channelKeeper.SetChannel(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, channel)
+
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	connectionChannelKey := host.ConnectionChannelKey(path.EndpointA.ConnectionID, ibctesting.MockPort, ibctesting.FirstChannelID)
+	suite.Require().False(store.Has(connectionChannelKey))
+
+	migrator := keeper.NewMigrator(channelKeeper)
+	err := migrator.MigrateConnectionChannels(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(host.ChannelKey(ibctesting.MockPort, ibctesting.FirstChannelID), store.Get(connectionChannelKey))
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// The functions in this file verify counterparty state of a channel end. Channels with a single connection hop
+// are verified by the connection keeper using the client of the connection. Channels with more than one connection
+// hop are multi-hop channels (ICS-33): the counterparty chain is reached through the connections of intermediate
+// chains and the proof is a connectiontypes.MultihopProof, which is verified starting from the client of the first
+// connection hop.
+
+// isMultihop returns true if the connection hops route a channel over intermediate chains.
+func isMultihop(connectionHops []string) bool {
+	return len(connectionHops) > 1
+}
+
+// getCounterpartyConnectionHops returns the connection hops of the counterparty channel end.
+func (k *Keeper) getCounterpartyConnectionHops(connectionHops []string, connectionEnd connectiontypes.ConnectionEnd, proof []byte) ([]string, error) {
+	if !isMultihop(connectionHops) {
+		return []string{connectionEnd.Counterparty.ConnectionId}, nil
+	}
+
+	return k.connectionKeeper.GetMultihopCounterpartyConnectionHops(connectionEnd, proof, connectionHops)
+}
+
+// verifyMultihopOrdering returns an error if the connection end of an intermediate connection hop of a multi-hop
+// channel does not support the channel ordering. The connection ends are taken from the multi-hop proof, so this
+// check must be combined with the verification of the proof.
+func (k *Keeper) verifyMultihopOrdering(connectionHops []string, proof []byte, order types.Order) error {
+	if !isMultihop(connectionHops) {
+		return nil
+	}
+
+	connections, err := k.connectionKeeper.GetMultihopConnectionEnds(proof, connectionHops)
+	if err != nil {
+		return err
+	}
+
+	for i, connection := range connections {
+		if len(connection.Versions) != 1 {
+			return errorsmod.Wrapf(
+				connectiontypes.ErrInvalidVersion,
+				"single version must be negotiated on connection (%s) before opening channel, got: %v",
+				connectionHops[i+1], connection.Versions,
+			)
+		}
+
+		if !connectiontypes.VerifySupportedFeature(connection.Versions[0], order.String()) {
+			return errorsmod.Wrapf(
+				connectiontypes.ErrInvalidVersion,
+				"connection (%s) version %s does not support channel ordering: %s",
+				connectionHops[i+1], connection.Versions[0], order.String(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// getCounterpartyTimestampAtHeight returns the height and timestamp of the counterparty chain at which the proof
+// is verified. For multi-hop channels these are the height and timestamp of the consensus state of the counterparty
+// chain proven on the last intermediate chain.
+func (k *Keeper) getCounterpartyTimestampAtHeight(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proof []byte,
+	proofHeight exported.Height,
+) (clienttypes.Height, uint64, error) {
+	if !isMultihop(connectionHops) {
+		proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connectionEnd.ClientId, proofHeight)
+		if err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		return proofHeight.(clienttypes.Height), proofTimestamp, nil
+	}
+
+	consensusHeight, timestamp, err := k.connectionKeeper.GetMultihopTimestampAtHeight(proof, connectionHops)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	return consensusHeight.(clienttypes.Height), timestamp, nil
+}
+
+// verifyChannelState verifies a proof of the channel state of the counterparty channel end.
+func (k *Keeper) verifyChannelState(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	channel types.Channel,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyChannelState(ctx, connectionEnd, proofHeight, proof, portID, channelID, channel)
+	}
+
+	bz, err := k.cdc.Marshal(&channel)
+	if err != nil {
+		return err
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.ChannelPath(portID, channelID), bz)
+}
+
+// verifyPacketReceiptAbsence verifies a proof of the absence of a packet receipt on the counterparty channel end.
+func (k *Keeper) verifyPacketReceiptAbsence(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyPacketReceiptAbsence(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence)
+	}
+
+	return k.connectionKeeper.VerifyMultihopNonMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.PacketReceiptPath(portID, channelID, sequence))
+}
+
+// verifyPacketReceipt verifies a proof of a packet receipt of the counterparty channel end.
+func (k *Keeper) verifyPacketReceipt(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyPacketReceipt(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence, receipt)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.PacketReceiptPath(portID, channelID, sequence), receipt)
+}
+
+// verifyNextSequenceRecv verifies a proof of the next sequence receive of the counterparty channel end.
+func (k *Keeper) verifyNextSequenceRecv(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyNextSequenceRecv(ctx, connectionEnd, proofHeight, proof, portID, channelID, nextSequenceRecv)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.NextSequenceRecvPath(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceRecv))
+}
+
+// verifyCommitmentStartSequence verifies a proof of the commitment start sequence of the counterparty channel end.
+func (k *Keeper) verifyCommitmentStartSequence(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	commitmentStartSequence uint64,
+) error {
+	if !isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyCommitmentStartSequence(ctx, connectionEnd, proofHeight, proof, portID, channelID, commitmentStartSequence)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(ctx, connectionEnd, proofHeight, proof, connectionHops, host.CommitmentStartSequencePath(portID, channelID), sdk.Uint64ToBigEndian(commitmentStartSequence))
+}
@@ -0,0 +1,307 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+// MultihopTestSuite is a testing suite to test multi-hop channels between chainA and chainC
+// routed over the connections of chainB.
+type MultihopTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+}
+
+// TestMultihopTestSuite runs all the multi-hop tests within this package.
+func TestMultihopTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(MultihopTestSuite))
+}
+
+// SetupTest creates a coordinator with 3 test chains.
+func (suite *MultihopTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+	// commit some blocks so that QueryProof returns valid proof (cannot return valid query if height <= 1)
+	suite.coordinator.CommitNBlocks(suite.chainA, 2)
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.coordinator.CommitNBlocks(suite.chainC, 2)
+}
+
+// TestMultihopChannelHandshake tests the channel handshake of a multi-hop channel between chainA and chainC.
+func (suite *MultihopTestSuite) TestMultihopChannelHandshake() {
+	path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+	path.Setup()
+
+	channelA := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channelA.State)
+	suite.Require().Equal(path.EndpointA.ConnectionHops(), channelA.ConnectionHops)
+	suite.Require().Equal(path.EndpointB.ChannelID, channelA.Counterparty.ChannelId)
+
+	channelC := path.EndpointB.GetChannel()
+	suite.Require().Equal(types.OPEN, channelC.State)
+	suite.Require().Equal(path.EndpointB.ConnectionHops(), channelC.ConnectionHops)
+	suite.Require().Equal(path.EndpointA.ChannelID, channelC.Counterparty.ChannelId)
+
+	// chainB only provides the connections of the channel
+	suite.Require().False(suite.chainB.App.GetIBCKeeper().ChannelKeeper.HasChannel(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+}
+
+// TestMultihopChannelHandshakeOrdering tests that the connections of the intermediate connection hops must support
+// the channel ordering.
+func (suite *MultihopTestSuite) TestMultihopChannelHandshakeOrdering() {
+	unorderedVersion := connectiontypes.NewVersion(connectiontypes.DefaultIBCVersionIdentifier, []string{types.UNORDERED.String()})
+
+	testCases := []struct {
+		msg      string
+		malleate func(path *ibctesting.MultihopPath) error
+	}{
+		{
+			"intermediate connection hop of the try channel end does not support the ordering",
+			func(path *ibctesting.MultihopPath) error {
+				path.Paths[0].EndpointB.UpdateConnection(func(connection *connectiontypes.ConnectionEnd) {
+					connection.Versions = []*connectiontypes.Version{unorderedVersion}
+				})
+
+				if err := path.EndpointA.ChanOpenInit(); err != nil {
+					return err
+				}
+
+				return path.EndpointB.ChanOpenTry()
+			},
+		},
+		{
+			"intermediate connection hop of the init channel end does not support the ordering",
+			func(path *ibctesting.MultihopPath) error {
+				path.Paths[1].EndpointA.UpdateConnection(func(connection *connectiontypes.ConnectionEnd) {
+					connection.Versions = []*connectiontypes.Version{unorderedVersion}
+				})
+
+				if err := path.EndpointA.ChanOpenInit(); err != nil {
+					return err
+				}
+
+				if err := path.EndpointB.ChanOpenTry(); err != nil {
+					return err
+				}
+
+				return path.EndpointA.ChanOpenAck()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			path.EndpointA.ChannelConfig.Order = types.ORDERED
+			path.EndpointB.ChannelConfig.Order = types.ORDERED
+			path.SetupConnections()
+
+			err := tc.malleate(path)
+			suite.Require().ErrorContains(err, connectiontypes.ErrInvalidVersion.Error())
+		})
+	}
+}
+
+// TestMultihopSendPacket tests that the timeout timestamp of a packet sent on a multi-hop channel is checked
+// against the timestamp of the first intermediate chain.
+func (suite *MultihopTestSuite) TestMultihopSendPacket() {
+	path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+	path.Setup()
+
+	// the timestamp of chainB known to chainA has already passed the timeout timestamp
+	timeoutTimestamp := path.EndpointA.Hops[0].GetConsensusState(path.EndpointA.Hops[0].GetClientLatestHeight().(clienttypes.Height)).GetTimestamp()
+	_, err := path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().ErrorIs(err, types.ErrTimeoutElapsed)
+
+	_, err = path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp+uint64(time.Hour), ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+}
+
+// TestMultihopRecvPacket tests the verification of the multi-hop proof of the packet commitment on RecvPacket.
+func (suite *MultihopTestSuite) TestMultihopRecvPacket() {
+	var (
+		path          *ibctesting.MultihopPath
+		packet        types.Packet
+		multihopProof connectiontypes.MultihopProof
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{
+			"missing hop proofs",
+			func() {
+				multihopProof.HopProofs = nil
+			},
+			connectiontypes.ErrInvalidMultihopProof,
+		},
+		{
+			"missing consensus state of hop",
+			func() {
+				multihopProof.HopProofs[0].ConsensusState = nil
+			},
+			connectiontypes.ErrInvalidMultihopProof,
+		},
+		{
+			"connection of hop is not OPEN",
+			func() {
+				multihopProof.HopProofs[0].Connection.State = connectiontypes.INIT
+			},
+			connectiontypes.ErrInvalidConnectionState,
+		},
+		{
+			"connection of hop does not match the proof",
+			func() {
+				multihopProof.HopProofs[0].Connection.Counterparty.ConnectionId = ibctesting.InvalidID
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"consensus height of hop does not match the proof",
+			func() {
+				multihopProof.HopProofs[0].ConsensusHeight = multihopProof.HopProofs[0].ConsensusHeight.Increment().(clienttypes.Height)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"key proof does not match the packet commitment",
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			path.Setup()
+
+			timeoutHeight := clienttypes.NewHeight(1, 1000)
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+			suite.Require().NoError(suite.chainC.Codec.Unmarshal(proof, &multihopProof))
+
+			tc.malleate()
+
+			proof, err = suite.chainC.Codec.Marshal(&multihopProof)
+			suite.Require().NoError(err)
+
+			channelCap := suite.chainC.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
+			err = suite.chainC.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainC.GetContext(), channelCap, packet, proof, proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				receipt, found := suite.chainC.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainC.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().NotEmpty(receipt)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestMultihopRelayPacket tests relaying a packet and its acknowledgement in both directions of a multi-hop channel.
+func (suite *MultihopTestSuite) TestMultihopRelayPacket() {
+	path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+	path.Setup()
+
+	for _, endpoint := range []*ibctesting.MultihopEndpoint{path.EndpointA, path.EndpointB} {
+		timeoutHeight := clienttypes.NewHeight(1, 1000)
+		sequence, err := endpoint.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := types.NewPacket(ibctesting.MockPacketData, sequence, endpoint.ChannelConfig.PortID, endpoint.ChannelID, endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, timeoutHeight, 0)
+
+		err = path.RelayPacket(packet)
+		suite.Require().NoError(err)
+
+		ack, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		suite.Require().True(found)
+		suite.Require().Equal(types.CommitAcknowledgement(ibcmock.MockAcknowledgement.Acknowledgement()), ack)
+
+		commitment := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(endpoint.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().Empty(commitment)
+	}
+}
+
+// TestMultihopTimeoutPacket tests timing out a packet on a multi-hop channel once chainC has passed the timeout height.
+func (suite *MultihopTestSuite) TestMultihopTimeoutPacket() {
+	testCases := []struct {
+		msg   string
+		order types.Order
+	}{
+		{"unordered channel", types.UNORDERED},
+		{"ordered channel", types.ORDERED},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainC.GetContext()).Increment().(clienttypes.Height)
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			// the packet cannot be received once chainC has passed the timeout height
+			suite.coordinator.CommitNBlocks(suite.chainC, 2)
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().ErrorContains(err, types.ErrTimeoutElapsed.Error())
+
+			err = path.EndpointA.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Empty(commitment)
+
+			if tc.order == types.ORDERED {
+				suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+			}
+		})
+	}
+}
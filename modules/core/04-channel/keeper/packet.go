@@ -38,6 +38,10 @@ func (k *Keeper) SendPacket(
 		return 0, errorsmod.Wrapf(types.ErrInvalidChannelState, "channel is not OPEN (got %s)", channel.State)
 	}
 
+	if k.IsChannelPaused(ctx, sourcePort, sourceChannel) {
+		return 0, errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(sourcePort, sourceChannel)) {
 		return 0, errorsmod.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
@@ -80,6 +84,13 @@ func (k *Keeper) SendPacket(
 
 	// check if packet is timed out on the receiving chain
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
+	if isMultihop(channel.ConnectionHops) {
+		// the receiving chain of a multi-hop channel is not tracked by a local client, its heights cannot be
+		// compared with the heights of the first intermediate chain. The timestamp of the first intermediate
+		// chain is a lower bound of the time of the receiving chain.
+		timeout.Height = clienttypes.ZeroHeight()
+	}
+
 	if timeout.Elapsed(latestHeight, latestTimestamp) {
 		return 0, errorsmod.Wrap(timeout.ErrTimeoutElapsed(latestHeight, latestTimestamp), "invalid packet timeout")
 	}
@@ -89,6 +100,11 @@ func (k *Keeper) SendPacket(
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
 
+	if k.IsPacketDataStored(ctx, sourcePort) {
+		k.SetPacket(ctx, packet)
+		k.SetPacketSendHeight(ctx, sourcePort, sourceChannel, packet.GetSequence(), uint64(ctx.BlockHeight()))
+	}
+
 	emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
 	k.Logger(ctx).Info(
@@ -105,6 +121,9 @@ func (k *Keeper) SendPacket(
 
 // RecvPacket is called by a module in order to receive & process an IBC packet
 // sent on the corresponding channel end on the counterparty chain.
+// On ORDERED_ALLOW_TIMEOUT channels a packet whose timeout has elapsed advances the
+// next sequence receive and writes a timeout receipt, returning ErrTimeoutReceipt
+// to indicate that the packet must not be executed.
 func (k *Keeper) RecvPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -112,7 +131,24 @@ func (k *Keeper) RecvPacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
-	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	return k.RecvPacketInBatch(ctx, chanCap, NewPacketBatch(packet.GetDestPort(), packet.GetDestChannel()), packet, proof, proofHeight)
+}
+
+// RecvPacketInBatch receives a packet of a batch of packets received on the same channel end, reusing the channel
+// end, connection end and client status looked up for the previous packets of the batch. See RecvPacket.
+func (k *Keeper) RecvPacketInBatch(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	batch *PacketBatch,
+	packet types.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	if err := batch.validatePacketChannel(packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return err
+	}
+
+	channel, found := k.GetPacketBatchChannel(ctx, batch)
 	if !found {
 		return errorsmod.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
@@ -121,6 +157,10 @@ func (k *Keeper) RecvPacket(
 		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected channel state to be one of [%s, %s, %s], but got %s", types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE, channel.State)
 	}
 
+	if k.IsChannelPaused(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
 	// If counterpartyUpgrade is stored we need to ensure that the
 	// packet sequence is < counterparty next sequence send. If the
 	// counterparty is implemented correctly, this may only occur
@@ -161,7 +201,7 @@ func (k *Keeper) RecvPacket(
 	// Connection must be OPEN to receive a packet. It is possible for connection to not yet be open if packet was
 	// sent optimistically before connection and channel handshake completed. However, to receive a packet,
 	// connection and channel must both be open
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getPacketBatchConnection(ctx, batch, channel.ConnectionHops[0])
 	if !found {
 		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
@@ -171,18 +211,20 @@ func (k *Keeper) RecvPacket(
 	}
 
 	// check if packet timed out by comparing it with the latest height of the chain
+	// NOTE: timed out packets are accepted on ORDERED_ALLOW_TIMEOUT channels in order to advance the receive sequence.
 	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if timeout.Elapsed(selfHeight, selfTimestamp) {
+	timeoutElapsed := timeout.Elapsed(selfHeight, selfTimestamp)
+	if timeoutElapsed && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
 		return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
 	}
 
 	commitment := types.CommitPacket(k.cdc, packet)
 
 	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+	if err := k.verifyPacketBatchMembership(
+		ctx, batch, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+		host.PacketCommitmentPath(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()),
 		commitment,
 	); err != nil {
 		return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
@@ -191,10 +233,12 @@ func (k *Keeper) RecvPacket(
 	// REPLAY PROTECTION: The recvStartSequence will prevent historical proofs from allowing replay
 	// attacks on packets processed in previous lifecycles of a channel. After a successful channel
 	// upgrade all packets under the recvStartSequence will have been processed and thus should be
-	// rejected.
+	// rejected. The recvStartSequence of UNORDERED channels is also advanced to the counterparty's
+	// commitment start sequence when packet receipts are pruned, all packets under it have been
+	// acknowledged or timed out on the counterparty.
 	recvStartSequence, _ := k.GetRecvStartSequence(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if packet.GetSequence() < recvStartSequence {
-		return errorsmod.Wrap(types.ErrPacketReceived, "packet already processed in previous channel upgrade")
+		return errorsmod.Wrapf(types.ErrPacketReceived, "packet sequence is lower than the recv start sequence (%d < %d)", packet.GetSequence(), recvStartSequence)
 	}
 
 	switch channel.Ordering {
@@ -217,7 +261,7 @@ func (k *Keeper) RecvPacket(
 		// it's just a single store key set to a single byte to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -251,6 +295,31 @@ func (k *Keeper) RecvPacket(
 		// incrementing nextSequenceRecv and storing under this chain's channelEnd identifiers
 		// Since this is the receiving chain, our channelEnd is packet's destination port and channel
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
+
+		// A timed out packet on an ORDERED_ALLOW_TIMEOUT channel is not executed. A timeout receipt
+		// is written so that the sending chain may prove that the packet will never be executed.
+		if timeoutElapsed {
+			k.SetTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+			k.Logger(ctx).Info(
+				"timed out packet received",
+				"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+				"src_port", packet.GetSourcePort(),
+				"src_channel", packet.GetSourceChannel(),
+				"dst_port", packet.GetDestPort(),
+				"dst_channel", packet.GetDestChannel(),
+			)
+
+			emitRecvPacketEvent(ctx, packet, channel)
+
+			// This error indicates that the packet timed out and must not be passed to the application.
+			// Core IBC will write the state changes made above and skip the application callback.
+			return types.ErrTimeoutReceipt
+		}
+	}
+
+	if k.IsPacketDataStored(ctx, packet.GetDestPort()) {
+		k.SetPacketRecvHeight(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), uint64(ctx.BlockHeight()))
 	}
 
 	// log that a packet has been received & executed
@@ -286,7 +355,23 @@ func (k *Keeper) WriteAcknowledgement(
 	packet exported.PacketI,
 	acknowledgement exported.Acknowledgement,
 ) error {
-	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	return k.WriteAcknowledgementInBatch(ctx, chanCap, NewPacketBatch(packet.GetDestPort(), packet.GetDestChannel()), packet, acknowledgement)
+}
+
+// WriteAcknowledgementInBatch writes the acknowledgement of a packet of a batch of packets received on the same
+// channel end, reusing the channel end looked up for the previous packets of the batch. See WriteAcknowledgement.
+func (k *Keeper) WriteAcknowledgementInBatch(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	batch *PacketBatch,
+	packet exported.PacketI,
+	acknowledgement exported.Acknowledgement,
+) error {
+	if err := batch.validatePacketChannel(packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return err
+	}
+
+	channel, found := k.GetPacketBatchChannel(ctx, batch)
 	if !found {
 		return errorsmod.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
@@ -311,7 +396,7 @@ func (k *Keeper) WriteAcknowledgement(
 	// will also be rejected.
 	recvStartSequence, _ := k.GetRecvStartSequence(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if packet.GetSequence() < recvStartSequence {
-		return errorsmod.Wrap(types.ErrPacketReceived, "packet already processed in previous channel upgrade")
+		return errorsmod.Wrapf(types.ErrPacketReceived, "packet sequence is lower than the recv start sequence (%d < %d)", packet.GetSequence(), recvStartSequence)
 	}
 
 	// NOTE: IBC app modules might have written the acknowledgement synchronously on
@@ -365,7 +450,26 @@ func (k *Keeper) AcknowledgePacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
-	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	return k.AcknowledgePacketInBatch(ctx, chanCap, NewPacketBatch(packet.GetSourcePort(), packet.GetSourceChannel()), packet, acknowledgement, proof, proofHeight)
+}
+
+// AcknowledgePacketInBatch processes the acknowledgement of a packet of a batch of packets sent on the same channel
+// end, reusing the channel end, connection end and client status looked up for the previous packets of the batch.
+// See AcknowledgePacket.
+func (k *Keeper) AcknowledgePacketInBatch(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	batch *PacketBatch,
+	packet types.Packet,
+	acknowledgement []byte,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	if err := batch.validatePacketChannel(packet.GetSourcePort(), packet.GetSourceChannel()); err != nil {
+		return err
+	}
+
+	channel, found := k.GetPacketBatchChannel(ctx, batch)
 	if !found {
 		return errorsmod.Wrapf(
 			types.ErrChannelNotFound,
@@ -401,7 +505,7 @@ func (k *Keeper) AcknowledgePacket(
 		)
 	}
 
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getPacketBatchConnection(ctx, batch, channel.ConnectionHops[0])
 	if !found {
 		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
@@ -428,15 +532,16 @@ func (k *Keeper) AcknowledgePacket(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
-		packet.GetSequence(), acknowledgement,
+	if err := k.verifyPacketBatchMembership(
+		ctx, batch, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+		host.PacketAcknowledgementPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
+		types.CommitAcknowledgement(acknowledgement),
 	); err != nil {
 		return err
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return errorsmod.Wrapf(
@@ -452,7 +557,7 @@ func (k *Keeper) AcknowledgePacket(
 			)
 		}
 
-		// All verification complete, in the case of ORDERED channels we must increment nextSequenceAck
+		// All verification complete, in the case of ordered channels we must increment nextSequenceAck
 		nextSequenceAck++
 
 		// incrementing NextSequenceAck and storing under this chain's channelEnd identifiers
@@ -462,7 +567,7 @@ func (k *Keeper) AcknowledgePacket(
 	}
 
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
-	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
@@ -488,14 +593,14 @@ func (k *Keeper) AcknowledgePacket(
 			if timeout.Elapsed(selfHeight, selfTimestamp) {
 				// packet flushing timeout has expired, abort the upgrade and return nil,
 				// committing an error receipt to state, restoring the channel and successfully acknowledging the packet.
-				k.MustAbortUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp))
+				k.abortPacketBatchUpgrade(ctx, batch, timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp))
 				return nil
 			}
 
 			// set the channel state to flush complete if all packets have been acknowledged/flushed.
 			if !k.HasInflightPackets(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
 				channel.State = types.FLUSHCOMPLETE
-				k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+				k.setPacketBatchChannel(ctx, batch, channel)
 				emitChannelFlushCompleteEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
 			}
 		}
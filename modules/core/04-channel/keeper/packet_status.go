@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// sentPacketStatus returns the status of a packet sent on the given channel. A packet whose commitment
+// has been deleted has either been timed out or acknowledged. The block height of the timeout is only
+// recorded for the packet data ports, until the commitment start sequence of the channel is advanced past
+// the packet, otherwise the packet is reported as acknowledged or timed out. A packet whose commitment still exists may
+// be timed out if the channel is closed. Otherwise its timeout can only be evaluated against the latest
+// height of the counterparty client if the packet is stored in full. The timeout of packets sent on multi-hop channels is not
+// evaluated, as it depends on the consensus state stored on the last intermediate chain. Packets of IBC
+// version 2 are sent without a channel end, using the client identified by the channel identifier.
+func (k *Keeper) sentPacketStatus(ctx sdk.Context, portID, channelID string, sequence uint64, channel types.Channel) *types.QueryPacketStatusResponse {
+	res := &types.QueryPacketStatusResponse{Status: types.IN_FLIGHT}
+
+	if !k.HasPacketCommitment(ctx, portID, channelID, sequence) {
+		if timeoutHeight, found := k.GetPacketTimeoutHeight(ctx, portID, channelID, sequence); found {
+			res.Status = types.TIMED_OUT
+			res.TimedOutHeight = timeoutHeight
+			return res
+		}
+
+		commitmentStartSequence, found := k.GetCommitmentStartSequence(ctx, portID, channelID)
+		if !k.IsPacketDataStored(ctx, portID) || (found && sequence < commitmentStartSequence) {
+			res.Status = types.ACKNOWLEDGED_OR_TIMED_OUT
+			return res
+		}
+
+		res.Status = types.ACKNOWLEDGED
+		return res
+	}
+
+	res.SendHeight, _ = k.GetPacketSendHeight(ctx, portID, channelID, sequence)
+
+	if channel.State == types.CLOSED {
+		res.Status = types.TIMED_OUT_ELIGIBLE
+		return res
+	}
+
+	var clientID string
+	switch len(channel.ConnectionHops) {
+	case 0:
+		clientID = channelID
+	case 1:
+		connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found {
+			return res
+		}
+
+		clientID = connection.ClientId
+	default:
+		return res
+	}
+
+	res.CounterpartyHeight = k.clientKeeper.GetClientLatestHeight(ctx, clientID)
+
+	packet, found := k.GetPacket(ctx, portID, channelID, sequence)
+	if !found {
+		return res
+	}
+
+	res.TimeoutHeight = packet.TimeoutHeight
+	res.TimeoutTimestamp = packet.TimeoutTimestamp
+
+	counterpartyTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, clientID, res.CounterpartyHeight)
+	if err != nil {
+		return res
+	}
+
+	timeout := types.NewTimeout(packet.TimeoutHeight, packet.TimeoutTimestamp)
+	if timeout.Elapsed(res.CounterpartyHeight, counterpartyTimestamp) {
+		res.Status = types.TIMED_OUT_ELIGIBLE
+	}
+
+	return res
+}
+
+// receivedPacketStatus returns the status of a packet received on the given channel. Packets which have
+// not been received are reported as not received, as the queried chain cannot tell whether they have been sent.
+func (k *Keeper) receivedPacketStatus(ctx sdk.Context, portID, channelID string, sequence uint64, channel types.Channel) *types.QueryPacketStatusResponse {
+	res := &types.QueryPacketStatusResponse{Status: types.NOT_RECEIVED}
+
+	receipt, hasReceipt := k.GetPacketReceipt(ctx, portID, channelID, sequence)
+	recvStartSequence, hasRecvStartSequence := k.GetRecvStartSequence(ctx, portID, channelID)
+	nextSequenceRecv, _ := k.GetNextSequenceRecv(ctx, portID, channelID)
+
+	switch {
+	case hasReceipt && receipt == string(types.TimeoutReceipt):
+		res.Status = types.TIMED_OUT_ELIGIBLE
+		return res
+	case k.HasPacketAcknowledgement(ctx, portID, channelID, sequence):
+		res.Status = types.ACKNOWLEDGED
+	case hasReceipt:
+		res.Status = types.RECEIVED
+	case hasRecvStartSequence && sequence < recvStartSequence:
+		// packet receipts and acknowledgements below the recv start sequence are pruned
+		res.Status = types.PRUNED
+		return res
+	case channel.Ordering != types.UNORDERED && sequence < nextSequenceRecv:
+		res.Status = types.RECEIVED
+	default:
+		return res
+	}
+
+	res.RecvHeight, _ = k.GetPacketRecvHeight(ctx, portID, channelID, sequence)
+
+	return res
+}
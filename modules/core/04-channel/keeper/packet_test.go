@@ -12,6 +12,7 @@ import (
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -315,6 +316,19 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			nil,
 		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			nil,
+		},
 		{
 			"success UNORDERED channel",
 			func() {
@@ -677,9 +691,9 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 				suite.Require().True(found)
 				receipt, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-				if channelB.Ordering == types.ORDERED {
+				if channelB.Ordering != types.UNORDERED {
 					suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv, "sequence not incremented in ordered channel")
-					suite.Require().False(receiptStored, "packet receipt stored on ORDERED channel")
+					suite.Require().False(receiptStored, "packet receipt stored on ordered channel")
 				} else {
 					suite.Require().Equal(uint64(1), nextSeqRecv, "sequence incremented for UNORDERED channel")
 					suite.Require().True(receiptStored, "packet receipt not stored after RecvPacket in UNORDERED channel")
@@ -693,6 +707,154 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 	}
 }
 
+// TestRecvPacketOrderedAllowTimeout tests that timed out packets received on ORDERED_ALLOW_TIMEOUT
+// channels advance the next sequence receive and write a timeout receipt.
+func (suite *KeeperTestSuite) TestRecvPacketOrderedAllowTimeout() {
+	var (
+		path   *ibctesting.Path
+		packet types.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: timeout receipt written",
+			func() {},
+			types.ErrTimeoutReceipt,
+		},
+		{
+			"packet already received",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()+1)
+			},
+			types.ErrNoOpMsg,
+		},
+		{
+			"packet received out of order",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()-1)
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			// send two packets so that the second packet may be received out of order
+			_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			tc.malleate()
+
+			// get proof of packet commitment from chainA
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+			err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainB.GetContext(), channelCap, packet, proof, proofHeight)
+			suite.Require().ErrorIs(err, tc.expError)
+
+			if tc.expError == types.ErrTimeoutReceipt {
+				nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv, "sequence not incremented for timed out packet")
+
+				receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+			}
+		})
+	}
+}
+
+// TestRecvPacketInBatch tests that the packets of a batch are received on the channel end of the batch.
+func (suite *KeeperTestSuite) TestRecvPacketInBatch() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"packet not received on the channel end of the batch",
+			func() {
+				packets[1].DestinationChannel = ibctesting.InvalidID
+			},
+			types.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packets = nil
+			for i := 0; i < 2; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp))
+			}
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			batch := keeper.NewPacketBatch(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			tc.malleate()
+
+			var err error
+			for _, packet := range packets {
+				packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+				err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacketInBatch(suite.chainB.GetContext(), channelCap, batch, packet, proof, proofHeight)
+				if err != nil {
+					break
+				}
+			}
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				for _, packet := range packets {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	var (
 		path       *ibctesting.Path
@@ -1348,3 +1510,93 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 		})
 	}
 }
+
+// TestPacketDataStorage tests that packets sent on a port with packet data storage enabled are stored
+// in full until they are acknowledged or timed out.
+func (suite *KeeperTestSuite) TestPacketDataStorage() {
+	var (
+		path          *ibctesting.Path
+		timeoutHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStored bool
+		settle    func(packet types.Packet) error
+	}{
+		{
+			"success: packet deleted on acknowledgement",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.PacketDataPorts = []string{ibctesting.MockPort}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			true,
+			func(packet types.Packet) error {
+				return path.RelayPacket(packet)
+			},
+		},
+		{
+			"success: packet deleted on timeout",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.PacketDataPorts = []string{ibctesting.MockPort}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			},
+			true,
+			func(packet types.Packet) error {
+				if err := path.EndpointA.UpdateClient(); err != nil {
+					return err
+				}
+				return path.EndpointA.TimeoutPacket(packet)
+			},
+		},
+		{
+			"packet not stored: packet data storage disabled for port",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.PacketDataPorts = []string{ibctesting.TransferPort}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+			func(packet types.Packet) error {
+				return path.RelayPacket(packet)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			timeoutHeight = defaultTimeoutHeight
+
+			tc.malleate()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			storedPacket, found := channelKeeper.GetPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Equal(tc.expStored, found)
+			if tc.expStored {
+				suite.Require().Equal(packet, storedPacket)
+			}
+
+			err = tc.settle(packet)
+			suite.Require().NoError(err)
+
+			_, found = channelKeeper.GetPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().False(found)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ChanPause is called by the authority to pause a channel. Packets can neither be sent nor received
+// on a paused channel, while acknowledgements and timeouts of in-flight packets are still processed
+// so that their state can settle. The block height at which the channel was paused is stored.
+func (k *Keeper) ChanPause(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State == types.CLOSED {
+		return errorsmod.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
+	}
+
+	if k.IsChannelPaused(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.SetChannelPauseHeight(ctx, portID, channelID, uint64(ctx.BlockHeight()))
+
+	k.Logger(ctx).Info("channel paused", "port-id", portID, "channel-id", channelID)
+
+	defer telemetry.IncrCounter(1, "ibc", "channel", "pause")
+
+	emitChannelPauseEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
+
+// ChanResume is called by the authority to resume a channel previously paused with ChanPause.
+func (k *Keeper) ChanResume(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !k.IsChannelPaused(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelNotPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.deleteChannelPauseHeight(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel resumed", "port-id", portID, "channel-id", channelID)
+
+	defer telemetry.IncrCounter(1, "ibc", "channel", "resume")
+
+	emitChannelResumeEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
+
+// ChanForceClose is called by the authority to close a channel. Unlike ChanCloseInit, neither the client
+// nor the connection of the channel are required to be active, so that a channel can be closed during an
+// incident. An upgrade in progress is aborted and the channel is resumed if it was paused. The application
+// bound to the channel is notified through its OnChanCloseInit callback by the message server. The
+// counterparty may close its channel end with ChanCloseConfirm and in-flight packets can be timed out on close.
+func (k *Keeper) ChanForceClose(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State == types.CLOSED {
+		return errorsmod.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
+	}
+
+	if k.hasUpgrade(ctx, portID, channelID) {
+		if err := k.abortUpgrade(ctx, portID, channelID, errorsmod.Wrap(types.ErrInvalidChannelState, "channel has been force closed")); err != nil {
+			return err
+		}
+
+		// the channel end is restored when the upgrade is aborted
+		channel, _ = k.GetChannel(ctx, portID, channelID)
+	}
+
+	k.deleteChannelPauseHeight(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", types.CLOSED.String())
+
+	defer telemetry.IncrCounter(1, "ibc", "channel", "force-close")
+
+	channel.State = types.CLOSED
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	// the close init event is emitted so that relayers close the counterparty channel end
+	emitChannelCloseInitEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestChanPause() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel is CLOSED",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+			},
+			types.ErrInvalidChannelState,
+		},
+		{
+			"channel is already paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanPause(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			types.ErrChannelPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			err := channelKeeper.ChanPause(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				height, found := channelKeeper.GetChannelPauseHeight(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(ctx.BlockHeight()), height)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChanResume() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel is not paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanResume(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			types.ErrChannelNotPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanPause(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			err = channelKeeper.ChanResume(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(channelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChanForceClose() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: paused channel",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanPause(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"success: upgrade in progress is aborted",
+			func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+				err := path.EndpointA.ChanUpgradeInit()
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"success: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel is already CLOSED",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+			},
+			types.ErrInvalidChannelState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			err := channelKeeper.ChanForceClose(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(types.CLOSED, channel.State)
+				suite.Require().Equal(mock.Version, channel.Version)
+				suite.Require().False(channelKeeper.IsChannelPaused(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				_, found := channelKeeper.GetUpgrade(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().False(found)
+
+				// the counterparty channel end can be closed with the proof of the closed channel
+				suite.coordinator.CommitBlock(suite.chainA)
+				err = path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				proof, proofHeight := suite.chainA.QueryProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanCloseConfirm(
+					suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, channelCap,
+					proof, proofHeight, channel.UpgradeSequence,
+				)
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestPausedChannelPackets tests that packets can neither be sent nor received on a paused channel, while
+// acknowledgements of in-flight packets are still processed.
+func (suite *KeeperTestSuite) TestPausedChannelPackets() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	timeoutHeight := clienttypes.NewHeight(1, 1000)
+
+	// packet sent from chainA before the channel is paused
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packetA := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	// packet sent from chainB towards the channel which is paused
+	sequence, err = path.EndpointB.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packetB := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	err = channelKeeper.ChanPause(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	_, err = path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().ErrorIs(err, types.ErrChannelPaused)
+
+	err = path.EndpointA.RecvPacket(packetB)
+	suite.Require().ErrorContains(err, types.ErrChannelPaused.Error())
+
+	// the in-flight packet of chainA is received by chainB and acknowledged on the paused channel
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.RecvPacket(packetA)
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.AcknowledgePacket(packetA, mock.MockAcknowledgement.Acknowledgement())
+	suite.Require().NoError(err)
+
+	commitment := channelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packetA.GetSourcePort(), packetA.GetSourceChannel(), packetA.GetSequence())
+	suite.Require().Empty(commitment)
+
+	// packets flow again once the channel is resumed
+	err = channelKeeper.ChanResume(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.RecvPacket(packetB)
+	suite.Require().NoError(err)
+
+	_, err = path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestGetAllPausedChannels() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path1.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	suite.Require().Empty(channelKeeper.GetAllPausedChannels(suite.chainA.GetContext()))
+
+	err := channelKeeper.ChanPause(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	err = channelKeeper.ChanPause(suite.chainA.GetContext(), path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	expPausedChannels := []types.PausedChannel{
+		{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Height: uint64(suite.chainA.GetContext().BlockHeight() - 1)},
+		{PortId: path1.EndpointA.ChannelConfig.PortID, ChannelId: path1.EndpointA.ChannelID, Height: uint64(suite.chainA.GetContext().BlockHeight())},
+	}
+	suite.Require().Equal(expPausedChannels, channelKeeper.GetAllPausedChannels(suite.chainA.GetContext()))
+}
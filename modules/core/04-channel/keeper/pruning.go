@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// PrunePacketReceipts verifies the proof of the commitment start sequence of the counterparty channel end of an
+// UNORDERED channel and advances the recv start sequence of the channel to it. All packets sent by the counterparty
+// with a sequence lower than its commitment start sequence have been acknowledged or timed out, thus they can never
+// be received again and their packet receipts and acknowledgements may be pruned. The number of packet
+// receipts/acks pruned is bounded by the limit, if the limit is zero only the recv start sequence is updated.
+//
+// REPLAY PROTECTION: packets with a sequence lower than the recv start sequence are rejected in RecvPacket,
+// the recv start sequence replaces the packet receipts which are pruned.
+func (k *Keeper) PrunePacketReceipts(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyCommitmentStartSequence uint64,
+	proof []byte,
+	proofHeight exported.Height,
+	limit uint64,
+) (uint64, uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, 0, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, 0, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "expected %s, got %s", types.UNORDERED, channel.Ordering)
+	}
+
+	recvStartSequence, _ := k.GetRecvStartSequence(ctx, portID, channelID)
+	if counterpartyCommitmentStartSequence > recvStartSequence {
+		connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found {
+			return 0, 0, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+		}
+
+		if err := k.verifyCommitmentStartSequence(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, counterpartyCommitmentStartSequence,
+		); err != nil {
+			return 0, 0, errorsmod.Wrap(err, "failed to verify counterparty commitment start sequence")
+		}
+
+		k.setRecvStartSequence(ctx, portID, channelID, counterpartyCommitmentStartSequence)
+	}
+
+	if !k.HasPruningSequenceStart(ctx, portID, channelID) {
+		k.SetPruningSequenceStart(ctx, portID, channelID, 1)
+	}
+
+	return k.PruneAcknowledgements(ctx, portID, channelID, limit)
+}
+
+// PrunePacketState advances the commitment start sequence of UNORDERED and ORDERED_ALLOW_TIMEOUT channels, pruning the
+// timeout heights of the packets it is advanced past, and prunes the packet receipts and acknowledgements below the
+// recv start sequence of channels. ORDERED channels are closed by the timeout of a packet, so that at most one timeout
+// height is stored per ORDERED channel. At most MaxPacketsPrunedPerBlock packet sequences are
+// processed and at most MaxPacketsPrunedPerBlock channels are visited per call. Channels are visited in a round robin
+// fashion, the path of the last channel visited is stored so that the next call resumes from the following channel.
+func (k *Keeper) PrunePacketState(ctx sdk.Context) {
+	maxPruned := k.GetParams(ctx).MaxPacketsPrunedPerBlock
+	if maxPruned == 0 {
+		return
+	}
+
+	cursor := k.getPacketPruningCursor(ctx)
+
+	var firstChannelPath string
+	budget := maxPruned
+	for visited := uint64(0); visited < maxPruned && budget > 0; visited++ {
+		channelPath, channel, found := k.nextChannel(ctx, cursor)
+		if !found || channelPath == firstChannelPath {
+			break
+		}
+
+		if firstChannelPath == "" {
+			firstChannelPath = channelPath
+		}
+		cursor = channelPath
+
+		portID, channelID := host.MustParseChannelPath(channelPath)
+		if channel.Ordering != types.ORDERED {
+			budget -= k.advanceCommitmentStartSequence(ctx, portID, channelID, budget)
+		}
+
+		recvStartSequence, found := k.GetRecvStartSequence(ctx, portID, channelID)
+		if !found || budget == 0 {
+			continue
+		}
+
+		// skip the channel if pruning has not started or has already completed
+		pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+		if !found || pruningSequenceStart >= recvStartSequence {
+			continue
+		}
+
+		pruned, _, err := k.PruneAcknowledgements(ctx, portID, channelID, budget)
+		if err != nil {
+			k.Logger(ctx).Error("failed to prune packet state", "port-id", portID, "channel-id", channelID, "error", err.Error())
+			continue
+		}
+
+		budget -= min(pruned, budget)
+	}
+
+	if cursor != "" {
+		k.setPacketPruningCursor(ctx, cursor)
+	}
+}
+
+// advanceCommitmentStartSequence advances the commitment start sequence of the channel past the packets which have
+// been acknowledged or timed out, deleting their timeout heights. It stops at the first packet commitment still stored
+// or at the next sequence send. At most limit sequences are advanced and the number of sequences advanced is returned.
+func (k *Keeper) advanceCommitmentStartSequence(ctx sdk.Context, portID, channelID string, limit uint64) uint64 {
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0
+	}
+
+	start, found := k.GetCommitmentStartSequence(ctx, portID, channelID)
+	if !found {
+		start = 1
+	}
+
+	sequence := start
+	for ; sequence < nextSequenceSend && sequence-start < limit; sequence++ {
+		if k.HasPacketCommitment(ctx, portID, channelID, sequence) {
+			break
+		}
+
+		k.deletePacketTimeoutHeight(ctx, portID, channelID, sequence)
+	}
+
+	if sequence == start {
+		return 0
+	}
+
+	k.setCommitmentStartSequence(ctx, portID, channelID, sequence)
+
+	return sequence - start
+}
+
+// nextChannel returns the path and channel end of the first channel stored after the channel with the provided path,
+// wrapping around to the first channel in the store. False is returned if no channels exist.
+func (k *Keeper) nextChannel(ctx sdk.Context, channelPath string) (string, types.Channel, bool) {
+	store := ctx.KVStore(k.storeKey)
+	channelsPrefix := []byte(fmt.Sprintf("%s/", host.KeyChannelEndPrefix))
+
+	start := channelsPrefix
+	if channelPath != "" {
+		// the smallest key strictly greater than the provided channel path
+		start = append([]byte(channelPath), 0x00)
+	}
+
+	if path, channel, found := k.firstChannelInRange(store, start, storetypes.PrefixEndBytes(channelsPrefix)); found {
+		return path, channel, true
+	}
+
+	return k.firstChannelInRange(store, channelsPrefix, storetypes.PrefixEndBytes(channelsPrefix))
+}
+
+// firstChannelInRange returns the path and channel end of the first channel stored in the range [start, end).
+func (k *Keeper) firstChannelInRange(store storetypes.KVStore, start, end []byte) (string, types.Channel, bool) {
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return "", types.Channel{}, false
+	}
+
+	var channel types.Channel
+	k.cdc.MustUnmarshal(iterator.Value(), &channel)
+
+	return string(iterator.Key()), channel, true
+}
+
+// getPacketPruningCursor returns the path of the last channel visited when pruning packet state.
+func (k *Keeper) getPacketPruningCursor(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get([]byte(types.KeyPacketPruningCursor)))
+}
+
+// setPacketPruningCursor stores the path of the last channel visited when pruning packet state.
+func (k *Keeper) setPacketPruningCursor(ctx sdk.Context, channelPath string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyPacketPruningCursor), []byte(channelPath))
+}
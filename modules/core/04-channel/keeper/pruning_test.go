@@ -0,0 +1,379 @@
+package keeper_test
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// advanceCommitmentStartSequence enables packet pruning on chainB, advances the commitment start sequence of
+// channelB at BeginBlock and updates the client on chainA so that the commitment start sequence can be proven.
+func (suite *KeeperTestSuite) advanceCommitmentStartSequence(path *ibctesting.Path) {
+	params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+	params.MaxPacketsPrunedPerBlock = 100
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.PrunePacketState(suite.chainB.GetContext())
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPrunePacketState() {
+	var (
+		path      *ibctesting.Path
+		maxPruned uint64
+		expStart  uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFound bool
+	}{
+		{
+			"success: commitment start sequence advanced past acknowledged and timed out packets",
+			func() {
+				suite.sendMockPackets(path, 5, true)
+				suite.sendMockPackets(path, 2, false)
+				suite.sendMockPackets(path, 3, true)
+
+				expStart = 11
+			},
+			true,
+		},
+		{
+			"success: commitment start sequence stops at first in-flight packet",
+			func() {
+				suite.sendMockPackets(path, 4, true)
+
+				_, err := path.EndpointB.SendPacket(clienttypes.NewHeight(1, 1000), disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				suite.sendMockPackets(path, 3, true)
+
+				expStart = 5
+			},
+			true,
+		},
+		{
+			"success: commitment start sequence advanced up to the per block limit",
+			func() {
+				suite.sendMockPackets(path, 10, true)
+
+				maxPruned = 6
+
+				expStart = 7
+			},
+			true,
+		},
+		{
+			"success: no packets sent, commitment start sequence not set",
+			func() {},
+			false,
+		},
+		{
+			"success: pruning disabled",
+			func() {
+				suite.sendMockPackets(path, 5, true)
+
+				maxPruned = 0
+			},
+			false,
+		},
+		{
+			"success: commitment start sequence of ORDERED_ALLOW_TIMEOUT channel advanced",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.EndpointA.ChannelConfig.Order = types.ORDERED_ALLOW_TIMEOUT
+				path.EndpointB.ChannelConfig.Order = types.ORDERED_ALLOW_TIMEOUT
+				path.Setup()
+
+				suite.sendMockPackets(path, 3, true)
+
+				expStart = 4
+			},
+			true,
+		},
+		{
+			"success: ordered channel is skipped",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				path.Setup()
+
+				suite.sendMockPackets(path, 5, true)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			maxPruned = 100
+
+			tc.malleate()
+
+			params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+			params.MaxPacketsPrunedPerBlock = maxPruned
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.PrunePacketState(suite.chainB.GetContext())
+
+			start, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetCommitmentStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(expStart, start)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPrunePacketStateTimeoutHeights() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+
+	params := channelKeeper.GetParams(suite.chainB.GetContext())
+	params.PacketDataPorts = []string{portID}
+	channelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// time out packets 1 and 2, leave packet 3 in flight and time out packet 4
+	suite.sendMockPackets(path, 2, false)
+	_, err := path.EndpointB.SendPacket(clienttypes.NewHeight(1, 1000), disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	suite.sendMockPackets(path, 1, false)
+
+	for _, sequence := range []uint64{1, 2, 4} {
+		_, found := channelKeeper.GetPacketTimeoutHeight(suite.chainB.GetContext(), portID, channelID, sequence)
+		suite.Require().True(found)
+	}
+
+	suite.advanceCommitmentStartSequence(path)
+
+	// the timeout heights of the packets below the commitment start sequence are pruned
+	for _, sequence := range []uint64{1, 2} {
+		_, found := channelKeeper.GetPacketTimeoutHeight(suite.chainB.GetContext(), portID, channelID, sequence)
+		suite.Require().False(found)
+	}
+
+	_, found := channelKeeper.GetPacketTimeoutHeight(suite.chainB.GetContext(), portID, channelID, 4)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestPrunePacketStateRecvSide() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// Send 10 packets from B -> A, creating 10 packet receipts and 10 packet acks on A.
+	suite.sendMockPackets(path, 10, true)
+	suite.advanceCommitmentStartSequence(path)
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.CommitmentStartSequenceKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+	// only advance the recv start sequence on A
+	pruned, left, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketReceipts(
+		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 11, proof, proofHeight, 0,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), pruned)
+	suite.Require().Equal(uint64(10), left)
+
+	params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	params.MaxPacketsPrunedPerBlock = 4
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketState(suite.chainA.GetContext())
+
+	receipts := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext())
+	suite.Require().Len(receipts, 6)
+
+	start, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(5), start)
+}
+
+func (suite *KeeperTestSuite) TestPrunePacketReceipts() {
+	var (
+		path                                *ibctesting.Path
+		counterpartyCommitmentStartSequence uint64
+		proof                               []byte
+		proofHeight                         clienttypes.Height
+		limit                               uint64
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPruned    uint64
+		expRemaining uint64
+		expError     error
+	}{
+		{
+			"success: all packet receipts and acks pruned",
+			func() {},
+			10,
+			0,
+			nil,
+		},
+		{
+			"success: packet receipts and acks partially pruned",
+			func() {
+				limit = 4
+			},
+			4,
+			6,
+			nil,
+		},
+		{
+			"success: limit is zero, only recv start sequence updated",
+			func() {
+				limit = 0
+			},
+			0,
+			10,
+			nil,
+		},
+		{
+			"success: recv start sequence already advanced, proof is not verified",
+			func() {
+				_, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketReceipts(
+					suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+					counterpartyCommitmentStartSequence, proof, proofHeight, 3,
+				)
+				suite.Require().NoError(err)
+
+				proof = []byte("invalid proof")
+			},
+			7,
+			0,
+			nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			0,
+			0,
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is not unordered",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.Ordering = types.ORDERED
+				path.EndpointA.SetChannel(channel)
+			},
+			0,
+			0,
+			types.ErrInvalidChannelOrdering,
+		},
+		{
+			"failure: connection not found",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.ConnectionHops[0] = ibctesting.InvalidID
+				path.EndpointA.SetChannel(channel)
+			},
+			0,
+			0,
+			connectiontypes.ErrConnectionNotFound,
+		},
+		{
+			"failure: counterparty commitment start sequence does not match proof",
+			func() {
+				counterpartyCommitmentStartSequence = 12
+			},
+			0,
+			0,
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// Send 10 packets from B -> A, creating 10 packet receipts and 10 packet acks on A.
+			suite.sendMockPackets(path, 10, true)
+			suite.advanceCommitmentStartSequence(path)
+
+			counterpartyCommitmentStartSequence = 11
+			proof, proofHeight = path.EndpointB.QueryProof(host.CommitmentStartSequenceKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			limit = 10
+
+			tc.malleate()
+
+			pruned, remaining, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketReceipts(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				counterpartyCommitmentStartSequence, proof, proofHeight, limit,
+			)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+				suite.Require().Equal(tc.expRemaining, remaining)
+
+				recvStartSequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetRecvStartSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(11), recvStartSequence)
+
+				receipts := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext())
+				suite.Require().Len(receipts, int(tc.expRemaining))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(errorsmod.IsOf(err, tc.expError), err.Error())
+			}
+		})
+	}
+}
+
+// TestRecvPacketBelowRecvStartSequence asserts that packets whose receipts have been pruned cannot be received again.
+func (suite *KeeperTestSuite) TestRecvPacketBelowRecvStartSequence() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	timeoutHeight := clienttypes.NewHeight(1, 1000)
+	sequence, err := path.EndpointB.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	packetProof, packetProofHeight := path.EndpointB.QueryProof(packetKey)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.advanceCommitmentStartSequence(path)
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.CommitmentStartSequenceKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	pruned, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PrunePacketReceipts(
+		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence+1, proof, proofHeight, 1,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+
+	// replay the packet using a historical proof of the packet commitment
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainA.GetContext(), suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), packet, packetProof, packetProofHeight)
+	suite.Require().ErrorIs(err, types.ErrPacketReceived)
+}
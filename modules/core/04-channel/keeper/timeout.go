@@ -29,7 +29,25 @@ func (k *Keeper) TimeoutPacket(
 	proofHeight exported.Height,
 	nextSequenceRecv uint64,
 ) error {
-	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	return k.TimeoutPacketInBatch(ctx, NewPacketBatch(packet.GetSourcePort(), packet.GetSourceChannel()), packet, proof, proofHeight, nextSequenceRecv)
+}
+
+// TimeoutPacketInBatch verifies the timeout of a packet of a batch of packets sent on the same channel end, reusing
+// the channel end, connection end, client status and counterparty timestamp looked up for the previous packets of
+// the batch. See TimeoutPacket.
+func (k *Keeper) TimeoutPacketInBatch(
+	ctx sdk.Context,
+	batch *PacketBatch,
+	packet types.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+	nextSequenceRecv uint64,
+) error {
+	if err := batch.validatePacketChannel(packet.GetSourcePort(), packet.GetSourceChannel()); err != nil {
+		return err
+	}
+
+	channel, found := k.GetPacketBatchChannel(ctx, batch)
 	if !found {
 		return errorsmod.Wrapf(
 			types.ErrChannelNotFound,
@@ -54,7 +72,7 @@ func (k *Keeper) TimeoutPacket(
 		)
 	}
 
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getPacketBatchConnection(ctx, batch, channel.ConnectionHops[0])
 	if !found {
 		return errorsmod.Wrap(
 			connectiontypes.ErrConnectionNotFound,
@@ -63,14 +81,14 @@ func (k *Keeper) TimeoutPacket(
 	}
 
 	// check that timeout height or timeout timestamp has passed on the other end
-	proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connectionEnd.ClientId, proofHeight)
+	counterpartyHeight, proofTimestamp, err := k.getPacketBatchCounterpartyTimestampAtHeight(ctx, batch, channel.ConnectionHops, connectionEnd, proof, proofHeight)
 	if err != nil {
 		return err
 	}
 
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if !timeout.Elapsed(proofHeight.(clienttypes.Height), proofTimestamp) {
-		return errorsmod.Wrap(timeout.ErrTimeoutNotReached(proofHeight.(clienttypes.Height), proofTimestamp), "packet timeout not reached")
+	if !timeout.Elapsed(counterpartyHeight, proofTimestamp) {
+		return errorsmod.Wrap(timeout.ErrTimeoutNotReached(counterpartyHeight, proofTimestamp), "packet timeout not reached")
 	}
 
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
@@ -102,14 +120,24 @@ func (k *Keeper) TimeoutPacket(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
-			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+		err = k.verifyPacketBatchMembership(
+			ctx, batch, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+			host.NextSequenceRecvPath(packet.GetDestPort(), packet.GetDestChannel()), sdk.Uint64ToBigEndian(nextSequenceRecv),
+		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.validateNextSequenceAck(ctx, packet); err != nil {
+			return err
+		}
+
+		// check that the counterparty wrote a timeout receipt for the packet, see verifyOrderedAllowTimeout
+		err = k.verifyPacketBatchMembership(
+			ctx, batch, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+			host.PacketReceiptPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), types.TimeoutReceipt,
 		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
-			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		err = k.verifyPacketBatchNonMembership(
+			ctx, batch, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+			host.PacketReceiptPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
 		)
 	default:
 		panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
@@ -125,6 +153,8 @@ func (k *Keeper) TimeoutPacket(
 
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If the timed-out packet came from an ORDERED_ALLOW_TIMEOUT channel then the next sequence
+// acknowledgement is incremented and the channel remains open.
 // If the channel is in the FLUSHING state and there is a counterparty upgrade, then the
 // upgrade will be aborted if the upgrade has timed out. Otherwise, if there are no more inflight packets,
 // then the channel will be set to the FLUSHCOMPLETE state.
@@ -135,7 +165,22 @@ func (k *Keeper) TimeoutExecuted(
 	chanCap *capabilitytypes.Capability,
 	packet types.Packet,
 ) error {
-	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	return k.TimeoutExecutedInBatch(ctx, chanCap, NewPacketBatch(packet.GetSourcePort(), packet.GetSourceChannel()), packet)
+}
+
+// TimeoutExecutedInBatch executes the timeout of a packet of a batch of packets sent on the same channel end,
+// reusing the channel end looked up for the previous packets of the batch. See TimeoutExecuted.
+func (k *Keeper) TimeoutExecutedInBatch(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	batch *PacketBatch,
+	packet types.Packet,
+) error {
+	if err := batch.validatePacketChannel(packet.GetSourcePort(), packet.GetSourceChannel()); err != nil {
+		return err
+	}
+
+	channel, found := k.GetPacketBatchChannel(ctx, batch)
 	if !found {
 		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
@@ -148,10 +193,19 @@ func (k *Keeper) TimeoutExecuted(
 		)
 	}
 
-	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if k.IsPacketDataStored(ctx, packet.GetSourcePort()) {
+		k.SetPacketTimeoutHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), uint64(ctx.BlockHeight()))
+	}
+
+	// timeouts are processed in order with acknowledgements on ORDERED_ALLOW_TIMEOUT channels,
+	// the packet sequence is checked against the next sequence ack during timeout verification.
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
+	}
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
-	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
+	if channel.State == types.FLUSHING && channel.Ordering != types.ORDERED {
 		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		// once we have received the counterparty timeout in the channel UpgradeAck or UpgradeConfirm handshake steps
 		// then we can move to flushing complete if the timeout has not passed and there are no in-flight packets
@@ -162,11 +216,11 @@ func (k *Keeper) TimeoutExecuted(
 			if timeout.Elapsed(selfHeight, selfTimestamp) {
 				// packet flushing timeout has expired, abort the upgrade and return nil,
 				// committing an error receipt to state, restoring the channel and successfully timing out the packet.
-				k.MustAbortUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp))
+				k.abortPacketBatchUpgrade(ctx, batch, timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp))
 			} else if !k.HasInflightPackets(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
 				// set the channel state to flush complete if all packets have been flushed.
 				channel.State = types.FLUSHCOMPLETE
-				k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+				k.setPacketBatchChannel(ctx, batch, channel)
 				emitChannelFlushCompleteEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
 			}
 		}
@@ -186,7 +240,7 @@ func (k *Keeper) TimeoutExecuted(
 		}
 
 		channel.State = types.CLOSED
-		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+		k.setPacketBatchChannel(ctx, batch, channel)
 		emitChannelClosedEvent(ctx, packet, channel)
 	}
 
@@ -268,7 +322,10 @@ func (k *Keeper) TimeoutOnClose(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(channel.ConnectionHops, connectionEnd, closedProof)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(packet.GetSourcePort(), packet.GetSourceChannel())
 	expectedChannel := types.Channel{
@@ -281,15 +338,14 @@ func (k *Keeper) TimeoutOnClose(
 	}
 
 	// check that the opposing channel end has closed
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, closedProof,
+	if err := k.verifyChannelState(
+		ctx, channel.ConnectionHops, connectionEnd, proofHeight, closedProof,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
 		return err
 	}
 
-	var err error
 	switch channel.Ordering {
 	case types.ORDERED:
 		// check that packet has not been received
@@ -298,13 +354,24 @@ func (k *Keeper) TimeoutOnClose(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if nextSequenceRecv > packet.GetSequence() {
+			// the counterparty received the packet after its timeout elapsed, before its channel end was closed
+			err = k.verifyOrderedAllowTimeout(ctx, channel.ConnectionHops, connectionEnd, packet, proof, proofHeight)
+		} else if err = k.validateNextSequenceAck(ctx, packet); err == nil {
+			// the closed counterparty channel end can no longer receive the packet
+			err = k.verifyNextSequenceRecv(
+				ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+			)
+		}
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, channel.ConnectionHops, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
 	default:
@@ -318,3 +385,47 @@ func (k *Keeper) TimeoutOnClose(
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return nil
 }
+
+// verifyOrderedAllowTimeout verifies that a packet sent on an ORDERED_ALLOW_TIMEOUT channel will never be executed
+// on the counterparty. Timeouts must be processed in the same order as acknowledgements. As specified in ICS-04, the
+// counterparty must first receive the timed out packet, advancing its next sequence receive, so that the timeout
+// receipt it wrote can be proven. Proving the next sequence receive instead would leave the counterparty unable to
+// ever receive the packet once its commitment is deleted, blocking every later packet of the channel.
+func (k *Keeper) verifyOrderedAllowTimeout(
+	ctx sdk.Context,
+	connectionHops []string,
+	connectionEnd connectiontypes.ConnectionEnd,
+	packet types.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	if err := k.validateNextSequenceAck(ctx, packet); err != nil {
+		return err
+	}
+
+	return k.verifyPacketReceipt(
+		ctx, connectionHops, connectionEnd, proofHeight, proof,
+		packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), types.TimeoutReceipt,
+	)
+}
+
+// validateNextSequenceAck returns an error if the packet sent on an ORDERED_ALLOW_TIMEOUT channel is not the next
+// packet to be acknowledged or timed out.
+func (k *Keeper) validateNextSequenceAck(ctx sdk.Context, packet types.Packet) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return errorsmod.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -773,3 +774,190 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 		})
 	}
 }
+
+// TestTimeoutPacketOrderedAllowTimeout tests that packets sent on ORDERED_ALLOW_TIMEOUT channels may only be timed out
+// using a proof of the timeout receipt written by the counterparty when receiving the timed out packet. Timing out a
+// packet must not close the channel.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeout() {
+	var (
+		path        *ibctesting.Path
+		packet      types.Packet
+		nextSeqRecv uint64
+		packetKey   []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: timeout receipt written on counterparty",
+			func() {
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+			},
+			nil,
+		},
+		{
+			"failure: packet not received on counterparty",
+			func() {
+				// the commitment must not be deleted before the counterparty has advanced its next sequence receive,
+				// otherwise the counterparty could never receive the packet and every later packet would time out
+				nextSeqRecv = packet.GetSequence()
+				packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: packet is not the next sequence to be acknowledged",
+			func() {
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence()+1)
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
+		{
+			"failure: packet received on counterparty without a timeout receipt",
+			func() {
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				// overwrite the timeout receipt with a packet receipt
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.coordinator.CommitBlock(suite.chainB)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: next sequence receive proven after counterparty moved past the packet",
+			func() {
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			nextSeqRecv = sequence + 1
+			packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+			tc.malleate()
+
+			// need to update chainA's client representing chainB to prove the timeout
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPacket(suite.chainA.GetContext(), packet, proof, proofHeight, nextSeqRecv)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutExecuted(suite.chainA.GetContext(), chanCap, packet)
+				suite.Require().NoError(err)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(types.OPEN, channel.State, "channel closed after timeout on ORDERED_ALLOW_TIMEOUT channel")
+
+				nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSeqAck)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().Empty(commitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestTimeoutOnCloseOrderedAllowTimeout tests that packets sent on ORDERED_ALLOW_TIMEOUT channels may be timed out
+// on close using a proof of the next sequence receive or a proof of the timeout receipt.
+func (suite *KeeperTestSuite) TestTimeoutOnCloseOrderedAllowTimeout() {
+	var (
+		path        *ibctesting.Path
+		packet      types.Packet
+		nextSeqRecv uint64
+		packetKey   []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: packet not received on counterparty",
+			func() {},
+			nil,
+		},
+		{
+			"success: timeout receipt written on counterparty",
+			func() {
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				nextSeqRecv = packet.GetSequence() + 1
+				packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			},
+			nil,
+		},
+		{
+			"failure: packet is not the next sequence to be acknowledged",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence()+1)
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			nextSeqRecv = sequence
+			packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+
+			tc.malleate()
+
+			path.EndpointB.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+
+			channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
+			closedProof, proofHeight := path.EndpointB.QueryProof(channelKey)
+			proof, _ := path.EndpointB.QueryProof(packetKey)
+
+			chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, closedProof, proofHeight, nextSeqRecv, 0)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
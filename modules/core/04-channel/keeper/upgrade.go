@@ -34,6 +34,10 @@ func (k *Keeper) ChanUpgradeInit(
 		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
 	}
 
+	if isMultihop(channel.ConnectionHops) {
+		return types.Upgrade{}, errorsmod.Wrap(types.ErrTooManyConnectionHops, "multi-hop channels cannot be upgraded")
+	}
+
 	if err := k.validateSelfUpgradeFields(ctx, upgradeFields, channel); err != nil {
 		return types.Upgrade{}, err
 	}
@@ -93,6 +97,10 @@ func (k *Keeper) ChanUpgradeTry(
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
 	}
 
+	if isMultihop(channel.ConnectionHops) {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(types.ErrTooManyConnectionHops, "multi-hop channels cannot be upgraded")
+	}
+
 	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
@@ -592,15 +600,16 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID stri
 
 	// next seq recv and ack is used for ordered channels to verify the packet has been received/acked in the correct order
 	// this is no longer necessary if the channel is UNORDERED and should be reset to 1
-	if channel.Ordering == types.ORDERED && upgrade.Fields.Ordering == types.UNORDERED {
+	// NOTE: sequences are kept as is when moving between ORDERED and ORDERED_ALLOW_TIMEOUT.
+	if channel.Ordering != types.UNORDERED && upgrade.Fields.Ordering == types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
 	}
 
-	// next seq recv and ack should updated when moving from UNORDERED to ORDERED using the counterparty NextSequenceSend as set just after blocking new packet sends.
+	// next seq recv and ack should updated when moving from UNORDERED to an ordered channel using the counterparty NextSequenceSend as set just after blocking new packet sends.
 	// we can be sure that the next packet we are set to receive will be the first packet the counterparty sends after reopening.
 	// we can be sure that our next acknowledgement will be our first packet sent after upgrade, as the counterparty processed all sent packets after flushing completes.
-	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering == types.ORDERED {
+	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering != types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
 		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
 	}
@@ -617,6 +626,12 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID stri
 		k.SetPruningSequenceStart(ctx, portID, channelID, 1)
 	}
 
+	// Index the channel under the connection of its upgraded connection hops
+	if channel.ConnectionHops[0] != upgrade.Fields.ConnectionHops[0] {
+		k.deleteConnectionChannel(ctx, portID, channelID, channel.ConnectionHops)
+		k.SetConnectionChannel(ctx, portID, channelID, upgrade.Fields.ConnectionHops)
+	}
+
 	// Switch channel fields to upgrade fields and set channel state to OPEN
 	previousState := channel.State
 	channel.Ordering = upgrade.Fields.Ordering
@@ -629,6 +644,8 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID stri
 	// delete state associated with upgrade which is no longer required.
 	k.deleteUpgradeInfo(ctx, portID, channelID)
 
+	k.reportScheduledChannelUpgrade(ctx, portID, channelID, types.COMPLETED, "")
+
 	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", types.OPEN.String())
 	return channel
 }
@@ -938,7 +955,7 @@ func (k *Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade type
 	if !connectiontypes.VerifySupportedFeature(getVersions[0], proposedUpgrade.Ordering.String()) {
 		return errorsmod.Wrapf(
 			connectiontypes.ErrInvalidVersion,
-			"connection version %s does not support channel ordering: %s",
+			"connection version %s does not support channel ordering: %s, the connection must first be upgraded to a version supporting it",
 			getVersions[0], proposedUpgrade.Ordering.String(),
 		)
 	}
@@ -1035,4 +1052,6 @@ func (k *Keeper) WriteErrorReceipt(ctx sdk.Context, portID, channelID string, up
 
 	k.setUpgradeErrorReceipt(ctx, portID, channelID, errorReceiptToWrite)
 	EmitErrorReceiptEvent(ctx, portID, channelID, channel, upgradeError)
+
+	k.reportScheduledChannelUpgrade(ctx, portID, channelID, types.CANCELLED, errorReceiptToWrite.Message)
 }
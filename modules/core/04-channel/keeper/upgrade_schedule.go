@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// ScheduleChannelUpgrades stores the given channel upgrade schedule under the next schedule identifier, which
+// is returned. The channel upgrades are initiated by the IBC core keeper from the BeginBlock of the schedule height.
+func (k *Keeper) ScheduleChannelUpgrades(ctx sdk.Context, schedule types.ChannelUpgradeSchedule) uint64 {
+	scheduleID := k.GetNextChannelUpgradeScheduleID(ctx)
+	k.SetNextChannelUpgradeScheduleID(ctx, scheduleID+1)
+
+	schedule.Id = scheduleID
+	schedule.Executed = false
+	schedule.UpgradesInitiated = 0
+	schedule.UpgradesFailed = 0
+	schedule.NextChannelKey = nil
+
+	k.SetChannelUpgradeSchedule(ctx, schedule)
+
+	k.Logger(ctx).Info("channel upgrades scheduled", "schedule-id", scheduleID, "height", schedule.Height)
+
+	return scheduleID
+}
+
+// GetChannelUpgradeSchedule returns the channel upgrade schedule with the given identifier.
+func (k *Keeper) GetChannelUpgradeSchedule(ctx sdk.Context, scheduleID uint64) (types.ChannelUpgradeSchedule, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeScheduleKey(scheduleID))
+	if len(bz) == 0 {
+		return types.ChannelUpgradeSchedule{}, false
+	}
+
+	var schedule types.ChannelUpgradeSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+
+	return schedule, true
+}
+
+// GetDueChannelUpgradeSchedules returns the channel upgrade schedules which have not been executed yet and whose
+// height has been reached, ordered by schedule identifier.
+func (k *Keeper) GetDueChannelUpgradeSchedules(ctx sdk.Context) []types.ChannelUpgradeSchedule {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyPendingChannelUpgradeSchedulePrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var schedules []types.ChannelUpgradeSchedule
+	for ; iterator.Valid(); iterator.Next() {
+		schedule, found := k.GetChannelUpgradeSchedule(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic(fmt.Errorf("could not find pending channel upgrade schedule, schedule ID: %d", sdk.BigEndianToUint64(iterator.Value())))
+		}
+
+		if schedule.Height <= uint64(ctx.BlockHeight()) {
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].Id < schedules[j].Id })
+
+	return schedules
+}
+
+// WriteChannelUpgradeScheduleExecuted stores a channel upgrade schedule whose channel upgrades have all been
+// initiated. The schedule is no longer pending and the status of the upgrades in progress is reported once they
+// complete or are cancelled.
+func (k *Keeper) WriteChannelUpgradeScheduleExecuted(ctx sdk.Context, schedule types.ChannelUpgradeSchedule) {
+	schedule.Executed = true
+	schedule.NextChannelKey = nil
+	k.SetChannelUpgradeSchedule(ctx, schedule)
+
+	k.Logger(ctx).Info("channel upgrade schedule executed", "schedule-id", schedule.Id, "upgrades-initiated", schedule.UpgradesInitiated, "upgrades-failed", schedule.UpgradesFailed)
+
+	emitChannelUpgradeScheduleExecutedEvent(ctx, schedule)
+}
+
+// GetScheduledChannelUpgrade returns the status of the upgrade of the given channel initiated by the channel
+// upgrade schedule with the given identifier.
+func (k *Keeper) GetScheduledChannelUpgrade(ctx sdk.Context, scheduleID uint64, portID, channelID string) (types.ScheduledChannelUpgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeScheduleUpgradeKey(scheduleID, portID, channelID))
+	if len(bz) == 0 {
+		return types.ScheduledChannelUpgrade{}, false
+	}
+
+	var upgrade types.ScheduledChannelUpgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetScheduledChannelUpgrade stores the status of a channel upgrade initiated by a channel upgrade schedule. The
+// identifier of the schedule is also stored under the channel while the upgrade is in progress, so that its status
+// can be reported once the upgrade completes or is cancelled.
+func (k *Keeper) SetScheduledChannelUpgrade(ctx sdk.Context, upgrade types.ScheduledChannelUpgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelUpgradeScheduleUpgradeKey(upgrade.ScheduleId, upgrade.PortId, upgrade.ChannelId), bz)
+
+	if upgrade.Status == types.IN_PROGRESS {
+		store.Set(host.ScheduledChannelUpgradeKey(upgrade.PortId, upgrade.ChannelId), sdk.Uint64ToBigEndian(upgrade.ScheduleId))
+	}
+}
+
+// reportScheduledChannelUpgrade updates the status of the upgrade in progress of the given channel if it was
+// initiated by a channel upgrade schedule. It is called once the upgrade has completed or has been cancelled.
+func (k *Keeper) reportScheduledChannelUpgrade(ctx sdk.Context, portID, channelID string, status types.ScheduledUpgradeStatus, message string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ScheduledChannelUpgradeKey(portID, channelID))
+	if len(bz) == 0 {
+		return
+	}
+
+	store.Delete(host.ScheduledChannelUpgradeKey(portID, channelID))
+
+	scheduleID := sdk.BigEndianToUint64(bz)
+	upgrade, found := k.GetScheduledChannelUpgrade(ctx, scheduleID, portID, channelID)
+	if !found {
+		panic(fmt.Errorf("could not find scheduled channel upgrade, schedule ID: %d, channelID: %s, portID: %s", scheduleID, channelID, portID))
+	}
+
+	if upgrade.Status != types.IN_PROGRESS {
+		return
+	}
+
+	upgrade.Status = status
+	upgrade.Error = message
+	k.SetScheduledChannelUpgrade(ctx, upgrade)
+
+	if status == types.CANCELLED {
+		k.Logger(ctx).Info("scheduled channel upgrade cancelled", "schedule-id", scheduleID, "port-id", portID, "channel-id", channelID, "upgrade-sequence", upgrade.UpgradeSequence)
+		emitScheduledChannelUpgradeCancelledEvent(ctx, upgrade)
+	}
+}
+
+// SetChannelUpgradeSchedule stores a channel upgrade schedule. The schedule is kept in the pending channel upgrade
+// schedules until it has been executed.
+func (k *Keeper) SetChannelUpgradeSchedule(ctx sdk.Context, schedule types.ChannelUpgradeSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&schedule)
+	store.Set(host.ChannelUpgradeScheduleKey(schedule.Id), bz)
+
+	if schedule.Executed {
+		store.Delete(host.PendingChannelUpgradeScheduleKey(schedule.Id))
+	} else {
+		store.Set(host.PendingChannelUpgradeScheduleKey(schedule.Id), sdk.Uint64ToBigEndian(schedule.Id))
+	}
+}
+
+// GetAllChannelUpgradeSchedules returns all stored channel upgrade schedules.
+func (k *Keeper) GetAllChannelUpgradeSchedules(ctx sdk.Context) (schedules []types.ChannelUpgradeSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyChannelUpgradeSchedulePrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.ChannelUpgradeSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// GetAllScheduledChannelUpgrades returns the status of all the channel upgrades initiated by channel upgrade schedules.
+func (k *Keeper) GetAllScheduledChannelUpgrades(ctx sdk.Context) (upgrades []types.ScheduledChannelUpgrade) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyChannelUpgradeScheduleUpgradePrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var upgrade types.ScheduledChannelUpgrade
+		k.cdc.MustUnmarshal(iterator.Value(), &upgrade)
+
+		upgrades = append(upgrades, upgrade)
+	}
+
+	return upgrades
+}
+
+// GetNextChannelUpgradeScheduleID returns the identifier of the next channel upgrade schedule.
+func (k *Keeper) GetNextChannelUpgradeScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyNextChannelUpgradeScheduleID))
+	if len(bz) == 0 {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextChannelUpgradeScheduleID sets the identifier of the next channel upgrade schedule.
+func (k *Keeper) SetNextChannelUpgradeScheduleID(ctx sdk.Context, scheduleID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyNextChannelUpgradeScheduleID), sdk.Uint64ToBigEndian(scheduleID))
+}
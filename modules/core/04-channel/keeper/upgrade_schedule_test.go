@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestGetAllChannelUpgradeSchedules() {
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	suite.Require().Empty(channelKeeper.GetAllChannelUpgradeSchedules(suite.chainA.GetContext()))
+	suite.Require().Empty(channelKeeper.GetAllScheduledChannelUpgrades(suite.chainA.GetContext()))
+
+	schedule := types.NewChannelUpgradeSchedule(100, ibctesting.MockPort, "", "", mock.UpgradeVersion)
+	schedule.Id = channelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), schedule)
+
+	executedSchedule := types.NewChannelUpgradeSchedule(10, ibctesting.MockPort, "", "", mock.UpgradeVersion)
+	executedSchedule.Id = channelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), executedSchedule)
+	executedSchedule.UpgradesInitiated = 1
+	executedSchedule.UpgradesFailed = 1
+	channelKeeper.WriteChannelUpgradeScheduleExecuted(suite.chainA.GetContext(), executedSchedule)
+	executedSchedule.Executed = true
+
+	expUpgrades := []types.ScheduledChannelUpgrade{
+		types.NewScheduledChannelUpgrade(executedSchedule.Id, ibctesting.MockPort, ibctesting.FirstChannelID, 1),
+		types.NewFailedScheduledChannelUpgrade(executedSchedule.Id, ibctesting.MockPort, "channel-1", types.ErrInvalidUpgrade),
+	}
+	for _, upgrade := range expUpgrades {
+		channelKeeper.SetScheduledChannelUpgrade(suite.chainA.GetContext(), upgrade)
+	}
+
+	suite.Require().Equal([]types.ChannelUpgradeSchedule{schedule, executedSchedule}, channelKeeper.GetAllChannelUpgradeSchedules(suite.chainA.GetContext()))
+	suite.Require().Equal(expUpgrades, channelKeeper.GetAllScheduledChannelUpgrades(suite.chainA.GetContext()))
+	suite.Require().Equal(executedSchedule.Id+1, channelKeeper.GetNextChannelUpgradeScheduleID(suite.chainA.GetContext()))
+
+	// only the schedule which has not been executed is pending
+	upgradeCtx := suite.chainA.GetContext().WithBlockHeight(100)
+	suite.Require().Equal([]types.ChannelUpgradeSchedule{schedule}, channelKeeper.GetDueChannelUpgradeSchedules(upgradeCtx))
+}
@@ -116,6 +116,37 @@ func (suite *KeeperTestSuite) TestChanUpgradeInit() {
 	}
 }
 
+// TestChanUpgradeInitOrderedAllowTimeoutOnPreExistingConnection tests that a channel built upon a connection whose
+// version was negotiated before ORDERED_ALLOW_TIMEOUT was supported cannot be upgraded to ORDERED_ALLOW_TIMEOUT
+// until the connection has been upgraded to a version supporting it.
+func (suite *KeeperTestSuite) TestChanUpgradeInitOrderedAllowTimeoutOnPreExistingConnection() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Order = types.ORDERED
+	path.EndpointB.ChannelConfig.Order = types.ORDERED
+	path.Setup()
+
+	legacyVersion := connectiontypes.NewVersion(connectiontypes.DefaultIBCVersionIdentifier, []string{"ORDER_ORDERED", "ORDER_UNORDERED"})
+	path.EndpointA.UpdateConnection(func(c *connectiontypes.ConnectionEnd) { c.Versions = []*connectiontypes.Version{legacyVersion} })
+
+	upgradeFields := types.NewUpgradeFields(types.ORDERED_ALLOW_TIMEOUT, []string{path.EndpointA.ConnectionID}, mock.Version)
+
+	_, err := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeInit(
+		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgradeFields,
+	)
+	suite.Require().ErrorIs(err, connectiontypes.ErrInvalidVersion)
+	suite.Require().ErrorContains(err, "the connection must first be upgraded to a version supporting it")
+
+	// once the connection version supports ORDERED_ALLOW_TIMEOUT the channel may be upgraded
+	path.EndpointA.UpdateConnection(func(c *connectiontypes.ConnectionEnd) {
+		c.Versions = []*connectiontypes.Version{connectiontypes.DefaultIBCVersion}
+	})
+
+	_, err = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeInit(
+		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgradeFields,
+	)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestChanUpgradeTry() {
 	var (
 		path                *ibctesting.Path
@@ -1560,6 +1591,47 @@ func (suite *KeeperTestSuite) TestWriteUpgradeOpenChannel_Ordering() {
 				suite.Require().Equal(uint64(2), counterpartySequenceSend)
 			},
 		},
+		{
+			name: "success: ORDERED -> ORDERED_ALLOW_TIMEOUT",
+			malleate: func() {
+				path.EndpointA.ChannelConfig.Order = types.ORDERED
+				path.EndpointB.ChannelConfig.Order = types.ORDERED
+
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+			},
+			preUpgrade: func() {
+				ctx := suite.chainA.GetContext()
+
+				// assert that NextSeqAck is incremented to 2 because channel is ORDERED
+				seq, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceAck(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+
+				// assert that NextSeqRecv is incremented to 2 because channel is ORDERED
+				seq, found = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+			},
+			postUpgrade: func() {
+				channel := path.EndpointA.GetChannel()
+				ctx := suite.chainA.GetContext()
+
+				// Assert that channel state has been updated
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal(types.ORDERED_ALLOW_TIMEOUT, channel.Ordering)
+
+				// assert that NextSeqRecv is unchanged, because channel is still ordered
+				seq, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+
+				// assert that NextSeqAck is unchanged, because channel is still ordered
+				seq, found = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceAck(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -29,17 +29,17 @@ func (ch Channel) ValidateBasic() error {
 	if ch.State == UNINITIALIZED {
 		return ErrInvalidChannelState
 	}
-	if !slices.Contains([]Order{ORDERED, UNORDERED}, ch.Ordering) {
+	if !slices.Contains([]Order{ORDERED, UNORDERED, ORDERED_ALLOW_TIMEOUT}, ch.Ordering) {
 		return errorsmod.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
-	if len(ch.ConnectionHops) != 1 {
-		return errorsmod.Wrap(
-			ErrTooManyConnectionHops,
-			"current IBC version only supports one connection hop",
-		)
+	if len(ch.ConnectionHops) == 0 {
+		return errorsmod.Wrap(ErrInvalidChannel, "connection hops cannot be empty")
 	}
-	if err := host.ConnectionIdentifierValidator(ch.ConnectionHops[0]); err != nil {
-		return errorsmod.Wrap(err, "invalid connection hop ID")
+	// channels with more than one connection hop are multi-hop channels (ICS-33)
+	for _, connectionID := range ch.ConnectionHops {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return errorsmod.Wrap(err, "invalid connection hop ID")
+		}
 	}
 	return ch.Counterparty.ValidateBasic()
 }
@@ -75,7 +75,7 @@ func (State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3a07336710636a0, []int{0}
 }
 
-// Order defines if a channel is ORDERED or UNORDERED
+// Order defines if a channel is ORDERED, UNORDERED or ORDERED_ALLOW_TIMEOUT
 type Order int32
 
 const (
@@ -86,18 +86,23 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are delivered exactly in the order which they were sent, but a
+	// packet which has timed out is skipped instead of closing the channel
+	ORDERED_ALLOW_TIMEOUT Order = 3
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":      0,
+	"ORDER_UNORDERED":             1,
+	"ORDER_ORDERED":               2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT": 3,
 }
 
 func (x Order) String() string {
@@ -108,6 +113,38 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3a07336710636a0, []int{1}
 }
 
+// IBCVersion defines the version of the IBC protocol used to route a packet
+type IBCVersion int32
+
+const (
+	// zero-value for the IBC version, packets are routed over channels
+	IBC_VERSION_UNSPECIFIED IBCVersion = 0
+	// packets are routed over channels established through the connection and channel handshakes
+	IBC_VERSION_1 IBCVersion = 1
+	// packets are routed by client identifier between a pair of clients with registered counterparties
+	IBC_VERSION_2 IBCVersion = 2
+)
+
+var IBCVersion_name = map[int32]string{
+	0: "IBC_VERSION_UNSPECIFIED",
+	1: "IBC_VERSION_1",
+	2: "IBC_VERSION_2",
+}
+
+var IBCVersion_value = map[string]int32{
+	"IBC_VERSION_UNSPECIFIED": 0,
+	"IBC_VERSION_1":           1,
+	"IBC_VERSION_2":           2,
+}
+
+func (x IBCVersion) String() string {
+	return proto.EnumName(IBCVersion_name, int32(x))
+}
+
+func (IBCVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{2}
+}
+
 // Channel defines pipeline for exactly-once packet delivery between specific
 // modules on separate blockchains, which has at least one end capable of
 // sending packets and one end capable of receiving packets.
@@ -278,6 +315,9 @@ type Packet struct {
 	TimeoutHeight types.Height `protobuf:"bytes,7,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
 	// block timestamp (in nanoseconds) after which the packet times out
 	TimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// version of the IBC protocol used to route the packet. Packets routed by client identifier use IBC_VERSION_2,
+	// in which case the source and destination channel fields contain client identifiers.
+	ProtocolVersion IBCVersion `protobuf:"varint,9,opt,name=protocol_version,json=protocolVersion,proto3,enum=ibc.core.channel.v1.IBCVersion" json:"protocol_version,omitempty"`
 }
 
 func (m *Packet) Reset()         { *m = Packet{} }
@@ -561,6 +601,15 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// max_packets_pruned_per_block defines the maximum number of packet sequences processed across all channels
+	// at BeginBlock, either to advance the commitment start sequence of a channel or to prune the packet receipts
+	// and acknowledgements below its recv start sequence. If zero, packet state is not pruned at BeginBlock.
+	MaxPacketsPrunedPerBlock uint64 `protobuf:"varint,2,opt,name=max_packets_pruned_per_block,json=maxPacketsPrunedPerBlock,proto3" json:"max_packets_pruned_per_block,omitempty"`
+	// packet_data_ports defines the ports whose in-flight packets are stored in full in addition to their commitments,
+	// until they are acknowledged or timed out. Stored packets may be queried by relayers and indexers. The block
+	// heights at which the packets of these ports are sent, received and timed out are also stored for the packet
+	// status query.
+	PacketDataPorts []string `protobuf:"bytes,3,rep,name=packet_data_ports,json=packetDataPorts,proto3" json:"packet_data_ports,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -603,9 +652,65 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
+func (m *Params) GetMaxPacketsPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPacketsPrunedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetPacketDataPorts() []string {
+	if m != nil {
+		return m.PacketDataPorts
+	}
+	return nil
+}
+
+// PausedChannel defines a channel paused by the authority, together with the block
+// height at which it was paused.
+type PausedChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Height    uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PausedChannel) Reset()         { *m = PausedChannel{} }
+func (m *PausedChannel) String() string { return proto.CompactTextString(m) }
+func (*PausedChannel) ProtoMessage()    {}
+func (*PausedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{9}
+}
+func (m *PausedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedChannel.Merge(m, src)
+}
+func (m *PausedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedChannel proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
+	proto.RegisterEnum("ibc.core.channel.v1.IBCVersion", IBCVersion_name, IBCVersion_value)
 	proto.RegisterType((*Channel)(nil), "ibc.core.channel.v1.Channel")
 	proto.RegisterType((*IdentifiedChannel)(nil), "ibc.core.channel.v1.IdentifiedChannel")
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
@@ -615,71 +720,83 @@ func init() {
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
 	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+	proto.RegisterType((*PausedChannel)(nil), "ibc.core.channel.v1.PausedChannel")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0xd5, 0xb6, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x4f,
-	0x21, 0x2d, 0x56, 0x26, 0xfd, 0xcd, 0x95, 0x09, 0x3e, 0x3a, 0x85, 0xdc, 0x66, 0x47, 0x49, 0x56,
-	0xf8, 0x06, 0x28, 0x0f, 0xa2, 0x0b, 0x1f, 0xd8, 0xb3, 0x10, 0xbd, 0x80, 0xf8, 0x2f, 0x36, 0x14,
-	0x2b, 0x14, 0x52, 0xa7, 0x77, 0xbe, 0x22, 0xa2, 0x30, 0x21, 0x96, 0x17, 0xa1, 0x02, 0x3d, 0xfb,
-	0x29, 0x09, 0xa9, 0x9e, 0x78, 0xd1, 0x4a, 0xbd, 0xfe, 0x45, 0xdf, 0x18, 0x0e, 0xda, 0x66, 0xdb,
-	0xec, 0x9b, 0x17, 0x2d, 0xf3, 0xa5, 0xd1, 0x18, 0x0e, 0xda, 0xbd, 0xae, 0x51, 0x37, 0x9f, 0x99,
-	0x46, 0x43, 0x49, 0x68, 0x47, 0xab, 0xb5, 0x7e, 0xb8, 0x43, 0x40, 0x2a, 0x00, 0x8f, 0x8b, 0x40,
-	0x45, 0xd2, 0xb2, 0xab, 0xb5, 0x2e, 0x47, 0x67, 0x54, 0x84, 0x43, 0xee, 0xe9, 0x5b, 0xdf, 0x75,
-	0xba, 0x46, 0x5b, 0x49, 0x6a, 0xfb, 0xab, 0xb5, 0x9e, 0x11, 0xe6, 0x26, 0x92, 0x39, 0xf7, 0x78,
-	0x24, 0xf3, 0x9c, 0xc2, 0x01, 0xf7, 0xd4, 0x5b, 0x9d, 0x9e, 0xd1, 0x50, 0x64, 0x0d, 0x56, 0x6b,
-	0x3d, 0xcd, 0x2d, 0xa4, 0x43, 0x9e, 0x7b, 0x9f, 0xb5, 0x06, 0xbd, 0xa6, 0xd9, 0x7e, 0xae, 0xa4,
-	0xb4, 0x83, 0xd5, 0x5a, 0xcf, 0xc6, 0x36, 0x3a, 0x83, 0xe3, 0x2d, 0x46, 0xbd, 0xf3, 0x6d, 0xb7,
-	0x65, 0xf4, 0x0d, 0x25, 0xcd, 0xeb, 0xdf, 0x01, 0x35, 0xf9, 0xed, 0xaf, 0xc5, 0xc4, 0xd9, 0x6b,
-	0x48, 0xb1, 0xa7, 0x1a, 0x7d, 0x02, 0x27, 0x1d, 0xab, 0x61, 0x58, 0xc3, 0x76, 0xa7, 0x6d, 0xdc,
-	0xea, 0x9e, 0x15, 0x18, 0xe1, 0xa8, 0x0c, 0x05, 0xce, 0x1a, 0xb4, 0xd9, 0xaf, 0xd1, 0x50, 0x24,
-	0xed, 0x70, 0xb5, 0xd6, 0x73, 0x37, 0x40, 0xd4, 0x3e, 0xe7, 0xc4, 0x0c, 0xd1, 0xbe, 0x30, 0xb9,
-	0xf0, 0x65, 0xef, 0xdd, 0x55, 0x51, 0x7a, 0x7f, 0x55, 0x94, 0xfe, 0xb8, 0x2a, 0x4a, 0x3f, 0x5f,
-	0x17, 0x13, 0xef, 0xaf, 0x8b, 0x89, 0x0f, 0xd7, 0xc5, 0xc4, 0xcb, 0xaf, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0xd1, 0xb4, 0xca, 0x94, 0x08, 0x00, 0x00,
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x8f, 0xda, 0xc6,
+	0x1b, 0xc6, 0xfc, 0xe7, 0xdd, 0x05, 0xbc, 0x93, 0x5f, 0x36, 0xfe, 0x39, 0x5b, 0x70, 0x50, 0xab,
+	0x6e, 0xb6, 0x0a, 0x64, 0xd3, 0xaa, 0x4a, 0x73, 0xa8, 0xb4, 0x80, 0x93, 0x75, 0x43, 0x00, 0x19,
+	0x48, 0xd5, 0x5c, 0x2c, 0x63, 0x4f, 0x59, 0x2b, 0xe0, 0x71, 0x6d, 0xb3, 0x4d, 0xd4, 0x73, 0xa5,
+	0x88, 0x53, 0xbf, 0x00, 0x52, 0xa5, 0xf6, 0x1b, 0xb4, 0x5f, 0xa0, 0xb7, 0x1c, 0x73, 0xec, 0xa9,
+	0xaa, 0x92, 0xef, 0xd0, 0x73, 0xe5, 0x99, 0xf1, 0x02, 0x2b, 0x14, 0x55, 0x91, 0x7a, 0xeb, 0x89,
+	0x79, 0x9f, 0xf7, 0x79, 0xff, 0xcc, 0xfb, 0xcc, 0x0c, 0x86, 0x1b, 0xce, 0xd8, 0x6a, 0x58, 0xc4,
+	0xc7, 0x0d, 0xeb, 0xcc, 0x74, 0x5d, 0x3c, 0x6d, 0x9c, 0x1f, 0xc7, 0xcb, 0xba, 0xe7, 0x93, 0x90,
+	0xa0, 0x2b, 0xce, 0xd8, 0xaa, 0x47, 0x94, 0x7a, 0x8c, 0x9f, 0x1f, 0xcb, 0xff, 0x9b, 0x90, 0x09,
+	0xa1, 0xfe, 0x46, 0xb4, 0x62, 0x54, 0xb9, 0xba, 0xca, 0x36, 0x75, 0xb0, 0x1b, 0xd2, 0x64, 0x74,
+	0xc5, 0x08, 0xb5, 0x5f, 0x93, 0x90, 0x6b, 0xb1, 0x2c, 0xe8, 0x36, 0x64, 0x82, 0xd0, 0x0c, 0xb1,
+	0x24, 0x28, 0xc2, 0x61, 0xe9, 0x8e, 0x5c, 0xdf, 0x52, 0xa7, 0x3e, 0x88, 0x18, 0x3a, 0x23, 0xa2,
+	0x4f, 0x21, 0x4f, 0x7c, 0x1b, 0xfb, 0x8e, 0x3b, 0x91, 0x92, 0x6f, 0x09, 0xea, 0x45, 0x24, 0xfd,
+	0x82, 0x8b, 0x1e, 0xc2, 0xae, 0x45, 0xe6, 0x6e, 0x88, 0x7d, 0xcf, 0xf4, 0xc3, 0xe7, 0x52, 0x4a,
+	0x11, 0x0e, 0x77, 0xee, 0xdc, 0xd8, 0x1a, 0xdb, 0x5a, 0x23, 0x36, 0xd3, 0x2f, 0xff, 0xa8, 0x26,
+	0xf4, 0x8d, 0x60, 0xf4, 0x21, 0x94, 0x2d, 0xe2, 0xba, 0xd8, 0x0a, 0x1d, 0xe2, 0x1a, 0x67, 0xc4,
+	0x0b, 0xa4, 0xb4, 0x92, 0x3a, 0x2c, 0xe8, 0xa5, 0x15, 0x7c, 0x4a, 0xbc, 0x00, 0x49, 0x90, 0x3b,
+	0xc7, 0x7e, 0xe0, 0x10, 0x57, 0xca, 0x28, 0xc2, 0x61, 0x41, 0x8f, 0x4d, 0x74, 0x13, 0xc4, 0xb9,
+	0x37, 0xf1, 0x4d, 0x1b, 0x1b, 0x01, 0xfe, 0x66, 0x8e, 0x5d, 0x0b, 0x4b, 0x59, 0x45, 0x38, 0x4c,
+	0xeb, 0x65, 0x8e, 0x0f, 0x38, 0x7c, 0x2f, 0xfd, 0xe2, 0xc7, 0x6a, 0xa2, 0xf6, 0x57, 0x12, 0xf6,
+	0x34, 0x1b, 0xbb, 0xa1, 0xf3, 0xb5, 0x83, 0xed, 0xff, 0x06, 0x78, 0x0d, 0x72, 0x1e, 0xf1, 0x43,
+	0xc3, 0xb1, 0xe9, 0xdc, 0x0a, 0x7a, 0x36, 0x32, 0x35, 0x1b, 0xbd, 0x07, 0xc0, 0x5b, 0x89, 0x7c,
+	0x39, 0xea, 0x2b, 0x70, 0x44, 0xb3, 0xb7, 0x0e, 0x3e, 0xff, 0xb6, 0xc1, 0x77, 0x60, 0x77, 0x7d,
+	0x3f, 0xeb, 0x85, 0x85, 0xb7, 0x14, 0x4e, 0x5e, 0x2a, 0xcc, 0xb3, 0xfd, 0x9c, 0x82, 0x6c, 0xdf,
+	0xb4, 0x9e, 0xe2, 0x10, 0xc9, 0x90, 0xbf, 0xe8, 0x40, 0xa0, 0x1d, 0x5c, 0xd8, 0xa8, 0x0a, 0x3b,
+	0x01, 0x99, 0xfb, 0x16, 0x36, 0xa2, 0xe4, 0x3c, 0x19, 0x30, 0xa8, 0x4f, 0xfc, 0x10, 0x7d, 0x00,
+	0x25, 0x4e, 0xe0, 0x15, 0xa8, 0x20, 0x05, 0xbd, 0xc8, 0xd0, 0xf8, 0x7c, 0xdc, 0x04, 0xd1, 0xc6,
+	0x41, 0xe8, 0xb8, 0x26, 0x9d, 0x34, 0x4d, 0x96, 0xa6, 0xc4, 0xf2, 0x1a, 0x4e, 0x33, 0x36, 0xe0,
+	0xca, 0x3a, 0x35, 0x4e, 0xcb, 0xc6, 0x8e, 0xd6, 0x5c, 0x71, 0x6e, 0x04, 0x69, 0xdb, 0x0c, 0x4d,
+	0x3a, 0xfe, 0x5d, 0x9d, 0xae, 0xd1, 0x03, 0x28, 0x85, 0xce, 0x0c, 0x93, 0x79, 0x68, 0x9c, 0x61,
+	0x67, 0x72, 0x16, 0x52, 0x01, 0x76, 0x36, 0xce, 0x18, 0x7b, 0x0c, 0xce, 0x8f, 0xeb, 0xa7, 0x94,
+	0xc1, 0x0f, 0x48, 0x91, 0xc7, 0x31, 0x10, 0x7d, 0x04, 0x7b, 0x71, 0xa2, 0xe8, 0x37, 0x08, 0xcd,
+	0x99, 0xc7, 0x75, 0x12, 0xb9, 0x63, 0x18, 0xe3, 0xe8, 0x0b, 0x10, 0xe9, 0xdb, 0x62, 0x91, 0xa9,
+	0x11, 0x1f, 0x97, 0x02, 0x3d, 0xdb, 0xd5, 0xad, 0xe7, 0x53, 0x6b, 0xb6, 0x1e, 0x33, 0x9a, 0x5e,
+	0x8e, 0x03, 0x39, 0xc0, 0x65, 0xfa, 0x0e, 0x76, 0x98, 0x4a, 0xf4, 0xee, 0xbc, 0xab, 0xe6, 0x1b,
+	0x12, 0xa7, 0x2e, 0x49, 0x1c, 0x8f, 0x2f, 0xbd, 0x1a, 0x1f, 0x2f, 0x6e, 0x43, 0x9e, 0x15, 0xd7,
+	0xec, 0x7f, 0xa3, 0x32, 0xaf, 0xd2, 0x83, 0xf2, 0x89, 0xf5, 0xd4, 0x25, 0xdf, 0x4e, 0xb1, 0x3d,
+	0xc1, 0x33, 0xec, 0x86, 0x48, 0x82, 0xac, 0x8f, 0x83, 0xf9, 0x34, 0x94, 0xae, 0x46, 0x4d, 0x9d,
+	0x26, 0x74, 0x6e, 0xa3, 0x7d, 0xc8, 0x60, 0xdf, 0x27, 0xbe, 0xb4, 0x1f, 0x15, 0x3a, 0x4d, 0xe8,
+	0xcc, 0x6c, 0x02, 0xe4, 0x7d, 0x1c, 0x78, 0xc4, 0x0d, 0x70, 0xcd, 0x84, 0xdc, 0x90, 0x29, 0x83,
+	0xee, 0x42, 0x96, 0xcb, 0x2f, 0xfc, 0x43, 0xf9, 0x39, 0x1f, 0x1d, 0x40, 0x61, 0xa5, 0x77, 0x92,
+	0x36, 0xbe, 0x02, 0x6a, 0xbf, 0x09, 0xd1, 0xed, 0xf1, 0xcd, 0x59, 0x80, 0x1e, 0x42, 0x7c, 0x5f,
+	0x0d, 0x7e, 0x1e, 0x78, 0xad, 0x83, 0xad, 0x92, 0xf3, 0xce, 0x78, 0xb5, 0x12, 0x0f, 0x8d, 0xfb,
+	0xfd, 0x1c, 0x0e, 0x66, 0xe6, 0x33, 0xc3, 0xa3, 0x53, 0x0f, 0x0c, 0xcf, 0x9f, 0xbb, 0xd8, 0x36,
+	0x3c, 0xec, 0x1b, 0xe3, 0x29, 0xb1, 0x9e, 0xf2, 0x46, 0xa4, 0x99, 0xf9, 0x8c, 0x09, 0x13, 0xf4,
+	0x29, 0xa3, 0x8f, 0xfd, 0x66, 0xe4, 0x47, 0x47, 0xb0, 0xc7, 0x62, 0x8d, 0x48, 0x46, 0x7a, 0xcd,
+	0x02, 0x29, 0x45, 0x5f, 0xb4, 0x32, 0x73, 0xb4, 0xcd, 0xd0, 0x8c, 0xae, 0x59, 0x50, 0xc3, 0x50,
+	0xec, 0x9b, 0xf3, 0x60, 0xf5, 0x86, 0xbf, 0xab, 0xc4, 0xfb, 0x17, 0x43, 0x66, 0x02, 0x73, 0x8b,
+	0xc9, 0x7b, 0xf4, 0x7d, 0x12, 0x32, 0x03, 0xfe, 0xe2, 0x57, 0x07, 0xc3, 0x93, 0xa1, 0x6a, 0x8c,
+	0xba, 0x5a, 0x57, 0x1b, 0x6a, 0x27, 0x1d, 0xed, 0x89, 0xda, 0x36, 0x46, 0xdd, 0x41, 0x5f, 0x6d,
+	0x69, 0xf7, 0x35, 0xb5, 0x2d, 0x26, 0xe4, 0xbd, 0xc5, 0x52, 0x29, 0x6e, 0x10, 0x90, 0x04, 0xc0,
+	0xe2, 0x22, 0x50, 0x14, 0xe4, 0xfc, 0x62, 0xa9, 0xa4, 0xa3, 0x35, 0xaa, 0x40, 0x91, 0x79, 0x86,
+	0xfa, 0x57, 0xbd, 0xbe, 0xda, 0x15, 0x93, 0xf2, 0xce, 0x62, 0xa9, 0xe4, 0xb8, 0xb9, 0x8a, 0xa4,
+	0xce, 0x14, 0x8b, 0xa4, 0x9e, 0x03, 0xd8, 0x65, 0x9e, 0x56, 0xa7, 0x37, 0x50, 0xdb, 0x62, 0x5a,
+	0x86, 0xc5, 0x52, 0xc9, 0x32, 0x0b, 0x29, 0x50, 0x62, 0xde, 0xfb, 0x9d, 0xd1, 0xe0, 0x54, 0xeb,
+	0x3e, 0x10, 0x33, 0xf2, 0xee, 0x62, 0xa9, 0xe4, 0x63, 0x1b, 0x1d, 0xc1, 0x95, 0x35, 0x46, 0xab,
+	0xf7, 0xa8, 0xdf, 0x51, 0x87, 0xaa, 0x98, 0x65, 0xfd, 0x6f, 0x80, 0x72, 0xfa, 0xc5, 0x4f, 0x95,
+	0xc4, 0xd1, 0x2f, 0x02, 0x64, 0xe8, 0x7f, 0x19, 0x7a, 0x1f, 0xf6, 0x7b, 0x7a, 0x5b, 0xd5, 0x8d,
+	0x6e, 0xaf, 0xab, 0x5e, 0xda, 0x3e, 0xed, 0x30, 0xc2, 0x51, 0x0d, 0xca, 0x8c, 0x35, 0xea, 0xd2,
+	0x5f, 0xb5, 0x2d, 0x0a, 0x72, 0x71, 0xb1, 0x54, 0x0a, 0x17, 0x40, 0xb4, 0x7f, 0xc6, 0x89, 0x19,
+	0x7c, 0xff, 0xb1, 0xff, 0x1e, 0x5c, 0xdf, 0xf0, 0x1b, 0x27, 0x9d, 0x4e, 0xef, 0x4b, 0x63, 0xa8,
+	0x3d, 0x52, 0x7b, 0xa3, 0xa1, 0x98, 0x92, 0xff, 0xbf, 0x58, 0x2a, 0x57, 0xb7, 0x3a, 0x79, 0xd7,
+	0x23, 0x80, 0xd5, 0x23, 0x85, 0xae, 0xc3, 0x35, 0xad, 0xd9, 0x32, 0x1e, 0xab, 0xfa, 0x40, 0xeb,
+	0x75, 0x37, 0x5b, 0x47, 0x7b, 0x50, 0x5c, 0x77, 0x1e, 0x8b, 0xc2, 0x65, 0xe8, 0x8e, 0x98, 0x64,
+	0x69, 0x9b, 0x83, 0x97, 0xaf, 0x2b, 0xc2, 0xab, 0xd7, 0x15, 0xe1, 0xcf, 0xd7, 0x15, 0xe1, 0x87,
+	0x37, 0x95, 0xc4, 0xab, 0x37, 0x95, 0xc4, 0xef, 0x6f, 0x2a, 0x89, 0x27, 0x9f, 0x4d, 0x9c, 0xf0,
+	0x6c, 0x3e, 0xae, 0x5b, 0x64, 0xd6, 0xb0, 0x48, 0x30, 0x23, 0x41, 0xc3, 0x19, 0x5b, 0xb7, 0x26,
+	0xa4, 0x71, 0x7e, 0xb7, 0x31, 0x23, 0xf6, 0x7c, 0x8a, 0x03, 0xf6, 0x59, 0x77, 0xfb, 0x93, 0x5b,
+	0xf1, 0x77, 0x62, 0xf8, 0xdc, 0xc3, 0xc1, 0x38, 0x4b, 0x9f, 0xd0, 0x8f, 0xff, 0x1e, 0x00, 0x11,
+	0xd5, 0x4d, 0xfa, 0x48, 0x0a, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -881,6 +998,11 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.TimeoutTimestamp != 0 {
 		i = encodeVarintChannel(dAtA, i, uint64(m.TimeoutTimestamp))
 		i--
@@ -1154,6 +1276,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketDataPorts) > 0 {
+		for iNdEx := len(m.PacketDataPorts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PacketDataPorts[iNdEx])
+			copy(dAtA[i:], m.PacketDataPorts[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.PacketDataPorts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxPacketsPrunedPerBlock != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPacketsPrunedPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1167,6 +1303,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PausedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChannel(v)
 	base := offset
@@ -1297,6 +1475,9 @@ func (m *Packet) Size() (n int) {
 	if m.TimeoutTimestamp != 0 {
 		n += 1 + sovChannel(uint64(m.TimeoutTimestamp))
 	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovChannel(uint64(m.ProtocolVersion))
+	}
 	return n
 }
 
@@ -1400,6 +1581,35 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.UpgradeTimeout.Size()
 	n += 1 + l + sovChannel(uint64(l))
+	if m.MaxPacketsPrunedPerBlock != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPacketsPrunedPerBlock))
+	}
+	if len(m.PacketDataPorts) > 0 {
+		for _, s := range m.PacketDataPorts {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PausedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovChannel(uint64(m.Height))
+	}
 	return n
 }
 
@@ -2257,6 +2467,25 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= IBCVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -2857,6 +3086,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsPrunedPerBlock", wireType)
+			}
+			m.MaxPacketsPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketsPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataPorts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketDataPorts = append(m.PacketDataPorts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
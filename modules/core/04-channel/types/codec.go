@@ -29,6 +29,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAcknowledgement{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
+		&MsgRecvPackets{},
+		&MsgAcknowledgements{},
+		&MsgTimeouts{},
 		&MsgChannelUpgradeInit{},
 		&MsgChannelUpgradeTry{},
 		&MsgChannelUpgradeAck{},
@@ -37,6 +40,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelUpgradeTimeout{},
 		&MsgChannelUpgradeCancel{},
 		&MsgPruneAcknowledgements{},
+		&MsgPrunePacketReceipts{},
+		&MsgChannelPause{},
+		&MsgChannelResume{},
+		&MsgChannelForceClose{},
+		&MsgScheduleChannelUpgrades{},
 		&MsgUpdateParams{},
 	)
 
@@ -60,4 +60,13 @@ var (
 	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 40, "timeout elapsed")
 	ErrPruningSequenceStartNotFound    = errorsmod.Register(SubModuleName, 41, "pruning sequence start not found")
 	ErrRecvStartSequenceNotFound       = errorsmod.Register(SubModuleName, 42, "recv start sequence not found")
+
+	// Perform a no-op on the application callback when a timed out packet is received on an ORDERED_ALLOW_TIMEOUT channel.
+	// The receive sequence is still advanced and a timeout receipt is written.
+	ErrTimeoutReceipt = errorsmod.Register(SubModuleName, 43, "packet timeout elapsed, timeout receipt written")
+
+	ErrChannelPaused    = errorsmod.Register(SubModuleName, 44, "channel is paused")
+	ErrChannelNotPaused = errorsmod.Register(SubModuleName, 45, "channel is not paused")
+
+	ErrUpgradeScheduleNotFound = errorsmod.Register(SubModuleName, 46, "channel upgrade schedule not found")
 )
@@ -62,6 +62,15 @@ var (
 	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
 	EventTypeChannelUpgradeError   = "channel_upgrade_error"
 	EventTypeChannelFlushComplete  = "channel_flush_complete"
+	EventTypeChannelPause          = "channel_pause"
+	EventTypeChannelResume         = "channel_resume"
+
+	EventTypeChannelUpgradeScheduleExecuted   = "channel_upgrade_schedule_executed"
+	EventTypeScheduledChannelUpgradeCancelled = "scheduled_channel_upgrade_cancelled"
+
+	AttributeKeyUpgradeScheduleID = "upgrade_schedule_id"
+	AttributeKeyUpgradesInitiated = "upgrades_initiated"
+	AttributeKeyUpgradesFailed    = "upgrades_failed"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
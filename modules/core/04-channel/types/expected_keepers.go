@@ -16,6 +16,7 @@ type ClientKeeper interface {
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	GetClientLatestHeight(ctx sdk.Context, clientID string) clienttypes.Height
 	GetClientTimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error)
+	GetCounterparty(ctx sdk.Context, clientID string) (clienttypes.Counterparty, bool)
 }
 
 // ConnectionKeeper expected account IBC connection keeper
@@ -59,6 +60,16 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		connection connectiontypes.ConnectionEnd,
@@ -68,6 +79,15 @@ type ConnectionKeeper interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyCommitmentStartSequence(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		commitmentStartSequence uint64,
+	) error
 	VerifyChannelUpgrade(
 		ctx sdk.Context,
 		connection connectiontypes.ConnectionEnd,
@@ -86,6 +106,41 @@ type ConnectionKeeper interface {
 		channelID string,
 		errorReceipt ErrorReceipt,
 	) error
+	VerifyMultihopMembership(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		path string,
+		value []byte,
+	) error
+	VerifyMultihopNonMembership(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		path string,
+	) error
+	VerifyPacketBatchMembership(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		path string,
+		value []byte,
+	) error
+	VerifyPacketBatchNonMembership(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		path string,
+	) error
+	GetMultihopCounterpartyConnectionHops(connection connectiontypes.ConnectionEnd, proof []byte, connectionHops []string) ([]string, error)
+	GetMultihopConnectionEnds(proof []byte, connectionHops []string) ([]connectiontypes.ConnectionEnd, error)
+	GetMultihopTimestampAtHeight(proof []byte, connectionHops []string) (exported.Height, uint64, error)
 }
 
 // PortKeeper expected account IBC port keeper
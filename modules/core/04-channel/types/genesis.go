@@ -41,6 +41,22 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewPacketHeight creates a new PacketHeight instance.
+func NewPacketHeight(portID, channelID string, seq, height uint64) PacketHeight {
+	return PacketHeight{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  seq,
+		Height:    height,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (ph PacketHeight) Validate() error {
+	return validateGenFields(ph.PortId, ph.ChannelId, ph.Sequence)
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
@@ -62,15 +78,23 @@ func NewGenesisState(
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
-		Params:              DefaultParams(),
+		Channels:                     []IdentifiedChannel{},
+		Acknowledgements:             []PacketState{},
+		Receipts:                     []PacketState{},
+		Commitments:                  []PacketState{},
+		SendSequences:                []PacketSequence{},
+		RecvSequences:                []PacketSequence{},
+		AckSequences:                 []PacketSequence{},
+		NextChannelSequence:          0,
+		Params:                       DefaultParams(),
+		PausedChannels:               []PausedChannel{},
+		Packets:                      []Packet{},
+		SendHeights:                  []PacketHeight{},
+		RecvHeights:                  []PacketHeight{},
+		TimeoutHeights:               []PacketHeight{},
+		UpgradeSchedules:             []ChannelUpgradeSchedule{},
+		ScheduledChannelUpgrades:     []ScheduledChannelUpgrade{},
+		NextChannelUpgradeScheduleId: 1,
 	}
 }
 
@@ -142,6 +166,60 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, pc := range gs.PausedChannels {
+		if err := host.PortIdentifierValidator(pc.PortId); err != nil {
+			return fmt.Errorf("invalid paused channel %v index %d: %w", pc, i, err)
+		}
+		if err := host.ChannelIdentifierValidator(pc.ChannelId); err != nil {
+			return fmt.Errorf("invalid paused channel %v index %d: %w", pc, i, err)
+		}
+	}
+
+	for i, packet := range gs.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid packet %v index %d: %w", packet, i, err)
+		}
+	}
+
+	for i, sh := range gs.SendHeights {
+		if err := sh.Validate(); err != nil {
+			return fmt.Errorf("invalid send height %v index %d: %w", sh, i, err)
+		}
+	}
+
+	for i, rh := range gs.RecvHeights {
+		if err := rh.Validate(); err != nil {
+			return fmt.Errorf("invalid receive height %v index %d: %w", rh, i, err)
+		}
+	}
+
+	for i, th := range gs.TimeoutHeights {
+		if err := th.Validate(); err != nil {
+			return fmt.Errorf("invalid timeout height %v index %d: %w", th, i, err)
+		}
+	}
+
+	var maxScheduleID uint64
+	for i, schedule := range gs.UpgradeSchedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("invalid channel upgrade schedule %v index %d: %w", schedule, i, err)
+		}
+
+		if schedule.Id > maxScheduleID {
+			maxScheduleID = schedule.Id
+		}
+	}
+
+	if maxScheduleID != 0 && maxScheduleID >= gs.NextChannelUpgradeScheduleId {
+		return fmt.Errorf("next channel upgrade schedule ID %d must be greater than maximum channel upgrade schedule ID %d", gs.NextChannelUpgradeScheduleId, maxScheduleID)
+	}
+
+	for i, upgrade := range gs.ScheduledChannelUpgrades {
+		if err := upgrade.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled channel upgrade %v index %d: %w", upgrade, i, err)
+		}
+	}
+
 	return nil
 }
 
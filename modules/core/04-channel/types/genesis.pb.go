@@ -35,6 +35,19 @@ type GenesisState struct {
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
 	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the channels paused by the authority
+	PausedChannels []PausedChannel `protobuf:"bytes,10,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels"`
+	// the packets stored in full until their packet commitments are deleted
+	Packets []Packet `protobuf:"bytes,11,rep,name=packets,proto3" json:"packets"`
+	// the block heights at which packets were sent, received and timed out
+	SendHeights    []PacketHeight `protobuf:"bytes,12,rep,name=send_heights,json=sendHeights,proto3" json:"send_heights"`
+	RecvHeights    []PacketHeight `protobuf:"bytes,13,rep,name=recv_heights,json=recvHeights,proto3" json:"recv_heights"`
+	TimeoutHeights []PacketHeight `protobuf:"bytes,14,rep,name=timeout_heights,json=timeoutHeights,proto3" json:"timeout_heights"`
+	// the channel upgrade schedules and the channel upgrades they initiated
+	UpgradeSchedules         []ChannelUpgradeSchedule  `protobuf:"bytes,15,rep,name=upgrade_schedules,json=upgradeSchedules,proto3" json:"upgrade_schedules"`
+	ScheduledChannelUpgrades []ScheduledChannelUpgrade `protobuf:"bytes,16,rep,name=scheduled_channel_upgrades,json=scheduledChannelUpgrades,proto3" json:"scheduled_channel_upgrades"`
+	// the identifier of the next channel upgrade schedule
+	NextChannelUpgradeScheduleId uint64 `protobuf:"varint,17,opt,name=next_channel_upgrade_schedule_id,json=nextChannelUpgradeScheduleId,proto3" json:"next_channel_upgrade_schedule_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +146,62 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPausedChannels() []PausedChannel {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPackets() []Packet {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSendHeights() []PacketHeight {
+	if m != nil {
+		return m.SendHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecvHeights() []PacketHeight {
+	if m != nil {
+		return m.RecvHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimeoutHeights() []PacketHeight {
+	if m != nil {
+		return m.TimeoutHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUpgradeSchedules() []ChannelUpgradeSchedule {
+	if m != nil {
+		return m.UpgradeSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledChannelUpgrades() []ScheduledChannelUpgrade {
+	if m != nil {
+		return m.ScheduledChannelUpgrades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextChannelUpgradeScheduleId() uint64 {
+	if m != nil {
+		return m.NextChannelUpgradeScheduleId
+	}
+	return 0
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,45 +264,129 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// PacketHeight defines the genesis type necessary to retrieve and store the
+// block height at which a packet was sent, received or timed out.
+type PacketHeight struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Height    uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PacketHeight) Reset()         { *m = PacketHeight{} }
+func (m *PacketHeight) String() string { return proto.CompactTextString(m) }
+func (*PacketHeight) ProtoMessage()    {}
+func (*PacketHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{2}
+}
+func (m *PacketHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketHeight.Merge(m, src)
+}
+func (m *PacketHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketHeight proto.InternalMessageInfo
+
+func (m *PacketHeight) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketHeight) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketHeight) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v1.GenesisState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v1.PacketSequence")
+	proto.RegisterType((*PacketHeight)(nil), "ibc.core.channel.v1.PacketHeight")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xb5, 0xeb, 0x5a, 0x77, 0x9b, 0xc0, 0x03, 0x11, 0x8a, 0xc8, 0xca, 0x90, 0x50,
-	0x2f, 0x8b, 0x59, 0xe1, 0xc0, 0xae, 0xe5, 0x00, 0xbd, 0xa0, 0x29, 0xbb, 0x21, 0xa1, 0x2a, 0xb1,
-	0x3f, 0x32, 0xab, 0x8d, 0x1d, 0x62, 0xb7, 0xc0, 0xbf, 0xe0, 0xb7, 0xf0, 0x2b, 0x76, 0xdc, 0x91,
-	0xd3, 0x84, 0xda, 0x7f, 0xc1, 0x09, 0xc5, 0x71, 0xb2, 0xa2, 0x95, 0x49, 0xbd, 0xc5, 0xdf, 0xf7,
-	0xbe, 0xcf, 0xfb, 0x1e, 0xf2, 0xa1, 0x67, 0x3c, 0xa2, 0x84, 0xca, 0x0c, 0x08, 0xbd, 0x08, 0x85,
-	0x80, 0x29, 0x99, 0x9f, 0x90, 0x18, 0x04, 0x28, 0xae, 0xfc, 0x34, 0x93, 0x5a, 0xe2, 0x03, 0x1e,
-	0x51, 0x3f, 0x97, 0xf8, 0x56, 0xe2, 0xcf, 0x4f, 0xba, 0x0f, 0x62, 0x19, 0x4b, 0xb3, 0x27, 0xf9,
-	0x57, 0x21, 0xed, 0xae, 0xa5, 0x95, 0x2e, 0x23, 0x39, 0xfa, 0xb9, 0x8d, 0x76, 0xdf, 0x15, 0xfc,
-	0x73, 0x1d, 0x6a, 0xc0, 0x9f, 0x50, 0xcb, 0x2a, 0x94, 0xeb, 0xf4, 0xea, 0xfd, 0xce, 0xe0, 0x85,
-	0xbf, 0x26, 0xd1, 0x1f, 0x31, 0x10, 0x9a, 0x7f, 0xe6, 0xc0, 0xde, 0x16, 0xc3, 0xe1, 0xe3, 0xcb,
-	0xeb, 0xc3, 0xda, 0x9f, 0xeb, 0xc3, 0xfb, 0xb7, 0x56, 0x41, 0x85, 0xc4, 0x01, 0xba, 0x17, 0xd2,
-	0x89, 0x90, 0x5f, 0xa7, 0xc0, 0x62, 0x48, 0x40, 0x68, 0xe5, 0x6e, 0x99, 0x98, 0xde, 0xda, 0x98,
-	0xb3, 0x90, 0x4e, 0x40, 0x9b, 0x6a, 0xc3, 0x46, 0x1e, 0x10, 0xdc, 0xf2, 0xe3, 0xf7, 0xa8, 0x43,
-	0x65, 0x92, 0x70, 0x5d, 0xe0, 0xea, 0x1b, 0xe1, 0x56, 0xad, 0x78, 0x88, 0x5a, 0x19, 0x50, 0xe0,
-	0xa9, 0x56, 0x6e, 0x63, 0x23, 0x4c, 0xe5, 0xc3, 0x67, 0x68, 0x5f, 0x81, 0x60, 0x63, 0x05, 0x5f,
-	0x66, 0x20, 0x28, 0x28, 0x77, 0xdb, 0x90, 0x9e, 0xdf, 0x45, 0xb2, 0x5a, 0x0b, 0xdb, 0xcb, 0x01,
-	0xe5, 0xcc, 0x10, 0x33, 0xa0, 0xf3, 0x15, 0x62, 0x73, 0x63, 0x62, 0x0e, 0xb8, 0x21, 0x7e, 0x40,
-	0x7b, 0x21, 0x9d, 0xac, 0x00, 0x77, 0x36, 0x05, 0xee, 0x86, 0x74, 0x72, 0xc3, 0x1b, 0xa0, 0x87,
-	0x02, 0xbe, 0xe9, 0xb1, 0x75, 0x55, 0x60, 0xb7, 0xd5, 0x73, 0xfa, 0x8d, 0xe0, 0x20, 0x5f, 0xda,
-	0x7f, 0xa1, 0x34, 0xe1, 0x53, 0xd4, 0x4c, 0xc3, 0x2c, 0x4c, 0x94, 0xdb, 0xee, 0x39, 0xfd, 0xce,
-	0xe0, 0xc9, 0x7f, 0xc2, 0x73, 0x89, 0x0d, 0xb5, 0x86, 0x23, 0x86, 0xf6, 0xff, 0x2d, 0x85, 0x1f,
-	0xa1, 0x9d, 0x54, 0x66, 0x7a, 0xcc, 0x99, 0xeb, 0xf4, 0x9c, 0x7e, 0x3b, 0x68, 0xe6, 0xcf, 0x11,
-	0xc3, 0x4f, 0x11, 0x2a, 0x4b, 0x71, 0xe6, 0x6e, 0x99, 0x5d, 0xdb, 0x4e, 0x46, 0x0c, 0x77, 0x51,
-	0xab, 0xea, 0x5a, 0x37, 0x5d, 0xab, 0xf7, 0xf0, 0xfc, 0x72, 0xe1, 0x39, 0x57, 0x0b, 0xcf, 0xf9,
-	0xbd, 0xf0, 0x9c, 0x1f, 0x4b, 0xaf, 0x76, 0xb5, 0xf4, 0x6a, 0xbf, 0x96, 0x5e, 0xed, 0xe3, 0x69,
-	0xcc, 0xf5, 0xc5, 0x2c, 0xf2, 0xa9, 0x4c, 0x08, 0x95, 0x2a, 0x91, 0x8a, 0xf0, 0x88, 0x1e, 0xc7,
-	0x92, 0xcc, 0xdf, 0x90, 0x44, 0xb2, 0xd9, 0x14, 0x54, 0x71, 0x77, 0x2f, 0x5f, 0x1f, 0x97, 0xa7,
-	0xa7, 0xbf, 0xa7, 0xa0, 0xa2, 0xa6, 0x39, 0xbb, 0x57, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xaf,
-	0xa9, 0x60, 0xe8, 0xe9, 0x03, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6f, 0xd3, 0x3c,
+	0x1c, 0xc7, 0x9b, 0xb5, 0x4f, 0xd7, 0xb9, 0x5d, 0xb7, 0x79, 0xcf, 0x1f, 0x3f, 0x05, 0xba, 0xac,
+	0x48, 0xa8, 0x12, 0x2c, 0x61, 0x83, 0x03, 0x13, 0xb7, 0x22, 0xc1, 0xca, 0x01, 0x8d, 0x4e, 0x5c,
+	0x90, 0xa0, 0x4a, 0x6d, 0x93, 0x46, 0x5d, 0xe2, 0x10, 0x3b, 0xe5, 0xcf, 0xab, 0xe0, 0x55, 0xa1,
+	0x1d, 0x77, 0xe4, 0x34, 0xa1, 0xed, 0x5d, 0x70, 0x42, 0x76, 0xec, 0xac, 0xdd, 0xba, 0x4a, 0x3d,
+	0x70, 0x5b, 0x7e, 0xbf, 0xef, 0xf7, 0xf3, 0xb5, 0xe7, 0x9f, 0x6b, 0xb0, 0x1d, 0x0c, 0xb0, 0x8b,
+	0x59, 0x42, 0x5d, 0x3c, 0xf4, 0xa2, 0x88, 0x1e, 0xbb, 0xe3, 0x5d, 0xd7, 0xa7, 0x11, 0xe5, 0x01,
+	0x77, 0xe2, 0x84, 0x09, 0x06, 0x37, 0x83, 0x01, 0x76, 0xa4, 0xc4, 0xd1, 0x12, 0x67, 0xbc, 0xdb,
+	0xf8, 0xdb, 0x67, 0x3e, 0x53, 0x7d, 0x57, 0xfe, 0x95, 0x49, 0x1b, 0x33, 0x69, 0xc6, 0x35, 0x47,
+	0x92, 0xc6, 0x7e, 0xe2, 0x11, 0x9a, 0x49, 0x5a, 0xdf, 0x01, 0xa8, 0xbd, 0xc8, 0x96, 0x70, 0x24,
+	0x3c, 0x41, 0xe1, 0x3b, 0x50, 0xd1, 0x62, 0x8e, 0x2c, 0xbb, 0xd8, 0xae, 0xee, 0xdd, 0x73, 0x66,
+	0x2c, 0xca, 0xe9, 0x12, 0x1a, 0x89, 0xe0, 0x43, 0x40, 0xc9, 0xb3, 0xac, 0xd8, 0xf9, 0xff, 0xe4,
+	0x6c, 0xab, 0xf0, 0xeb, 0x6c, 0x6b, 0xe3, 0x5a, 0xab, 0x97, 0x23, 0x61, 0x0f, 0xac, 0x7b, 0x78,
+	0x14, 0xb1, 0x4f, 0xc7, 0x94, 0xf8, 0x34, 0xa4, 0x91, 0xe0, 0x68, 0x49, 0xc5, 0xd8, 0x33, 0x63,
+	0x0e, 0x3d, 0x3c, 0xa2, 0x42, 0x2d, 0xad, 0x53, 0x92, 0x01, 0xbd, 0x6b, 0x7e, 0x78, 0x00, 0xaa,
+	0x98, 0x85, 0x61, 0x20, 0x32, 0x5c, 0x71, 0x21, 0xdc, 0xa4, 0x15, 0x76, 0x40, 0x25, 0xa1, 0x98,
+	0x06, 0xb1, 0xe0, 0xa8, 0xb4, 0x10, 0x26, 0xf7, 0xc1, 0x43, 0x50, 0xe7, 0x34, 0x22, 0x7d, 0x4e,
+	0x3f, 0xa6, 0x34, 0xc2, 0x94, 0xa3, 0xbf, 0x14, 0xe9, 0xee, 0x3c, 0x92, 0xd6, 0x6a, 0xd8, 0xaa,
+	0x04, 0x98, 0x9a, 0x22, 0x26, 0x14, 0x8f, 0x27, 0x88, 0xe5, 0x85, 0x89, 0x12, 0x70, 0x49, 0x7c,
+	0x05, 0x56, 0x3d, 0x3c, 0x9a, 0x00, 0x2e, 0x2f, 0x0a, 0xac, 0x79, 0x78, 0x74, 0xc9, 0xdb, 0x03,
+	0xff, 0x44, 0xf4, 0xb3, 0xe8, 0x6b, 0x57, 0x0e, 0x46, 0x15, 0xdb, 0x6a, 0x97, 0x7a, 0x9b, 0xb2,
+	0xa9, 0x67, 0xc1, 0x98, 0xe0, 0x3e, 0x28, 0xc7, 0x5e, 0xe2, 0x85, 0x1c, 0xad, 0xd8, 0x56, 0xbb,
+	0xba, 0x77, 0xeb, 0x86, 0x70, 0x29, 0xd1, 0xa1, 0xda, 0x00, 0x5f, 0x83, 0xb5, 0xd8, 0x4b, 0x39,
+	0x25, 0xfd, 0x7c, 0x54, 0x81, 0xda, 0x40, 0xeb, 0x06, 0x86, 0xd4, 0x9a, 0x31, 0xcd, 0x50, 0xf5,
+	0x78, 0xb2, 0xc8, 0xe1, 0x53, 0xb0, 0x1c, 0xab, 0x7d, 0x72, 0x54, 0xb5, 0x8b, 0x73, 0x96, 0x23,
+	0x35, 0x9a, 0x61, 0x1c, 0xf0, 0x25, 0xa8, 0xa9, 0x23, 0x1f, 0xd2, 0xc0, 0x1f, 0x0a, 0x8e, 0x6a,
+	0x8a, 0xb0, 0x3d, 0x87, 0x70, 0xa0, 0x94, 0x66, 0x04, 0xa5, 0x39, 0xab, 0x28, 0x96, 0x3a, 0x6c,
+	0xc3, 0x5a, 0x5d, 0x90, 0x25, 0xcd, 0x86, 0x75, 0x08, 0xd6, 0x44, 0x10, 0x52, 0x96, 0x8a, 0x1c,
+	0x57, 0x5f, 0x0c, 0x57, 0xd7, 0x7e, 0x43, 0x7c, 0x0f, 0x36, 0xf4, 0xef, 0x47, 0x9f, 0xe3, 0x21,
+	0x25, 0xe9, 0x31, 0xe5, 0x68, 0x4d, 0x31, 0xef, 0xcf, 0x64, 0xea, 0x7f, 0xf0, 0x9b, 0xcc, 0x74,
+	0xa4, 0x3d, 0xe6, 0x2a, 0xa7, 0xd3, 0x65, 0x0e, 0x63, 0xd0, 0x30, 0xdc, 0xfc, 0x70, 0xfb, 0x5a,
+	0xc5, 0xd1, 0xba, 0x0a, 0x7a, 0x30, 0x33, 0xc8, 0x30, 0xc8, 0x74, 0xa2, 0x4e, 0x42, 0x7c, 0x76,
+	0x9b, 0xc3, 0xe7, 0xc0, 0x9e, 0x1a, 0xdd, 0xab, 0xdb, 0xeb, 0x07, 0x04, 0x6d, 0xa8, 0x29, 0xbe,
+	0x3d, 0x31, 0xc5, 0x57, 0xf6, 0xd3, 0x25, 0x2d, 0x02, 0xea, 0xd3, 0x17, 0x05, 0xfe, 0x07, 0x96,
+	0x63, 0x96, 0x08, 0x09, 0xb0, 0x6c, 0xab, 0xbd, 0xd2, 0x2b, 0xcb, 0xcf, 0x2e, 0x81, 0x77, 0x00,
+	0x30, 0x69, 0x01, 0x41, 0x4b, 0xaa, 0xb7, 0xa2, 0x2b, 0x5d, 0x02, 0x1b, 0xa0, 0x92, 0xdf, 0x9f,
+	0xa2, 0x4a, 0xce, 0xbf, 0x5b, 0x5f, 0x41, 0x6d, 0xf2, 0x94, 0xfe, 0x44, 0x06, 0xfc, 0x17, 0x94,
+	0xb3, 0x69, 0x41, 0x25, 0xd5, 0xd1, 0x5f, 0x9d, 0xa3, 0x93, 0xf3, 0xa6, 0x75, 0x7a, 0xde, 0xb4,
+	0x7e, 0x9e, 0x37, 0xad, 0x6f, 0x17, 0xcd, 0xc2, 0xe9, 0x45, 0xb3, 0xf0, 0xe3, 0xa2, 0x59, 0x78,
+	0xbb, 0xef, 0x07, 0x62, 0x98, 0x0e, 0x1c, 0xcc, 0x42, 0x17, 0x33, 0x1e, 0x32, 0xee, 0x06, 0x03,
+	0xbc, 0xe3, 0x33, 0x77, 0xfc, 0xc4, 0x0d, 0x99, 0x3a, 0xda, 0xec, 0x1d, 0x7a, 0xf8, 0x78, 0xc7,
+	0x3c, 0x45, 0xe2, 0x4b, 0x4c, 0xf9, 0xa0, 0xac, 0x9e, 0xa1, 0x47, 0xbf, 0x07, 0x00, 0xc4, 0xe7,
+	0xd8, 0x7a, 0x1c, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -256,6 +409,113 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextChannelUpgradeScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelUpgradeScheduleId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ScheduledChannelUpgrades) > 0 {
+		for iNdEx := len(m.ScheduledChannelUpgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledChannelUpgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.UpgradeSchedules) > 0 {
+		for iNdEx := len(m.UpgradeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TimeoutHeights) > 0 {
+		for iNdEx := len(m.TimeoutHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RecvHeights) > 0 {
+		for iNdEx := len(m.RecvHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SendHeights) > 0 {
+		for iNdEx := len(m.SendHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -414,6 +674,53 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -478,6 +785,51 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PausedChannels) > 0 {
+		for _, e := range m.PausedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendHeights) > 0 {
+		for _, e := range m.SendHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecvHeights) > 0 {
+		for _, e := range m.RecvHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimeoutHeights) > 0 {
+		for _, e := range m.TimeoutHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UpgradeSchedules) > 0 {
+		for _, e := range m.UpgradeSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledChannelUpgrades) > 0 {
+		for _, e := range m.ScheduledChannelUpgrades {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextChannelUpgradeScheduleId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextChannelUpgradeScheduleId))
+	}
 	return n
 }
 
@@ -501,6 +853,29 @@ func (m *PacketSequence) Size() (n int) {
 	return n
 }
 
+func (m *PacketHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,11 +1201,268 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, PausedChannel{})
+			if err := m.PausedChannels[len(m.PausedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendHeights = append(m.SendHeights, PacketHeight{})
+			if err := m.SendHeights[len(m.SendHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvHeights = append(m.RecvHeights, PacketHeight{})
+			if err := m.RecvHeights[len(m.RecvHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutHeights = append(m.TimeoutHeights, PacketHeight{})
+			if err := m.TimeoutHeights[len(m.TimeoutHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeSchedules = append(m.UpgradeSchedules, ChannelUpgradeSchedule{})
+			if err := m.UpgradeSchedules[len(m.UpgradeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledChannelUpgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledChannelUpgrades = append(m.ScheduledChannelUpgrades, ScheduledChannelUpgrade{})
+			if err := m.ScheduledChannelUpgrades[len(m.ScheduledChannelUpgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelUpgradeScheduleId", wireType)
+			}
+			m.NextChannelUpgradeScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextChannelUpgradeScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
@@ -980,6 +1612,158 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
@@ -214,6 +215,109 @@ func TestValidateGenesis(t *testing.T) {
 			),
 			expPass: false,
 		},
+		{
+			name: "valid paused channel",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.PausedChannels = []types.PausedChannel{{PortId: testPort1, ChannelId: testChannel1, Height: 10}}
+				return gs
+			}(),
+			expPass: true,
+		},
+		{
+			name: "valid packet",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.Packets = []types.Packet{types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0)}
+				return gs
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid packet sequence",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.Packets = []types.Packet{types.NewPacket([]byte("data"), 0, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0)}
+				return gs
+			}(),
+			expPass: false,
+		},
+		{
+			name: "valid packet heights",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.SendHeights = []types.PacketHeight{types.NewPacketHeight(testPort1, testChannel1, 1, 10)}
+				gs.RecvHeights = []types.PacketHeight{types.NewPacketHeight(testPort2, testChannel2, 1, 10)}
+				gs.TimeoutHeights = []types.PacketHeight{types.NewPacketHeight(testPort1, testChannel1, 2, 12)}
+				return gs
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid timeout height sequence",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.TimeoutHeights = []types.PacketHeight{types.NewPacketHeight(testPort1, testChannel1, 0, 12)}
+				return gs
+			}(),
+			expPass: false,
+		},
+		{
+			name: "valid channel upgrade schedule",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				schedule := types.NewChannelUpgradeSchedule(10, testPort1, "", "", "version-2")
+				schedule.Id = 1
+				gs.UpgradeSchedules = []types.ChannelUpgradeSchedule{schedule}
+				gs.ScheduledChannelUpgrades = []types.ScheduledChannelUpgrade{types.NewScheduledChannelUpgrade(1, testPort1, testChannel1, 1)}
+				gs.NextChannelUpgradeScheduleId = 2
+				return gs
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid next channel upgrade schedule ID",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				schedule := types.NewChannelUpgradeSchedule(10, testPort1, "", "", "version-2")
+				schedule.Id = 1
+				gs.UpgradeSchedules = []types.ChannelUpgradeSchedule{schedule}
+				gs.NextChannelUpgradeScheduleId = 1
+				return gs
+			}(),
+			expPass: false,
+		},
+		{
+			name: "invalid channel upgrade schedule next channel key",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				schedule := types.NewChannelUpgradeSchedule(10, testPort1, "", "", "version-2")
+				schedule.Id = 1
+				schedule.NextChannelKey = []byte("invalid")
+				gs.UpgradeSchedules = []types.ChannelUpgradeSchedule{schedule}
+				gs.NextChannelUpgradeScheduleId = 2
+				return gs
+			}(),
+			expPass: false,
+		},
+		{
+			name: "invalid scheduled channel upgrade schedule ID",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.ScheduledChannelUpgrades = []types.ScheduledChannelUpgrade{types.NewScheduledChannelUpgrade(0, testPort1, testChannel1, 1)}
+				return gs
+			}(),
+			expPass: false,
+		},
+		{
+			name: "invalid paused channel identifier",
+			genState: func() types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.PausedChannels = []types.PausedChannel{{PortId: testPort1, ChannelId: "(invalidchannel)", Height: 10}}
+				return gs
+			}(),
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
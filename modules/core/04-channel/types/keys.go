@@ -26,11 +26,19 @@ const (
 	// the keeper.
 	KeyNextChannelSequence = "nextChannelSequence"
 
+	// KeyNextChannelUpgradeScheduleID is the key used to store the identifier of the next channel
+	// upgrade schedule in the keeper.
+	KeyNextChannelUpgradeScheduleID = "nextChannelUpgradeScheduleID"
+
 	// ChannelPrefix is the prefix used when creating a channel identifier
 	ChannelPrefix = "channel-"
 
 	// ParamsKey defines the key to store the params in the keeper.
 	ParamsKey = "channelParams"
+
+	// KeyPacketPruningCursor is the key used to store the channel end path of the last channel
+	// whose packet state was pruned at BeginBlock.
+	KeyPacketPruningCursor = "packetPruningCursor"
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
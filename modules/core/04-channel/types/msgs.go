@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/base64"
 	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -25,6 +26,9 @@ var (
 	_ sdk.Msg = (*MsgAcknowledgement)(nil)
 	_ sdk.Msg = (*MsgTimeout)(nil)
 	_ sdk.Msg = (*MsgTimeoutOnClose)(nil)
+	_ sdk.Msg = (*MsgRecvPackets)(nil)
+	_ sdk.Msg = (*MsgAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgTimeouts)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeInit)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeTry)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeAck)(nil)
@@ -32,6 +36,11 @@ var (
 	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgPrunePacketReceipts)(nil)
+	_ sdk.Msg = (*MsgChannelPause)(nil)
+	_ sdk.Msg = (*MsgChannelResume)(nil)
+	_ sdk.Msg = (*MsgChannelForceClose)(nil)
+	_ sdk.Msg = (*MsgScheduleChannelUpgrades)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgChannelOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelOpenTry)(nil)
@@ -43,6 +52,9 @@ var (
 	_ sdk.HasValidateBasic = (*MsgAcknowledgement)(nil)
 	_ sdk.HasValidateBasic = (*MsgTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgTimeoutOnClose)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecvPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgTimeouts)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTry)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeAck)(nil)
@@ -50,6 +62,11 @@ var (
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgPrunePacketReceipts)(nil)
+	_ sdk.HasValidateBasic = (*MsgChannelPause)(nil)
+	_ sdk.HasValidateBasic = (*MsgChannelResume)(nil)
+	_ sdk.HasValidateBasic = (*MsgChannelForceClose)(nil)
+	_ sdk.HasValidateBasic = (*MsgScheduleChannelUpgrades)(nil)
 )
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -316,7 +333,8 @@ func (msg MsgTimeout) ValidateBasic() error {
 	if len(msg.ProofUnreceived) == 0 {
 		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty unreceived proof")
 	}
-	if msg.NextSequenceRecv == 0 {
+	// the next sequence receive is not used to time out packets routed by client identifier
+	if msg.NextSequenceRecv == 0 && msg.Packet.ProtocolVersion != IBC_VERSION_2 {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "next sequence receive cannot be 0")
 	}
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
@@ -393,6 +411,122 @@ func (msg MsgAcknowledgement) ValidateBasic() error {
 	return msg.Packet.ValidateBasic()
 }
 
+// NewMsgRecvPackets constructs a new MsgRecvPackets
+func NewMsgRecvPackets(
+	packets []Packet, commitmentProofs [][]byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofsCommitment: commitmentProofs,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPackets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validatePacketBatch(msg.Packets, msg.ProofsCommitment)
+}
+
+// NewMsgTimeouts constructs a new MsgTimeouts
+func NewMsgTimeouts(
+	packets []Packet, nextSequenceRecv uint64, unreceivedProofs [][]byte,
+	proofHeight clienttypes.Height, signer string,
+) *MsgTimeouts {
+	return &MsgTimeouts{
+		Packets:          packets,
+		NextSequenceRecv: nextSequenceRecv,
+		ProofsUnreceived: unreceivedProofs,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTimeouts) ValidateBasic() error {
+	if err := validatePacketBatch(msg.Packets, msg.ProofsUnreceived); err != nil {
+		return err
+	}
+	// the next sequence receive is not used to time out packets routed by client identifier
+	if msg.NextSequenceRecv == 0 && msg.Packets[0].ProtocolVersion != IBC_VERSION_2 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "next sequence receive cannot be 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgAcknowledgements constructs a new MsgAcknowledgements
+func NewMsgAcknowledgements(
+	packets []Packet,
+	acks, ackedProofs [][]byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofsAcked:      ackedProofs,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgements) ValidateBasic() error {
+	if err := validatePacketBatch(msg.Packets, msg.ProofsAcked); err != nil {
+		return err
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements must be equal to the number of packets (%d ≠ %d)", len(msg.Acknowledgements), len(msg.Packets))
+	}
+	for i, ack := range msg.Acknowledgements {
+		if len(ack) == 0 {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgement, "ack bytes cannot be empty for packet at index %d", i)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// validatePacketBatch performs basic checks on a batch of packets, each packet must be provided with a
+// non-empty proof and all packets must have been sent on the same channel.
+func validatePacketBatch(packets []Packet, proofs [][]byte) error {
+	if len(packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets cannot be empty")
+	}
+	if len(proofs) != len(packets) {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of proofs must be equal to the number of packets (%d ≠ %d)", len(proofs), len(packets))
+	}
+
+	first := packets[0]
+	for i, packet := range packets {
+		if len(proofs[i]) == 0 {
+			return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof for packet at index %d", i)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+		if packet.SourcePort != first.SourcePort || packet.SourceChannel != first.SourceChannel ||
+			packet.DestinationPort != first.DestinationPort || packet.DestinationChannel != first.DestinationChannel ||
+			packet.ProtocolVersion != first.ProtocolVersion {
+			return errorsmod.Wrapf(ErrInvalidPacket, "packet at index %d is not sent on the same channel as the first packet", i)
+		}
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgChannelUpgradeInit{}
 
 // NewMsgChannelUpgradeInit constructs a new MsgChannelUpgradeInit
@@ -774,3 +908,155 @@ func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgPrunePacketReceipts creates a new instance of MsgPrunePacketReceipts.
+func NewMsgPrunePacketReceipts(
+	portID, channelID string, counterpartyCommitmentStartSequence uint64,
+	commitmentStartSequenceProof []byte, proofHeight clienttypes.Height,
+	limit uint64, signer string,
+) *MsgPrunePacketReceipts {
+	return &MsgPrunePacketReceipts{
+		PortId:                              portID,
+		ChannelId:                           channelID,
+		CounterpartyCommitmentStartSequence: counterpartyCommitmentStartSequence,
+		ProofCommitmentStartSequence:        commitmentStartSequenceProof,
+		ProofHeight:                         proofHeight,
+		Limit:                               limit,
+		Signer:                              signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPrunePacketReceipts.
+func (msg *MsgPrunePacketReceipts) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if msg.CounterpartyCommitmentStartSequence == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "counterparty commitment start sequence cannot be 0")
+	}
+
+	if len(msg.ProofCommitmentStartSequence) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty commitment start sequence proof")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgChannelPause creates a new MsgChannelPause instance
+func NewMsgChannelPause(portID, channelID, signer string) *MsgChannelPause {
+	return &MsgChannelPause{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelPause) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgChannelResume creates a new MsgChannelResume instance
+func NewMsgChannelResume(portID, channelID, signer string) *MsgChannelResume {
+	return &MsgChannelResume{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelResume) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgChannelForceClose creates a new MsgChannelForceClose instance
+func NewMsgChannelForceClose(portID, channelID, signer string) *MsgChannelForceClose {
+	return &MsgChannelForceClose{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelForceClose) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgScheduleChannelUpgrades creates a new MsgScheduleChannelUpgrades instance
+func NewMsgScheduleChannelUpgrades(height uint64, portID, connectionID, version, upgradeVersion, signer string) *MsgScheduleChannelUpgrades {
+	return &MsgScheduleChannelUpgrades{
+		Height:         height,
+		PortId:         portID,
+		ConnectionId:   connectionID,
+		Version:        version,
+		UpgradeVersion: upgradeVersion,
+		Signer:         signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgScheduleChannelUpgrades) ValidateBasic() error {
+	if msg.Height == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidHeight, "schedule height cannot be zero")
+	}
+	if msg.PortId != "" {
+		if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+			return errorsmod.Wrap(err, "invalid port ID")
+		}
+	}
+	if msg.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+			return errorsmod.Wrap(err, "invalid connection ID")
+		}
+	}
+	if strings.TrimSpace(msg.UpgradeVersion) == "" {
+		return errorsmod.Wrap(ErrInvalidChannelVersion, "upgrade version cannot be empty")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
@@ -74,7 +74,8 @@ var (
 	emptyAddr string
 
 	connHops             = []string{"testconnection"}
-	invalidConnHops      = []string{"testconnection", "testconnection"}
+	multihopConnHops     = []string{"testconnection", "testconnection"}
+	emptyConnHops        = []string{}
 	invalidShortConnHops = []string{invalidShortConnection}
 	invalidLongConnHops  = []string{invalidLongConnection}
 )
@@ -176,17 +177,19 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		},
 		{
 			"invalid channel order",
-			types.NewMsgChannelOpenInit(portid, version, types.Order(3),
+			types.NewMsgChannelOpenInit(portid, version, types.Order(4),
 				connHops, cpportid, addr),
-			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(3).String()),
+			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(4).String()),
 		},
 		{
-			"connection hops more than 1 ",
-			types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr),
-			errorsmod.Wrap(
-				types.ErrTooManyConnectionHops,
-				"current IBC version only supports one connection hop",
-			),
+			"success: multi-hop connection hops",
+			types.NewMsgChannelOpenInit(portid, version, types.ORDERED, multihopConnHops, cpportid, addr),
+			nil,
+		},
+		{
+			"empty connection hops",
+			types.NewMsgChannelOpenInit(portid, version, types.ORDERED, emptyConnHops, cpportid, addr),
+			errorsmod.Wrap(types.ErrInvalidChannel, "connection hops cannot be empty"),
 		},
 		{
 			"too short connection id",
@@ -334,12 +337,14 @@ func (suite *TypesTestSuite) TestMsgChannelOpenTryValidateBasic() {
 			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(4).String()),
 		},
 		{
-			"connection hops more than 1 ",
-			types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidConnHops, cpportid, cpchanid, version, suite.proof, height, addr),
-			errorsmod.Wrap(
-				types.ErrTooManyConnectionHops,
-				"current IBC version only supports one connection hop",
-			),
+			"success: multi-hop connection hops",
+			types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, multihopConnHops, cpportid, cpchanid, version, suite.proof, height, addr),
+			nil,
+		},
+		{
+			"empty connection hops",
+			types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, emptyConnHops, cpportid, cpchanid, version, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidChannel, "connection hops cannot be empty"),
 		},
 		{
 			"too short connection id",
@@ -1092,6 +1097,174 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementGetSigners() {
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	secondPacket := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, "channel-1", cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRecvPackets
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgRecvPackets([]types.Packet{packet, secondPacket}, [][]byte{suite.proof, suite.proof}, height, addr),
+			nil,
+		},
+		{
+			"missing signer address",
+			types.NewMsgRecvPackets([]types.Packet{packet}, [][]byte{suite.proof}, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"empty packets",
+			types.NewMsgRecvPackets(nil, nil, height, addr),
+			errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty"),
+		},
+		{
+			"mismatched number of proofs",
+			types.NewMsgRecvPackets([]types.Packet{packet, secondPacket}, [][]byte{suite.proof}, height, addr),
+			errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of proofs must be equal to the number of packets (%d ≠ %d)", 1, 2),
+		},
+		{
+			"empty proof",
+			types.NewMsgRecvPackets([]types.Packet{packet, secondPacket}, [][]byte{suite.proof, emptyProof}, height, addr),
+			errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof for packet at index %d", 1),
+		},
+		{
+			"invalid packet",
+			types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, [][]byte{suite.proof, suite.proof}, height, addr),
+			errorsmod.Wrapf(errorsmod.Wrap(types.ErrInvalidPacket, "packet sequence cannot be 0"), "invalid packet at index %d", 1),
+		},
+		{
+			"packets sent on different channels",
+			types.NewMsgRecvPackets([]types.Packet{packet, otherChannelPacket}, [][]byte{suite.proof, suite.proof}, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidPacket, "packet at index %d is not sent on the same channel as the first packet", 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgTimeoutsValidateBasic() {
+	secondPacket := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgTimeouts
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgTimeouts([]types.Packet{packet, secondPacket}, 1, [][]byte{suite.proof, suite.proof}, height, addr),
+			nil,
+		},
+		{
+			"seq 0",
+			types.NewMsgTimeouts([]types.Packet{packet}, 0, [][]byte{suite.proof}, height, addr),
+			errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "next sequence receive cannot be 0"),
+		},
+		{
+			"missing signer address",
+			types.NewMsgTimeouts([]types.Packet{packet}, 1, [][]byte{suite.proof}, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"empty packets",
+			types.NewMsgTimeouts(nil, 1, nil, height, addr),
+			errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty"),
+		},
+		{
+			"empty proof",
+			types.NewMsgTimeouts([]types.Packet{packet}, 1, [][]byte{emptyProof}, height, addr),
+			errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof for packet at index %d", 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	secondPacket := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	ack := []byte("ack")
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAcknowledgements
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgAcknowledgements([]types.Packet{packet, secondPacket}, [][]byte{ack, ack}, [][]byte{suite.proof, suite.proof}, height, addr),
+			nil,
+		},
+		{
+			"missing signer address",
+			types.NewMsgAcknowledgements([]types.Packet{packet}, [][]byte{ack}, [][]byte{suite.proof}, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"mismatched number of acknowledgements",
+			types.NewMsgAcknowledgements([]types.Packet{packet, secondPacket}, [][]byte{ack}, [][]byte{suite.proof, suite.proof}, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements must be equal to the number of packets (%d ≠ %d)", 1, 2),
+		},
+		{
+			"empty acknowledgement",
+			types.NewMsgAcknowledgements([]types.Packet{packet, secondPacket}, [][]byte{ack, {}}, [][]byte{suite.proof, suite.proof}, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "ack bytes cannot be empty for packet at index %d", 1),
+		},
+		{
+			"empty proof",
+			types.NewMsgAcknowledgements([]types.Packet{packet}, [][]byte{ack}, [][]byte{emptyProof}, height, addr),
+			errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof for packet at index %d", 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgChannelUpgradeInitValidateBasic() {
 	var msg *types.MsgChannelUpgradeInit
 
@@ -1861,6 +2034,81 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgPrunePacketReceiptsValidateBasic() {
+	var msg *types.MsgPrunePacketReceipts
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: zero pruning limit",
+			func() {
+				msg.Limit = 0
+			},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"zero counterparty commitment start sequence",
+			func() {
+				msg.CounterpartyCommitmentStartSequence = 0
+			},
+			ibcerrors.ErrInvalidSequence,
+		},
+		{
+			"empty proof",
+			func() {
+				msg.ProofCommitmentStartSequence = emptyProof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgPrunePacketReceipts(ibctesting.MockPort, ibctesting.FirstChannelID, 1, suite.proof, height, 1, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 	var msg *types.MsgUpdateParams
 
@@ -1896,6 +2144,27 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
+		{
+			"success: packet data ports",
+			func() {
+				msg.Params.PacketDataPorts = []string{ibctesting.MockPort, ibctesting.TransferPort}
+			},
+			nil,
+		},
+		{
+			"invalid params: invalid packet data port",
+			func() {
+				msg.Params.PacketDataPorts = []string{invalidPort}
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid params: duplicate packet data port",
+			func() {
+				msg.Params.PacketDataPorts = []string{ibctesting.MockPort, ibctesting.MockPort}
+			},
+			host.ErrInvalidID,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1927,3 +2196,143 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsGetSigners() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
+
+func (suite *TypesTestSuite) TestMsgChannelPauseResumeForceCloseValidateBasic() {
+	var portID, channelID, signer string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				portID = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				channelID = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty signer address",
+			func() {
+				signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			portID, channelID, signer = ibctesting.MockPort, ibctesting.FirstChannelID, addr
+
+			tc.malleate()
+
+			msgs := []sdk.HasValidateBasic{
+				types.NewMsgChannelPause(portID, channelID, signer),
+				types.NewMsgChannelResume(portID, channelID, signer),
+				types.NewMsgChannelForceClose(portID, channelID, signer),
+			}
+
+			for _, msg := range msgs {
+				err := msg.ValidateBasic()
+
+				expPass := tc.expErr == nil
+				if expPass {
+					suite.Require().NoError(err)
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgScheduleChannelUpgradesValidateBasic() {
+	var msg *types.MsgScheduleChannelUpgrades
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty filters",
+			func() {
+				msg.PortId = ""
+				msg.ConnectionId = ""
+				msg.Version = ""
+			},
+			nil,
+		},
+		{
+			"zero height",
+			func() {
+				msg.Height = 0
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.ConnectionId = invalidConnection
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"empty upgrade version",
+			func() {
+				msg.UpgradeVersion = "  "
+			},
+			types.ErrInvalidChannelVersion,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgScheduleChannelUpgrades(100, ibctesting.MockPort, ibctesting.FirstConnectionID, mock.Version, mock.UpgradeVersion, addr)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
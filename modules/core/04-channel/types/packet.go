@@ -13,11 +13,22 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+// TimeoutReceipt is the packet receipt value written when a packet is received
+// after its timeout has elapsed on an ORDERED_ALLOW_TIMEOUT channel. It is
+// distinct from the receipt written for received packets on UNORDERED channels
+// so that the sending chain can prove that the packet was never executed.
+var TimeoutReceipt = []byte{byte(2)}
+
 // CommitPacket returns the packet commitment bytes. The commitment consists of:
 // sha256_hash(timeout_timestamp + timeout_height.RevisionNumber + timeout_height.RevisionHeight + sha256_hash(data))
 // from a given packet. This results in a fixed length preimage.
 // NOTE: sdk.Uint64ToBigEndian sets the uint64 to a slice of length 8.
+// Packets routed by client identifier are committed using commitV2Packet.
 func CommitPacket(cdc codec.BinaryCodec, packet Packet) []byte {
+	if packet.ProtocolVersion == IBC_VERSION_2 {
+		return commitV2Packet(packet)
+	}
+
 	timeoutHeight := packet.GetTimeoutHeight()
 
 	buf := sdk.Uint64ToBigEndian(packet.GetTimeoutTimestamp())
@@ -35,6 +46,40 @@ func CommitPacket(cdc codec.BinaryCodec, packet Packet) []byte {
 	return hash[:]
 }
 
+// commitV2Packet returns the commitment bytes of a packet routed by client identifier. Unlike channel
+// packets, the ports and identifiers of these packets are not fixed by a channel end, so the commitment
+// also binds them together with the protocol version:
+// sha256_hash(timeout_timestamp + timeout_height.RevisionNumber + timeout_height.RevisionHeight + sha256_hash(data) +
+// sha256_hash(source_port) + sha256_hash(source_client) + sha256_hash(destination_port) + sha256_hash(destination_client) +
+// protocol_version)
+func commitV2Packet(packet Packet) []byte {
+	timeoutHeight := packet.GetTimeoutHeight()
+
+	buf := sdk.Uint64ToBigEndian(packet.GetTimeoutTimestamp())
+
+	revisionNumber := sdk.Uint64ToBigEndian(timeoutHeight.GetRevisionNumber())
+	buf = append(buf, revisionNumber...)
+
+	revisionHeight := sdk.Uint64ToBigEndian(timeoutHeight.GetRevisionHeight())
+	buf = append(buf, revisionHeight...)
+
+	for _, bz := range [][]byte{
+		packet.GetData(),
+		[]byte(packet.GetSourcePort()),
+		[]byte(packet.GetSourceChannel()),
+		[]byte(packet.GetDestPort()),
+		[]byte(packet.GetDestChannel()),
+	} {
+		hash := sha256.Sum256(bz)
+		buf = append(buf, hash[:]...)
+	}
+
+	buf = append(buf, sdk.Uint64ToBigEndian(uint64(packet.ProtocolVersion))...)
+
+	hash := sha256.Sum256(buf)
+	return hash[:]
+}
+
 // CommitAcknowledgement returns the hash of commitment bytes
 func CommitAcknowledgement(data []byte) []byte {
 	hash := sha256.Sum256(data)
@@ -93,11 +138,21 @@ func (p Packet) ValidateBasic() error {
 	if err := host.PortIdentifierValidator(p.DestinationPort); err != nil {
 		return errorsmod.Wrap(err, "invalid destination port ID")
 	}
-	if err := host.ChannelIdentifierValidator(p.SourceChannel); err != nil {
-		return errorsmod.Wrap(err, "invalid source channel ID")
-	}
-	if err := host.ChannelIdentifierValidator(p.DestinationChannel); err != nil {
-		return errorsmod.Wrap(err, "invalid destination channel ID")
+	// packets routed by client identifier use the source and destination client identifiers in place of channel identifiers
+	if p.ProtocolVersion == IBC_VERSION_2 {
+		if err := host.ClientIdentifierValidator(p.SourceChannel); err != nil {
+			return errorsmod.Wrap(err, "invalid source client ID")
+		}
+		if err := host.ClientIdentifierValidator(p.DestinationChannel); err != nil {
+			return errorsmod.Wrap(err, "invalid destination client ID")
+		}
+	} else {
+		if err := host.ChannelIdentifierValidator(p.SourceChannel); err != nil {
+			return errorsmod.Wrap(err, "invalid source channel ID")
+		}
+		if err := host.ChannelIdentifierValidator(p.DestinationChannel); err != nil {
+			return errorsmod.Wrap(err, "invalid destination channel ID")
+		}
 	}
 	if p.Sequence == 0 {
 		return errorsmod.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
@@ -23,6 +23,21 @@ func TestCommitPacket(t *testing.T) {
 
 	commitment := types.CommitPacket(cdc, packet)
 	require.NotNil(t, commitment)
+
+	// packets routed by client identifier commit to the ports and identifiers of the packet
+	v2Packet := types.NewPacket(validPacketData, 1, portid, "07-tendermint-0", cpportid, "07-tendermint-1", timeoutHeight, timeoutTimestamp)
+	v2Packet.ProtocolVersion = types.IBC_VERSION_2
+
+	v2Commitment := types.CommitPacket(cdc, v2Packet)
+	require.NotEqual(t, commitment, v2Commitment)
+
+	redirected := v2Packet
+	redirected.DestinationPort = "redirected"
+	require.NotEqual(t, v2Commitment, types.CommitPacket(cdc, redirected))
+
+	v1Packet := v2Packet
+	v1Packet.ProtocolVersion = types.IBC_VERSION_1
+	require.NotEqual(t, v2Commitment, types.CommitPacket(cdc, v1Packet))
 }
 
 func TestPacketValidateBasic(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // DefaultTimeout defines a default parameter for the channel upgrade protocol.
@@ -33,5 +34,18 @@ func (p Params) Validate() error {
 	if p.UpgradeTimeout.Timestamp == 0 {
 		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
 	}
+
+	seenPorts := make(map[string]bool)
+	for _, portID := range p.PacketDataPorts {
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			return errorsmod.Wrap(err, "invalid packet data port")
+		}
+
+		if seenPorts[portID] {
+			return errorsmod.Wrapf(host.ErrInvalidID, "duplicate packet data port: %s", portID)
+		}
+		seenPorts[portID] = true
+	}
+
 	return nil
 }
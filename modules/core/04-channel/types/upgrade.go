@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"slices"
@@ -9,6 +10,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // NewUpgrade creates a new Upgrade instance.
@@ -126,3 +128,88 @@ func (u *UpgradeError) GetErrorReceipt() ErrorReceipt {
 func IsUpgradeError(err error) bool {
 	return errors.Is(err, &UpgradeError{})
 }
+
+// NewChannelUpgradeSchedule creates a new ChannelUpgradeSchedule instance.
+func NewChannelUpgradeSchedule(height uint64, portID, connectionID, version, upgradeVersion string) ChannelUpgradeSchedule {
+	return ChannelUpgradeSchedule{
+		Height:         height,
+		PortId:         portID,
+		ConnectionId:   connectionID,
+		Version:        version,
+		UpgradeVersion: upgradeVersion,
+	}
+}
+
+// Matches returns true if the channel is OPEN and matches the port, connection and version filters of
+// the schedule. Empty filters match all channels.
+func (s ChannelUpgradeSchedule) Matches(channel IdentifiedChannel) bool {
+	if channel.State != OPEN {
+		return false
+	}
+
+	if s.PortId != "" && s.PortId != channel.PortId {
+		return false
+	}
+
+	if s.ConnectionId != "" && (len(channel.ConnectionHops) == 0 || s.ConnectionId != channel.ConnectionHops[0]) {
+		return false
+	}
+
+	return s.Version == "" || s.Version == channel.Version
+}
+
+// NewScheduledChannelUpgrade returns the ScheduledChannelUpgrade of a channel for which an upgrade has been initiated.
+func NewScheduledChannelUpgrade(scheduleID uint64, portID, channelID string, upgradeSequence uint64) ScheduledChannelUpgrade {
+	return ScheduledChannelUpgrade{
+		ScheduleId:      scheduleID,
+		PortId:          portID,
+		ChannelId:       channelID,
+		UpgradeSequence: upgradeSequence,
+		Status:          IN_PROGRESS,
+	}
+}
+
+// NewFailedScheduledChannelUpgrade returns the ScheduledChannelUpgrade of a channel for which an upgrade could
+// not be initiated. As for error receipts, only the ABCI code of the error is recorded.
+func NewFailedScheduledChannelUpgrade(scheduleID uint64, portID, channelID string, err error) ScheduledChannelUpgrade {
+	_, code, _ := errorsmod.ABCIInfo(err, false) // discard non-deterministic codespace and log values
+	return ScheduledChannelUpgrade{
+		ScheduleId: scheduleID,
+		PortId:     portID,
+		ChannelId:  channelID,
+		Status:     FAILED,
+		Error:      fmt.Sprintf("ABCI code: %d: %s", code, "channel upgrade could not be initiated"),
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (s ChannelUpgradeSchedule) Validate() error {
+	if s.Id == 0 {
+		return errors.New("schedule ID cannot be zero")
+	}
+
+	if strings.TrimSpace(s.UpgradeVersion) == "" {
+		return errors.New("upgrade version cannot be empty")
+	}
+
+	if len(s.NextChannelKey) != 0 && !bytes.HasPrefix(s.NextChannelKey, []byte(host.KeyChannelEndPrefix)) {
+		return errors.New("next channel key must be a channel end store key")
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (u ScheduledChannelUpgrade) Validate() error {
+	if u.ScheduleId == 0 {
+		return errors.New("schedule ID cannot be zero")
+	}
+
+	if err := host.PortIdentifierValidator(u.PortId); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(u.ChannelId)
+}
@@ -23,6 +23,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ScheduledUpgradeStatus defines the status of a channel upgrade initiated by a channel upgrade schedule.
+type ScheduledUpgradeStatus int32
+
+const (
+	// Default zero value enumeration
+	SCHEDULED_UPGRADE_STATUS_UNSPECIFIED ScheduledUpgradeStatus = 0
+	// The upgrade handshake could not be initiated
+	FAILED ScheduledUpgradeStatus = 1
+	// The upgrade handshake has been initiated and is in progress
+	IN_PROGRESS ScheduledUpgradeStatus = 2
+	// The upgrade handshake has completed and the channel is open with the upgraded parameters
+	COMPLETED ScheduledUpgradeStatus = 3
+	// The upgrade handshake has been cancelled and an error receipt has been written
+	CANCELLED ScheduledUpgradeStatus = 4
+)
+
+var ScheduledUpgradeStatus_name = map[int32]string{
+	0: "SCHEDULED_UPGRADE_STATUS_UNSPECIFIED",
+	1: "SCHEDULED_UPGRADE_STATUS_FAILED",
+	2: "SCHEDULED_UPGRADE_STATUS_IN_PROGRESS",
+	3: "SCHEDULED_UPGRADE_STATUS_COMPLETED",
+	4: "SCHEDULED_UPGRADE_STATUS_CANCELLED",
+}
+
+var ScheduledUpgradeStatus_value = map[string]int32{
+	"SCHEDULED_UPGRADE_STATUS_UNSPECIFIED": 0,
+	"SCHEDULED_UPGRADE_STATUS_FAILED":      1,
+	"SCHEDULED_UPGRADE_STATUS_IN_PROGRESS": 2,
+	"SCHEDULED_UPGRADE_STATUS_COMPLETED":   3,
+	"SCHEDULED_UPGRADE_STATUS_CANCELLED":   4,
+}
+
+func (x ScheduledUpgradeStatus) String() string {
+	return proto.EnumName(ScheduledUpgradeStatus_name, int32(x))
+}
+
+func (ScheduledUpgradeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{0}
+}
+
 // Upgrade is a verifiable type which contains the relevant information
 // for an attempted upgrade. It provides the proposed changes to the channel
 // end, the timeout for this upgrade attempt and the next packet sequence
@@ -151,42 +191,176 @@ func (m *ErrorReceipt) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ErrorReceipt proto.InternalMessageInfo
 
+// ChannelUpgradeSchedule defines a set of channel upgrades scheduled by the authority. At the BeginBlock of
+// the scheduled height, an upgrade handshake to the upgrade version is initiated for every OPEN channel
+// matching the port, connection and version filters. Empty filters match all channels.
+type ChannelUpgradeSchedule struct {
+	// the unique identifier of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the block height at which the channel upgrades are initiated
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the port identifier of the channels to upgrade
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the identifier of the connection of the channels to upgrade
+	ConnectionId string `protobuf:"bytes,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// the current version of the channels to upgrade
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// the version proposed in the upgrade of each channel
+	UpgradeVersion string `protobuf:"bytes,6,opt,name=upgrade_version,json=upgradeVersion,proto3" json:"upgrade_version,omitempty"`
+	// executed is true once the schedule has been executed
+	Executed bool `protobuf:"varint,8,opt,name=executed,proto3" json:"executed,omitempty"`
+	// the number of channel upgrades initiated by the schedule
+	UpgradesInitiated uint64 `protobuf:"varint,9,opt,name=upgrades_initiated,json=upgradesInitiated,proto3" json:"upgrades_initiated,omitempty"`
+	// the number of channel upgrades which could not be initiated by the schedule
+	UpgradesFailed uint64 `protobuf:"varint,10,opt,name=upgrades_failed,json=upgradesFailed,proto3" json:"upgrades_failed,omitempty"`
+	// the store key of the next channel to consider while the schedule is being executed over several blocks
+	NextChannelKey []byte `protobuf:"bytes,11,opt,name=next_channel_key,json=nextChannelKey,proto3" json:"next_channel_key,omitempty"`
+}
+
+func (m *ChannelUpgradeSchedule) Reset()         { *m = ChannelUpgradeSchedule{} }
+func (m *ChannelUpgradeSchedule) String() string { return proto.CompactTextString(m) }
+func (*ChannelUpgradeSchedule) ProtoMessage()    {}
+func (*ChannelUpgradeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{3}
+}
+func (m *ChannelUpgradeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelUpgradeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelUpgradeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelUpgradeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelUpgradeSchedule.Merge(m, src)
+}
+func (m *ChannelUpgradeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelUpgradeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelUpgradeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelUpgradeSchedule proto.InternalMessageInfo
+
+// ScheduledChannelUpgrade defines the channel upgrade initiated for a channel by a channel upgrade schedule.
+type ScheduledChannelUpgrade struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the channel upgrade sequence of the upgrade initiated
+	UpgradeSequence uint64 `protobuf:"varint,3,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// the status of the upgrade
+	Status ScheduledUpgradeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ibc.core.channel.v1.ScheduledUpgradeStatus" json:"status,omitempty"`
+	// the error message detailing why the upgrade could not be initiated or has been cancelled
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// the identifier of the channel upgrade schedule which initiated the upgrade
+	ScheduleId uint64 `protobuf:"varint,6,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+}
+
+func (m *ScheduledChannelUpgrade) Reset()         { *m = ScheduledChannelUpgrade{} }
+func (m *ScheduledChannelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*ScheduledChannelUpgrade) ProtoMessage()    {}
+func (*ScheduledChannelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{4}
+}
+func (m *ScheduledChannelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledChannelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledChannelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledChannelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledChannelUpgrade.Merge(m, src)
+}
+func (m *ScheduledChannelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledChannelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledChannelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledChannelUpgrade proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("ibc.core.channel.v1.ScheduledUpgradeStatus", ScheduledUpgradeStatus_name, ScheduledUpgradeStatus_value)
 	proto.RegisterType((*Upgrade)(nil), "ibc.core.channel.v1.Upgrade")
 	proto.RegisterType((*UpgradeFields)(nil), "ibc.core.channel.v1.UpgradeFields")
 	proto.RegisterType((*ErrorReceipt)(nil), "ibc.core.channel.v1.ErrorReceipt")
+	proto.RegisterType((*ChannelUpgradeSchedule)(nil), "ibc.core.channel.v1.ChannelUpgradeSchedule")
+	proto.RegisterType((*ScheduledChannelUpgrade)(nil), "ibc.core.channel.v1.ScheduledChannelUpgrade")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/upgrade.proto", fileDescriptor_fb1cef68588848b2) }
 
 var fileDescriptor_fb1cef68588848b2 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0xad, 0x5a, 0x57, 0x03, 0x03, 0x19, 0x0e, 0x51, 0x85, 0xb2, 0xd2, 0x0b, 0x3d,
-	0xb0, 0x98, 0x0d, 0x84, 0x00, 0x71, 0x40, 0x93, 0x40, 0x88, 0x0b, 0x92, 0x0b, 0x17, 0x2e, 0x55,
-	0xe3, 0x3c, 0x52, 0x4b, 0x8d, 0x5f, 0xb0, 0x9d, 0x08, 0xbe, 0x01, 0xc7, 0x7d, 0x04, 0xbe, 0x08,
-	0xf7, 0x1d, 0x77, 0xe4, 0x84, 0x50, 0xfb, 0x45, 0x50, 0x9c, 0xa4, 0x68, 0x52, 0x6e, 0x7e, 0x7e,
-	0xbf, 0xff, 0xdf, 0xff, 0xe7, 0x47, 0x1f, 0xa8, 0x44, 0x72, 0x89, 0x06, 0xb8, 0x5c, 0x2d, 0xb5,
-	0x86, 0x35, 0xaf, 0x4e, 0x79, 0x59, 0x64, 0x66, 0x99, 0x42, 0x5c, 0x18, 0x74, 0xc8, 0xee, 0xaa,
-	0x44, 0xc6, 0x35, 0x12, 0xb7, 0x48, 0x5c, 0x9d, 0x8e, 0xef, 0x65, 0x98, 0xa1, 0xef, 0xf3, 0xfa,
-	0xd4, 0xa0, 0xe3, 0x5e, 0xb7, 0x4e, 0xe5, 0x91, 0xe9, 0x2f, 0x42, 0x87, 0x9f, 0x1a, 0x7f, 0xf6,
-	0x9a, 0x1e, 0x7c, 0x51, 0xb0, 0x4e, 0x6d, 0x48, 0x26, 0x64, 0x76, 0xe3, 0x6c, 0x1a, 0xf7, 0x3c,
-	0x15, 0xb7, 0xf4, 0x5b, 0x4f, 0x9e, 0x0f, 0x2e, 0xff, 0x1c, 0x07, 0xa2, 0xd5, 0xb1, 0x57, 0x74,
-	0xe8, 0x54, 0x0e, 0x58, 0xba, 0x70, 0xcf, 0x5b, 0xdc, 0xef, 0xb5, 0xf8, 0xd8, 0x30, 0xad, 0xb8,
-	0x93, 0xb0, 0x47, 0x94, 0x69, 0xf8, 0xe6, 0x16, 0x16, 0xbe, 0x96, 0xa0, 0x25, 0x2c, 0x2c, 0xe8,
-	0x34, 0xdc, 0x9f, 0x90, 0xd9, 0x40, 0xdc, 0xa9, 0x3b, 0xf3, 0xb6, 0x31, 0x07, 0x9d, 0xbe, 0x1c,
-	0xfc, 0xf8, 0x79, 0x1c, 0x4c, 0x2f, 0x08, 0xbd, 0x75, 0x2d, 0x11, 0x7b, 0x46, 0x0f, 0xd1, 0xa4,
-	0x60, 0x94, 0xce, 0xfc, 0x1c, 0x47, 0x67, 0xe3, 0xde, 0x10, 0x1f, 0x6a, 0x48, 0xec, 0x58, 0xf6,
-	0x90, 0xde, 0x96, 0xa8, 0x35, 0x48, 0xa7, 0x50, 0x2f, 0x56, 0x58, 0xd8, 0x70, 0x6f, 0xb2, 0x3f,
-	0x1b, 0x89, 0xa3, 0xff, 0xd7, 0xef, 0xb0, 0xb0, 0x2c, 0xa4, 0xc3, 0x0a, 0x8c, 0x55, 0xa8, 0x7d,
-	0xb6, 0x91, 0xe8, 0xca, 0x36, 0xd2, 0x7b, 0x7a, 0xf3, 0x8d, 0x31, 0x68, 0x04, 0x48, 0x50, 0x85,
-	0x63, 0x63, 0x7a, 0xd8, 0x4d, 0xe4, 0x03, 0x0d, 0xc4, 0xae, 0xae, 0xbd, 0x72, 0xb0, 0x76, 0x99,
-	0x81, 0xff, 0xb0, 0x91, 0xe8, 0xca, 0xc6, 0xeb, 0x7c, 0x7e, 0xb9, 0x89, 0xc8, 0xd5, 0x26, 0x22,
-	0x7f, 0x37, 0x11, 0xb9, 0xd8, 0x46, 0xc1, 0xd5, 0x36, 0x0a, 0x7e, 0x6f, 0xa3, 0xe0, 0xf3, 0x8b,
-	0x4c, 0xb9, 0x55, 0x99, 0xc4, 0x12, 0x73, 0x2e, 0xd1, 0xe6, 0x68, 0xb9, 0x4a, 0xe4, 0x49, 0x86,
-	0xbc, 0x7a, 0xce, 0x73, 0x4c, 0xcb, 0x35, 0xd8, 0x66, 0xf7, 0x8f, 0x9f, 0x9e, 0x74, 0xeb, 0x77,
-	0xdf, 0x0b, 0xb0, 0xc9, 0x81, 0x5f, 0xfd, 0x93, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x31,
-	0xc0, 0xc6, 0x6d, 0x02, 0x00, 0x00,
+	// 807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x65, 0x86, 0x92, 0xc6, 0xb6, 0xa2, 0x6e, 0x03, 0x87, 0x20, 0x5a, 0x99, 0x55, 0x0b,
+	0x54, 0xfd, 0xb1, 0xd8, 0xb8, 0x3f, 0x68, 0x8a, 0x1e, 0xea, 0x48, 0x74, 0xc2, 0xd4, 0xb5, 0x0d,
+	0x52, 0xea, 0xa1, 0x17, 0x42, 0xe2, 0x6e, 0xa8, 0x45, 0x25, 0xae, 0xca, 0xa5, 0x84, 0xf8, 0x0d,
+	0x02, 0x9f, 0xf2, 0x02, 0x06, 0x8a, 0xf6, 0x39, 0x7a, 0xcf, 0x31, 0xc7, 0x9e, 0x8a, 0xc2, 0x3e,
+	0xf6, 0x1d, 0x8a, 0x80, 0xcb, 0x5d, 0x45, 0x02, 0x94, 0xdc, 0x38, 0x33, 0xdf, 0x37, 0xfb, 0xcd,
+	0xb7, 0xc3, 0x85, 0x0f, 0xe8, 0x28, 0x72, 0x22, 0x96, 0x12, 0x27, 0x1a, 0x0f, 0x93, 0x84, 0x4c,
+	0x9c, 0xc5, 0x3d, 0x67, 0x3e, 0x8b, 0xd3, 0x21, 0x26, 0x9d, 0x59, 0xca, 0x32, 0x86, 0xde, 0xa5,
+	0xa3, 0xa8, 0x93, 0x43, 0x3a, 0x12, 0xd2, 0x59, 0xdc, 0xb3, 0xee, 0xc4, 0x2c, 0x66, 0xa2, 0xee,
+	0xe4, 0x5f, 0x05, 0xd4, 0xda, 0xd8, 0x4d, 0xb1, 0x04, 0xa4, 0xf5, 0x97, 0x06, 0x95, 0x41, 0xd1,
+	0x1f, 0xfd, 0x00, 0xc6, 0x13, 0x4a, 0x26, 0x98, 0x9b, 0x9a, 0xad, 0xb5, 0xb7, 0x0f, 0x5b, 0x9d,
+	0x0d, 0x47, 0x75, 0x24, 0xfa, 0x58, 0x20, 0x1f, 0xe8, 0x2f, 0xfe, 0xd9, 0x2f, 0xf9, 0x92, 0x87,
+	0xbe, 0x87, 0x4a, 0x46, 0xa7, 0x84, 0xcd, 0x33, 0xb3, 0x2c, 0x5a, 0xbc, 0xb7, 0xb1, 0x45, 0xbf,
+	0xc0, 0x48, 0xb2, 0xa2, 0xa0, 0xcf, 0x01, 0x25, 0xe4, 0x69, 0x16, 0x72, 0xf2, 0xdb, 0x9c, 0x24,
+	0x11, 0x09, 0x39, 0x49, 0xb0, 0xb9, 0x65, 0x6b, 0x6d, 0xdd, 0x6f, 0xe4, 0x95, 0x40, 0x16, 0x02,
+	0x92, 0xe0, 0xef, 0xf4, 0x67, 0xbf, 0xef, 0x97, 0x5a, 0xcf, 0x35, 0xd8, 0x5d, 0x53, 0x84, 0xbe,
+	0x81, 0x2a, 0x4b, 0x31, 0x49, 0x69, 0x12, 0x8b, 0x39, 0xea, 0x87, 0xd6, 0x46, 0x11, 0x67, 0x39,
+	0xc8, 0x5f, 0x62, 0xd1, 0xc7, 0x70, 0x3b, 0x62, 0x49, 0x42, 0xa2, 0x8c, 0xb2, 0x24, 0x1c, 0xb3,
+	0x19, 0x37, 0xcb, 0xf6, 0x56, 0xbb, 0xe6, 0xd7, 0x5f, 0xa7, 0x1f, 0xb1, 0x19, 0x47, 0x26, 0x54,
+	0x16, 0x24, 0xe5, 0x94, 0x25, 0x42, 0x5b, 0xcd, 0x57, 0xa1, 0x94, 0xf4, 0x18, 0x76, 0xdc, 0x34,
+	0x65, 0xa9, 0x4f, 0x22, 0x42, 0x67, 0x19, 0xb2, 0xa0, 0xaa, 0x26, 0x12, 0x82, 0x74, 0x7f, 0x19,
+	0xe7, 0xbd, 0xa6, 0x84, 0xf3, 0x61, 0x4c, 0x84, 0x61, 0x35, 0x5f, 0x85, 0xb2, 0xd7, 0x7f, 0x65,
+	0xd8, 0xeb, 0x16, 0x9a, 0xe5, 0x94, 0x41, 0x34, 0x26, 0x78, 0x3e, 0x21, 0xa8, 0x0e, 0x65, 0x8a,
+	0x65, 0xc3, 0x32, 0xc5, 0x68, 0x0f, 0x8c, 0x31, 0xa1, 0xf1, 0xb8, 0xb0, 0x5e, 0xf7, 0x65, 0x84,
+	0xee, 0x42, 0x65, 0xc6, 0xd2, 0x2c, 0xa4, 0x58, 0xca, 0x35, 0xf2, 0xd0, 0xc3, 0xe8, 0x43, 0xd8,
+	0x5d, 0x19, 0x98, 0x62, 0x53, 0x17, 0xe5, 0x9d, 0xd7, 0x49, 0x0f, 0xaf, 0x0e, 0x7b, 0x6b, 0x6d,
+	0xd8, 0xdc, 0x2f, 0xb9, 0x98, 0xa1, 0x42, 0x18, 0x02, 0x51, 0x97, 0xe9, 0x9f, 0x25, 0xd0, 0x82,
+	0x2a, 0x79, 0x4a, 0xa2, 0x79, 0x46, 0xb0, 0x59, 0xb5, 0xb5, 0x76, 0xd5, 0x5f, 0xc6, 0xe8, 0x00,
+	0x90, 0x44, 0xf3, 0x90, 0x26, 0x34, 0xa3, 0xc3, 0x1c, 0x55, 0x13, 0x03, 0xbc, 0xa3, 0x2a, 0x9e,
+	0x2a, 0xac, 0x9c, 0xc9, 0xc3, 0x27, 0x43, 0x3a, 0x21, 0xd8, 0x04, 0x81, 0x55, 0x67, 0xf2, 0x63,
+	0x91, 0x45, 0x6d, 0x10, 0x0b, 0x13, 0xca, 0xfb, 0x0e, 0x7f, 0x25, 0x17, 0xe6, 0xb6, 0xad, 0xb5,
+	0x77, 0xfc, 0x7a, 0x9e, 0x97, 0x96, 0xfe, 0x48, 0x2e, 0x0a, 0x9f, 0x1f, 0xeb, 0xd5, 0x4a, 0xa3,
+	0xda, 0xfa, 0x5f, 0x83, 0xbb, 0xca, 0x5f, 0xbc, 0x6e, 0xfb, 0xaa, 0x8d, 0xda, 0x9a, 0x8d, 0xef,
+	0x03, 0xa8, 0x53, 0x28, 0x96, 0xb7, 0x58, 0x93, 0x19, 0x0f, 0xa3, 0x4f, 0xa0, 0xa1, 0x6c, 0x5a,
+	0x6e, 0x41, 0xb1, 0xd2, 0x6a, 0x14, 0xb5, 0xd5, 0xa8, 0x0b, 0x06, 0xcf, 0x86, 0xd9, 0x9c, 0x8b,
+	0x9b, 0xa8, 0x1f, 0x7e, 0xb6, 0x71, 0x6f, 0x97, 0x02, 0xd5, 0x42, 0x08, 0x8a, 0x2f, 0xa9, 0xe8,
+	0x0e, 0xdc, 0x22, 0xf9, 0xf6, 0xc9, 0xeb, 0x2a, 0x02, 0xb4, 0x0f, 0xdb, 0x5c, 0xf2, 0x72, 0x95,
+	0x86, 0x10, 0x00, 0x2a, 0xe5, 0xc9, 0xbf, 0xe9, 0xd3, 0x3f, 0xca, 0xb0, 0xb7, 0xb9, 0x3f, 0x6a,
+	0xc3, 0x47, 0x41, 0xf7, 0x91, 0xdb, 0x1b, 0x9c, 0xb8, 0xbd, 0x70, 0x70, 0xfe, 0xd0, 0x3f, 0xea,
+	0xb9, 0x61, 0xd0, 0x3f, 0xea, 0x0f, 0x82, 0x70, 0x70, 0x1a, 0x9c, 0xbb, 0x5d, 0xef, 0xd8, 0x73,
+	0x7b, 0x8d, 0x12, 0x72, 0x60, 0xff, 0x8d, 0xc8, 0xe3, 0x23, 0xef, 0xc4, 0xed, 0x35, 0x34, 0x0b,
+	0x2e, 0xaf, 0x6c, 0xa3, 0x88, 0xd0, 0xfd, 0xb7, 0xb4, 0xf6, 0x4e, 0xc3, 0x73, 0xff, 0xec, 0xa1,
+	0xef, 0x06, 0x41, 0xa3, 0x6c, 0xdd, 0xbe, 0xbc, 0xb2, 0xb7, 0x57, 0x52, 0xe8, 0x6b, 0x68, 0xbd,
+	0x91, 0xda, 0x3d, 0xfb, 0xe9, 0xfc, 0xc4, 0xed, 0xbb, 0xbd, 0xc6, 0x96, 0xb5, 0x7b, 0x79, 0x65,
+	0xd7, 0x96, 0x89, 0xb7, 0xd3, 0x8e, 0x4e, 0xbb, 0xee, 0x49, 0xae, 0x52, 0x97, 0x34, 0x95, 0xb0,
+	0xf4, 0x67, 0x7f, 0x36, 0x4b, 0x0f, 0x82, 0x17, 0xd7, 0x4d, 0xed, 0xe5, 0x75, 0x53, 0xfb, 0xf7,
+	0xba, 0xa9, 0x3d, 0xbf, 0x69, 0x96, 0x5e, 0xde, 0x34, 0x4b, 0x7f, 0xdf, 0x34, 0x4b, 0xbf, 0xdc,
+	0x8f, 0x69, 0x36, 0x9e, 0x8f, 0x3a, 0x11, 0x9b, 0x3a, 0x11, 0xe3, 0x53, 0xc6, 0x1d, 0x3a, 0x8a,
+	0x0e, 0x62, 0xe6, 0x2c, 0xbe, 0x75, 0xa6, 0x2c, 0x77, 0x95, 0x17, 0xef, 0xf1, 0x17, 0x5f, 0x1d,
+	0xa8, 0x27, 0x39, 0xbb, 0x98, 0x11, 0x3e, 0x32, 0xc4, 0x73, 0xfc, 0xe5, 0xab, 0x01, 0x00, 0xde,
+	0xf1, 0xef, 0x28, 0x01, 0x06, 0x00, 0x00,
 }
 
 func (m *Upgrade) Marshal() (dAtA []byte, err error) {
@@ -316,6 +490,153 @@ func (m *ErrorReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelUpgradeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelUpgradeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelUpgradeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextChannelKey) > 0 {
+		i -= len(m.NextChannelKey)
+		copy(dAtA[i:], m.NextChannelKey)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.NextChannelKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.UpgradesFailed != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.UpgradesFailed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UpgradesInitiated != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.UpgradesInitiated))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.UpgradeVersion) > 0 {
+		i -= len(m.UpgradeVersion)
+		copy(dAtA[i:], m.UpgradeVersion)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.UpgradeVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledChannelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledChannelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledChannelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduleId != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -381,6 +702,80 @@ func (m *ErrorReceipt) Size() (n int) {
 	return n
 }
 
+func (m *ChannelUpgradeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovUpgrade(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.UpgradeVersion)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Executed {
+		n += 2
+	}
+	if m.UpgradesInitiated != 0 {
+		n += 1 + sovUpgrade(uint64(m.UpgradesInitiated))
+	}
+	if m.UpgradesFailed != 0 {
+		n += 1 + sovUpgrade(uint64(m.UpgradesFailed))
+	}
+	l = len(m.NextChannelKey)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledChannelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovUpgrade(uint64(m.UpgradeSequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovUpgrade(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovUpgrade(uint64(m.ScheduleId))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -756,6 +1151,517 @@ func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChannelUpgradeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelUpgradeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelUpgradeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradesInitiated", wireType)
+			}
+			m.UpgradesInitiated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradesInitiated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradesFailed", wireType)
+			}
+			m.UpgradesFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradesFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextChannelKey = append(m.NextChannelKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextChannelKey == nil {
+				m.NextChannelKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledChannelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledChannelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledChannelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ScheduledUpgradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -259,3 +259,74 @@ func (suite *TypesTestSuite) TestIsUpgradeError() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestChannelUpgradeScheduleMatches() {
+	var (
+		schedule types.ChannelUpgradeSchedule
+		channel  types.IdentifiedChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expMatch bool
+	}{
+		{
+			"success: empty filters",
+			func() {},
+			true,
+		},
+		{
+			"success: all filters match",
+			func() {
+				schedule.PortId = ibctesting.MockPort
+				schedule.ConnectionId = ibctesting.FirstConnectionID
+				schedule.Version = mock.Version
+			},
+			true,
+		},
+		{
+			"channel is not OPEN",
+			func() {
+				channel.State = types.FLUSHING
+			},
+			false,
+		},
+		{
+			"port does not match",
+			func() {
+				schedule.PortId = ibctesting.TransferPort
+			},
+			false,
+		},
+		{
+			"connection does not match",
+			func() {
+				schedule.ConnectionId = "connection-1"
+			},
+			false,
+		},
+		{
+			"version does not match",
+			func() {
+				schedule.Version = mock.UpgradeVersion
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			schedule = types.NewChannelUpgradeSchedule(100, "", "", "", mock.UpgradeVersion)
+			channel = types.NewIdentifiedChannel(
+				ibctesting.MockPort, ibctesting.FirstChannelID,
+				types.NewChannel(types.OPEN, types.UNORDERED, types.NewCounterparty(ibctesting.MockPort, ibctesting.FirstChannelID), []string{ibctesting.FirstConnectionID}, mock.Version),
+			)
+
+			tc.malleate()
+
+			suite.Require().Equal(tc.expMatch, schedule.Matches(channel))
+		})
+	}
+}
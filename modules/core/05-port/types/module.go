@@ -157,6 +157,37 @@ type UpgradableModule interface {
 	)
 }
 
+// UpgradeCancellableModule defines an optional callback which is executed when a channel upgrade is cancelled,
+// timed out or aborted and the channel has been restored to its pre-upgrade parameters. Middleware should
+// forward this callback to the underlying application when the underlying application implements it.
+type UpgradeCancellableModule interface {
+	// OnChanUpgradeCancel enables additional custom logic to be executed once the upgrade information
+	// of the channel has been deleted. It cannot abort the cancellation of the upgrade.
+	OnChanUpgradeCancel(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	)
+}
+
+// ClientRoutedModule defines an optional interface which applications implement to opt in to packets
+// routed by client identifier (IBC_VERSION_2). These packets are not preceded by a channel handshake,
+// so the OnChanOpen* callbacks are never executed. Core IBC instead asks the application to authorize
+// the route before the packet, acknowledgement or timeout is processed. Applications which do not
+// implement this interface cannot receive, acknowledge or time out packets routed by client identifier.
+// NOTE: asynchronous acknowledgements are not supported for packets routed by client identifier.
+type ClientRoutedModule interface {
+	// AuthorizeClientRoute must return an error if the application does not accept packets exchanged between
+	// the given port and client on this chain and the given port and client on the counterparty chain.
+	AuthorizeClientRoute(
+		ctx sdk.Context,
+		portID,
+		clientID,
+		counterpartyPortID,
+		counterpartyClientID string,
+	) error
+}
+
 // ICS4Wrapper implements the ICS4 interfaces that IBC applications use to send packets and acknowledgements.
 type ICS4Wrapper interface {
 	SendPacket(
@@ -19,3 +19,43 @@ func ChannelUpgradeKey(portID, channelID string) []byte {
 func ChannelCounterpartyUpgradeKey(portID, channelID string) []byte {
 	return []byte(ChannelCounterpartyUpgradePath(portID, channelID))
 }
+
+// ChannelPausedKey returns the store key for the pause height of a particular channel
+func ChannelPausedKey(portID, channelID string) []byte {
+	return []byte(ChannelPausedPath(portID, channelID))
+}
+
+// ChannelUpgradeScheduleKey returns the store key for a particular channel upgrade schedule
+func ChannelUpgradeScheduleKey(scheduleID uint64) []byte {
+	return []byte(ChannelUpgradeSchedulePath(scheduleID))
+}
+
+// PendingChannelUpgradeScheduleKey returns the store key for the height of a pending channel upgrade schedule
+func PendingChannelUpgradeScheduleKey(scheduleID uint64) []byte {
+	return []byte(PendingChannelUpgradeSchedulePath(scheduleID))
+}
+
+// ScheduledChannelUpgradeKey returns the store key for the schedule identifier of a particular channel upgrade in progress
+func ScheduledChannelUpgradeKey(portID, channelID string) []byte {
+	return []byte(ScheduledChannelUpgradePath(portID, channelID))
+}
+
+// ChannelUpgradeScheduleUpgradeKey returns the store key for the status of a particular channel upgrade initiated by a channel upgrade schedule
+func ChannelUpgradeScheduleUpgradeKey(scheduleID uint64, portID, channelID string) []byte {
+	return []byte(ChannelUpgradeScheduleUpgradePath(scheduleID, portID, channelID))
+}
+
+// ChannelUpgradeScheduleUpgradesPrefixKey returns the store key prefix for the status of the channel upgrades initiated by a channel upgrade schedule
+func ChannelUpgradeScheduleUpgradesPrefixKey(scheduleID uint64) []byte {
+	return []byte(ChannelUpgradeScheduleUpgradesPrefixPath(scheduleID))
+}
+
+// ConnectionChannelKey returns the store key under which a channel is indexed by the connection of its first connection hop
+func ConnectionChannelKey(connectionID, portID, channelID string) []byte {
+	return []byte(ConnectionChannelPath(connectionID, portID, channelID))
+}
+
+// ConnectionChannelsPrefixKey returns the store key prefix under which the channels built upon a connection are indexed
+func ConnectionChannelsPrefixKey(connectionID string) []byte {
+	return []byte(ConnectionChannelsPrefixPath(connectionID))
+}
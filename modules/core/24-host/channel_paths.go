@@ -10,6 +10,13 @@ const (
 	KeyUpgradeErrorPrefix      = "upgradeError"
 	KeyCounterpartyUpgrade     = "counterpartyUpgrade"
 	KeyChannelCapabilityPrefix = "capabilities"
+	KeyChannelPausedPrefix     = "channelPaused"
+
+	KeyChannelUpgradeSchedulePrefix        = "channelUpgradeSchedules"
+	KeyPendingChannelUpgradeSchedulePrefix = "pendingChannelUpgradeSchedules"
+	KeyScheduledChannelUpgradePrefix       = "scheduledChannelUpgrades"
+	KeyChannelUpgradeScheduleUpgradePrefix = "channelUpgradeScheduleUpgrades"
+	KeyConnectionChannelPrefix             = "connectionChannels"
 )
 
 // ICS04
@@ -41,6 +48,51 @@ func ChannelCounterpartyUpgradePath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID))
 }
 
+// ChannelPausedPath defines the path under which the block height at which a channel was paused by the authority is stored.
+func ChannelPausedPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelPausedPrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeSchedulePath defines the path under which a channel upgrade schedule is stored.
+func ChannelUpgradeSchedulePath(scheduleID uint64) string {
+	return fmt.Sprintf("%s/%d", KeyChannelUpgradeSchedulePrefix, scheduleID)
+}
+
+// PendingChannelUpgradeSchedulePath defines the path under which the height of a channel upgrade schedule
+// which has not been executed yet is stored.
+func PendingChannelUpgradeSchedulePath(scheduleID uint64) string {
+	return fmt.Sprintf("%s/%d", KeyPendingChannelUpgradeSchedulePrefix, scheduleID)
+}
+
+// ScheduledChannelUpgradePath defines the path under which the identifier of the channel upgrade schedule
+// which initiated the upgrade in progress of a channel is stored.
+func ScheduledChannelUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyScheduledChannelUpgradePrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeScheduleUpgradePath defines the path under which the status of the upgrade of a channel initiated
+// by a channel upgrade schedule is stored.
+func ChannelUpgradeScheduleUpgradePath(scheduleID uint64, portID, channelID string) string {
+	return fmt.Sprintf("%s/%d/%s", KeyChannelUpgradeScheduleUpgradePrefix, scheduleID, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeScheduleUpgradesPrefixPath defines the prefix path under which the status of the channel upgrades
+// initiated by a channel upgrade schedule are stored.
+func ChannelUpgradeScheduleUpgradesPrefixPath(scheduleID uint64) string {
+	return fmt.Sprintf("%s/%d/%s", KeyChannelUpgradeScheduleUpgradePrefix, scheduleID, KeyPortPrefix)
+}
+
+// ConnectionChannelPath defines the path under which a channel is indexed by the identifier of the connection
+// of its first connection hop.
+func ConnectionChannelPath(connectionID, portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyConnectionChannelPrefix, ConnectionPath(connectionID), channelPath(portID, channelID))
+}
+
+// ConnectionChannelsPrefixPath defines the prefix path under which the channels built upon a connection are indexed.
+func ConnectionChannelsPrefixPath(connectionID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyConnectionChannelPrefix, ConnectionPath(connectionID), KeyPortPrefix)
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
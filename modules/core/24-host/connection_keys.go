@@ -9,3 +9,18 @@ func ClientConnectionsKey(clientID string) []byte {
 func ConnectionKey(connectionID string) []byte {
 	return []byte(ConnectionPath(connectionID))
 }
+
+// ConnectionUpgradeErrorKey returns the store key for a particular connectionEnd used to store the ErrorReceipt in the case that a chain does not accept the proposed upgrade
+func ConnectionUpgradeErrorKey(connectionID string) []byte {
+	return []byte(ConnectionUpgradeErrorPath(connectionID))
+}
+
+// ConnectionUpgradeKey returns the store key for a particular connection upgrade attempt
+func ConnectionUpgradeKey(connectionID string) []byte {
+	return []byte(ConnectionUpgradePath(connectionID))
+}
+
+// ConnectionCounterpartyUpgradeKey returns the store key for the upgrade used on the counterparty connection.
+func ConnectionCounterpartyUpgradeKey(connectionID string) []byte {
+	return []byte(ConnectionCounterpartyUpgradePath(connectionID))
+}
@@ -2,7 +2,10 @@ package host
 
 import "fmt"
 
-const KeyConnectionPrefix = "connections"
+const (
+	KeyConnectionPrefix        = "connections"
+	KeyConnectionUpgradePrefix = "connectionUpgrades"
+)
 
 // ICS03
 // The following paths are the keys to the store as defined in https://github.com/cosmos/ibc/blob/master/spec/core/ics-003-connection-semantics#store-paths
@@ -16,3 +19,18 @@ func ClientConnectionsPath(clientID string) string {
 func ConnectionPath(connectionID string) string {
 	return fmt.Sprintf("%s/%s", KeyConnectionPrefix, connectionID)
 }
+
+// ConnectionUpgradeErrorPath defines the path under which the ErrorReceipt is stored in the case that a chain does not accept the proposed connection upgrade
+func ConnectionUpgradeErrorPath(connectionID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyConnectionUpgradePrefix, KeyUpgradeErrorPrefix, connectionID)
+}
+
+// ConnectionUpgradePath defines the path which stores the information related to a connection upgrade attempt
+func ConnectionUpgradePath(connectionID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyConnectionUpgradePrefix, KeyUpgradePrefix, connectionID)
+}
+
+// ConnectionCounterpartyUpgradePath defines the path under which the upgrade used on the counterparty connection is stored.
+func ConnectionCounterpartyUpgradePath(connectionID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyConnectionUpgradePrefix, KeyCounterpartyUpgrade, connectionID)
+}
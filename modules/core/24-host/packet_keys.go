@@ -24,6 +24,26 @@ func PacketCommitmentKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(PacketCommitmentPath(portID, channelID, sequence))
 }
 
+// PacketDataKey returns the store key of under which a packet is stored in full
+func PacketDataKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketDataPath(portID, channelID, sequence))
+}
+
+// PacketSendHeightKey returns the store key under which the block height at which a packet was sent is stored
+func PacketSendHeightKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketSendHeightPath(portID, channelID, sequence))
+}
+
+// PacketRecvHeightKey returns the store key under which the block height at which a packet was received is stored
+func PacketRecvHeightKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketRecvHeightPath(portID, channelID, sequence))
+}
+
+// PacketTimeoutHeightKey returns the store key under which the block height at which a packet was timed out is stored
+func PacketTimeoutHeightKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketTimeoutHeightPath(portID, channelID, sequence))
+}
+
 // PacketAcknowledgementKey returns the store key of under which a packet
 // acknowledgement is stored
 func PacketAcknowledgementKey(portID, channelID string, sequence uint64) []byte {
@@ -45,3 +65,8 @@ func PruningSequenceStartKey(portID, channelID string) []byte {
 func RecvStartSequenceKey(portID, channelID string) []byte {
 	return []byte(RecvStartSequencePath(portID, channelID))
 }
+
+// CommitmentStartSequenceKey returns the store key for the commitment start sequence of a particular channel
+func CommitmentStartSequenceKey(portID, channelID string) []byte {
+	return []byte(CommitmentStartSequencePath(portID, channelID))
+}
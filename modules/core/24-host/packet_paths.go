@@ -3,15 +3,20 @@ package host
 import "fmt"
 
 const (
-	KeySequencePrefix         = "sequences"
-	KeyNextSeqSendPrefix      = "nextSequenceSend"
-	KeyNextSeqRecvPrefix      = "nextSequenceRecv"
-	KeyNextSeqAckPrefix       = "nextSequenceAck"
-	KeyPacketCommitmentPrefix = "commitments"
-	KeyPacketAckPrefix        = "acks"
-	KeyPacketReceiptPrefix    = "receipts"
-	KeyPruningSequenceStart   = "pruningSequenceStart"
-	KeyRecvStartSequence      = "recvStartSequence"
+	KeySequencePrefix          = "sequences"
+	KeyNextSeqSendPrefix       = "nextSequenceSend"
+	KeyNextSeqRecvPrefix       = "nextSequenceRecv"
+	KeyNextSeqAckPrefix        = "nextSequenceAck"
+	KeyPacketCommitmentPrefix  = "commitments"
+	KeyPacketAckPrefix         = "acks"
+	KeyPacketReceiptPrefix     = "receipts"
+	KeyPruningSequenceStart    = "pruningSequenceStart"
+	KeyRecvStartSequence       = "recvStartSequence"
+	KeyCommitmentStartSequence = "commitmentStartSequence"
+	KeyPacketDataPrefix        = "packetData"
+	KeySendHeightPrefix        = "sendHeights"
+	KeyRecvHeightPrefix        = "recvHeights"
+	KeyTimeoutHeightPrefix     = "timeoutHeights"
 )
 
 // ICS04
@@ -42,6 +47,31 @@ func PacketCommitmentPrefixPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s", KeyPacketCommitmentPrefix, channelPath(portID, channelID), KeySequencePrefix)
 }
 
+// PacketDataPath defines the store path of a packet stored in full
+func PacketDataPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", PacketDataPrefixPath(portID, channelID), sequence)
+}
+
+// PacketDataPrefixPath defines the prefix for the store path of packets stored in full.
+func PacketDataPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketDataPrefix, channelPath(portID, channelID), KeySequencePrefix)
+}
+
+// PacketSendHeightPath defines the store path of the block height at which a packet was sent
+func PacketSendHeightPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeySendHeightPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketRecvHeightPath defines the store path of the block height at which a packet was received
+func PacketRecvHeightPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyRecvHeightPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketTimeoutHeightPath defines the store path of the block height at which a packet was timed out
+func PacketTimeoutHeightPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyTimeoutHeightPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
 // PacketAcknowledgementPath defines the packet acknowledgement store path
 func PacketAcknowledgementPath(portID, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s/%d", PacketAcknowledgementPrefixPath(portID, channelID), sequence)
@@ -67,6 +97,11 @@ func RecvStartSequencePath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyRecvStartSequence, channelPath(portID, channelID))
 }
 
+// CommitmentStartSequencePath defines the path under which the commitment start sequence is stored
+func CommitmentStartSequencePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyCommitmentStartSequence, channelPath(portID, channelID))
+}
+
 func sequencePath(sequence uint64) string {
 	return fmt.Sprintf("%s/%d", KeySequencePrefix, sequence)
 }
@@ -5,6 +5,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
 )
 
@@ -17,16 +18,21 @@ func NewRedundantRelayDecorator(k *keeper.Keeper) RedundantRelayDecorator {
 }
 
 // AnteHandle returns an error if a multiMsg tx only contains packet messages (Recv, Ack, Timeout) and additional update messages
-// and all packet messages are redundant. If the transaction is just a single UpdateClient message, or the multimsg transaction
-// contains some other message type, then the antedecorator returns no error and continues processing to ensure these transactions
-// are included. This will ensure that relayers do not waste fees on multiMsg transactions when another relayer has already submitted
-// all packets, by rejecting the tx at the mempool layer.
+// and all packet messages are redundant, or if a tx only contains update messages and all of them are redundant. An update message
+// is redundant if it neither adds a new consensus state to the client nor freezes it, e.g. if the client has already been updated
+// at the same height by another tx in the same block. If the multimsg transaction contains some other message type,
+// then the antedecorator returns no error and continues processing to ensure these transactions are included. This will ensure that
+// relayers do not waste fees on multiMsg transactions when another relayer has already submitted all packets or updates, by rejecting
+// the tx at the mempool layer. Each packet of a batched packet message is counted as an individual packet message.
 func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// do not run redundancy check on DeliverTx or simulate
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
 		// keep track of total packet messages and number of redundancies across `RecvPacket`, `AcknowledgePacket`, and `TimeoutPacket/OnClose`
 		redundancies := 0
 		packetMsgs := 0
+		// keep track of total update messages and number of redundant updates
+		redundantUpdates := 0
+		updateMsgs := 0
 		for _, m := range tx.GetMsgs() {
 			switch msg := m.(type) {
 			case *channeltypes.MsgRecvPacket:
@@ -69,11 +75,39 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				packetMsgs++
 
+			case *channeltypes.MsgRecvPackets:
+				response, err := rrd.k.RecvPackets(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				redundancies += countRedundancies(response.Results)
+				packetMsgs += len(response.Results)
+
+			case *channeltypes.MsgAcknowledgements:
+				response, err := rrd.k.Acknowledgements(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				redundancies += countRedundancies(response.Results)
+				packetMsgs += len(response.Results)
+
+			case *channeltypes.MsgTimeouts:
+				response, err := rrd.k.Timeouts(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				redundancies += countRedundancies(response.Results)
+				packetMsgs += len(response.Results)
+
 			case *clienttypes.MsgUpdateClient:
-				_, err := rrd.k.UpdateClient(ctx, msg)
+				redundant, err := rrd.updateClientCheckTx(ctx, msg)
 				if err != nil {
 					return ctx, err
 				}
+				if redundant {
+					redundantUpdates++
+				}
+				updateMsgs++
 
 			default:
 				// if the multiMsg tx has a msg that is not a packet msg or update msg, then we will not return error
@@ -87,6 +121,64 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if redundancies == packetMsgs && packetMsgs > 0 {
 			return ctx, channeltypes.ErrRedundantTx
 		}
+
+		// only return error if the tx contains no packet messages and all update messages are redundant
+		if packetMsgs == 0 && redundantUpdates == updateMsgs && updateMsgs > 0 {
+			return ctx, clienttypes.ErrRedundantUpdate
+		}
 	}
 	return next(ctx, tx, simulate)
 }
+
+// updateClientCheckTx runs the provided MsgUpdateClient and returns true if the update is redundant, i.e. if it did not
+// add a new consensus state to the client and did not freeze it. An update adds a new consensus state if no consensus
+// state was stored at the height of the client message before the update, including below the latest height of the
+// client. For client messages which do not expose their height, the update is redundant if it did not advance the
+// latest height of the client.
+func (rrd RedundantRelayDecorator) updateClientCheckTx(ctx sdk.Context, msg *clienttypes.MsgUpdateClient) (bool, error) {
+	clientMsg, err := clienttypes.UnpackClientMessage(msg.ClientMessage)
+	if err != nil {
+		return false, err
+	}
+
+	var consensusStateExists bool
+	heightMsg, hasHeight := clientMsg.(heightClientMessage)
+	if hasHeight {
+		_, consensusStateExists = rrd.k.ClientKeeper.GetClientConsensusState(ctx, msg.ClientId, heightMsg.GetHeight())
+	}
+
+	heightBefore := rrd.k.ClientKeeper.GetClientLatestHeight(ctx, msg.ClientId)
+
+	if _, err := rrd.k.UpdateClient(ctx, msg); err != nil {
+		return false, err
+	}
+
+	if status := rrd.k.ClientKeeper.GetClientStatus(ctx, msg.ClientId); status == exported.Frozen {
+		return false, nil
+	}
+
+	if hasHeight {
+		return consensusStateExists, nil
+	}
+
+	heightAfter := rrd.k.ClientKeeper.GetClientLatestHeight(ctx, msg.ClientId)
+	return !heightAfter.GT(heightBefore), nil
+}
+
+// heightClientMessage is implemented by client messages which add a consensus state at a single height, such as
+// tendermint headers.
+type heightClientMessage interface {
+	GetHeight() exported.Height
+}
+
+// countRedundancies returns the number of no-op results in a batched packet message response.
+func countRedundancies(results []channeltypes.ResponseResultType) int {
+	redundancies := 0
+	for _, result := range results {
+		if result == channeltypes.NOOP {
+			redundancies++
+		}
+	}
+
+	return redundancies
+}
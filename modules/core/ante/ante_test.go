@@ -1,12 +1,17 @@
 package ante_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	testifysuite "github.com/stretchr/testify/suite"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -69,6 +74,44 @@ func (suite *AnteTestSuite) createRecvPacketMessage(isRedundant bool) sdk.Msg {
 	return channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketsMessage creates a RecvPackets message for packets sent from chain A to chain B. A packet is
+// received on chain B before the message is created for each true entry in isRedundant.
+func (suite *AnteTestSuite) createRecvPacketsMessage(isRedundant ...bool) sdk.Msg {
+	var packets []channeltypes.Packet
+	for _, redundant := range isRedundant {
+		sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			clienttypes.NewHeight(2, 0), 0)
+
+		if redundant {
+			err = suite.path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}
+
+		packets = append(packets, packet)
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	var (
+		proofs      [][]byte
+		proofHeight clienttypes.Height
+	)
+	for _, packet := range packets {
+		packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		var proof []byte
+		proof, proofHeight = suite.chainA.QueryProof(packetKey)
+		proofs = append(proofs, proof)
+	}
+
+	return channeltypes.NewMsgRecvPackets(packets, proofs, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createAcknowledgementMessage creates an Acknowledgement message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createAcknowledgementMessage(isRedundant bool) sdk.Msg {
 	sequence, err := suite.path.EndpointB.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
@@ -150,8 +193,10 @@ func (suite *AnteTestSuite) createTimeoutOnCloseMessage(isRedundant bool) sdk.Ms
 }
 
 func (suite *AnteTestSuite) createUpdateClientMessage() sdk.Msg {
-	endpoint := suite.path.EndpointB
+	return suite.createUpdateClientMessageForEndpoint(suite.path.EndpointB)
+}
 
+func (suite *AnteTestSuite) createUpdateClientMessageForEndpoint(endpoint *ibctesting.Endpoint) sdk.Msg {
 	// ensure counterparty has committed state
 	endpoint.Chain.Coordinator.CommitBlock(endpoint.Counterparty.Chain)
 
@@ -315,6 +360,17 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on one redundant and one new UpdateClient message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				redundantMsg := suite.createUpdateClientMessage()
+				_, err := suite.chainB.SendMsgs(redundantMsg)
+				suite.Require().NoError(err)
+
+				return []sdk.Msg{redundantMsg, suite.createUpdateClientMessage()}
+			},
+			true,
+		},
 		{
 			"success on three new Updateclient messages and one new RecvPacket message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -342,6 +398,30 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on one RecvPackets message with new and redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, false, true)}
+			},
+			true,
+		},
+		{
+			"success on one RecvPackets message with redundant packets and one new RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{
+					suite.createRecvPacketsMessage(true, true),
+					suite.createRecvPacketMessage(false),
+				}
+			},
+			true,
+		},
+		{
+			"no success on one RecvPackets message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, true, true)}
+			},
+			false,
+		},
 		{
 			"no success on one redundant RecvPacket message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -412,6 +492,28 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			false,
 		},
+		{
+			"no success on one redundant UpdateClient message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createUpdateClientMessage()
+				_, err := suite.chainB.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				return []sdk.Msg{msg}
+			},
+			false,
+		},
+		{
+			"success on one UpdateClient message adding a consensus state below the latest height of the client",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createUpdateClientMessage()
+				err := suite.path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				return []sdk.Msg{msg}
+			},
+			true,
+		},
 		{
 			"no success on one new message and one invalid message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -451,6 +553,27 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			false,
 		},
+		{
+			"no success on one new UpdateClient message and the same message in the same block",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createUpdateClientMessage()
+
+				k := suite.chainB.App.GetIBCKeeper()
+				decorator := ante.NewRedundantRelayDecorator(k)
+				checkCtx := suite.chainB.GetContext().WithIsCheckTx(true)
+				next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+				txBuilder := suite.chainB.TxConfig.NewTxBuilder()
+				err := txBuilder.SetMsgs([]sdk.Msg{msg}...)
+				suite.Require().NoError(err)
+				tx := txBuilder.GetTx()
+
+				_, err = decorator.AnteHandle(checkCtx, tx, false, next)
+				suite.Require().NoError(err)
+
+				return []sdk.Msg{msg}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -488,3 +611,159 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 		})
 	}
 }
+
+// failingBankKeeper is a bank keeper which fails to send any coins.
+type failingBankKeeper struct{}
+
+func (failingBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, _ sdk.AccAddress, _ sdk.Coins) error {
+	return errors.New("failed to send coins")
+}
+
+func (suite *AnteTestSuite) TestClientUpdateFeeRefundDecorator() {
+	var (
+		msgs             []sdk.Msg
+		simulate         bool
+		refundBankKeeper ante.BankKeeper
+	)
+
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	refundRatio := sdkmath.LegacyNewDecWithPrec(5, 1)
+	expRefund := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(500))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expRefund bool
+	}{
+		{
+			"success: one new UpdateClient message",
+			func() {},
+			true,
+		},
+		{
+			"success: new UpdateClient messages for two clients",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				msgs = append(msgs, suite.createUpdateClientMessageForEndpoint(path.EndpointB))
+			},
+			true,
+		},
+		{
+			"no refund: client does not back an open connection",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				msgs = []sdk.Msg{suite.createUpdateClientMessageForEndpoint(path.EndpointB)}
+			},
+			false,
+		},
+		{
+			"no refund: refund failure does not fail the tx",
+			func() {
+				refundBankKeeper = failingBankKeeper{}
+			},
+			false,
+		},
+		{
+			"no refund: redundant UpdateClient message",
+			func() {
+				_, err := suite.chainB.SendMsgs(msgs...)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"no refund: two UpdateClient messages for the same client",
+			func() {
+				msgs = append(msgs, suite.createUpdateClientMessage())
+			},
+			false,
+		},
+		{
+			"no refund: tx contains a message other than MsgUpdateClient",
+			func() {
+				msgs = append(msgs, suite.createRecvPacketMessage(false))
+			},
+			false,
+		},
+		{
+			"no refund: simulate",
+			func() {
+				simulate = true
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			simulate = false
+			msgs = []sdk.Msg{suite.createUpdateClientMessage()}
+			refundBankKeeper = suite.chainB.GetSimApp().BankKeeper
+
+			tc.malleate()
+
+			bankKeeper := suite.chainB.GetSimApp().BankKeeper
+			sender := suite.chainB.SenderAccount.GetAddress()
+
+			txBuilder := suite.chainB.TxConfig.NewTxBuilder()
+			err := txBuilder.SetMsgs(msgs...)
+			suite.Require().NoError(err)
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetFeePayer(sender)
+			tx := txBuilder.GetTx()
+
+			ctx := suite.chainB.GetContext().WithIsCheckTx(false)
+
+			// deduct fees as the auth module would do before executing the decorator
+			err = bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, fee)
+			suite.Require().NoError(err)
+			balanceAfterFees := bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+			decorator := ante.NewClientUpdateFeeRefundDecorator(suite.chainB.App.GetIBCKeeper(), refundBankKeeper, authtypes.FeeCollectorName, refundRatio)
+
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+			ctx, err = decorator.AnteHandle(ctx, tx, simulate, next)
+			suite.Require().NoError(err)
+
+			for _, msg := range msgs {
+				if msg, ok := msg.(*clienttypes.MsgUpdateClient); ok {
+					_, err = suite.chainB.App.GetIBCKeeper().UpdateClient(ctx, msg)
+					suite.Require().NoError(err)
+				}
+			}
+
+			postNext := func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) { return ctx, nil }
+			_, err = decorator.PostHandle(ctx, tx, simulate, true, postNext)
+			suite.Require().NoError(err)
+
+			balance := bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+			if tc.expRefund {
+				suite.Require().Equal(balanceAfterFees.Add(expRefund), balance)
+			} else {
+				suite.Require().Equal(balanceAfterFees, balance)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestNewClientUpdateFeeRefundDecorator() {
+	bankKeeper := suite.chainB.GetSimApp().BankKeeper
+
+	for _, ratio := range []sdkmath.LegacyDec{sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec(), sdkmath.LegacyNewDec(-1)} {
+		suite.Require().Panics(func() {
+			ante.NewClientUpdateFeeRefundDecorator(suite.chainB.App.GetIBCKeeper(), bankKeeper, authtypes.FeeCollectorName, ratio)
+		}, ratio.String())
+	}
+
+	suite.Require().NotPanics(func() {
+		ante.NewClientUpdateFeeRefundDecorator(suite.chainB.App.GetIBCKeeper(), bankKeeper, authtypes.FeeCollectorName, sdkmath.LegacyNewDecWithPrec(5, 1))
+	})
+}
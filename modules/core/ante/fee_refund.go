@@ -0,0 +1,158 @@
+package ante
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
+)
+
+// BankKeeper defines the expected bank keeper used to refund the fees of client updates.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// clientHeightsKey is the context key under which the latest heights of the clients updated by a tx are stored
+// between the execution of the ante and post handlers.
+type clientHeightsKey struct{}
+
+// ClientUpdateFeeRefundDecorator refunds part of the fees of transactions which only contain MsgUpdateClient
+// messages, each for a distinct client backing an open connection, if every update either advanced the latest
+// height of its client or froze it. This incentivises relayers to keep light clients up to date in a timely manner,
+// while the unrefunded part of the fees prevents client updates from using block space for free. The decorator must
+// be added to the ante handler chain after the fees have been deducted, and to the post handler chain of the application.
+type ClientUpdateFeeRefundDecorator struct {
+	k                *keeper.Keeper
+	bankKeeper       BankKeeper
+	feeCollectorName string
+	refundRatio      sdkmath.LegacyDec
+}
+
+// NewClientUpdateFeeRefundDecorator creates a new ClientUpdateFeeRefundDecorator which refunds the provided ratio
+// of the fees from the provided fee collector module account. It panics if the ratio is not strictly between 0 and 1.
+func NewClientUpdateFeeRefundDecorator(k *keeper.Keeper, bankKeeper BankKeeper, feeCollectorName string, refundRatio sdkmath.LegacyDec) ClientUpdateFeeRefundDecorator {
+	if !refundRatio.IsPositive() || refundRatio.GTE(sdkmath.LegacyOneDec()) {
+		panic(fmt.Errorf("client update fee refund ratio must be between 0 and 1 exclusive, got %s", refundRatio))
+	}
+
+	return ClientUpdateFeeRefundDecorator{
+		k:                k,
+		bankKeeper:       bankKeeper,
+		feeCollectorName: feeCollectorName,
+		refundRatio:      refundRatio,
+	}
+}
+
+// AnteHandle records the latest heights of the clients updated by the tx in the context, so that they can be compared
+// against once the messages have been executed. It is a no-op on CheckTx and simulate.
+func (d ClientUpdateFeeRefundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if heights, ok := d.clientHeights(ctx, tx); ok {
+		ctx = ctx.WithValue(clientHeightsKey{}, heights)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// PostHandle refunds part of the fees of the tx to the account which paid them if the tx succeeded and all of its
+// client updates were useful. A failed refund is logged and does not revert the client updates.
+func (d ClientUpdateFeeRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate, success)
+	}
+
+	heights, ok := ctx.Value(clientHeightsKey{}).(map[string]clienttypes.Height)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	for clientID, heightBefore := range heights {
+		if status := d.k.ClientKeeper.GetClientStatus(ctx, clientID); status == exported.Frozen {
+			continue
+		}
+
+		if !d.k.ClientKeeper.GetClientLatestHeight(ctx, clientID).GT(heightBefore) {
+			return next(ctx, tx, simulate, success)
+		}
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	refund, _ := sdk.NewDecCoinsFromCoins(feeTx.GetFee()...).MulDecTruncate(d.refundRatio).TruncateDecimal()
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// fees are deducted from the fee granter if one is set
+	refundAddr := sdk.AccAddress(feeTx.FeePayer())
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		refundAddr = sdk.AccAddress(feeGranter)
+	}
+
+	if err := d.bankKeeper.SendCoinsFromModuleToAccount(ctx, d.feeCollectorName, refundAddr, refund); err != nil {
+		ctx.Logger().Error("failed to refund fees for client updates", "refund-address", refundAddr, "refund", refund, "error", err)
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// clientHeights returns the latest heights of the clients updated by the tx. It returns false if the tx contains
+// any message other than MsgUpdateClient, if it updates the same client more than once or if it updates a client
+// which does not back an open connection.
+func (d ClientUpdateFeeRefundDecorator) clientHeights(ctx sdk.Context, tx sdk.Tx) (map[string]clienttypes.Height, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, false
+	}
+
+	heights := make(map[string]clienttypes.Height, len(msgs))
+	for _, m := range msgs {
+		msg, ok := m.(*clienttypes.MsgUpdateClient)
+		if !ok {
+			return nil, false
+		}
+
+		if _, found := heights[msg.ClientId]; found {
+			return nil, false
+		}
+
+		if !d.hasOpenConnection(ctx, msg.ClientId) {
+			return nil, false
+		}
+
+		heights[msg.ClientId] = d.k.ClientKeeper.GetClientLatestHeight(ctx, msg.ClientId)
+	}
+
+	return heights, true
+}
+
+// hasOpenConnection returns true if the client backs at least one connection in the OPEN state. Clients can be
+// created permissionlessly, so only updates of clients used to relay packets are eligible to a refund.
+func (d ClientUpdateFeeRefundDecorator) hasOpenConnection(ctx sdk.Context, clientID string) bool {
+	connectionIDs, found := d.k.ConnectionKeeper.GetClientConnectionPaths(ctx, clientID)
+	if !found {
+		return false
+	}
+
+	for _, connectionID := range connectionIDs {
+		connection, found := d.k.ConnectionKeeper.GetConnection(ctx, connectionID)
+		if found && connection.State == connectiontypes.OPEN {
+			return true
+		}
+	}
+
+	return false
+}
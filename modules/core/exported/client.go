@@ -123,6 +123,10 @@ type LightClientModule interface {
 	// The light client module must set the updated client and consensus states within the clientStore for the subject client.
 	RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error
 
+	// DeleteClient must remove all state associated with the client from the clientStore, including the
+	// client state, consensus states and any associated metadata.
+	DeleteClient(ctx sdk.Context, clientID string) error
+
 	// Upgrade functions
 	// NOTE: proof heights are not included as upgrade to a new revision is expected to pass only on the last
 	// height committed by the current revision. Clients are responsible for ensuring that the planned last
@@ -140,6 +144,22 @@ type LightClientModule interface {
 	) error
 }
 
+// ConsensusStatePruningModule is an optional interface which may be implemented by light client modules
+// in order to allow core IBC to prune expired consensus states of their clients.
+type ConsensusStatePruningModule interface {
+	// PruneExpiredConsensusStates prunes at most limit expired consensus states, along with any associated
+	// metadata, of the client with the provided identifier and returns the number of consensus states pruned.
+	// The consensus state at the latest height of the client must not be pruned.
+	PruneExpiredConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error)
+}
+
+// ClientStateDecoderModule is an optional interface which may be implemented by light client modules in order
+// to allow core IBC to evaluate client creation policies against a client state before it is initialized.
+type ClientStateDecoderModule interface {
+	// DecodeClientState unmarshals the provided client state bytes into the client state of the light client.
+	DecodeClientState(clientState []byte) (ClientState, error)
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -7,3 +7,9 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 func ConvertToErrorEvents(events sdk.Events) sdk.Events {
 	return convertToErrorEvents(events)
 }
+
+// ExecuteChannelUpgradeSchedulesWithLimit is a wrapper around executeChannelUpgradeSchedules
+// to allow the number of channels considered per block to be set in tests.
+func (k *Keeper) ExecuteChannelUpgradeSchedulesWithLimit(ctx sdk.Context, limit int) {
+	k.executeChannelUpgradeSchedules(ctx, limit)
+}
@@ -38,11 +38,31 @@ func (k *Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientSta
 	return k.ClientKeeper.ClientStatus(c, req)
 }
 
+// DeletableClients implements the IBC QueryServer interface
+func (k *Keeper) DeletableClients(c context.Context, req *clienttypes.QueryDeletableClientsRequest) (*clienttypes.QueryDeletableClientsResponse, error) {
+	return k.ClientKeeper.DeletableClients(c, req)
+}
+
+// ExpiringClients implements the IBC QueryServer interface
+func (k *Keeper) ExpiringClients(c context.Context, req *clienttypes.QueryExpiringClientsRequest) (*clienttypes.QueryExpiringClientsResponse, error) {
+	return k.ClientKeeper.ExpiringClients(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (k *Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return k.ClientKeeper.ClientParams(c, req)
 }
 
+// ClientCreationPolicy implements the IBC QueryServer interface
+func (k *Keeper) ClientCreationPolicy(c context.Context, req *clienttypes.QueryClientCreationPolicyRequest) (*clienttypes.QueryClientCreationPolicyResponse, error) {
+	return k.ClientKeeper.ClientCreationPolicy(c, req)
+}
+
+// Counterparty implements the IBC QueryServer interface
+func (k *Keeper) Counterparty(c context.Context, req *clienttypes.QueryCounterpartyRequest) (*clienttypes.QueryCounterpartyResponse, error) {
+	return k.ClientKeeper.Counterparty(c, req)
+}
+
 // UpgradedClientState implements the IBC QueryServer interface
 func (k *Keeper) UpgradedClientState(c context.Context, req *clienttypes.QueryUpgradedClientStateRequest) (*clienttypes.QueryUpgradedClientStateResponse, error) {
 	return k.ClientKeeper.UpgradedClientState(c, req)
@@ -58,6 +78,16 @@ func (k *Keeper) VerifyMembership(c context.Context, req *clienttypes.QueryVerif
 	return k.ClientKeeper.VerifyMembership(c, req)
 }
 
+// VerifyNonMembership implements the IBC QueryServer interface.
+func (k *Keeper) VerifyNonMembership(c context.Context, req *clienttypes.QueryVerifyNonMembershipRequest) (*clienttypes.QueryVerifyNonMembershipResponse, error) {
+	return k.ClientKeeper.VerifyNonMembership(c, req)
+}
+
+// VerifyMembershipBatch implements the IBC QueryServer interface.
+func (k *Keeper) VerifyMembershipBatch(c context.Context, req *clienttypes.QueryVerifyMembershipBatchRequest) (*clienttypes.QueryVerifyMembershipBatchResponse, error) {
+	return k.ClientKeeper.VerifyMembershipBatch(c, req)
+}
+
 // Connection implements the IBC QueryServer interface
 func (k *Keeper) Connection(c context.Context, req *connectiontypes.QueryConnectionRequest) (*connectiontypes.QueryConnectionResponse, error) {
 	return k.ConnectionKeeper.Connection(c, req)
@@ -83,6 +113,16 @@ func (k *Keeper) ConnectionConsensusState(c context.Context, req *connectiontype
 	return k.ConnectionKeeper.ConnectionConsensusState(c, req)
 }
 
+// ConnectionUpgrade implements the IBC QueryServer interface
+func (k *Keeper) ConnectionUpgrade(c context.Context, req *connectiontypes.QueryConnectionUpgradeRequest) (*connectiontypes.QueryConnectionUpgradeResponse, error) {
+	return k.ConnectionKeeper.ConnectionUpgrade(c, req)
+}
+
+// ConnectionUpgradeError implements the IBC QueryServer interface
+func (k *Keeper) ConnectionUpgradeError(c context.Context, req *connectiontypes.QueryConnectionUpgradeErrorRequest) (*connectiontypes.QueryConnectionUpgradeErrorResponse, error) {
+	return k.ConnectionKeeper.ConnectionUpgradeError(c, req)
+}
+
 // ConnectionParams implements the IBC QueryServer interface
 func (k *Keeper) ConnectionParams(c context.Context, req *connectiontypes.QueryConnectionParamsRequest) (*connectiontypes.QueryConnectionParamsResponse, error) {
 	return k.ConnectionKeeper.ConnectionParams(c, req)
@@ -172,3 +212,38 @@ func (k *Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeReques
 func (k *Keeper) ChannelParams(c context.Context, req *channeltypes.QueryChannelParamsRequest) (*channeltypes.QueryChannelParamsResponse, error) {
 	return k.ChannelKeeper.ChannelParams(c, req)
 }
+
+// ChannelPaused implements the IBC QueryServer interface
+func (k *Keeper) ChannelPaused(c context.Context, req *channeltypes.QueryChannelPausedRequest) (*channeltypes.QueryChannelPausedResponse, error) {
+	return k.ChannelKeeper.ChannelPaused(c, req)
+}
+
+// PausedChannels implements the IBC QueryServer interface
+func (k *Keeper) PausedChannels(c context.Context, req *channeltypes.QueryPausedChannelsRequest) (*channeltypes.QueryPausedChannelsResponse, error) {
+	return k.ChannelKeeper.PausedChannels(c, req)
+}
+
+// Packet implements the IBC QueryServer interface
+func (k *Keeper) Packet(c context.Context, req *channeltypes.QueryPacketRequest) (*channeltypes.QueryPacketResponse, error) {
+	return k.ChannelKeeper.Packet(c, req)
+}
+
+// Packets implements the IBC QueryServer interface
+func (k *Keeper) Packets(c context.Context, req *channeltypes.QueryPacketsRequest) (*channeltypes.QueryPacketsResponse, error) {
+	return k.ChannelKeeper.Packets(c, req)
+}
+
+// PacketStatus implements the IBC QueryServer interface
+func (k *Keeper) PacketStatus(c context.Context, req *channeltypes.QueryPacketStatusRequest) (*channeltypes.QueryPacketStatusResponse, error) {
+	return k.ChannelKeeper.PacketStatus(c, req)
+}
+
+// ChannelUpgradeSchedule implements the IBC QueryServer interface
+func (k *Keeper) ChannelUpgradeSchedule(c context.Context, req *channeltypes.QueryChannelUpgradeScheduleRequest) (*channeltypes.QueryChannelUpgradeScheduleResponse, error) {
+	return k.ChannelKeeper.ChannelUpgradeSchedule(c, req)
+}
+
+// ChannelUpgradeSchedules implements the IBC QueryServer interface
+func (k *Keeper) ChannelUpgradeSchedules(c context.Context, req *channeltypes.QueryChannelUpgradeSchedulesRequest) (*channeltypes.QueryChannelUpgradeSchedulesResponse, error) {
+	return k.ChannelKeeper.ChannelUpgradeSchedules(c, req)
+}
@@ -17,6 +17,7 @@ import (
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	portkeeper "github.com/cosmos/ibc-go/v8/modules/core/05-port/keeper"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	packetserverkeeper "github.com/cosmos/ibc-go/v8/modules/core/packet-server/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
@@ -34,6 +35,8 @@ type Keeper struct {
 	ChannelKeeper    *channelkeeper.Keeper
 	PortKeeper       *portkeeper.Keeper
 
+	PacketServerKeeper *packetserverkeeper.Keeper
+
 	authority string
 }
 
@@ -64,14 +67,16 @@ func NewKeeper(
 	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
 	portKeeper := portkeeper.NewKeeper(scopedKeeper)
 	channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
+	packetServerKeeper := packetserverkeeper.NewKeeper(cdc, channelKeeper, clientKeeper, scopedKeeper)
 
 	return &Keeper{
-		cdc:              cdc,
-		ClientKeeper:     clientKeeper,
-		ConnectionKeeper: connectionKeeper,
-		ChannelKeeper:    channelKeeper,
-		PortKeeper:       portKeeper,
-		authority:        authority,
+		cdc:                cdc,
+		ClientKeeper:       clientKeeper,
+		ConnectionKeeper:   connectionKeeper,
+		ChannelKeeper:      channelKeeper,
+		PortKeeper:         portKeeper,
+		PacketServerKeeper: packetServerKeeper,
+		authority:          authority,
 	}
 }
 
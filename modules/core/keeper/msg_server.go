@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
@@ -40,10 +41,16 @@ func (k *Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateC
 		return nil, err
 	}
 
-	if _, err = k.ClientKeeper.CreateClient(ctx, clientState.ClientType(), msg.ClientState.Value, msg.ConsensusState.Value); err != nil {
+	clientID, err := k.ClientKeeper.CreateClientWithCreator(ctx, clientState.ClientType(), msg.ClientState.Value, msg.ConsensusState.Value, msg.Signer)
+	if err != nil {
 		return nil, err
 	}
 
+	// the creator is only stored for clients whose counterparty will be provided
+	if msg.AllowCounterparty {
+		k.ClientKeeper.SetClientCreator(ctx, clientID, msg.Signer)
+	}
+
 	return &clienttypes.MsgCreateClientResponse{}, nil
 }
 
@@ -110,13 +117,54 @@ func (k *Keeper) RecoverClient(goCtx context.Context, msg *clienttypes.MsgRecove
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.ClientKeeper.RecoverClient(ctx, msg.SubjectClientId, msg.SubstituteClientId); err != nil {
+	if err := k.ClientKeeper.RecoverClientWithOverrides(ctx, msg.SubjectClientId, msg.SubstituteClientId, msg.Overrides); err != nil {
 		return nil, errorsmod.Wrap(err, "client recovery failed")
 	}
 
 	return &clienttypes.MsgRecoverClientResponse{}, nil
 }
 
+// DeleteClient defines a rpc handler method for MsgDeleteClient. The signer must be the authority, unless
+// the client deletion grace period has passed for the client, in which case any account may delete it.
+func (k *Keeper) DeleteClient(goCtx context.Context, msg *clienttypes.MsgDeleteClient) (*clienttypes.MsgDeleteClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Signer && !k.ClientKeeper.IsPermissionlessDeletionAllowed(ctx, msg.ClientId) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s or the client deletion grace period to have passed, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if err := k.ClientKeeper.DeleteClient(ctx, msg.ClientId); err != nil {
+		return nil, errorsmod.Wrap(err, "client deletion failed")
+	}
+
+	return &clienttypes.MsgDeleteClientResponse{}, nil
+}
+
+// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+func (k *Keeper) PruneConsensusStates(goCtx context.Context, msg *clienttypes.MsgPruneConsensusStates) (*clienttypes.MsgPruneConsensusStatesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pruned, err := k.ClientKeeper.PruneConsensusStates(ctx, msg.ClientId, msg.Limit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "consensus state pruning failed")
+	}
+
+	return &clienttypes.MsgPruneConsensusStatesResponse{ConsensusStatesPruned: pruned}, nil
+}
+
+// ProvideCounterparty defines a rpc handler method for MsgProvideCounterparty.
+func (k *Keeper) ProvideCounterparty(goCtx context.Context, msg *clienttypes.MsgProvideCounterparty) (*clienttypes.MsgProvideCounterpartyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.ProvideCounterparty(ctx, msg.ClientId, msg.Counterparty, msg.Signer); err != nil {
+		return nil, errorsmod.Wrap(err, "counterparty provision failed")
+	}
+
+	return &clienttypes.MsgProvideCounterpartyResponse{}, nil
+}
+
 // IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 func (k *Keeper) IBCSoftwareUpgrade(goCtx context.Context, msg *clienttypes.MsgIBCSoftwareUpgrade) (*clienttypes.MsgIBCSoftwareUpgradeResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -200,6 +248,186 @@ func (k *Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontyp
 	return &connectiontypes.MsgConnectionOpenConfirmResponse{}, nil
 }
 
+// ConnectionCloseInit defines a rpc handler method for MsgConnectionCloseInit.
+func (k *Keeper) ConnectionCloseInit(goCtx context.Context, msg *connectiontypes.MsgConnectionCloseInit) (*connectiontypes.MsgConnectionCloseInitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnCloseInit(ctx, msg.ConnectionId); err != nil {
+		return nil, errorsmod.Wrap(err, "connection handshake close init failed")
+	}
+
+	return &connectiontypes.MsgConnectionCloseInitResponse{}, nil
+}
+
+// ConnectionCloseConfirm defines a rpc handler method for MsgConnectionCloseConfirm.
+func (k *Keeper) ConnectionCloseConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionCloseConfirm) (*connectiontypes.MsgConnectionCloseConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnCloseConfirm(
+		ctx, msg.ConnectionId, msg.ProofInit, msg.ProofHeight, msg.CounterpartyUpgradeSequence,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "connection handshake close confirm failed")
+	}
+
+	return &connectiontypes.MsgConnectionCloseConfirmResponse{}, nil
+}
+
+// ConnectionUpgradeInit defines a rpc handler method for MsgConnectionUpgradeInit.
+func (k *Keeper) ConnectionUpgradeInit(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeInit) (*connectiontypes.MsgConnectionUpgradeInitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	upgrade, err := k.ConnectionKeeper.ConnUpgradeInit(ctx, msg.ConnectionId, msg.Fields)
+	if err != nil {
+		ctx.Logger().Error("connection upgrade init failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade init failed")
+	}
+
+	connection, upgrade := k.ConnectionKeeper.WriteUpgradeInitConnection(ctx, msg.ConnectionId, upgrade)
+
+	ctx.Logger().Info("connection upgrade init succeeded", "connection-id", msg.ConnectionId, "version", upgrade.Fields.Version)
+
+	return &connectiontypes.MsgConnectionUpgradeInitResponse{
+		Upgrade:         upgrade,
+		UpgradeSequence: connection.UpgradeSequence,
+	}, nil
+}
+
+// ConnectionUpgradeTry defines a rpc handler method for MsgConnectionUpgradeTry.
+func (k *Keeper) ConnectionUpgradeTry(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTry) (*connectiontypes.MsgConnectionUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, upgrade, err := k.ConnectionKeeper.ConnUpgradeTry(ctx, msg.ConnectionId, msg.CounterpartyUpgradeFields, msg.CounterpartyUpgradeSequence, msg.ProofConnection, msg.ProofUpgrade, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("connection upgrade try failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		if connectiontypes.IsUpgradeError(err) {
+			// In case the error is a wrapped upgrade error, we need to extract the inner error else process as normal
+			var upgradeErr *connectiontypes.UpgradeError
+			if errors.As(err, &upgradeErr) {
+				k.ConnectionKeeper.WriteErrorReceipt(ctx, msg.ConnectionId, upgradeErr)
+				// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+				// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
+				return &connectiontypes.MsgConnectionUpgradeTryResponse{Result: connectiontypes.FAILURE}, nil
+			}
+		}
+
+		// NOTE: an error is returned to baseapp and transaction state is not committed.
+		return nil, errorsmod.Wrap(err, "connection upgrade try failed")
+	}
+
+	connection, upgrade := k.ConnectionKeeper.WriteUpgradeTryConnection(ctx, msg.ConnectionId, upgrade, msg.CounterpartyUpgradeFields)
+
+	ctx.Logger().Info("connection upgrade try succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeTryResponse{
+		Result:          connectiontypes.SUCCESS,
+		Upgrade:         upgrade,
+		UpgradeSequence: connection.UpgradeSequence,
+	}, nil
+}
+
+// ConnectionUpgradeAck defines a rpc handler method for MsgConnectionUpgradeAck.
+func (k *Keeper) ConnectionUpgradeAck(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeAck) (*connectiontypes.MsgConnectionUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeAck(ctx, msg.ConnectionId, msg.CounterpartyUpgrade, msg.ProofConnection, msg.ProofUpgrade, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade ack failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		if connectiontypes.IsUpgradeError(err) {
+			k.ConnectionKeeper.MustAbortUpgrade(ctx, msg.ConnectionId, err)
+
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
+			return &connectiontypes.MsgConnectionUpgradeAckResponse{Result: connectiontypes.FAILURE}, nil
+		}
+
+		// NOTE: an error is returned to baseapp and transaction state is not committed.
+		return nil, errorsmod.Wrap(err, "connection upgrade ack failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeAckConnection(ctx, msg.ConnectionId, msg.CounterpartyUpgrade)
+
+	ctx.Logger().Info("connection upgrade ack succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeAckResponse{Result: connectiontypes.SUCCESS}, nil
+}
+
+// ConnectionUpgradeConfirm defines a rpc handler method for MsgConnectionUpgradeConfirm.
+func (k *Keeper) ConnectionUpgradeConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeConfirm) (*connectiontypes.MsgConnectionUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeConfirm(ctx, msg.ConnectionId, msg.ProofConnection, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade confirm failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade confirm failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeConfirmConnection(ctx, msg.ConnectionId)
+
+	ctx.Logger().Info("connection upgrade confirm succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeConfirmResponse{Result: connectiontypes.SUCCESS}, nil
+}
+
+// ConnectionUpgradeTimeout defines a rpc handler method for MsgConnectionUpgradeTimeout.
+func (k *Keeper) ConnectionUpgradeTimeout(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTimeout) (*connectiontypes.MsgConnectionUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeTimeout(ctx, msg.ConnectionId, msg.CounterpartyConnection, msg.ProofConnection, msg.ProofHeight); err != nil {
+		return nil, errorsmod.Wrapf(err, "could not timeout upgrade for connection: %s", msg.ConnectionId)
+	}
+
+	k.ConnectionKeeper.WriteUpgradeTimeoutConnection(ctx, msg.ConnectionId)
+
+	ctx.Logger().Info("connection upgrade timeout succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeTimeoutResponse{}, nil
+}
+
+// ConnectionUpgradeCancel defines a rpc handler method for MsgConnectionUpgradeCancel.
+func (k *Keeper) ConnectionUpgradeCancel(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeCancel) (*connectiontypes.MsgConnectionUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	connection, found := k.ConnectionKeeper.GetConnection(ctx, msg.ConnectionId)
+	if !found {
+		return nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, msg.ConnectionId)
+	}
+
+	// if the msgSender is authorized to make and cancel upgrades AND the upgrade has not yet been accepted
+	// then we can restore immediately without any additional checks
+	isAuthority := k.GetAuthority() == msg.Signer
+	if isAuthority {
+		upgrade, found := k.ConnectionKeeper.GetUpgrade(ctx, msg.ConnectionId)
+		if !found {
+			return nil, errorsmod.Wrapf(connectiontypes.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", msg.ConnectionId)
+		}
+
+		if upgrade.TimeoutTimestamp == 0 {
+			k.ConnectionKeeper.WriteUpgradeCancelConnection(ctx, msg.ConnectionId, connection.UpgradeSequence)
+
+			ctx.Logger().Info("connection upgrade cancel succeeded", "connection-id", msg.ConnectionId)
+
+			return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+		}
+	}
+
+	if err := k.ConnectionKeeper.ConnUpgradeCancel(ctx, msg.ConnectionId, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade cancel failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade cancel failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeCancelConnection(ctx, msg.ConnectionId, msg.ErrorReceipt.Sequence)
+
+	ctx.Logger().Info("connection upgrade cancel succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+}
+
 // ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
 // ChannelOpenInit will perform 04-channel checks, route to the application
 // callback, and write an OpenInit channel into state upon successful execution.
@@ -455,44 +683,151 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
-	// Lookup module by channel capability
-	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+	cbs, capability, err := k.lookupRecvPacketRoute(ctx, msg.Packet)
 	if err != nil {
-		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
-		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+		return nil, err
+	}
+
+	result, err := k.recvPacket(ctx, cbs, capability, k.newRecvPacketBatch(msg.Packet), relayer, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgRecvPacketResponse{Result: result}, nil
+}
+
+// RecvPackets defines a rpc handler method for MsgRecvPackets. The module, capability, application callbacks,
+// connection end and client status are looked up once for the batch. Redundant packets are skipped
+// and any other failure fails the whole batch.
+func (k *Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	// all packets in the batch are received on the same channel
+	cbs, capability, err := k.lookupRecvPacketRoute(ctx, msg.Packets[0])
+	if err != nil {
+		return nil, err
+	}
+
+	batch := k.newRecvPacketBatch(msg.Packets[0])
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		results[i], err = k.recvPacket(ctx, cbs, capability, batch, relayer, packet, msg.ProofsCommitment[i], msg.ProofHeight)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "packet at index %d", i)
+		}
+	}
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// lookupRecvPacketRoute returns the application callbacks and capability used to receive the packet.
+func (k *Keeper) lookupRecvPacketRoute(ctx sdk.Context, packet channeltypes.Packet) (porttypes.IBCModule, *capabilitytypes.Capability, error) {
+	// Lookup module by channel capability, or by port capability for packets routed by client identifier
+	module, capability, err := k.lookupModuleByPacket(ctx, packet, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		ctx.Logger().Error("receive packet failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
-		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+		ctx.Logger().Error("receive packet failed", "port-id", packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		if err := authorizeClientRoute(ctx, cbs, packet.DestinationPort, packet.DestinationChannel, packet.SourcePort, packet.SourceChannel); err != nil {
+			ctx.Logger().Error("receive packet failed", "port-id", packet.DestinationPort, "client-id", packet.DestinationChannel, "error", err)
+			return nil, nil, err
+		}
+	}
+
+	return cbs, capability, nil
+}
+
+// newRecvPacketBatch returns the batch of the packets received on the destination channel end of the packet. Packets
+// routed by client identifier are not received on a channel end and are not batched.
+func (k *Keeper) newRecvPacketBatch(packet channeltypes.Packet) *keeper.PacketBatch {
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		return nil
+	}
+
+	return keeper.NewPacketBatch(packet.DestinationPort, packet.DestinationChannel)
+}
+
+// newSendPacketBatch returns the batch of the packets sent on the source channel end of the packet. Packets routed
+// by client identifier are not sent on a channel end and are not batched.
+func (k *Keeper) newSendPacketBatch(packet channeltypes.Packet) *keeper.PacketBatch {
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		return nil
+	}
+
+	return keeper.NewPacketBatch(packet.SourcePort, packet.SourceChannel)
+}
+
+// recvPacket performs TAO verification of the packet and executes the application callback.
+func (k *Keeper) recvPacket(
+	ctx sdk.Context,
+	cbs porttypes.IBCModule,
+	capability *capabilitytypes.Capability,
+	batch *keeper.PacketBatch,
+	relayer sdk.AccAddress,
+	packet channeltypes.Packet,
+	proof []byte,
+	proofHeight clienttypes.Height,
+) (channeltypes.ResponseResultType, error) {
+	var err error
+
 	// Perform TAO verification
 	//
 	// If the packet was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.RecvPacket(cacheCtx, capability, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		err = k.PacketServerKeeper.RecvPacket(cacheCtx, capability, packet, proof, proofHeight)
+	} else {
+		err = k.ChannelKeeper.RecvPacketInBatch(cacheCtx, capability, batch, packet, proof, proofHeight)
+	}
 
 	switch err {
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		// no-ops do not need event emission as they will be ignored
-		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
-		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+		ctx.Logger().Debug("no-op on redundant relay", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel)
+		return channeltypes.NOOP, nil
+	case channeltypes.ErrTimeoutReceipt:
+		// timed out packets on ORDERED_ALLOW_TIMEOUT channels advance the receive sequence
+		// but are never passed to the application
+		writeFn()
+		ctx.Logger().Info("timed out packet received, skipping application callback", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+		return channeltypes.SUCCESS, nil
 	default:
-		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
-		return nil, errorsmod.Wrap(err, "receive packet verification failed")
+		ctx.Logger().Error("receive packet failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
+		return channeltypes.UNSPECIFIED, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
 	// Perform application logic callback
 	//
 	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
 	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
+	ack := cbs.OnRecvPacket(cacheCtx, packet, relayer)
+	// the application may have modified the channel end
+	batch.InvalidateChannel()
+
+	if ack == nil && packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		ctx.Logger().Error("receive packet failed", "port-id", packet.DestinationPort, "client-id", packet.DestinationChannel, "error", "asynchronous acknowledgement")
+		return channeltypes.UNSPECIFIED, errorsmod.Wrap(channeltypes.ErrInvalidAcknowledgement, "asynchronous acknowledgements are not supported for packets routed by client identifier")
+	}
+
 	if ack == nil || ack.Success() {
 		// write application state changes for asynchronous and successful acknowledgements
 		writeFn()
@@ -505,8 +840,14 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 	// acknowledgement is nil.
 	if ack != nil {
-		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, capability, msg.Packet, ack); err != nil {
-			return nil, err
+		if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+			err = k.PacketServerKeeper.WriteAcknowledgement(ctx, capability, packet, ack)
+		} else {
+			err = k.ChannelKeeper.WriteAcknowledgementInBatch(ctx, capability, batch, packet, ack)
+		}
+
+		if err != nil {
+			return channeltypes.UNSPECIFIED, err
 		}
 	}
 
@@ -514,16 +855,16 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 		[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
 		1,
 		[]metrics.Label{
-			telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-			telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-			telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-			telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+			telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+			telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 		},
 	)
 
-	ctx.Logger().Info("receive packet callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	ctx.Logger().Info("receive packet callback succeeded", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "result", channeltypes.SUCCESS.String())
 
-	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+	return channeltypes.SUCCESS, nil
 }
 
 // Timeout defines a rpc handler method for MsgTimeout.
@@ -536,66 +877,127 @@ func (k *Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
-	// Lookup module by channel capability
-	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
+	cbs, capability, err := k.lookupSendPacketRoute(ctx, msg.Packet, "timeout failed")
 	if err != nil {
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
-		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+		return nil, err
 	}
 
-	// Retrieve callbacks from router
-	cbs, ok := k.PortKeeper.Route(module)
-	if !ok {
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
-		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	result, err := k.timeoutPacket(ctx, cbs, capability, k.newSendPacketBatch(msg.Packet), relayer, msg.Packet, msg.ProofUnreceived, msg.ProofHeight, msg.NextSequenceRecv)
+	if err != nil {
+		return nil, err
 	}
 
+	return &channeltypes.MsgTimeoutResponse{Result: result}, nil
+}
+
+// Timeouts defines a rpc handler method for MsgTimeouts. The module, capability, application callbacks,
+// connection end and client status are looked up once for the batch. Redundant packets are skipped and any
+// other failure fails the whole batch.
+func (k *Keeper) Timeouts(goCtx context.Context, msg *channeltypes.MsgTimeouts) (*channeltypes.MsgTimeoutsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("timeouts failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	// all packets in the batch are sent on the same channel
+	cbs, capability, err := k.lookupSendPacketRoute(ctx, msg.Packets[0], "timeout failed")
+	if err != nil {
+		return nil, err
+	}
+
+	batch := k.newSendPacketBatch(msg.Packets[0])
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		results[i], err = k.timeoutPacket(ctx, cbs, capability, batch, relayer, packet, msg.ProofsUnreceived[i], msg.ProofHeight, msg.NextSequenceRecv)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "packet at index %d", i)
+		}
+	}
+
+	return &channeltypes.MsgTimeoutsResponse{Results: results}, nil
+}
+
+// timeoutPacket performs TAO verification of the packet timeout, deletes the packet commitment and executes the
+// application callback.
+func (k *Keeper) timeoutPacket(
+	ctx sdk.Context,
+	cbs porttypes.IBCModule,
+	capability *capabilitytypes.Capability,
+	batch *keeper.PacketBatch,
+	relayer sdk.AccAddress,
+	packet channeltypes.Packet,
+	proof []byte,
+	proofHeight clienttypes.Height,
+	nextSequenceRecv uint64,
+) (channeltypes.ResponseResultType, error) {
+	var err error
+
+	// the upgrade of a flushing channel is aborted if the counterparty upgrade timeout has elapsed
+	flushing := k.isChannelFlushing(ctx, batch)
+
 	// Perform TAO verification
 	//
 	// If the timeout was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.TimeoutPacket(cacheCtx, msg.Packet, msg.ProofUnreceived, msg.ProofHeight, msg.NextSequenceRecv)
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		// NOTE: the packet commitment is deleted by the packet server upon successful verification
+		err = k.PacketServerKeeper.TimeoutPacket(cacheCtx, capability, packet, proof, proofHeight)
+	} else {
+		err = k.ChannelKeeper.TimeoutPacketInBatch(cacheCtx, batch, packet, proof, proofHeight, nextSequenceRecv)
+	}
 
 	switch err {
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		// no-ops do not need event emission as they will be ignored
-		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
-		return &channeltypes.MsgTimeoutResponse{Result: channeltypes.NOOP}, nil
+		ctx.Logger().Debug("no-op on redundant relay", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel)
+		return channeltypes.NOOP, nil
 	default:
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout packet verification failed"))
-		return nil, errorsmod.Wrap(err, "timeout packet verification failed")
+		ctx.Logger().Error("timeout failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout packet verification failed"))
+		return channeltypes.UNSPECIFIED, errorsmod.Wrap(err, "timeout packet verification failed")
 	}
 
 	// Delete packet commitment
-	if err = k.ChannelKeeper.TimeoutExecuted(ctx, capability, msg.Packet); err != nil {
-		return nil, err
+	if packet.ProtocolVersion != channeltypes.IBC_VERSION_2 {
+		if err = k.ChannelKeeper.TimeoutExecutedInBatch(ctx, capability, batch, packet); err != nil {
+			return channeltypes.UNSPECIFIED, err
+		}
+
+		if flushing {
+			k.onChanUpgradeAborted(ctx, packet.SourcePort, packet.SourceChannel)
+		}
 	}
 
 	// Perform application logic callback
-	err = cbs.OnTimeoutPacket(ctx, msg.Packet, relayer)
+	err = cbs.OnTimeoutPacket(ctx, packet, relayer)
+	// the application may have modified the channel end
+	batch.InvalidateChannel()
 	if err != nil {
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout packet callback failed"))
-		return nil, errorsmod.Wrap(err, "timeout packet callback failed")
+		ctx.Logger().Error("timeout failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout packet callback failed"))
+		return channeltypes.UNSPECIFIED, errorsmod.Wrap(err, "timeout packet callback failed")
 	}
 
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"ibc", "timeout", "packet"},
 		1,
 		[]metrics.Label{
-			telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-			telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-			telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-			telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+			telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+			telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 			telemetry.NewLabel(coretypes.LabelTimeoutType, "height"),
 		},
 	)
 
-	ctx.Logger().Info("timeout packet callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	ctx.Logger().Info("timeout packet callback succeeded", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "result", channeltypes.SUCCESS.String())
 
-	return &channeltypes.MsgTimeoutResponse{Result: channeltypes.SUCCESS}, nil
+	return channeltypes.SUCCESS, nil
 }
 
 // TimeoutOnClose defines a rpc handler method for MsgTimeoutOnClose.
@@ -608,6 +1010,10 @@ func (k *Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTime
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
+	if msg.Packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "packets routed by client identifier cannot be timed out on close")
+	}
+
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 	if err != nil {
@@ -683,60 +1089,169 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAck
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
-	// Lookup module by channel capability
-	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
+	cbs, capability, err := k.lookupSendPacketRoute(ctx, msg.Packet, "acknowledgement failed")
 	if err != nil {
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
-		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+		return nil, err
 	}
 
-	// Retrieve callbacks from router
-	cbs, ok := k.PortKeeper.Route(module)
-	if !ok {
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
-		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	result, err := k.acknowledgePacket(ctx, cbs, capability, k.newSendPacketBatch(msg.Packet), relayer, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgAcknowledgementResponse{Result: result}, nil
+}
+
+// Acknowledgements defines a rpc handler method for MsgAcknowledgements. The module, capability, application
+// callbacks, connection end and client status are looked up once for the batch. Redundant packets
+// are skipped and any other failure fails the whole batch.
+func (k *Keeper) Acknowledgements(goCtx context.Context, msg *channeltypes.MsgAcknowledgements) (*channeltypes.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
+	// all packets in the batch are sent on the same channel
+	cbs, capability, err := k.lookupSendPacketRoute(ctx, msg.Packets[0], "acknowledgement failed")
+	if err != nil {
+		return nil, err
+	}
+
+	batch := k.newSendPacketBatch(msg.Packets[0])
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		results[i], err = k.acknowledgePacket(ctx, cbs, capability, batch, relayer, packet, msg.Acknowledgements[i], msg.ProofsAcked[i], msg.ProofHeight)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "packet at index %d", i)
+		}
+	}
+
+	return &channeltypes.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// acknowledgePacket performs TAO verification of the packet acknowledgement and executes the application callback.
+func (k *Keeper) acknowledgePacket(
+	ctx sdk.Context,
+	cbs porttypes.IBCModule,
+	capability *capabilitytypes.Capability,
+	batch *keeper.PacketBatch,
+	relayer sdk.AccAddress,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	proof []byte,
+	proofHeight clienttypes.Height,
+) (channeltypes.ResponseResultType, error) {
+	var err error
+
+	// the upgrade of a flushing channel is aborted if the counterparty upgrade timeout has elapsed
+	flushing := k.isChannelFlushing(ctx, batch)
+
 	// Perform TAO verification
 	//
 	// If the acknowledgement was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.AcknowledgePacket(cacheCtx, capability, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		err = k.PacketServerKeeper.AcknowledgePacket(cacheCtx, capability, packet, acknowledgement, proof, proofHeight)
+	} else {
+		err = k.ChannelKeeper.AcknowledgePacketInBatch(cacheCtx, capability, batch, packet, acknowledgement, proof, proofHeight)
+	}
 
 	switch err {
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		// no-ops do not need event emission as they will be ignored
-		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
-		return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.NOOP}, nil
+		ctx.Logger().Debug("no-op on redundant relay", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel)
+		return channeltypes.NOOP, nil
 	default:
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet verification failed"))
-		return nil, errorsmod.Wrap(err, "acknowledge packet verification failed")
+		ctx.Logger().Error("acknowledgement failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet verification failed"))
+		return channeltypes.UNSPECIFIED, errorsmod.Wrap(err, "acknowledge packet verification failed")
+	}
+
+	if flushing {
+		k.onChanUpgradeAborted(ctx, packet.SourcePort, packet.SourceChannel)
 	}
 
 	// Perform application logic callback
-	err = cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer)
+	err = cbs.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	// the application may have modified the channel end
+	batch.InvalidateChannel()
 	if err != nil {
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet callback failed"))
-		return nil, errorsmod.Wrap(err, "acknowledge packet callback failed")
+		ctx.Logger().Error("acknowledgement failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet callback failed"))
+		return channeltypes.UNSPECIFIED, errorsmod.Wrap(err, "acknowledge packet callback failed")
 	}
 
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
 		1,
 		[]metrics.Label{
-			telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-			telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-			telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-			telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+			telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+			telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 		},
 	)
 
-	ctx.Logger().Info("acknowledgement succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	ctx.Logger().Info("acknowledgement succeeded", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+
+	return channeltypes.SUCCESS, nil
+}
+
+// lookupSendPacketRoute returns the application callbacks and capability used to acknowledge or time out the packet.
+// The provided log message is used when the lookup fails.
+func (k *Keeper) lookupSendPacketRoute(ctx sdk.Context, packet channeltypes.Packet, logMsg string) (porttypes.IBCModule, *capabilitytypes.Capability, error) {
+	// Lookup module by channel capability, or by port capability for packets routed by client identifier
+	module, capability, err := k.lookupModuleByPacket(ctx, packet, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		ctx.Logger().Error(logMsg, "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.PortKeeper.Route(module)
+	if !ok {
+		ctx.Logger().Error(logMsg, "port-id", packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		if err := authorizeClientRoute(ctx, cbs, packet.SourcePort, packet.SourceChannel, packet.DestinationPort, packet.DestinationChannel); err != nil {
+			ctx.Logger().Error(logMsg, "port-id", packet.SourcePort, "client-id", packet.SourceChannel, "error", err)
+			return nil, nil, err
+		}
+	}
 
-	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
+	return cbs, capability, nil
+}
+
+// lookupModuleByPacket returns the module and capability used to process the packet. Packets routed by
+// client identifier are looked up by the port capability, all other packets are looked up by the channel capability.
+func (k *Keeper) lookupModuleByPacket(ctx sdk.Context, packet channeltypes.Packet, portID, channelID string) (string, *capabilitytypes.Capability, error) {
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		return k.PortKeeper.LookupModuleByPort(ctx, portID)
+	}
+
+	return k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+}
+
+// authorizeClientRoute returns an error if the application has not opted in to packets routed by client identifier
+// or if it rejects the route between the given port and client and the counterparty port and client.
+func authorizeClientRoute(ctx sdk.Context, cbs porttypes.IBCModule, portID, clientID, counterpartyPortID, counterpartyClientID string) error {
+	app, ok := cbs.(porttypes.ClientRoutedModule)
+	if !ok {
+		return errorsmod.Wrapf(porttypes.ErrInvalidRoute, "application bound to port %s does not support packets routed by client identifier", portID)
+	}
+
+	if err := app.AuthorizeClientRoute(ctx, portID, clientID, counterpartyPortID, counterpartyClientID); err != nil {
+		return errorsmod.Wrapf(err, "application rejected route from port %s and client %s", portID, clientID)
+	}
+
+	return nil
 }
 
 // ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
@@ -747,48 +1262,59 @@ func (k *Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.Msg
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
 	}
 
-	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	channel, upgrade, err := k.initChannelUpgrade(ctx, msg.PortId, msg.ChannelId, msg.Fields)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
-		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+		return nil, err
+	}
+
+	return &channeltypes.MsgChannelUpgradeInitResponse{
+		Upgrade:         upgrade,
+		UpgradeSequence: channel.UpgradeSequence,
+	}, nil
+}
+
+// initChannelUpgrade initiates an upgrade handshake of the given channel to the proposed upgrade fields. It is
+// used by the authority to upgrade a channel with MsgChannelUpgradeInit or with a channel upgrade schedule.
+func (k *Keeper) initChannelUpgrade(ctx sdk.Context, portID, channelID string, fields channeltypes.UpgradeFields) (channeltypes.Channel, channeltypes.Upgrade, error) {
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade init failed", "port-id", portID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return channeltypes.Channel{}, channeltypes.Upgrade{}, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	app, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
-		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+		ctx.Logger().Error("channel upgrade init failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return channeltypes.Channel{}, channeltypes.Upgrade{}, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	cbs, ok := app.(porttypes.UpgradableModule)
 	if !ok {
-		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module))
-		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
+		ctx.Logger().Error("channel upgrade init failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module))
+		return channeltypes.Channel{}, channeltypes.Upgrade{}, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
 	}
 
-	upgrade, err := k.ChannelKeeper.ChanUpgradeInit(ctx, msg.PortId, msg.ChannelId, msg.Fields)
+	upgrade, err := k.ChannelKeeper.ChanUpgradeInit(ctx, portID, channelID, fields)
 	if err != nil {
 		ctx.Logger().Error("channel upgrade init failed", "error", errorsmod.Wrap(err, "channel upgrade init failed"))
-		return nil, errorsmod.Wrap(err, "channel upgrade init failed")
+		return channeltypes.Channel{}, channeltypes.Upgrade{}, errorsmod.Wrap(err, "channel upgrade init failed")
 	}
 
 	// NOTE: a cached context is used to discard ibc application state changes and events.
 	// IBC applications must flush in-flight packets using the pre-upgrade channel parameters.
 	cacheCtx, _ := ctx.CacheContext()
-	upgradeVersion, err := cbs.OnChanUpgradeInit(cacheCtx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
+	upgradeVersion, err := cbs.OnChanUpgradeInit(cacheCtx, portID, channelID, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade init callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
-		return nil, errorsmod.Wrapf(err, "channel upgrade init callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
+		ctx.Logger().Error("channel upgrade init callback failed", "port-id", portID, "channel-id", channelID, "error", err.Error())
+		return channeltypes.Channel{}, channeltypes.Upgrade{}, errorsmod.Wrapf(err, "channel upgrade init callback failed for port ID: %s, channel ID: %s", portID, channelID)
 	}
 
-	channel, upgrade := k.ChannelKeeper.WriteUpgradeInitChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
+	channel, upgrade := k.ChannelKeeper.WriteUpgradeInitChannel(ctx, portID, channelID, upgrade, upgradeVersion)
 
-	ctx.Logger().Info("channel upgrade init succeeded", "channel-id", msg.ChannelId, "version", upgradeVersion)
-	keeper.EmitChannelUpgradeInitEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
+	ctx.Logger().Info("channel upgrade init succeeded", "channel-id", channelID, "version", upgradeVersion)
+	keeper.EmitChannelUpgradeInitEvent(ctx, portID, channelID, channel, upgrade)
 
-	return &channeltypes.MsgChannelUpgradeInitResponse{
-		Upgrade:         upgrade,
-		UpgradeSequence: channel.UpgradeSequence,
-	}, nil
+	return channel, upgrade, nil
 }
 
 // ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
@@ -881,6 +1407,7 @@ func (k *Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgC
 		ctx.Logger().Error("channel upgrade ack failed", "error", errorsmod.Wrap(err, "channel upgrade ack failed"))
 		if channeltypes.IsUpgradeError(err) {
 			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+			k.onChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId)
 
 			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
 			// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
@@ -906,6 +1433,7 @@ func (k *Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgC
 		// explicitly wrap the application callback in an upgrade error with the correct upgrade sequence.
 		// this prevents any errors caused from the application returning an UpgradeError with an incorrect sequence.
 		k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, channeltypes.NewUpgradeError(channel.UpgradeSequence, err))
+		k.onChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId)
 
 		return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
 	}
@@ -947,6 +1475,7 @@ func (k *Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.
 		ctx.Logger().Error("channel upgrade confirm failed", "error", errorsmod.Wrap(err, "channel upgrade confirm failed"))
 		if channeltypes.IsUpgradeError(err) {
 			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+			k.onChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId)
 
 			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
 			// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
@@ -1031,6 +1560,7 @@ func (k *Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.
 	}
 
 	channel, upgrade := k.ChannelKeeper.WriteUpgradeTimeoutChannel(ctx, msg.PortId, msg.ChannelId)
+	k.onChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId)
 
 	ctx.Logger().Info("channel upgrade timeout callback succeeded: portID %s, channelID %s", msg.PortId, msg.ChannelId)
 	keeper.EmitChannelUpgradeTimeoutEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
@@ -1057,6 +1587,7 @@ func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.M
 		}
 
 		k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId, channel.UpgradeSequence)
+		k.onChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId)
 
 		ctx.Logger().Info("channel upgrade cancel succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
 
@@ -1077,6 +1608,7 @@ func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.M
 	}
 
 	k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt.Sequence)
+	k.onChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId)
 
 	ctx.Logger().Info("channel upgrade cancel succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
 
@@ -1090,6 +1622,43 @@ func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.M
 	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
 }
 
+// onChanUpgradeCancel executes the OnChanUpgradeCancel callback of the application bound to the port
+// if the application implements the optional UpgradeCancellableModule interface.
+func (k *Keeper) onChanUpgradeCancel(ctx sdk.Context, portID, channelID string) {
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		return
+	}
+
+	app, ok := k.PortKeeper.Route(module)
+	if !ok {
+		return
+	}
+
+	if cbs, ok := app.(porttypes.UpgradeCancellableModule); ok {
+		cbs.OnChanUpgradeCancel(ctx, portID, channelID)
+	}
+}
+
+// isChannelFlushing returns true if the channel end of the packet batch exists and is in the FLUSHING state.
+func (k *Keeper) isChannelFlushing(ctx sdk.Context, batch *keeper.PacketBatch) bool {
+	if batch == nil {
+		return false
+	}
+
+	channel, found := k.ChannelKeeper.GetPacketBatchChannel(ctx, batch)
+	return found && channel.State == channeltypes.FLUSHING
+}
+
+// onChanUpgradeAborted executes the OnChanUpgradeCancel callback if the upgrade of a channel which was flushing
+// has been aborted while processing a packet acknowledgement or timeout, restoring the channel to the OPEN state.
+func (k *Keeper) onChanUpgradeAborted(ctx sdk.Context, portID, channelID string) {
+	channel, found := k.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if found && channel.State == channeltypes.OPEN {
+		k.onChanUpgradeCancel(ctx, portID, channelID)
+	}
+}
+
 // PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 func (k *Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -1105,6 +1674,120 @@ func (k *Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.
 	}, nil
 }
 
+// PrunePacketReceipts defines a rpc handler method for MsgPrunePacketReceipts.
+func (k *Keeper) PrunePacketReceipts(goCtx context.Context, msg *channeltypes.MsgPrunePacketReceipts) (*channeltypes.MsgPrunePacketReceiptsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pruned, remaining, err := k.ChannelKeeper.PrunePacketReceipts(
+		ctx, msg.PortId, msg.ChannelId, msg.CounterpartyCommitmentStartSequence,
+		msg.ProofCommitmentStartSequence, msg.ProofHeight, msg.Limit,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "packet receipt pruning failed")
+	}
+
+	return &channeltypes.MsgPrunePacketReceiptsResponse{
+		TotalPrunedSequences:    pruned,
+		TotalRemainingSequences: remaining,
+	}, nil
+}
+
+// ChannelPause defines a rpc handler method for MsgChannelPause.
+func (k *Keeper) ChannelPause(goCtx context.Context, msg *channeltypes.MsgChannelPause) (*channeltypes.MsgChannelPauseResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ChannelKeeper.ChanPause(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, errorsmod.Wrap(err, "channel pause failed")
+	}
+
+	return &channeltypes.MsgChannelPauseResponse{}, nil
+}
+
+// ChannelResume defines a rpc handler method for MsgChannelResume.
+func (k *Keeper) ChannelResume(goCtx context.Context, msg *channeltypes.MsgChannelResume) (*channeltypes.MsgChannelResumeResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ChannelKeeper.ChanResume(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, errorsmod.Wrap(err, "channel resume failed")
+	}
+
+	return &channeltypes.MsgChannelResumeResponse{}, nil
+}
+
+// ChannelForceClose defines a rpc handler method for MsgChannelForceClose.
+func (k *Keeper) ChannelForceClose(goCtx context.Context, msg *channeltypes.MsgChannelForceClose) (*channeltypes.MsgChannelForceCloseResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel force close failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.PortKeeper.Route(module)
+	if !ok {
+		ctx.Logger().Error("channel force close failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	_, upgrading := k.ChannelKeeper.GetUpgrade(ctx, msg.PortId, msg.ChannelId)
+
+	if err := k.ChannelKeeper.ChanForceClose(ctx, msg.PortId, msg.ChannelId); err != nil {
+		ctx.Logger().Error("channel force close failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "channel force close failed")
+	}
+
+	// the application is notified of the aborted upgrade before the channel is closed
+	if upgrading {
+		k.onChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId)
+	}
+
+	// the application is notified that the channel has been closed, it cannot reject the force close:
+	// the state changes of a failing callback are discarded and the channel remains closed
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := cbs.OnChanCloseConfirm(cacheCtx, msg.PortId, msg.ChannelId); err != nil {
+		ctx.Logger().Error("channel force close callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", errorsmod.Wrap(err, "channel close confirm callback failed"))
+	} else {
+		writeFn()
+	}
+
+	ctx.Logger().Info("channel force close succeeded", "channel-id", msg.ChannelId, "port-id", msg.PortId)
+
+	return &channeltypes.MsgChannelForceCloseResponse{}, nil
+}
+
+// ScheduleChannelUpgrades defines a rpc handler method for MsgScheduleChannelUpgrades.
+func (k *Keeper) ScheduleChannelUpgrades(goCtx context.Context, msg *channeltypes.MsgScheduleChannelUpgrades) (*channeltypes.MsgScheduleChannelUpgradesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Height <= uint64(ctx.BlockHeight()) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "schedule height (%d) must be greater than the current block height (%d)", msg.Height, ctx.BlockHeight())
+	}
+
+	schedule := channeltypes.NewChannelUpgradeSchedule(msg.Height, msg.PortId, msg.ConnectionId, msg.Version, msg.UpgradeVersion)
+	scheduleID := k.ChannelKeeper.ScheduleChannelUpgrades(ctx, schedule)
+
+	return &channeltypes.MsgScheduleChannelUpgradesResponse{ScheduleId: scheduleID}, nil
+}
+
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 func (k *Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUpdateParams) (*clienttypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
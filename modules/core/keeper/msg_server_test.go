@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -104,6 +105,40 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 
 			packet = channeltypes.NewPacket(ibcmock.MockAsyncPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
 		}, true, false, true, false},
+		{"success: packet routed by client", func() {
+			path.SetupClients()
+			path.SetupCounterparties()
+
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, 0)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+		}, true, false, false, false},
+		{"failure: packet routed by client - async acknowledgement", func() {
+			path.SetupClients()
+			path.SetupCounterparties()
+
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, 0, ibcmock.MockAsyncPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibcmock.MockAsyncPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, 0)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+		}, false, false, false, false},
+		{"failure: packet routed by client - application rejects route", func() {
+			path.SetupClients()
+			path.SetupCounterparties()
+
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, 0)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+
+			suite.chainB.GetSimApp().IBCMockModule.IBCApp.AuthorizeClientRoute = func(ctx sdk.Context, portID, clientID, counterpartyPortID, counterpartyClientID string) error {
+				return ibcmock.MockApplicationCallbackError
+			}
+		}, false, false, false, false},
 		{"failure: ORDERED out of order packet", func() {
 			path.SetChannelOrdered()
 			path.Setup()
@@ -147,6 +182,19 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 			err = path.EndpointB.RecvPacket(packet)
 			suite.Require().NoError(err)
 		}, true, false, false, true},
+		{"successful no-op: packet routed by client already received (replay)", func() {
+			// mock will panic if application callback is called twice on the same packet
+			path.SetupClients()
+			path.SetupCounterparties()
+
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, 0)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}, true, false, false, true},
 	}
 
 	for _, tc := range testCases {
@@ -165,7 +213,7 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 
 			// get proof of packet commitment from chainA
 			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-			if path.EndpointA.ChannelID != "" {
+			if path.EndpointA.ChannelID != "" || packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
 				proof, proofHeight = path.EndpointA.QueryProof(packetKey)
 			}
 
@@ -222,6 +270,163 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler receiving a timed out packet on an ORDERED_ALLOW_TIMEOUT channel.
+// It verifies that the application callback is skipped, no acknowledgement is written
+// and the next sequence receive is advanced.
+func (suite *KeeperTestSuite) TestHandleRecvPacketOrderedAllowTimeout() {
+	suite.SetupTest()
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	packetTimeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(packetTimeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packetTimeoutHeight, 0)
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+	ctx := suite.chainB.GetContext()
+	res, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+	// application callback must not be executed
+	_, exists := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(suite.chainB.GetContext(), ibcmock.GetMockRecvCanaryCapabilityName(packet))
+	suite.Require().False(exists, "application callback executed for timed out packet")
+	suite.Require().NotContains(ctx.EventManager().Events(), ibcmock.NewMockRecvPacketEvent())
+
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found, "acknowledgement written for timed out packet")
+
+	receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(string(channeltypes.TimeoutReceipt), receipt)
+
+	nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+	suite.Require().Equal(sequence+1, nextSeqRecv)
+
+	// replay should be treated as a no-op
+	res, err = suite.chainB.App.GetIBCKeeper().RecvPacket(suite.chainB.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NOOP, res.Result)
+}
+
+// tests the IBC handler receiving a batch of packets sharing a single proof height.
+func (suite *KeeperTestSuite) TestHandleRecvPackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []channeltypes.Packet
+		proofs     [][]byte
+		expResults []channeltypes.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: redundant packet is a no-op",
+			func() {
+				err := path.EndpointB.RecvPacket(packets[1])
+				suite.Require().NoError(err)
+
+				expResults[1] = channeltypes.NOOP
+			},
+			nil,
+		},
+		{
+			"failure: invalid proof fails the batch",
+			func() {
+				proofs[2] = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: channel closed by the application callback fails the batch",
+			func() {
+				suite.chainB.GetSimApp().IBCMockModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+					channel, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+					suite.Require().True(found)
+
+					channel.State = channeltypes.CLOSED
+					suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel(), channel)
+
+					return ibcmock.MockAcknowledgement
+				}
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packets, proofs, expResults = nil, nil, nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+				expResults = append(expResults, channeltypes.SUCCESS)
+			}
+
+			err := path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			var proofHeight clienttypes.Height
+			for _, packet := range packets {
+				var proof []byte
+				proof, proofHeight = path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				proofs = append(proofs, proof)
+			}
+
+			tc.malleate()
+
+			msg := channeltypes.NewMsgRecvPackets(packets, proofs, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			res, err := suite.chainB.App.GetIBCKeeper().RecvPackets(suite.chainB.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				for _, packet := range packets {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(found)
+
+					_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(found)
+				}
+
+				// replay should not error and every packet is a no-op
+				res, err = suite.chainB.App.GetIBCKeeper().RecvPackets(suite.chainB.GetContext(), msg)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.NOOP, channeltypes.NOOP}, res.Results)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRecoverClient() {
 	var msg *clienttypes.MsgRecoverClient
 
@@ -235,6 +440,21 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			func() {},
 			nil,
 		},
+		{
+			"success: recover client with overrides",
+			func() {
+				trustingPeriod := ibctesting.TrustingPeriod / 2
+				msg.Overrides = &clienttypes.RecoveryOverrides{TrustingPeriod: &trustingPeriod}
+			},
+			nil,
+		},
+		{
+			"failure: recovered client state is invalid",
+			func() {
+				msg.Overrides = &clienttypes.RecoveryOverrides{TrustLevelNumerator: 1, TrustLevelDenominator: 10}
+			},
+			clienttypes.ErrInvalidRecoveryClient,
+		},
 		{
 			"signer doesn't match authority",
 			func() {
@@ -299,96 +519,357 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 	}
 }
 
-// tests the IBC handler acknowledgement of a packet on ordered and unordered
-// channels. It verifies that the deletion of packet commitments from state
-// occurs. It test high level properties like ordering and basic sanity
-// checks. More rigorous testing of 'AcknowledgePacket'
-// can be found in the 04-channel/keeper/packet_test.go.
-func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
-	var (
-		packet channeltypes.Packet
-		path   *ibctesting.Path
-	)
+func (suite *KeeperTestSuite) TestDeleteClient() {
+	var msg *clienttypes.MsgDeleteClient
 
 	testCases := []struct {
 		name     string
 		malleate func()
-		expPass  bool
-		replay   bool // indicate replay (no-op)
+		expErr   error
 	}{
-		{"success: ORDERED", func() {
-			path.SetChannelOrdered()
-			path.Setup()
+		{
+			"success: authority deletes client",
+			func() {},
+			nil,
+		},
+		{
+			"success: permissionless deletion after grace period",
+			func() {
+				params := clienttypes.DefaultParams()
+				params.ClientDeletionGracePeriod = time.Hour
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+				suite.coordinator.IncrementTimeBy(time.Hour)
 
-			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
-			suite.Require().NoError(err)
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			nil,
+		},
+		{
+			"signer doesn't match authority and grace period has not passed",
+			func() {
+				params := clienttypes.DefaultParams()
+				params.ClientDeletionGracePeriod = time.Hour
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
 
-			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
-			err = path.EndpointB.RecvPacket(packet)
-			suite.Require().NoError(err)
-		}, true, false},
-		{"success: UNORDERED", func() {
-			path.Setup()
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"signer doesn't match authority and grace period is not set",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"client is active",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
 
-			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
-			suite.Require().NoError(err)
+				msg.ClientId = path.EndpointA.ClientID
+			},
+			clienttypes.ErrClientNotDeletable,
+		},
+	}
 
-			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
-			err = path.EndpointB.RecvPacket(packet)
-			suite.Require().NoError(err)
-		}, true, false},
-		{"success: UNORDERED acknowledge out of order packet", func() {
-			// setup uses an UNORDERED channel
-			path.Setup()
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
 
-			// attempts to acknowledge ack with sequence 10 without acknowledging ack with sequence 1 (removing packet commitment)
-			for i := uint64(1); i < 10; i++ {
-				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
-				suite.Require().NoError(err)
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
 
-				packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
-				err = path.EndpointB.RecvPacket(packet)
-				suite.Require().NoError(err)
-			}
-		}, true, false},
-		{"failure: ORDERED acknowledge out of order packet", func() {
-			path.SetChannelOrdered()
-			path.Setup()
+			tmClientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			tmClientState.FrozenHeight = tmClientState.LatestHeight
+			path.EndpointA.SetClientState(tmClientState)
 
-			// attempts to acknowledge ack with sequence 10 without acknowledging ack with sequence 1 (removing packet commitment
-			for i := uint64(1); i < 10; i++ {
-				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
-				suite.Require().NoError(err)
+			msg = clienttypes.NewMsgDeleteClient(path.EndpointA.ClientID, suite.chainA.App.GetIBCKeeper().GetAuthority())
 
-				packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
-				err = path.EndpointB.RecvPacket(packet)
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().DeleteClient(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
 				suite.Require().NoError(err)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
 			}
-		}, false, false},
-		{"channel does not exist", func() {
-			// any non-nil value of packet is valid
-			suite.Require().NotNil(packet)
-		}, false, false},
-		{"packet not received", func() {
-			path.Setup()
+		})
+	}
+}
 
-			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
-			suite.Require().NoError(err)
+func (suite *KeeperTestSuite) TestProvideCounterparty() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgProvideCounterparty
+	)
 
-			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
-		}, false, false},
-		{"successful no-op: ORDERED - packet already acknowledged (replay)", func() {
-			path.Setup()
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"signer is not the client creator",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"client created without allowing the counterparty to be provided",
+			func() {
+				clientState := path.EndpointA.GetClientState()
+				consensusState := path.EndpointA.GetConsensusState(path.EndpointA.GetClientLatestHeight())
 
-			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
-			suite.Require().NoError(err)
+				createMsg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, msg.Signer)
+				suite.Require().NoError(err)
 
-			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
-			err = path.EndpointB.RecvPacket(packet)
-			suite.Require().NoError(err)
+				_, err = suite.chainA.App.GetIBCKeeper().CreateClient(suite.chainA.GetContext(), createMsg)
+				suite.Require().NoError(err)
 
-			err = path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
-			suite.Require().NoError(err)
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreator(suite.chainA.GetContext(), ibctesting.SecondClientID)
+				suite.Require().False(found)
+
+				msg.ClientId = ibctesting.SecondClientID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"client does not exist",
+			func() {
+				msg.ClientId = ibctesting.SecondClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			counterparty := clienttypes.NewCounterparty(path.EndpointB.ClientID, commitmenttypes.NewMerklePath("ibc"))
+			msg = clienttypes.NewMsgProvideCounterparty(path.EndpointA.ClientID, counterparty, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().ProvideCounterparty(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				storedCounterparty, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetCounterparty(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(counterparty, storedCounterparty)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgPruneConsensusStates
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expErr    error
+		expPruned uint64
+	}{
+		{
+			"success: authority prunes expired consensus state",
+			func() {},
+			nil,
+			1,
+		},
+		{
+			"success: no expired consensus states",
+			func() {
+				// create a new client whose consensus states are not expired
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				msg.ClientId = path.EndpointA.ClientID
+			},
+			nil,
+			0,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+			0,
+		},
+		{
+			"client does not exist",
+			func() {
+				msg.ClientId = ibctesting.SecondClientID
+			},
+			clienttypes.ErrClientNotFound,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			// store a second consensus state halfway through the trusting period, then expire the first one
+			trustingPeriod := path.EndpointA.GetClientState().(*ibctm.ClientState).TrustingPeriod
+			suite.coordinator.IncrementTimeBy(trustingPeriod / 2)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.coordinator.IncrementTimeBy(trustingPeriod/2 + time.Minute)
+
+			msg = clienttypes.NewMsgPruneConsensusStates(path.EndpointA.ClientID, 10, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			res, err := suite.chainA.App.GetIBCKeeper().PruneConsensusStates(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, res.ConsensusStatesPruned)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// tests the IBC handler acknowledgement of a packet on ordered and unordered
+// channels. It verifies that the deletion of packet commitments from state
+// occurs. It test high level properties like ordering and basic sanity
+// checks. More rigorous testing of 'AcknowledgePacket'
+// can be found in the 04-channel/keeper/packet_test.go.
+func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
+	var (
+		packet channeltypes.Packet
+		path   *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		replay   bool // indicate replay (no-op)
+	}{
+		{"success: ORDERED", func() {
+			path.SetChannelOrdered()
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}, true, false},
+		{"success: UNORDERED", func() {
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}, true, false},
+		{"success: packet routed by client", func() {
+			path.SetupClients()
+			path.SetupCounterparties()
+
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, 0)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}, true, false},
+		{"success: UNORDERED acknowledge out of order packet", func() {
+			// setup uses an UNORDERED channel
+			path.Setup()
+
+			// attempts to acknowledge ack with sequence 10 without acknowledging ack with sequence 1 (removing packet commitment)
+			for i := uint64(1); i < 10; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+			}
+		}, true, false},
+		{"failure: ORDERED acknowledge out of order packet", func() {
+			path.SetChannelOrdered()
+			path.Setup()
+
+			// attempts to acknowledge ack with sequence 10 without acknowledging ack with sequence 1 (removing packet commitment
+			for i := uint64(1); i < 10; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+			}
+		}, false, false},
+		{"channel does not exist", func() {
+			// any non-nil value of packet is valid
+			suite.Require().NotNil(packet)
+		}, false, false},
+		{"packet not received", func() {
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+		}, false, false},
+		{"successful no-op: ORDERED - packet already acknowledged (replay)", func() {
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
 		}, true, true},
 		{"successful no-op: UNORDERED - packet already acknowledged (replay)", func() {
 			path.Setup()
@@ -419,7 +900,7 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 				proofHeight clienttypes.Height
 			)
 			packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-			if path.EndpointB.ChannelID != "" {
+			if path.EndpointB.ChannelID != "" || packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
 				proof, proofHeight = path.EndpointB.QueryProof(packetKey)
 			}
 
@@ -455,6 +936,96 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 	}
 }
 
+// tests the IBC handler acknowledging a batch of packets sharing a single proof height.
+func (suite *KeeperTestSuite) TestHandleAcknowledgePackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []channeltypes.Packet
+		proofs     [][]byte
+		expResults []channeltypes.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: redundant packet is a no-op",
+			func() {
+				err := path.EndpointA.AcknowledgePacket(packets[0], ibcmock.MockAcknowledgement.Acknowledgement())
+				suite.Require().NoError(err)
+
+				expResults[0] = channeltypes.NOOP
+			},
+			nil,
+		},
+		{
+			"failure: invalid proof fails the batch",
+			func() {
+				proofs[1] = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packets, proofs, expResults = nil, nil, nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				packets = append(packets, packet)
+				expResults = append(expResults, channeltypes.SUCCESS)
+			}
+
+			var proofHeight clienttypes.Height
+			for _, packet := range packets {
+				var proof []byte
+				proof, proofHeight = path.EndpointB.QueryProof(host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+				proofs = append(proofs, proof)
+			}
+
+			tc.malleate()
+
+			acks := [][]byte{ibcmock.MockAcknowledgement.Acknowledgement(), ibcmock.MockAcknowledgement.Acknowledgement(), ibcmock.MockAcknowledgement.Acknowledgement()}
+			msg := channeltypes.NewMsgAcknowledgements(packets, acks, proofs, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+			res, err := suite.chainA.App.GetIBCKeeper().Acknowledgements(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// verify packet commitments were deleted on source chain
+				for _, packet := range packets {
+					has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().False(has)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 // tests the IBC handler timing out a packet on ordered and unordered channels.
 // It verifies that the deletion of a packet commitment occurs. It tests
 // high level properties like ordering and basic sanity checks. More
@@ -508,11 +1079,30 @@ func (suite *KeeperTestSuite) TestHandleTimeoutPacket() {
 			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, timeoutTimestamp)
 			packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 		}, true, false},
-		{"success: UNORDERED timeout out of order packet", func() {
-			// setup uses an UNORDERED channel
-			path.Setup()
+		{"success: packet routed by client", func() {
+			path.SetupClients()
+			path.SetupCounterparties()
 
-			// attempts to timeout the last packet sent without timing out the first packet
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+
+			// create packet commitment
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			// need to update chainA client to prove missing ack
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, timeoutTimestamp)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+			packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		}, true, false},
+		{"success: UNORDERED timeout out of order packet", func() {
+			// setup uses an UNORDERED channel
+			path.Setup()
+
+			// attempts to timeout the last packet sent without timing out the first packet
 			// packet sequences begin at 1
 			for i := uint64(1); i < maxSequence; i++ {
 				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
@@ -576,7 +1166,7 @@ func (suite *KeeperTestSuite) TestHandleTimeoutPacket() {
 				proof       []byte
 				proofHeight clienttypes.Height
 			)
-			if path.EndpointB.ChannelID != "" {
+			if path.EndpointB.ChannelID != "" || packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
 				proof, proofHeight = path.EndpointB.QueryProof(packetKey)
 			}
 
@@ -613,6 +1203,97 @@ func (suite *KeeperTestSuite) TestHandleTimeoutPacket() {
 	}
 }
 
+// tests the IBC handler timing out a batch of packets sharing a single proof height.
+func (suite *KeeperTestSuite) TestHandleTimeoutPackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []channeltypes.Packet
+		proofs     [][]byte
+		expResults []channeltypes.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: redundant packet is a no-op",
+			func() {
+				err := path.EndpointA.TimeoutPacket(packets[2])
+				suite.Require().NoError(err)
+
+				expResults[2] = channeltypes.NOOP
+			},
+			nil,
+		},
+		{
+			"failure: invalid proof fails the batch",
+			func() {
+				proofs[0] = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			packets, proofs, expResults = nil, nil, nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+				expResults = append(expResults, channeltypes.SUCCESS)
+			}
+
+			// need to update chainA client to prove missing receipts
+			err := path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			var proofHeight clienttypes.Height
+			for _, packet := range packets {
+				var proof []byte
+				proof, proofHeight = path.EndpointB.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+				proofs = append(proofs, proof)
+			}
+
+			tc.malleate()
+
+			msg := channeltypes.NewMsgTimeouts(packets, 1, proofs, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+			res, err := suite.chainA.App.GetIBCKeeper().Timeouts(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// verify packet commitments were deleted on source chain
+				for _, packet := range packets {
+					has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().False(has)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 // tests the IBC handler timing out a packet via channel closure on ordered
 // and unordered channels. It verifies that the deletion of a packet
 // commitment occurs. It tests high level properties like ordering and basic
@@ -739,6 +1420,18 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
 			packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
 		}, false},
+		{"failure: packet routed by client cannot be timed out on close", func() {
+			path.SetupClients()
+			path.SetupCounterparties()
+
+			// create packet commitment
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, 0)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+			packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		}, false},
 	}
 
 	for _, tc := range testCases {
@@ -2355,6 +3048,41 @@ func (suite *KeeperTestSuite) TestChannelUpgradeCancel() {
 	}
 }
 
+// TestChannelUpgradeCancelCallback tests that the OnChanUpgradeCancel callback is executed when an upgrade is cancelled.
+func (suite *KeeperTestSuite) TestChannelUpgradeCancelCallback() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+	var cancelled bool
+	suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeCancel = func(ctx sdk.Context, portID, channelID string) {
+		suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, portID)
+		suite.Require().Equal(path.EndpointA.ChannelID, channelID)
+
+		// the upgrade must be deleted by the time the callback is executed
+		_, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetUpgrade(ctx, portID, channelID)
+		suite.Require().False(found)
+
+		cancelled = true
+	}
+
+	msg := &channeltypes.MsgChannelUpgradeCancel{
+		PortId:    path.EndpointA.ChannelConfig.PortID,
+		ChannelId: path.EndpointA.ChannelID,
+		Signer:    suite.chainA.App.GetIBCKeeper().GetAuthority(),
+	}
+
+	_, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelUpgradeCancel(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().True(cancelled)
+}
+
 func (suite *KeeperTestSuite) TestChannelUpgradeTimeout() {
 	var (
 		path *ibctesting.Path
@@ -2685,38 +3413,93 @@ func (suite *KeeperTestSuite) TestUpdateConnectionParams() {
 	}
 }
 
-// TestUpdateChannelParams tests the UpdateChannelParams rpc handler
-func (suite *KeeperTestSuite) TestUpdateChannelParams() {
-	authority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+// TestConnectionCloseInit tests the ConnectionCloseInit rpc handler
+func (suite *KeeperTestSuite) TestConnectionCloseInit() {
+	var (
+		path *ibctesting.Path
+		msg  *connectiontypes.MsgConnectionCloseInit
+	)
+
 	testCases := []struct {
 		name     string
-		msg      *channeltypes.MsgUpdateParams
-		expError error
+		malleate func()
+		expErr   error
 	}{
 		{
-			"success: valid authority and default params",
-			channeltypes.NewMsgUpdateChannelParams(authority, channeltypes.DefaultParams()),
+			"success",
+			func() {},
 			nil,
 		},
 		{
-			"failure: malformed authority address",
-			channeltypes.NewMsgUpdateChannelParams(ibctesting.InvalidID, channeltypes.DefaultParams()),
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
 			ibcerrors.ErrUnauthorized,
 		},
 		{
-			"failure: empty authority address",
-			channeltypes.NewMsgUpdateChannelParams("", channeltypes.DefaultParams()),
-			ibcerrors.ErrUnauthorized,
+			"failure: channel on connection is not closed",
+			func() {
+				path.CreateChannels()
+			},
+			connectiontypes.ErrConnectionInUse,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			msg = connectiontypes.NewMsgConnectionCloseInit(path.EndpointA.ConnectionID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().ConnectionCloseInit(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Equal(connectiontypes.CLOSED, connection.State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnectionUpgradeInit() {
+	var (
+		path *ibctesting.Path
+		msg  *connectiontypes.MsgConnectionUpgradeInit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
 		},
 		{
-			"failure: empty signer",
-			channeltypes.NewMsgUpdateChannelParams("", channeltypes.DefaultParams()),
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
 			ibcerrors.ErrUnauthorized,
 		},
 		{
-			"failure: unauthorized authority address",
-			channeltypes.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, channeltypes.DefaultParams()),
-			ibcerrors.ErrUnauthorized,
+			"failure: proposed upgrade is identical to the existing connection",
+			func() {
+				msg.Fields.DelayPeriod = path.EndpointA.GetConnection().DelayPeriod
+			},
+			connectiontypes.ErrInvalidUpgrade,
 		},
 	}
 
@@ -2724,40 +3507,46 @@ func (suite *KeeperTestSuite) TestUpdateChannelParams() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
-			resp, err := suite.chainA.App.GetIBCKeeper().UpdateChannelParams(suite.chainA.GetContext(), tc.msg)
-			expPass := tc.expError == nil
-			if expPass {
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			msg = connectiontypes.NewMsgConnectionUpgradeInit(path.EndpointA.ConnectionID, path.EndpointA.GetProposedConnectionUpgrade(), suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			res, err := suite.chainA.App.GetIBCKeeper().ConnectionUpgradeInit(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
 				suite.Require().NoError(err)
-				suite.Require().NotNil(resp)
-				p := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
-				suite.Require().Equal(tc.msg.Params, p)
+				suite.Require().Equal(uint64(1), res.UpgradeSequence)
+				suite.Require().Equal(msg.Fields, res.Upgrade.Fields)
 			} else {
-				suite.Require().Nil(resp)
-				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().ErrorIs(err, tc.expErr)
 			}
 		})
 	}
 }
 
-func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
-	var msg *channeltypes.MsgPruneAcknowledgements
+func (suite *KeeperTestSuite) TestConnectionUpgradeTry() {
+	var path *ibctesting.Path
 
 	testCases := []struct {
-		name     string
-		malleate func()
-		expErr   error
+		name      string
+		malleate  func()
+		expResult connectiontypes.ResponseResultType
 	}{
 		{
 			"success",
 			func() {},
-			nil,
+			connectiontypes.SUCCESS,
 		},
 		{
-			"failure: core keeper function fails, pruning sequence end not found",
+			"failure: counterparty upgrade sequence is outdated, error receipt is written",
 			func() {
-				msg.PortId = "portidone"
+				path.EndpointB.UpdateConnection(func(connection *connectiontypes.ConnectionEnd) { connection.UpgradeSequence = 5 })
 			},
-			channeltypes.ErrRecvStartSequenceNotFound,
+			connectiontypes.FAILURE,
 		},
 	}
 
@@ -2766,43 +3555,292 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
 
-			path := ibctesting.NewPath(suite.chainA, suite.chainB)
-			path.Setup()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
 
-			// configure the channel upgrade version on testing endpoints
-			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
-			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
 
-			err := path.EndpointA.ChanUpgradeInit()
-			suite.Require().NoError(err)
+			tc.malleate()
 
-			err = path.EndpointB.ChanUpgradeTry()
-			suite.Require().NoError(err)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
 
-			err = path.EndpointA.ChanUpgradeAck()
-			suite.Require().NoError(err)
+			connectionProof, upgradeProof, proofHeight := path.EndpointA.QueryConnectionUpgradeProof()
+			counterpartyUpgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+			suite.Require().True(found)
 
-			err = path.EndpointB.ChanUpgradeConfirm()
-			suite.Require().NoError(err)
+			msg := connectiontypes.NewMsgConnectionUpgradeTry(
+				path.EndpointB.ConnectionID, counterpartyUpgrade.Fields, path.EndpointA.GetConnection().UpgradeSequence,
+				connectionProof, upgradeProof, proofHeight, suite.chainB.SenderAccount.GetAddress().String(),
+			)
 
-			err = path.EndpointA.ChanUpgradeOpen()
+			res, err := suite.chainB.App.GetIBCKeeper().ConnectionUpgradeTry(suite.chainB.GetContext(), msg)
 			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResult, res.Result)
 
-			err = path.EndpointA.UpdateClient()
-			suite.Require().NoError(err)
+			_, found = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().Equal(tc.expResult == connectiontypes.FAILURE, found)
+		})
+	}
+}
 
-			msg = channeltypes.NewMsgPruneAcknowledgements(
-				path.EndpointA.ChannelConfig.PortID,
-				path.EndpointA.ChannelID,
-				10,
-				suite.chainA.SenderAccount.GetAddress().String(),
-			)
+func (suite *KeeperTestSuite) TestConnectionUpgradeAck() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult connectiontypes.ResponseResultType
+	}{
+		{
+			"success",
+			func() {},
+			connectiontypes.SUCCESS,
+		},
+		{
+			"failure: counterparty upgrade timeout has elapsed, upgrade is aborted",
+			func() {
+				suite.coordinator.IncrementTimeBy(connectiontypes.DefaultUpgradeTimeout + time.Second)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			connectiontypes.FAILURE,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			connectionProof, upgradeProof, proofHeight := path.EndpointB.QueryConnectionUpgradeProof()
+			counterpartyUpgrade, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
 
 			tc.malleate()
 
-			resp, err := suite.chainA.App.GetIBCKeeper().PruneAcknowledgements(suite.chainA.GetContext(), msg)
+			msg := connectiontypes.NewMsgConnectionUpgradeAck(
+				path.EndpointA.ConnectionID, counterpartyUpgrade,
+				connectionProof, upgradeProof, proofHeight, suite.chainA.SenderAccount.GetAddress().String(),
+			)
 
-			if tc.expErr == nil {
+			res, err := suite.chainA.App.GetIBCKeeper().ConnectionUpgradeAck(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResult, res.Result)
+
+			// the upgrade is removed in both the success and failure case
+			_, found = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+			suite.Require().False(found)
+
+			connection := path.EndpointA.GetConnection()
+			if tc.expResult == connectiontypes.SUCCESS {
+				suite.Require().Equal(uint64(time.Hour.Nanoseconds()), connection.DelayPeriod)
+			} else {
+				suite.Require().Equal(ibctesting.DefaultDelayPeriod, connection.DelayPeriod)
+
+				_, found = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnectionUpgradeCancel() {
+	var (
+		path *ibctesting.Path
+		msg  *connectiontypes.MsgConnectionUpgradeCancel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: authority cancels an upgrade which has not been accepted without a proof",
+			func() {},
+			nil,
+		},
+		{
+			"failure: non authority cancels without a proof",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: authority cancels an accepted upgrade without a proof",
+			func() {
+				upgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = 1000
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+
+			msg = connectiontypes.NewMsgConnectionUpgradeCancel(
+				path.EndpointA.ConnectionID, connectiontypes.ErrorReceipt{Sequence: 1}, nil,
+				clienttypes.ZeroHeight(), suite.chainA.App.GetIBCKeeper().GetAuthority(),
+			)
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().ConnectionUpgradeCancel(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().False(found)
+
+				errorReceipt, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestUpdateChannelParams tests the UpdateChannelParams rpc handler
+func (suite *KeeperTestSuite) TestUpdateChannelParams() {
+	authority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+	testCases := []struct {
+		name     string
+		msg      *channeltypes.MsgUpdateParams
+		expError error
+	}{
+		{
+			"success: valid authority and default params",
+			channeltypes.NewMsgUpdateChannelParams(authority, channeltypes.DefaultParams()),
+			nil,
+		},
+		{
+			"failure: malformed authority address",
+			channeltypes.NewMsgUpdateChannelParams(ibctesting.InvalidID, channeltypes.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: empty authority address",
+			channeltypes.NewMsgUpdateChannelParams("", channeltypes.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: empty signer",
+			channeltypes.NewMsgUpdateChannelParams("", channeltypes.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: unauthorized authority address",
+			channeltypes.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, channeltypes.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			resp, err := suite.chainA.App.GetIBCKeeper().UpdateChannelParams(suite.chainA.GetContext(), tc.msg)
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				p := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				suite.Require().Equal(tc.msg.Params, p)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var msg *channeltypes.MsgPruneAcknowledgements
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: core keeper function fails, pruning sequence end not found",
+			func() {
+				msg.PortId = "portidone"
+			},
+			channeltypes.ErrRecvStartSequenceNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// configure the channel upgrade version on testing endpoints
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+
+			err := path.EndpointA.ChanUpgradeInit()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanUpgradeTry()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.ChanUpgradeAck()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanUpgradeConfirm()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.ChanUpgradeOpen()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			msg = channeltypes.NewMsgPruneAcknowledgements(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				10,
+				suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().PruneAcknowledgements(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(resp)
 				suite.Require().Equal(uint64(0), resp.TotalPrunedSequences)
@@ -2814,3 +3852,332 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPrunePacketReceipts() {
+	var msg *channeltypes.MsgPrunePacketReceipts
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: core keeper function fails, channel not found",
+			func() {
+				msg.PortId = "portidone"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sequence, err := path.EndpointB.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			// advance the commitment start sequence on chainB at BeginBlock
+			params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+			params.MaxPacketsPrunedPerBlock = 10
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			proof, proofHeight := path.EndpointB.QueryProof(host.CommitmentStartSequenceKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+			msg = channeltypes.NewMsgPrunePacketReceipts(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sequence+1,
+				proof,
+				proofHeight,
+				10,
+				suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().PrunePacketReceipts(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().Equal(uint64(1), resp.TotalPrunedSequences)
+				suite.Require().Equal(uint64(0), resp.TotalRemainingSequences)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelPause() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgChannelPause
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: core keeper function fails, channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = channeltypes.NewMsgChannelPause(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().ChannelPause(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelResume() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgChannelResume
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: core keeper function fails, channel is not paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanResume(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			channeltypes.ErrChannelNotPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanPause(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			msg = channeltypes.NewMsgChannelResume(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err = suite.chainA.App.GetIBCKeeper().ChannelResume(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelForceClose() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgChannelForceClose
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: core keeper function fails, channel is already CLOSED",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"success: application callback error does not revert the force close",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanCloseConfirm = func(ctx sdk.Context, portID, channelID string) error {
+					return ibcmock.MockApplicationCallbackError
+				}
+			},
+			nil,
+		},
+		{
+			"success: application rejecting channel close init",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanCloseInit = func(ctx sdk.Context, portID, channelID string) error {
+					return ibcmock.MockApplicationCallbackError
+				}
+			},
+			nil,
+		},
+		{
+			"success: transfer channel",
+			func() {
+				path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				msg = channeltypes.NewMsgChannelForceClose(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = channeltypes.NewMsgChannelForceClose(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().ChannelForceClose(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestScheduleChannelUpgrades() {
+	var msg *channeltypes.MsgScheduleChannelUpgrades
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: schedule height is not in the future",
+			func() {
+				msg.Height = uint64(suite.chainA.GetContext().BlockHeight())
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			height := uint64(suite.chainA.GetContext().BlockHeight() + 10)
+			msg = channeltypes.NewMsgScheduleChannelUpgrades(height, ibctesting.MockPort, "", "", ibcmock.UpgradeVersion, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			res, err := suite.chainA.App.GetIBCKeeper().ScheduleChannelUpgrades(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				schedule, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelUpgradeSchedule(suite.chainA.GetContext(), res.ScheduleId)
+				suite.Require().True(found)
+				suite.Require().Equal(height, schedule.Height)
+				suite.Require().Equal(ibctesting.MockPort, schedule.PortId)
+				suite.Require().Equal(ibcmock.UpgradeVersion, schedule.UpgradeVersion)
+				suite.Require().False(schedule.Executed)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// MaxScheduledChannelUpgradesPerBlock is the maximum number of channels considered for a scheduled channel upgrade
+// in a single block, across all the channel upgrade schedules being executed.
+const MaxScheduledChannelUpgradesPerBlock = 100
+
+// ExecuteChannelUpgradeSchedules initiates the channel upgrades of the channel upgrade schedules whose height has
+// been reached. It is called at BeginBlock. An upgrade to the upgrade version of a schedule is initiated for every
+// OPEN channel matching its filters, keeping the ordering and connection hops of the channel. At most
+// MaxScheduledChannelUpgradesPerBlock channels are considered per block, a schedule with channels left being resumed
+// from the next block. The upgrade of each channel is initiated in a cached context, so that a channel whose upgrade
+// cannot be initiated is recorded as failed without affecting the other channels of the schedule.
+func (k *Keeper) ExecuteChannelUpgradeSchedules(ctx sdk.Context) {
+	k.executeChannelUpgradeSchedules(ctx, MaxScheduledChannelUpgradesPerBlock)
+}
+
+// executeChannelUpgradeSchedules executes the due channel upgrade schedules, considering at most limit channels.
+// The store key of the next channel to consider is stored in a schedule whose channels have not all been considered.
+func (k *Keeper) executeChannelUpgradeSchedules(ctx sdk.Context, limit int) {
+	for _, schedule := range k.ChannelKeeper.GetDueChannelUpgradeSchedules(ctx) {
+		if limit <= 0 {
+			return
+		}
+
+		channels, nextKey := k.ChannelKeeper.GetChannelsFrom(ctx, schedule.NextChannelKey, limit)
+		limit -= len(channels)
+
+		for _, channel := range channels {
+			if !schedule.Matches(channel) {
+				continue
+			}
+
+			upgrade := k.initScheduledChannelUpgrade(ctx, schedule, channel)
+			if upgrade.Status == channeltypes.IN_PROGRESS {
+				schedule.UpgradesInitiated++
+			} else {
+				schedule.UpgradesFailed++
+			}
+
+			k.ChannelKeeper.SetScheduledChannelUpgrade(ctx, upgrade)
+		}
+
+		if nextKey != nil {
+			schedule.NextChannelKey = nextKey
+			k.ChannelKeeper.SetChannelUpgradeSchedule(ctx, schedule)
+			return
+		}
+
+		k.ChannelKeeper.WriteChannelUpgradeScheduleExecuted(ctx, schedule)
+	}
+}
+
+// initScheduledChannelUpgrade initiates the upgrade of the given channel to the upgrade version of the schedule
+// and returns its status. The state changes are only written if the upgrade is initiated. Panics raised while
+// initiating the upgrade are recovered and recorded as a failed upgrade, except for out of gas panics.
+func (k *Keeper) initScheduledChannelUpgrade(ctx sdk.Context, schedule channeltypes.ChannelUpgradeSchedule, channel channeltypes.IdentifiedChannel) (upgrade channeltypes.ScheduledChannelUpgrade) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			ctx.Logger().Error("scheduled channel upgrade init panicked", "schedule-id", schedule.Id, "port-id", channel.PortId, "channel-id", channel.ChannelId, "panic", r)
+			upgrade = channeltypes.NewFailedScheduledChannelUpgrade(schedule.Id, channel.PortId, channel.ChannelId, fmt.Errorf("scheduled channel upgrade init panicked: %v", r))
+		}
+	}()
+
+	fields := channeltypes.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, schedule.UpgradeVersion)
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	upgradedChannel, _, err := k.initChannelUpgrade(cacheCtx, channel.PortId, channel.ChannelId, fields)
+	if err != nil {
+		return channeltypes.NewFailedScheduledChannelUpgrade(schedule.Id, channel.PortId, channel.ChannelId, err)
+	}
+
+	writeFn()
+	return channeltypes.NewScheduledChannelUpgrade(schedule.Id, channel.PortId, channel.ChannelId, upgradedChannel.UpgradeSequence)
+}
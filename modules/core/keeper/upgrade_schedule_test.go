@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestExecuteChannelUpgradeSchedules() {
+	var (
+		path1, path2 *ibctesting.Path
+		schedule     channeltypes.ChannelUpgradeSchedule
+		expUpgraded  []*ibctesting.Path
+		expFailed    []*ibctesting.Path
+		expErr       error
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: all open channels are upgraded",
+			func() {},
+		},
+		{
+			"success: channels filtered by port",
+			func() {
+				schedule.PortId = ibctesting.TransferPort
+				expUpgraded = nil
+			},
+		},
+		{
+			"success: channels filtered by connection",
+			func() {
+				schedule.ConnectionId = path1.EndpointA.ConnectionID
+				expUpgraded = []*ibctesting.Path{path1}
+			},
+		},
+		{
+			"success: channels filtered by version",
+			func() {
+				path2.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.Version = ibcmock.UpgradeVersion })
+
+				schedule.Version = ibcmock.Version
+				expUpgraded = []*ibctesting.Path{path1}
+			},
+		},
+		{
+			"success: closed channels are not upgraded",
+			func() {
+				path2.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+				expUpgraded = []*ibctesting.Path{path1}
+			},
+		},
+		{
+			"failure: upgrade cannot be initiated on a channel already at the upgrade version",
+			func() {
+				path2.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.Version = ibcmock.UpgradeVersion })
+
+				expUpgraded = []*ibctesting.Path{path1}
+				expFailed = []*ibctesting.Path{path2}
+			},
+		},
+		{
+			"failure: panic in the application callback is recorded as a failed upgrade",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeInit = func(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) (string, error) {
+					panic(errors.New("mock panic"))
+				}
+
+				expUpgraded = nil
+				expFailed = []*ibctesting.Path{path1, path2}
+				expErr = errors.New("mock panic")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path1 = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path1.Setup()
+
+			path2 = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path2.Setup()
+
+			schedule = channeltypes.NewChannelUpgradeSchedule(uint64(suite.chainA.GetContext().BlockHeight()), "", "", "", ibcmock.UpgradeVersion)
+			expUpgraded = []*ibctesting.Path{path1, path2}
+			expFailed = nil
+			expErr = channeltypes.ErrInvalidUpgrade
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			scheduleID := channelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), schedule)
+
+			// the schedule is executed at the BeginBlock of the schedule height
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			schedule, found := channelKeeper.GetChannelUpgradeSchedule(suite.chainA.GetContext(), scheduleID)
+			suite.Require().True(found)
+			suite.Require().True(schedule.Executed)
+			suite.Require().Empty(schedule.NextChannelKey)
+			suite.Require().Equal(uint64(len(expUpgraded)), schedule.UpgradesInitiated)
+			suite.Require().Equal(uint64(len(expFailed)), schedule.UpgradesFailed)
+			suite.Require().Empty(channelKeeper.GetDueChannelUpgradeSchedules(suite.chainA.GetContext()))
+
+			for _, path := range expUpgraded {
+				channel := path.EndpointA.GetChannel()
+				scheduledUpgrade, found := channelKeeper.GetScheduledChannelUpgrade(suite.chainA.GetContext(), scheduleID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.NewScheduledChannelUpgrade(scheduleID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel.UpgradeSequence), scheduledUpgrade)
+
+				upgrade, found := channelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, ibcmock.UpgradeVersion), upgrade.Fields)
+			}
+
+			for _, path := range expFailed {
+				scheduledUpgrade, found := channelKeeper.GetScheduledChannelUpgrade(suite.chainA.GetContext(), scheduleID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.NewFailedScheduledChannelUpgrade(scheduleID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expErr), scheduledUpgrade)
+
+				_, found = channelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+// TestExecuteChannelUpgradeSchedulesInBatches tests that a channel upgrade schedule is executed over several blocks
+// when more channels than the per block limit must be considered.
+func (suite *KeeperTestSuite) TestExecuteChannelUpgradeSchedulesInBatches() {
+	var paths []*ibctesting.Path
+	for i := 0; i < 3; i++ {
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		path.Setup()
+
+		paths = append(paths, path)
+	}
+
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+	schedule := channeltypes.NewChannelUpgradeSchedule(uint64(suite.chainA.GetContext().BlockHeight()), "", "", "", ibcmock.UpgradeVersion)
+	scheduleID := ibcKeeper.ChannelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), schedule)
+
+	ibcKeeper.ExecuteChannelUpgradeSchedulesWithLimit(suite.chainA.GetContext(), 2)
+
+	schedule, found := ibcKeeper.ChannelKeeper.GetChannelUpgradeSchedule(suite.chainA.GetContext(), scheduleID)
+	suite.Require().True(found)
+	suite.Require().False(schedule.Executed)
+	suite.Require().NotEmpty(schedule.NextChannelKey)
+	suite.Require().Equal(uint64(2), schedule.UpgradesInitiated)
+	suite.Require().Len(ibcKeeper.ChannelKeeper.GetDueChannelUpgradeSchedules(suite.chainA.GetContext()), 1)
+
+	ibcKeeper.ExecuteChannelUpgradeSchedulesWithLimit(suite.chainA.GetContext(), 2)
+
+	schedule, found = ibcKeeper.ChannelKeeper.GetChannelUpgradeSchedule(suite.chainA.GetContext(), scheduleID)
+	suite.Require().True(found)
+	suite.Require().True(schedule.Executed)
+	suite.Require().Empty(schedule.NextChannelKey)
+	suite.Require().Equal(uint64(3), schedule.UpgradesInitiated)
+	suite.Require().Zero(schedule.UpgradesFailed)
+	suite.Require().Empty(ibcKeeper.ChannelKeeper.GetDueChannelUpgradeSchedules(suite.chainA.GetContext()))
+
+	for _, path := range paths {
+		scheduledUpgrade, found := ibcKeeper.ChannelKeeper.GetScheduledChannelUpgrade(suite.chainA.GetContext(), scheduleID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		suite.Require().True(found)
+		suite.Require().Equal(channeltypes.IN_PROGRESS, scheduledUpgrade.Status)
+	}
+}
+
+// TestScheduledChannelUpgradeStatus tests that the status of a scheduled channel upgrade is reported once the
+// upgrade handshake completes or is cancelled.
+func (suite *KeeperTestSuite) TestScheduledChannelUpgradeStatus() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus channeltypes.ScheduledUpgradeStatus
+	}{
+		{
+			"upgrade in progress",
+			func() {},
+			channeltypes.IN_PROGRESS,
+		},
+		{
+			"upgrade completed",
+			func() {
+				suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+				suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+				suite.Require().Equal(ibcmock.UpgradeVersion, path.EndpointA.GetChannel().Version)
+			},
+			channeltypes.COMPLETED,
+		},
+		{
+			"upgrade cancelled by a new upgrade",
+			func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.Version + "-2"
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			},
+			channeltypes.CANCELLED,
+		},
+		{
+			"upgrade cancelled when the channel is force closed",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanForceClose(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			channeltypes.CANCELLED,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.Setup()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			schedule := channeltypes.NewChannelUpgradeSchedule(uint64(suite.chainA.GetContext().BlockHeight()+1), "", "", "", ibcmock.UpgradeVersion)
+			scheduleID := channelKeeper.ScheduleChannelUpgrades(suite.chainA.GetContext(), schedule)
+
+			// the schedule is not executed before the schedule height
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			schedule, found := channelKeeper.GetChannelUpgradeSchedule(suite.chainA.GetContext(), scheduleID)
+			suite.Require().True(found)
+			suite.Require().False(schedule.Executed)
+
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			tc.malleate()
+
+			schedule, found = channelKeeper.GetChannelUpgradeSchedule(suite.chainA.GetContext(), scheduleID)
+			suite.Require().True(found)
+			suite.Require().True(schedule.Executed)
+			suite.Require().Equal(uint64(1), schedule.UpgradesInitiated)
+
+			scheduledUpgrade, found := channelKeeper.GetScheduledChannelUpgrade(suite.chainA.GetContext(), scheduleID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expStatus, scheduledUpgrade.Status)
+
+			if tc.expStatus == channeltypes.CANCELLED {
+				errorReceipt, found := channelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(scheduledUpgrade.UpgradeSequence, errorReceipt.Sequence)
+				suite.Require().Equal(errorReceipt.Message, scheduledUpgrade.Error)
+			}
+		})
+	}
+}
@@ -164,6 +164,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	if err := cfg.RegisterMigration(exported.ModuleName, 6, channelMigrator.MigrateConnectionChannels); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -184,11 +188,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	ibcclient.BeginBlocker(sdk.UnwrapSDKContext(ctx), am.keeper.ClientKeeper)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ibcclient.BeginBlocker(sdkCtx, am.keeper.ClientKeeper)
+	am.keeper.ExecuteChannelUpgradeSchedules(sdkCtx)
+	am.keeper.ChannelKeeper.PrunePacketState(sdkCtx)
 	return nil
 }
 
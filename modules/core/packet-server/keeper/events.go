@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// emitSendPacketEvent emits a send packet event for a packet routed by client identifier.
+func emitSendPacketEvent(ctx sdk.Context, packet channeltypes.Packet) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			channeltypes.EventTypeSendPacket,
+			sdk.NewAttribute(channeltypes.AttributeKeyDataHex, hex.EncodeToString(packet.GetData())),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutHeight, packet.TimeoutHeight.String()),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, channeltypes.AttributeValueCategory),
+		),
+	})
+}
+
+// emitRecvPacketEvent emits a receive packet event for a packet routed by client identifier.
+// It will be emitted both the first time a packet is received for a certain sequence and for
+// all duplicate receives.
+func emitRecvPacketEvent(ctx sdk.Context, packet channeltypes.Packet) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			channeltypes.EventTypeRecvPacket,
+			sdk.NewAttribute(channeltypes.AttributeKeyDataHex, hex.EncodeToString(packet.GetData())),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutHeight, packet.TimeoutHeight.String()),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, channeltypes.AttributeValueCategory),
+		),
+	})
+}
+
+// emitWriteAcknowledgementEvent emits a write acknowledgement event for a packet routed by client identifier.
+func emitWriteAcknowledgementEvent(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			channeltypes.EventTypeWriteAck,
+			sdk.NewAttribute(channeltypes.AttributeKeyDataHex, hex.EncodeToString(packet.GetData())),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutHeight, packet.TimeoutHeight.String()),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeyAckHex, hex.EncodeToString(acknowledgement)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, channeltypes.AttributeValueCategory),
+		),
+	})
+}
+
+// emitAcknowledgePacketEvent emits an acknowledge packet event for a packet routed by client identifier.
+// It will be emitted both the first time a packet is acknowledged for a certain sequence and for all
+// duplicate acknowledgements.
+func emitAcknowledgePacketEvent(ctx sdk.Context, packet channeltypes.Packet) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			channeltypes.EventTypeAcknowledgePacket,
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutHeight, packet.TimeoutHeight.String()),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, channeltypes.AttributeValueCategory),
+		),
+	})
+}
+
+// emitTimeoutPacketEvent emits a timeout packet event for a packet routed by client identifier.
+// It will be emitted both the first time a packet is timed out for a certain sequence and for all
+// duplicate timeouts.
+func emitTimeoutPacketEvent(ctx sdk.Context, packet channeltypes.Packet) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			channeltypes.EventTypeTimeoutPacket,
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutHeight, packet.TimeoutHeight.String()),
+			sdk.NewAttribute(channeltypes.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, channeltypes.AttributeValueCategory),
+		),
+	})
+}
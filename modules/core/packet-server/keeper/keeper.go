@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/packet-server/types"
+)
+
+// Keeper defines the packet server keeper. It handles the lifecycle of packets which are routed
+// directly between a pair of light clients and their counterparties, without a connection or channel.
+// Packet commitments, receipts and acknowledgements are stored by the channel keeper under the ICS-24
+// paths of the port and client identifiers.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
+	scopedKeeper  exported.ScopedKeeper
+}
+
+// NewKeeper creates a new packet server keeper
+func NewKeeper(cdc codec.BinaryCodec, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper, scopedKeeper exported.ScopedKeeper) *Keeper {
+	return &Keeper{
+		cdc:           cdc,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		scopedKeeper:  scopedKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.SubModuleName)
+}
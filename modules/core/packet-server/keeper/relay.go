@@ -0,0 +1,437 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/packet-server/types"
+)
+
+// SendPacket is called by a module in order to send an IBC packet from the provided source port
+// to the destination port on the chain tracked by the source client. The counterparty of the source
+// client must have been provided. The packet sequence generated for the packet to be sent is returned.
+// An error is returned if one occurs.
+func (k *Keeper) SendPacket(
+	ctx sdk.Context,
+	portCap *capabilitytypes.Capability,
+	sourceClientID string,
+	sourcePort string,
+	destPort string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if !k.scopedKeeper.AuthenticateCapability(ctx, portCap, host.PortPath(sourcePort)) {
+		return 0, errorsmod.Wrapf(porttypes.ErrInvalidPort, "caller does not own capability for port ID (%s)", sourcePort)
+	}
+
+	counterparty, found := k.clientKeeper.GetCounterparty(ctx, sourceClientID)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrCounterpartyNotFound, sourceClientID)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceClientID)
+	if !found {
+		// the first packet sent using a client begins at sequence 1
+		sequence = 1
+	}
+
+	// construct packet from given fields and client counterparty
+	packet := channeltypes.NewPacket(data, sequence, sourcePort, sourceClientID,
+		destPort, counterparty.ClientId, timeoutHeight, timeoutTimestamp)
+	packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+
+	if err := packet.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(err, "constructed packet failed basic validation")
+	}
+
+	// prevent accidental sends with clients that cannot be updated
+	if status := k.clientKeeper.GetClientStatus(ctx, sourceClientID); status != exported.Active {
+		return 0, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", sourceClientID, status)
+	}
+
+	latestHeight := k.clientKeeper.GetClientLatestHeight(ctx, sourceClientID)
+	if latestHeight.IsZero() {
+		return 0, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "cannot send packet using client (%s) with zero height", sourceClientID)
+	}
+
+	latestTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, sourceClientID, latestHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	// check if packet is timed out on the receiving chain
+	timeout := channeltypes.NewTimeout(timeoutHeight, timeoutTimestamp)
+	if timeout.Elapsed(latestHeight, latestTimestamp) {
+		return 0, errorsmod.Wrap(timeout.ErrTimeoutElapsed(latestHeight, latestTimestamp), "invalid packet timeout")
+	}
+
+	commitment := channeltypes.CommitPacket(k.cdc, packet)
+
+	k.channelKeeper.SetNextSequenceSend(ctx, sourcePort, sourceClientID, sequence+1)
+	k.channelKeeper.SetPacketCommitment(ctx, sourcePort, sourceClientID, packet.GetSequence(), commitment)
+
+	if k.channelKeeper.IsPacketDataStored(ctx, sourcePort) {
+		k.channelKeeper.SetPacket(ctx, packet)
+		k.channelKeeper.SetPacketSendHeight(ctx, sourcePort, sourceClientID, packet.GetSequence(), uint64(ctx.BlockHeight()))
+	}
+
+	emitSendPacketEvent(ctx, packet)
+
+	k.Logger(ctx).Info(
+		"packet sent",
+		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+		"src_port", sourcePort,
+		"src_client", sourceClientID,
+		"dst_port", packet.GetDestPort(),
+		"dst_client", packet.GetDestChannel(),
+	)
+
+	return packet.GetSequence(), nil
+}
+
+// RecvPacket is called by core IBC in order to receive and process a packet sent by the counterparty
+// of the destination client. The packet commitment is verified against the merkle path prefix of the
+// counterparty and a packet receipt is written in order to provide replay protection.
+func (k *Keeper) RecvPacket(
+	ctx sdk.Context,
+	portCap *capabilitytypes.Capability,
+	packet channeltypes.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	if packet.ProtocolVersion != channeltypes.IBC_VERSION_2 {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidPacket, "packet protocol version must be %s, got %s", channeltypes.IBC_VERSION_2, packet.ProtocolVersion)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, portCap, host.PortPath(packet.GetDestPort())) {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "port capability failed authentication for port ID (%s)", packet.GetDestPort())
+	}
+
+	// the destination channel of the packet is the identifier of the client on this chain
+	counterparty, found := k.clientKeeper.GetCounterparty(ctx, packet.GetDestChannel())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrCounterpartyNotFound, packet.GetDestChannel())
+	}
+
+	// packet must come from the counterparty of the client
+	if packet.GetSourceChannel() != counterparty.ClientId {
+		return errorsmod.Wrapf(
+			channeltypes.ErrInvalidPacket,
+			"packet source client doesn't match the counterparty's client (%s ≠ %s)", packet.GetSourceChannel(), counterparty.ClientId,
+		)
+	}
+
+	// check if packet timed out by comparing it with the latest height of the chain
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+	timeout := channeltypes.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
+	if timeout.Elapsed(selfHeight, selfTimestamp) {
+		return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
+	}
+
+	// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received.
+	if _, found := k.channelKeeper.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()); found {
+		emitRecvPacketEvent(ctx, packet)
+		// This error indicates that the packet has already been relayed. Core IBC will
+		// treat this error as a no-op in order to prevent an entire relay transaction
+		// from failing and consuming unnecessary fees.
+		return channeltypes.ErrNoOpMsg
+	}
+
+	path := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	merklePath := types.BuildMerklePath(counterparty.MerklePathPrefix, path)
+
+	commitment := channeltypes.CommitPacket(k.cdc, packet)
+
+	// verify that the counterparty did commit to sending this packet
+	if err := k.verifyMembership(ctx, packet.GetDestChannel(), proofHeight, proof, merklePath, commitment); err != nil {
+		return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
+	}
+
+	// All verification complete, update state
+	// The receipt does not contain any data, since the packet has not yet been processed,
+	// it's just a single store key set to a single byte to indicate that the packet has been received
+	k.channelKeeper.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	if k.channelKeeper.IsPacketDataStored(ctx, packet.GetDestPort()) {
+		k.channelKeeper.SetPacketRecvHeight(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), uint64(ctx.BlockHeight()))
+	}
+
+	// log that a packet has been received & executed
+	k.Logger(ctx).Info(
+		"packet received",
+		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+		"src_port", packet.GetSourcePort(),
+		"src_client", packet.GetSourceChannel(),
+		"dst_port", packet.GetDestPort(),
+		"dst_client", packet.GetDestChannel(),
+	)
+
+	// emit an event that the relayer can query for
+	emitRecvPacketEvent(ctx, packet)
+
+	return nil
+}
+
+// WriteAcknowledgement writes the packet execution acknowledgement to the state,
+// which will be verified by the counterparty chain using AcknowledgePacket.
+// It assumes that the packet receipt has been written previously by RecvPacket.
+func (k *Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	portCap *capabilitytypes.Capability,
+	packet channeltypes.Packet,
+	acknowledgement exported.Acknowledgement,
+) error {
+	if !k.scopedKeeper.AuthenticateCapability(ctx, portCap, host.PortPath(packet.GetDestPort())) {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "port capability failed authentication for port ID (%s)", packet.GetDestPort())
+	}
+
+	if _, found := k.channelKeeper.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()); !found {
+		return errorsmod.Wrap(channeltypes.ErrInvalidPacket, "receipt not found for packet")
+	}
+
+	// NOTE: IBC app modules might have written the acknowledgement synchronously on
+	// the OnRecvPacket callback so we need to check if the acknowledgement is already
+	// set on the store and return an error if so.
+	if k.channelKeeper.HasPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()) {
+		return channeltypes.ErrAcknowledgementExists
+	}
+
+	if acknowledgement == nil {
+		return errorsmod.Wrap(channeltypes.ErrInvalidAcknowledgement, "acknowledgement cannot be nil")
+	}
+
+	bz := acknowledgement.Acknowledgement()
+	if len(bz) == 0 {
+		return errorsmod.Wrap(channeltypes.ErrInvalidAcknowledgement, "acknowledgement cannot be empty")
+	}
+
+	// set the acknowledgement so that it can be verified on the other side
+	k.channelKeeper.SetPacketAcknowledgement(
+		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		channeltypes.CommitAcknowledgement(bz),
+	)
+
+	// log that a packet acknowledgement has been written
+	k.Logger(ctx).Info(
+		"acknowledgement written",
+		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+		"src_port", packet.GetSourcePort(),
+		"src_client", packet.GetSourceChannel(),
+		"dst_port", packet.GetDestPort(),
+		"dst_client", packet.GetDestChannel(),
+	)
+
+	emitWriteAcknowledgementEvent(ctx, packet, bz)
+
+	return nil
+}
+
+// AcknowledgePacket is called by core IBC in order to process the acknowledgement of a packet
+// previously sent to the counterparty of the source client. The acknowledgement is verified against
+// the merkle path prefix of the counterparty and the packet commitment is deleted.
+func (k *Keeper) AcknowledgePacket(
+	ctx sdk.Context,
+	portCap *capabilitytypes.Capability,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	if packet.ProtocolVersion != channeltypes.IBC_VERSION_2 {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidPacket, "packet protocol version must be %s, got %s", channeltypes.IBC_VERSION_2, packet.ProtocolVersion)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, portCap, host.PortPath(packet.GetSourcePort())) {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "port capability failed authentication for port ID (%s)", packet.GetSourcePort())
+	}
+
+	// the source channel of the packet is the identifier of the client on this chain
+	counterparty, found := k.clientKeeper.GetCounterparty(ctx, packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrCounterpartyNotFound, packet.GetSourceChannel())
+	}
+
+	// packet must have been sent to the counterparty of the client
+	if packet.GetDestChannel() != counterparty.ClientId {
+		return errorsmod.Wrapf(
+			channeltypes.ErrInvalidPacket,
+			"packet destination client doesn't match the counterparty's client (%s ≠ %s)", packet.GetDestChannel(), counterparty.ClientId,
+		)
+	}
+
+	commitment := k.channelKeeper.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if len(commitment) == 0 {
+		emitAcknowledgePacketEvent(ctx, packet)
+		// This error indicates that the acknowledgement has already been relayed
+		// or there is a misconfigured relayer attempting to prove an acknowledgement
+		// for a packet never sent. Core IBC will treat this error as a no-op in order to
+		// prevent an entire relay transaction from failing and consuming unnecessary fees.
+		return channeltypes.ErrNoOpMsg
+	}
+
+	packetCommitment := channeltypes.CommitPacket(k.cdc, packet)
+
+	// verify we sent the packet and haven't cleared it out yet
+	if !bytes.Equal(commitment, packetCommitment) {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
+	}
+
+	path := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	merklePath := types.BuildMerklePath(counterparty.MerklePathPrefix, path)
+
+	if err := k.verifyMembership(ctx, packet.GetSourceChannel(), proofHeight, proof, merklePath, channeltypes.CommitAcknowledgement(acknowledgement)); err != nil {
+		return errorsmod.Wrap(err, "failed packet acknowledgement verification")
+	}
+
+	// Delete packet commitment, since the packet has been acknowledged, the commitment is no longer necessary
+	k.channelKeeper.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	// log that a packet has been acknowledged
+	k.Logger(ctx).Info(
+		"packet acknowledged",
+		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+		"src_port", packet.GetSourcePort(),
+		"src_client", packet.GetSourceChannel(),
+		"dst_port", packet.GetDestPort(),
+		"dst_client", packet.GetDestChannel(),
+	)
+
+	// emit an event marking that we have processed the acknowledgement
+	emitAcknowledgePacketEvent(ctx, packet)
+
+	return nil
+}
+
+// TimeoutPacket is called by core IBC in order to process a packet which has timed out on the
+// counterparty of the source client. The absence of a packet receipt is verified against the
+// merkle path prefix of the counterparty and the packet commitment is deleted.
+func (k *Keeper) TimeoutPacket(
+	ctx sdk.Context,
+	portCap *capabilitytypes.Capability,
+	packet channeltypes.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	if packet.ProtocolVersion != channeltypes.IBC_VERSION_2 {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidPacket, "packet protocol version must be %s, got %s", channeltypes.IBC_VERSION_2, packet.ProtocolVersion)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, portCap, host.PortPath(packet.GetSourcePort())) {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "port capability failed authentication for port ID (%s)", packet.GetSourcePort())
+	}
+
+	// the source channel of the packet is the identifier of the client on this chain
+	counterparty, found := k.clientKeeper.GetCounterparty(ctx, packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrCounterpartyNotFound, packet.GetSourceChannel())
+	}
+
+	// packet must have been sent to the counterparty of the client
+	if packet.GetDestChannel() != counterparty.ClientId {
+		return errorsmod.Wrapf(
+			channeltypes.ErrInvalidPacket,
+			"packet destination client doesn't match the counterparty's client (%s ≠ %s)", packet.GetDestChannel(), counterparty.ClientId,
+		)
+	}
+
+	// check that timeout height or timeout timestamp has passed on the other end
+	proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, packet.GetSourceChannel(), proofHeight)
+	if err != nil {
+		return err
+	}
+
+	timeout := channeltypes.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
+	if !timeout.Elapsed(proofHeight.(clienttypes.Height), proofTimestamp) {
+		return errorsmod.Wrap(timeout.ErrTimeoutNotReached(proofHeight.(clienttypes.Height), proofTimestamp), "packet timeout not reached")
+	}
+
+	commitment := k.channelKeeper.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if len(commitment) == 0 {
+		emitTimeoutPacketEvent(ctx, packet)
+		// This error indicates that the timeout has already been relayed
+		// or there is a misconfigured relayer attempting to prove a timeout
+		// for a packet never sent. Core IBC will treat this error as a no-op in order to
+		// prevent an entire relay transaction from failing and consuming unnecessary fees.
+		return channeltypes.ErrNoOpMsg
+	}
+
+	packetCommitment := channeltypes.CommitPacket(k.cdc, packet)
+
+	// verify we sent the packet and haven't cleared it out yet
+	if !bytes.Equal(commitment, packetCommitment) {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
+	}
+
+	path := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	merklePath := types.BuildMerklePath(counterparty.MerklePathPrefix, path)
+
+	if err := k.verifyNonMembership(ctx, packet.GetSourceChannel(), proofHeight, proof, merklePath); err != nil {
+		return errorsmod.Wrap(err, "failed packet receipt absence verification")
+	}
+
+	// Delete packet commitment, since the packet has timed out, the commitment is no longer necessary
+	k.channelKeeper.DeletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if k.channelKeeper.IsPacketDataStored(ctx, packet.GetSourcePort()) {
+		k.channelKeeper.SetPacketTimeoutHeight(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), uint64(ctx.BlockHeight()))
+	}
+
+	k.Logger(ctx).Info(
+		"packet timed-out",
+		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+		"src_port", packet.GetSourcePort(),
+		"src_client", packet.GetSourceChannel(),
+		"dst_port", packet.GetDestPort(),
+		"dst_client", packet.GetDestChannel(),
+	)
+
+	// emit an event marking that we have processed the timeout
+	emitTimeoutPacketEvent(ctx, packet)
+
+	return nil
+}
+
+// verifyMembership routes the membership proof verification to the light client module of the
+// provided client. The client must be active.
+func (k *Keeper) verifyMembership(ctx sdk.Context, clientID string, height exported.Height, proof []byte, path exported.Path, value []byte) error {
+	clientModule, err := k.activeClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	return clientModule.VerifyMembership(ctx, clientID, height, 0, 0, proof, path, value)
+}
+
+// verifyNonMembership routes the non-membership proof verification to the light client module of
+// the provided client. The client must be active.
+func (k *Keeper) verifyNonMembership(ctx sdk.Context, clientID string, height exported.Height, proof []byte, path exported.Path) error {
+	clientModule, err := k.activeClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	return clientModule.VerifyNonMembership(ctx, clientID, height, 0, 0, proof, path)
+}
+
+// activeClientModule returns the light client module of the provided client if the client is active.
+func (k *Keeper) activeClientModule(ctx sdk.Context, clientID string) (exported.LightClientModule, error) {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	return clientModule, nil
+}
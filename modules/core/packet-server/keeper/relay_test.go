@@ -0,0 +1,494 @@
+package keeper_test
+
+import (
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+var (
+	disabledTimeoutTimestamp = uint64(0)
+	defaultTimeoutHeight     = clienttypes.NewHeight(1, 100)
+)
+
+// setupPath creates clients on both chains and provides the counterparty of each client.
+func (suite *KeeperTestSuite) setupPath() *ibctesting.Path {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+	path.SetupCounterparties()
+
+	return path
+}
+
+// freezeClient freezes the tendermint client of the provided endpoint.
+func (suite *KeeperTestSuite) freezeClient(endpoint *ibctesting.Endpoint) {
+	cs, ok := endpoint.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+
+	cs.FrozenHeight = clienttypes.NewHeight(0, 1)
+	endpoint.SetClientState(cs)
+}
+
+func (suite *KeeperTestSuite) TestSendPacket() {
+	var (
+		path          *ibctesting.Path
+		portCap       *capabilitytypes.Capability
+		sourceClient  string
+		timeoutHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: port capability not owned",
+			func() {
+				portCap = capabilitytypes.NewCapability(100)
+			},
+			porttypes.ErrInvalidPort,
+		},
+		{
+			"failure: counterparty not found",
+			func() {
+				sourceClient = ibctesting.InvalidID
+			},
+			clienttypes.ErrCounterpartyNotFound,
+		},
+		{
+			"failure: client is not active",
+			func() {
+				suite.freezeClient(path.EndpointA)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: timeout elapsed",
+			func() {
+				timeoutHeight = path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			},
+			channeltypes.ErrTimeoutElapsed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = suite.setupPath()
+			portCap = suite.chainA.GetPortCapability(path.EndpointA.ChannelConfig.PortID)
+			sourceClient = path.EndpointA.ClientID
+			timeoutHeight = defaultTimeoutHeight
+
+			tc.malleate()
+
+			sequence, err := suite.chainA.App.GetIBCKeeper().PacketServerKeeper.SendPacket(
+				suite.chainA.GetContext(), portCap, sourceClient, path.EndpointA.ChannelConfig.PortID,
+				path.EndpointB.ChannelConfig.PortID, timeoutHeight, disabledTimeoutTimestamp, ibcmock.MockPacketData,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+
+				packet := channeltypes.NewPacket(ibcmock.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID,
+					path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, disabledTimeoutTimestamp)
+				packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, sequence)
+				suite.Require().Equal(channeltypes.CommitPacket(suite.chainA.Codec, packet), commitment)
+
+				nextSequenceSend, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel)
+				suite.Require().True(found)
+				suite.Require().Equal(sequence+1, nextSequenceSend)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Zero(sequence)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecvPacket() {
+	var (
+		path    *ibctesting.Path
+		packet  channeltypes.Packet
+		portCap *capabilitytypes.Capability
+		proof   []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: packet receipt already exists",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			},
+			channeltypes.ErrNoOpMsg,
+		},
+		{
+			"failure: packet protocol version is not IBC_VERSION_2",
+			func() {
+				packet.ProtocolVersion = channeltypes.IBC_VERSION_1
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"failure: port capability not owned",
+			func() {
+				portCap = capabilitytypes.NewCapability(100)
+			},
+			porttypes.ErrInvalidPort,
+		},
+		{
+			"failure: counterparty not found",
+			func() {
+				packet.DestinationChannel = ibctesting.InvalidID
+			},
+			clienttypes.ErrCounterpartyNotFound,
+		},
+		{
+			"failure: packet source client does not match counterparty client",
+			func() {
+				packet.SourceChannel = ibctesting.InvalidID
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"failure: timeout elapsed",
+			func() {
+				packet.TimeoutHeight = clienttypes.NewHeight(1, 1)
+			},
+			channeltypes.ErrTimeoutElapsed,
+		},
+		{
+			"failure: client is not active",
+			func() {
+				suite.freezeClient(path.EndpointB)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: packet commitment verification failed",
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: packet delivered to a different destination port",
+			func() {
+				packet.DestinationPort = ibctesting.MockFeePort
+				portCap = suite.chainB.GetPortCapability(ibctesting.MockFeePort)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = suite.setupPath()
+
+			sequence, err := path.EndpointA.SendPacketWithClient(defaultTimeoutHeight, disabledTimeoutTimestamp, ibcmock.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibcmock.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+
+			portCap = suite.chainB.GetPortCapability(path.EndpointB.ChannelConfig.PortID)
+			proof, _ = suite.chainA.QueryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+			proofHeight := path.EndpointB.GetClientLatestHeight()
+
+			tc.malleate()
+
+			err = suite.chainB.App.GetIBCKeeper().PacketServerKeeper.RecvPacket(suite.chainB.GetContext(), portCap, packet, proof, proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
+	var (
+		packet channeltypes.Packet
+		ack    exported.Acknowledgement
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: packet receipt not found",
+			func() {
+				packet.Sequence = 2
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"failure: acknowledgement already exists",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence, ack.Acknowledgement())
+			},
+			channeltypes.ErrAcknowledgementExists,
+		},
+		{
+			"failure: empty acknowledgement",
+			func() {
+				ack = ibcmock.NewEmptyAcknowledgement()
+			},
+			channeltypes.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := suite.setupPath()
+
+			packet = channeltypes.NewPacket(ibcmock.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+			ack = ibcmock.MockAcknowledgement
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			portCap := suite.chainB.GetPortCapability(path.EndpointB.ChannelConfig.PortID)
+
+			tc.malleate()
+
+			err := suite.chainB.App.GetIBCKeeper().PacketServerKeeper.WriteAcknowledgement(suite.chainB.GetContext(), portCap, packet, ack)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				ackCommitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), ackCommitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAcknowledgePacket() {
+	var (
+		packet channeltypes.Packet
+		ack    []byte
+		proof  []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: packet commitment does not exist",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.DeletePacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			},
+			channeltypes.ErrNoOpMsg,
+		},
+		{
+			"failure: packet commitment does not match packet",
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"failure: packet destination client does not match counterparty client",
+			func() {
+				packet.DestinationChannel = ibctesting.InvalidID
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"failure: invalid acknowledgement proof",
+			func() {
+				ack = ibcmock.MockFailAcknowledgement.Acknowledgement()
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := suite.setupPath()
+
+			sequence, err := path.EndpointA.SendPacketWithClient(defaultTimeoutHeight, disabledTimeoutTimestamp, ibcmock.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibcmock.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+
+			// receiving the packet on chainB writes the mock acknowledgement and updates the client on chainA
+			suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+			ack = ibcmock.MockAcknowledgement.Acknowledgement()
+			portCap := suite.chainA.GetPortCapability(path.EndpointA.ChannelConfig.PortID)
+			proof, _ = suite.chainB.QueryProof(host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+			proofHeight := path.EndpointA.GetClientLatestHeight()
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().PacketServerKeeper.AcknowledgePacket(suite.chainA.GetContext(), portCap, packet, ack, proof, proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+				suite.Require().Empty(commitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTimeoutPacket() {
+	var (
+		path   *ibctesting.Path
+		packet channeltypes.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: packet commitment does not exist",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.DeletePacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			},
+			channeltypes.ErrNoOpMsg,
+		},
+		{
+			"failure: packet commitment does not match packet",
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"failure: packet destination port does not match commitment",
+			func() {
+				packet.DestinationPort = ibctesting.MockFeePort
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"failure: packet timeout not reached",
+			func() {
+				packet.TimeoutHeight = clienttypes.NewHeight(1, 1000)
+			},
+			channeltypes.ErrTimeoutNotReached,
+		},
+		{
+			"failure: packet receipt exists on counterparty",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+				suite.coordinator.CommitBlock(suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = suite.setupPath()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacketWithClient(timeoutHeight, disabledTimeoutTimestamp, ibcmock.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibcmock.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ClientID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ClientID, timeoutHeight, disabledTimeoutTimestamp)
+			packet.ProtocolVersion = channeltypes.IBC_VERSION_2
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			tc.malleate()
+
+			portCap := suite.chainA.GetPortCapability(path.EndpointA.ChannelConfig.PortID)
+			proof, proofHeight := suite.chainB.QueryProof(host.PacketReceiptKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+
+			err = suite.chainA.App.GetIBCKeeper().PacketServerKeeper.TimeoutPacket(suite.chainA.GetContext(), portCap, packet, proof, proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+				suite.Require().Empty(commitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper, which is used to store
+// packet sequences, commitments, receipts and acknowledgements.
+type ChannelKeeper interface {
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SetNextSequenceSend(ctx sdk.Context, portID, channelID string, sequence uint64)
+	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
+	SetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64, commitmentHash []byte)
+	DeletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64)
+	SetPacket(ctx sdk.Context, packet channeltypes.Packet)
+	IsPacketDataStored(ctx sdk.Context, portID string) bool
+	GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool)
+	SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64)
+	HasPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) bool
+	SetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ackHash []byte)
+	SetPacketSendHeight(ctx sdk.Context, portID, channelID string, sequence, height uint64)
+	SetPacketRecvHeight(ctx sdk.Context, portID, channelID string, sequence, height uint64)
+	SetPacketTimeoutHeight(ctx sdk.Context, portID, channelID string, sequence, height uint64)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetCounterparty(ctx sdk.Context, clientID string) (clienttypes.Counterparty, bool)
+	GetClientStatus(ctx sdk.Context, clientID string) exported.Status
+	GetClientLatestHeight(ctx sdk.Context, clientID string) clienttypes.Height
+	GetClientTimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error)
+	Route(clientID string) (exported.LightClientModule, bool)
+}
@@ -0,0 +1,6 @@
+package types
+
+const (
+	// SubModuleName defines the IBC packet server name
+	SubModuleName = "packetserver"
+)
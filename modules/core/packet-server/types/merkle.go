@@ -0,0 +1,14 @@
+package types
+
+import (
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+)
+
+// BuildMerklePath takes the merkle path prefix of a counterparty and an ICS-24 path
+// and builds a new merkle path by appending the path as the final key of the prefix.
+func BuildMerklePath(prefix commitmenttypes.MerklePath, path []byte) commitmenttypes.MerklePath {
+	keyPath := make([]string, len(prefix.KeyPath), len(prefix.KeyPath)+1)
+	copy(keyPath, prefix.KeyPath)
+
+	return commitmenttypes.NewMerklePath(append(keyPath, string(path))...)
+}
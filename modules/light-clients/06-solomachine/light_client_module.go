@@ -12,7 +12,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule           = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruningModule = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface
 type LightClientModule struct {
@@ -246,6 +249,33 @@ func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteCl
 	return clientState.CheckSubstituteAndUpdateState(ctx, l.cdc, clientStore, substituteClientStore, substituteClient)
 }
 
+// DeleteClient removes the client state and all associated data from the client store.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 06-solomachine-{n}.
+func (l LightClientModule) DeleteClient(ctx sdk.Context, clientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	if _, found := getClientState(clientStore, l.cdc); !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	clienttypes.DeleteClientStore(clientStore)
+
+	return nil
+}
+
+// PruneExpiredConsensusStates performs a no-op and returns zero. The solomachine client only stores its latest
+// consensus state, as part of the client state, and thus never has any expired consensus states to prune.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 06-solomachine-{n}.
+func (l LightClientModule) PruneExpiredConsensusStates(ctx sdk.Context, clientID string, _ uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	if _, found := getClientState(clientStore, l.cdc); !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return 0, nil
+}
+
 // VerifyUpgradeAndUpdateState returns an error since solomachine client does not support upgrades
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 06-solomachine-{n}.
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cmtmath "github.com/cometbft/cometbft/libs/math"
 	"github.com/cometbft/cometbft/light"
 	cmttypes "github.com/cometbft/cometbft/types"
 
@@ -21,7 +22,11 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState                  = (*ClientState)(nil)
+	_ clienttypes.TrustingPeriodClientState = (*ClientState)(nil)
+	_ clienttypes.CreationPolicyClientState = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
@@ -68,6 +73,21 @@ func (ClientState) GetTimestampAtHeight(
 	return consState.GetTimestamp(), nil
 }
 
+// GetTrustingPeriod returns the trusting period of the client.
+func (cs ClientState) GetTrustingPeriod() time.Duration {
+	return cs.TrustingPeriod
+}
+
+// GetUnbondingPeriod returns the unbonding period of the client.
+func (cs ClientState) GetUnbondingPeriod() time.Duration {
+	return cs.UnbondingPeriod
+}
+
+// GetTrustLevel returns the trust level of the client.
+func (cs ClientState) GetTrustLevel() cmtmath.Fraction {
+	return cs.TrustLevel.ToTendermint()
+}
+
 // Status returns the status of the tendermint client.
 // The client may be:
 // - Active: FrozenHeight is zero and client is not expired
@@ -100,6 +120,11 @@ func (cs ClientState) Status(
 	return exported.Active
 }
 
+// IsFrozen returns true if the client has been frozen for misbehaviour.
+func (cs ClientState) IsFrozen() bool {
+	return !cs.FrozenHeight.IsZero()
+}
+
 // IsExpired returns whether or not the client has passed the trusting period since the last
 // update (in which case no headers are considered valid).
 func (cs ClientState) IsExpired(latestTimestamp, now time.Time) bool {
@@ -21,6 +21,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*exported.ClientMessage)(nil),
 		&Header{},
 	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&HeaderBatch{},
+	)
 	registry.RegisterImplementations(
 		(*exported.ClientMessage)(nil),
 		&Misbehaviour{},
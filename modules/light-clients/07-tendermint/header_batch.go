@@ -0,0 +1,95 @@
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*HeaderBatch)(nil)
+
+// MaxHeaderBatchSize is the maximum number of headers which may be included in a single HeaderBatch.
+const MaxHeaderBatchSize = 100
+
+// NewHeaderBatch creates a new HeaderBatch instance.
+func NewHeaderBatch(headers []*Header, storeIndices []uint32) *HeaderBatch {
+	return &HeaderBatch{
+		Headers:      headers,
+		StoreIndices: storeIndices,
+	}
+}
+
+// ClientType defines that the HeaderBatch is a Tendermint consensus algorithm
+func (HeaderBatch) ClientType() string {
+	return exported.Tendermint
+}
+
+// GetStoredHeaders returns the headers whose consensus states are persisted when the batch
+// is applied, in ascending order. The last header is always included.
+// NOTE: the batch is checked to be non empty and the store indices to be valid in ValidateBasic.
+func (hb HeaderBatch) GetStoredHeaders() []*Header {
+	lastIndex := uint32(len(hb.Headers) - 1)
+
+	storedHeaders := make([]*Header, 0, len(hb.StoreIndices)+1)
+	for _, index := range hb.StoreIndices {
+		if index == lastIndex {
+			continue
+		}
+
+		storedHeaders = append(storedHeaders, hb.Headers[index])
+	}
+
+	return append(storedHeaders, hb.Headers[lastIndex])
+}
+
+// ValidateBasic calls ValidateBasic on each header and checks that the headers form a
+// contiguous chain of trust, where every header after the first uses the height of the
+// preceding header as its trusted height. The store indices must be strictly increasing
+// and within the bounds of the batch.
+func (hb HeaderBatch) ValidateBasic() error {
+	if len(hb.Headers) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "header batch cannot be empty")
+	}
+	if len(hb.Headers) > MaxHeaderBatchSize {
+		return errorsmod.Wrapf(ErrInvalidHeader, "header batch size %d exceeds maximum %d", len(hb.Headers), MaxHeaderBatchSize)
+	}
+
+	for i, header := range hb.Headers {
+		if header == nil {
+			return errorsmod.Wrapf(ErrInvalidHeader, "header %d cannot be nil", i)
+		}
+		if err := header.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "header %d failed validation", i)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		previous := hb.Headers[i-1]
+		if previous.Header.ChainID != header.Header.ChainID {
+			return errorsmod.Wrapf(ErrInvalidHeader, "header %d chain-id %s does not match chain-id %s", i, header.Header.ChainID, previous.Header.ChainID)
+		}
+		if !header.TrustedHeight.EQ(previous.GetHeight()) {
+			return errorsmod.Wrapf(
+				ErrInvalidHeaderHeight,
+				"header %d trusted height %s must equal preceding header height %s", i, header.TrustedHeight, previous.GetHeight(),
+			)
+		}
+		if header.TrustedValidators == nil {
+			return errorsmod.Wrapf(ErrInvalidValidatorSet, "trusted validator set in header %d cannot be empty", i)
+		}
+	}
+
+	for i, index := range hb.StoreIndices {
+		if int(index) >= len(hb.Headers) {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "store index %d out of range for batch of %d headers", index, len(hb.Headers))
+		}
+		if i > 0 && index <= hb.StoreIndices[i-1] {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "store indices must be strictly increasing, got %d after %d", index, hb.StoreIndices[i-1])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package tendermint_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TendermintTestSuite) TestHeaderBatchValidateBasic() {
+	var headerBatch *ibctm.HeaderBatch
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"valid header batch", func() {}, true},
+		{"valid header batch with a single header", func() {
+			headerBatch.Headers = headerBatch.Headers[:1]
+			headerBatch.StoreIndices = nil
+		}, true},
+		{"valid header batch storing the last header explicitly", func() {
+			headerBatch.StoreIndices = []uint32{2}
+		}, true},
+		{"header batch is empty", func() {
+			headerBatch.Headers = nil
+		}, false},
+		{"header batch exceeds maximum size", func() {
+			for len(headerBatch.Headers) <= ibctm.MaxHeaderBatchSize {
+				headerBatch.Headers = append(headerBatch.Headers, headerBatch.Headers[0])
+			}
+		}, false},
+		{"header is nil", func() {
+			headerBatch.Headers[1] = nil
+		}, false},
+		{"header failed validation", func() {
+			headerBatch.Headers[1].ValidatorSet = nil
+		}, false},
+		{"header chain-id does not match preceding header", func() {
+			headerBatch.Headers[1].Header.ChainID = "other-chain"
+		}, false},
+		{"trusted height does not match preceding header height", func() {
+			headerBatch.Headers[2].TrustedHeight = headerBatch.Headers[0].GetHeight().(clienttypes.Height)
+		}, false},
+		{"trusted validators are nil", func() {
+			headerBatch.Headers[2].TrustedValidators = nil
+		}, false},
+		{"store index out of range", func() {
+			headerBatch.StoreIndices = []uint32{3}
+		}, false},
+		{"store indices are not strictly increasing", func() {
+			headerBatch.StoreIndices = []uint32{1, 1}
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			headerBatch = getHeaderBatch(suite, path.EndpointA, 3, []uint32{0})
+			suite.Require().Equal(exported.Tendermint, headerBatch.ClientType())
+
+			tc.malleate()
+
+			err := headerBatch.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestHeaderBatchGetStoredHeaders() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	headerBatch := getHeaderBatch(suite, path.EndpointA, 4, nil)
+	suite.Require().Equal([]*ibctm.Header{headerBatch.Headers[3]}, headerBatch.GetStoredHeaders())
+
+	headerBatch.StoreIndices = []uint32{0, 2, 3}
+	suite.Require().Equal([]*ibctm.Header{headerBatch.Headers[0], headerBatch.Headers[2], headerBatch.Headers[3]}, headerBatch.GetStoredHeaders())
+}
@@ -14,7 +14,12 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint/internal/keeper"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule           = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruningModule = (*LightClientModule)(nil)
+	_ exported.ClientStateDecoderModule    = (*LightClientModule)(nil)
+	_ clienttypes.RecoveryOverridesModule  = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -64,6 +69,16 @@ func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientSt
 	return clientState.Initialize(ctx, l.keeper.Codec(), clientStore, &consensusState)
 }
 
+// DecodeClientState unmarshals the provided client state bytes into a tendermint client state.
+func (l LightClientModule) DecodeClientState(clientStateBz []byte) (exported.ClientState, error) {
+	var clientState ClientState
+	if err := l.keeper.Codec().Unmarshal(clientStateBz, &clientState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client state bytes into client state: %w", err)
+	}
+
+	return &clientState, nil
+}
+
 // VerifyClientMessage obtains the client state associated with the client identifier and calls into the clientState.VerifyClientMessage method.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
@@ -225,6 +240,15 @@ func (l LightClientModule) TimestampAtHeight(
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
 func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
+	return l.RecoverClientWithOverrides(ctx, clientID, substituteClientID, nil)
+}
+
+// RecoverClientWithOverrides asserts that the substitute client is a tendermint client. It obtains the client state associated
+// with the subject client and calls into the subjectClientState.CheckSubstituteAndUpdateStateWithOverrides method. The trusting
+// period, unbonding period, trust level and proof specs of the client state may be overridden.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) RecoverClientWithOverrides(ctx sdk.Context, clientID, substituteClientID string, overrides *clienttypes.RecoveryOverrides) error {
 	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
 	if err != nil {
 		return err
@@ -248,7 +272,35 @@ func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteCl
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
 	}
 
-	return clientState.CheckSubstituteAndUpdateState(ctx, cdc, clientStore, substituteClientStore, substituteClient)
+	return clientState.CheckSubstituteAndUpdateStateWithOverrides(ctx, cdc, clientStore, substituteClientStore, substituteClient, overrides)
+}
+
+// DeleteClient removes the client state, all consensus states and associated metadata from the client store.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) DeleteClient(ctx sdk.Context, clientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	if _, found := getClientState(clientStore, l.keeper.Codec()); !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	clienttypes.DeleteClientStore(clientStore)
+
+	return nil
+}
+
+// PruneExpiredConsensusStates prunes at most limit expired consensus states of the client, in ascending height order,
+// together with their associated metadata. The consensus state at the latest height of the client is never pruned.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) PruneExpiredConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return pruneExpiredConsensusStates(ctx, clientStore, l.keeper.Codec(), clientState, limit), nil
 }
 
 // VerifyUpgradeAndUpdateState obtains the client state associated with the client identifier and calls into the clientState.VerifyUpgradeAndUpdateState method.
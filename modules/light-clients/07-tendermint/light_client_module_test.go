@@ -358,6 +358,170 @@ func (suite *TendermintTestSuite) TestRecoverClient() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestDeleteClient() {
+	var clientID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientID = tmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(clientID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			err := lightClientModule.DeleteClient(suite.chainA.GetContext(), clientID)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+				iterator := clientStore.Iterator(nil, nil)
+				defer iterator.Close()
+				suite.Require().False(iterator.Valid())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestPruneExpiredConsensusStates() {
+	var (
+		clientID string
+		limit    uint64
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned uint64
+		expErr    error
+	}{
+		{
+			"success",
+			func() {},
+			2,
+			nil,
+		},
+		{
+			"success: limit is respected",
+			func() {
+				limit = 1
+			},
+			1,
+			nil,
+		},
+		{
+			"success: latest consensus state is not pruned when expired",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			2,
+			nil,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientID = tmClientID
+			},
+			0,
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+			limit = 10
+
+			// store two more consensus states, then expire all but the latest
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod / 3)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod / 3)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.coordinator.IncrementTimeBy(2*ibctesting.TrustingPeriod/3 + time.Minute)
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(clientID)
+			suite.Require().True(found)
+
+			pruningModule, ok := lightClientModule.(exported.ConsensusStatePruningModule)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			pruned, err := pruningModule.PruneExpiredConsensusStates(suite.chainA.GetContext(), clientID, limit)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+
+				latestHeight := path.EndpointA.GetClientLatestHeight()
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, latestHeight)
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestDecodeClientState() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	decoderModule, ok := lightClientModule.(exported.ClientStateDecoderModule)
+	suite.Require().True(ok)
+
+	clientState := path.EndpointA.GetClientState()
+	clientStateBz := suite.chainA.App.AppCodec().MustMarshal(clientState.(*ibctm.ClientState))
+
+	decodedClientState, err := decoderModule.DecodeClientState(clientStateBz)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clientState, decodedClientState)
+
+	_, err = decoderModule.DecodeClientState([]byte("invalid"))
+	suite.Require().Error(err)
+}
+
 func (suite *TendermintTestSuite) TestVerifyUpgradeAndUpdateState() {
 	var (
 		clientID                                              string
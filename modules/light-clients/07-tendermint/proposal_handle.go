@@ -29,12 +29,34 @@ import (
 func (cs ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
 	substituteClientStore storetypes.KVStore, substituteClient exported.ClientState,
+) error {
+	return cs.CheckSubstituteAndUpdateStateWithOverrides(ctx, cdc, subjectClientStore, substituteClientStore, substituteClient, nil)
+}
+
+// CheckSubstituteAndUpdateStateWithOverrides will try to update the client with the state of the substitute,
+// as CheckSubstituteAndUpdateState does, after applying the provided overrides to both the subject and the
+// substitute client states. The overridden parameters therefore do not need to match between the subject and
+// the substitute, and take precedence over the trusting period of the substitute. The resulting client state
+// is validated if any override is provided.
+func (cs ClientState) CheckSubstituteAndUpdateStateWithOverrides(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore storetypes.KVStore, substituteClient exported.ClientState,
+	overrides *clienttypes.RecoveryOverrides,
 ) error {
 	substituteClientState, ok := substituteClient.(*ClientState)
 	if !ok {
 		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient)
 	}
 
+	if !overrides.IsEmpty() {
+		// apply the overrides to a copy of the substitute in order to not modify the stored substitute client state
+		substituteCopy := *substituteClientState
+		substituteClientState = &substituteCopy
+
+		cs.applyRecoveryOverrides(*overrides)
+		substituteClientState.applyRecoveryOverrides(*overrides)
+	}
+
 	if !IsMatchingClientState(cs, *substituteClientState) {
 		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
 	}
@@ -74,13 +96,38 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 	// set new trusting period based on the substitute client state
 	cs.TrustingPeriod = substituteClientState.TrustingPeriod
 
-	// no validation is necessary since the substitute is verified to be Active
+	// no validation is necessary without overrides since the substitute is verified to be Active
 	// in 02-client.
+	if !overrides.IsEmpty() {
+		if err := cs.Validate(); err != nil {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidRecoveryClient, "invalid client state after applying recovery overrides: %v", err)
+		}
+	}
+
 	setClientState(subjectClientStore, cdc, &cs)
 
 	return nil
 }
 
+// applyRecoveryOverrides sets the client state parameters which are overridden by the provided recovery overrides.
+func (cs *ClientState) applyRecoveryOverrides(overrides clienttypes.RecoveryOverrides) {
+	if overrides.TrustingPeriod != nil {
+		cs.TrustingPeriod = *overrides.TrustingPeriod
+	}
+
+	if overrides.UnbondingPeriod != nil {
+		cs.UnbondingPeriod = *overrides.UnbondingPeriod
+	}
+
+	if overrides.HasTrustLevel() {
+		cs.TrustLevel = Fraction{Numerator: overrides.TrustLevelNumerator, Denominator: overrides.TrustLevelDenominator}
+	}
+
+	if len(overrides.ProofSpecs) > 0 {
+		cs.ProofSpecs = overrides.ProofSpecs
+	}
+}
+
 // IsMatchingClientState returns true if all the client state parameters match
 // except for frozen height, latest height, trusting period, chain-id.
 func IsMatchingClientState(subject, substitute ClientState) bool {
@@ -170,6 +170,90 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateStateWithOverrides() {
+	var overrides *clienttypes.RecoveryOverrides
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: overrides applied to the subject client state",
+			func() {},
+			nil,
+		},
+		{
+			"failure: substitute does not match subject without overrides",
+			func() {
+				overrides = nil
+			},
+			clienttypes.ErrInvalidSubstitute,
+		},
+		{
+			"failure: overridden trusting period is not less than the unbonding period",
+			func() {
+				trustingPeriod := ibctesting.UnbondingPeriod
+				overrides.TrustingPeriod = &trustingPeriod
+			},
+			clienttypes.ErrInvalidRecoveryClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+			subjectClientState, ok := suite.chainA.GetClientState(subjectPath.EndpointA.ClientID).(*ibctm.ClientState)
+			suite.Require().True(ok)
+			subjectClientState.FrozenHeight = frozenHeight
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+			err := substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			// the substitute does not match the subject in its proof specs
+			substituteClientState, ok := suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+			suite.Require().True(ok)
+			substituteClientState.ProofSpecs = substituteClientState.ProofSpecs[:1]
+
+			trustingPeriod := time.Hour * 24 * 7
+			overrides = &clienttypes.RecoveryOverrides{
+				TrustingPeriod: &trustingPeriod,
+				ProofSpecs:     subjectClientState.ProofSpecs,
+			}
+
+			tc.malleate()
+
+			subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
+			substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
+
+			err = subjectClientState.CheckSubstituteAndUpdateStateWithOverrides(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState, overrides)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				updatedClient, ok := subjectPath.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(clienttypes.ZeroHeight(), updatedClient.FrozenHeight)
+				suite.Require().Equal(substituteClientState.LatestHeight, updatedClient.LatestHeight)
+				suite.Require().Equal(trustingPeriod, updatedClient.TrustingPeriod)
+				suite.Require().Equal(subjectClientState.ProofSpecs, updatedClient.ProofSpecs)
+
+				// the substitute client state must not be modified
+				suite.Require().Len(substituteClientState.ProofSpecs, 1)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestIsMatchingClientState() {
 	var (
 		subjectPath, substitutePath               *ibctesting.Path
@@ -292,6 +292,44 @@ func PruneAllExpiredConsensusStates(
 	return len(heights)
 }
 
+// pruneExpiredConsensusStates iterates over the consensus states of a client in ascending height order and
+// deletes at most limit expired consensus states along with their metadata. Iteration stops at the first
+// consensus state which is not expired. The consensus state at the latest height of the client is never
+// pruned. The number of consensus states pruned is returned.
+func pruneExpiredConsensusStates(
+	ctx sdk.Context, clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec, clientState *ClientState, limit uint64,
+) uint64 {
+	var heights []exported.Height
+
+	pruneCb := func(height exported.Height) bool {
+		if uint64(len(heights)) >= limit || height.EQ(clientState.LatestHeight) {
+			return true
+		}
+
+		consState, found := GetConsensusState(clientStore, cdc, height)
+		if !found { // consensus state should always be found
+			return true
+		}
+
+		if !clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+			return true
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	IterateConsensusStateAscending(clientStore, pruneCb)
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return uint64(len(heights))
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
@@ -256,6 +256,66 @@ func (m *Header) GetTrustedValidators() *types2.ValidatorSet {
 	return nil
 }
 
+// HeaderBatch defines a sequence of Tendermint Headers which are verified in
+// order within a single client update. The first Header is verified against a
+// stored ConsensusState at its TrustedHeight, and every following Header must
+// use the height of the preceding Header as its TrustedHeight. Only the
+// ConsensusStates of the Headers at the given store indices and of the last
+// Header are persisted.
+type HeaderBatch struct {
+	Headers []*Header `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	// indices of the headers, in ascending order, whose consensus states are stored
+	// in addition to the consensus state of the last header
+	StoreIndices []uint32 `protobuf:"varint,2,rep,packed,name=store_indices,json=storeIndices,proto3" json:"store_indices,omitempty"`
+}
+
+func (m *HeaderBatch) Reset()         { *m = HeaderBatch{} }
+func (m *HeaderBatch) String() string { return proto.CompactTextString(m) }
+func (*HeaderBatch) ProtoMessage()    {}
+func (*HeaderBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
+}
+func (m *HeaderBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderBatch.Merge(m, src)
+}
+func (m *HeaderBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderBatch proto.InternalMessageInfo
+
+func (m *HeaderBatch) GetHeaders() []*Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *HeaderBatch) GetStoreIndices() []uint32 {
+	if m != nil {
+		return m.StoreIndices
+	}
+	return nil
+}
+
 // Fraction defines the protobuf message type for tmmath.Fraction that only
 // supports positive values.
 type Fraction struct {
@@ -267,7 +327,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,6 +375,7 @@ func init() {
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
+	proto.RegisterType((*HeaderBatch)(nil), "ibc.lightclients.tendermint.v1.HeaderBatch")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
 }
 
@@ -323,66 +384,69 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xc7, 0xe3, 0x34, 0xdb, 0x26, 0x93, 0x64, 0xfb, 0xfb, 0x8d, 0x56, 0xc8, 0xad, 0xaa, 0x24,
-	0xf4, 0x00, 0xb9, 0xd4, 0xde, 0x64, 0x91, 0x40, 0x2c, 0x1c, 0x48, 0x77, 0xa1, 0x5d, 0xb6, 0x50,
-	0xb9, 0xc0, 0x81, 0x8b, 0x35, 0xb6, 0x27, 0xf6, 0x68, 0x6d, 0x8f, 0x35, 0x33, 0x0e, 0x29, 0x27,
-	0x8e, 0x1c, 0xf7, 0xc8, 0x91, 0x97, 0xc0, 0xcb, 0xd8, 0x63, 0x2f, 0x48, 0x9c, 0x0a, 0x4a, 0xdf,
-	0x05, 0x27, 0x34, 0x7f, 0x9c, 0x98, 0xb2, 0x62, 0x2b, 0x2e, 0xd1, 0x33, 0xf3, 0x7c, 0x9f, 0x4f,
-	0x66, 0x9e, 0x3f, 0x63, 0xe0, 0x92, 0x20, 0x74, 0x53, 0x12, 0x27, 0x22, 0x4c, 0x09, 0xce, 0x05,
-	0x77, 0x05, 0xce, 0x23, 0xcc, 0x32, 0x92, 0x0b, 0x77, 0x31, 0xa9, 0xad, 0x9c, 0x82, 0x51, 0x41,
-	0xe1, 0x80, 0x04, 0xa1, 0x53, 0x0f, 0x70, 0x6a, 0x92, 0xc5, 0x64, 0x7f, 0x54, 0x8b, 0x17, 0x97,
-	0x05, 0xe6, 0xee, 0x02, 0xa5, 0x24, 0x42, 0x82, 0x32, 0x4d, 0xd8, 0x3f, 0xf8, 0x87, 0x42, 0xfd,
-	0x56, 0xde, 0x90, 0xf2, 0x8c, 0x72, 0x97, 0x84, 0x7c, 0xfa, 0x48, 0x9e, 0xa0, 0x60, 0x94, 0xce,
-	0x2b, 0xef, 0x20, 0xa6, 0x34, 0x4e, 0xb1, 0xab, 0x56, 0x41, 0x39, 0x77, 0xa3, 0x92, 0x21, 0x41,
-	0x68, 0x6e, 0xfc, 0xc3, 0xdb, 0x7e, 0x41, 0x32, 0xcc, 0x05, 0xca, 0x8a, 0x4a, 0x20, 0xef, 0x1b,
-	0x52, 0x86, 0x5d, 0x7d, 0x7c, 0xf9, 0x0f, 0xda, 0x32, 0x82, 0x77, 0x37, 0x02, 0x9a, 0x65, 0x44,
-	0x64, 0x95, 0x68, 0xbd, 0x32, 0xc2, 0x07, 0x31, 0x8d, 0xa9, 0x32, 0x5d, 0x69, 0xe9, 0xdd, 0xc3,
-	0xd5, 0x3d, 0xd0, 0x3d, 0x56, 0xbc, 0x0b, 0x81, 0x04, 0x86, 0x7b, 0xa0, 0x1d, 0x26, 0x88, 0xe4,
-	0x3e, 0x89, 0x6c, 0x6b, 0x64, 0x8d, 0x3b, 0xde, 0x8e, 0x5a, 0x9f, 0x46, 0xf0, 0x4b, 0xd0, 0x15,
-	0xac, 0xe4, 0xc2, 0x4f, 0xf1, 0x02, 0xa7, 0x76, 0x73, 0x64, 0x8d, 0xbb, 0xd3, 0xb1, 0xf3, 0xef,
-	0xf9, 0x75, 0x3e, 0x65, 0x28, 0x94, 0x17, 0x9e, 0xb5, 0x5e, 0x5d, 0x0f, 0x1b, 0x1e, 0x50, 0x88,
-	0xe7, 0x92, 0x00, 0x9f, 0x83, 0x5d, 0xb5, 0x22, 0x79, 0xec, 0x17, 0x98, 0x11, 0x1a, 0xd9, 0x5b,
-	0x0a, 0xba, 0xe7, 0xe8, 0xb4, 0x38, 0x55, 0x5a, 0x9c, 0x27, 0x26, 0x6d, 0xb3, 0xb6, 0xa4, 0xfc,
-	0xf4, 0xfb, 0xd0, 0xf2, 0xee, 0x57, 0xb1, 0xe7, 0x2a, 0x14, 0x7e, 0x01, 0xfe, 0x57, 0xe6, 0x01,
-	0xcd, 0xa3, 0x1a, 0xae, 0x75, 0x77, 0xdc, 0xee, 0x3a, 0xd8, 0xf0, 0x3e, 0x07, 0xbb, 0x19, 0x5a,
-	0xfa, 0x61, 0x4a, 0xc3, 0x17, 0x7e, 0xc4, 0xc8, 0x5c, 0xd8, 0xf7, 0xee, 0x8e, 0xeb, 0x67, 0x68,
-	0x79, 0x2c, 0x43, 0x9f, 0xc8, 0x48, 0xf8, 0x14, 0xf4, 0xe7, 0x8c, 0x7e, 0x8f, 0x73, 0x3f, 0xc1,
-	0x32, 0x57, 0xf6, 0xb6, 0x42, 0xed, 0xab, 0xec, 0xc9, 0xea, 0x39, 0xa6, 0xa8, 0x8b, 0x89, 0x73,
-	0xa2, 0x14, 0x26, 0x5f, 0x3d, 0x1d, 0xa6, 0xf7, 0x24, 0x26, 0x45, 0x02, 0x73, 0x51, 0x61, 0x76,
-	0xee, 0x8a, 0xd1, 0x61, 0x06, 0xf3, 0x18, 0x74, 0x55, 0x97, 0xfa, 0xbc, 0xc0, 0x21, 0xb7, 0xdb,
-	0xa3, 0x2d, 0x05, 0xd1, 0x9d, 0xec, 0xa8, 0x4e, 0x96, 0x84, 0x73, 0xa9, 0xb9, 0x28, 0x70, 0xe8,
-	0x81, 0xa2, 0x32, 0x39, 0x7c, 0x1b, 0xf4, 0xca, 0x22, 0x66, 0x28, 0xc2, 0x7e, 0x81, 0x44, 0x62,
-	0x77, 0x46, 0x5b, 0xe3, 0x8e, 0xd7, 0x35, 0x7b, 0xe7, 0x48, 0x24, 0xf0, 0x63, 0xb0, 0x87, 0xd2,
-	0x94, 0x7e, 0xe7, 0x97, 0x45, 0x84, 0x04, 0xf6, 0xd1, 0x5c, 0x60, 0xe6, 0xe3, 0x65, 0x41, 0xd8,
-	0xa5, 0x0d, 0x46, 0xd6, 0xb8, 0x3d, 0x6b, 0xda, 0x96, 0xf7, 0x96, 0x12, 0x7d, 0xad, 0x34, 0x9f,
-	0x48, 0xc9, 0x53, 0xa5, 0x80, 0xa7, 0x60, 0xf8, 0x9a, 0xf0, 0x8c, 0xf0, 0x00, 0x27, 0x68, 0x41,
-	0x68, 0xc9, 0xec, 0xee, 0x1a, 0x72, 0x70, 0x1b, 0x72, 0x56, 0xd3, 0x7d, 0xd8, 0xfa, 0xf1, 0xe7,
-	0x61, 0xe3, 0xf0, 0x87, 0x26, 0xb8, 0x7f, 0x4c, 0x73, 0x8e, 0x73, 0x5e, 0x72, 0xdd, 0xe7, 0x33,
-	0xd0, 0x59, 0x8f, 0x9a, 0x6a, 0x74, 0x99, 0x80, 0xdb, 0x75, 0xfd, 0xaa, 0x52, 0xe8, 0xc2, 0xbe,
-	0x94, 0x85, 0xdd, 0x84, 0xc1, 0x8f, 0x40, 0x8b, 0x51, 0x2a, 0xcc, 0x24, 0x1c, 0xd6, 0x8a, 0xb0,
-	0x99, 0xbd, 0xc5, 0xc4, 0x39, 0xc3, 0xec, 0x45, 0x8a, 0x3d, 0x4a, 0xab, 0x62, 0xa8, 0x28, 0x38,
-	0x07, 0x0f, 0x72, 0xbc, 0x14, 0xfe, 0xfa, 0xb9, 0xe1, 0x7e, 0x82, 0x78, 0xa2, 0x46, 0xa0, 0x37,
-	0x7b, 0xef, 0xcf, 0xeb, 0xe1, 0xc3, 0x98, 0x88, 0xa4, 0x0c, 0x24, 0x4e, 0x8e, 0x33, 0x16, 0xc1,
-	0x5c, 0x6c, 0x8c, 0x94, 0x04, 0xdc, 0x0d, 0x2e, 0x05, 0xe6, 0xce, 0x09, 0x5e, 0xce, 0xa4, 0xe1,
-	0x41, 0x49, 0xfc, 0x66, 0x0d, 0x3c, 0x41, 0x3c, 0x31, 0x29, 0xf8, 0xd5, 0x02, 0xbd, 0x7a, 0x66,
-	0xe0, 0x10, 0x74, 0x74, 0xaf, 0xac, 0x27, 0x5d, 0xa5, 0xb3, 0xad, 0x37, 0x4f, 0xe5, 0x3c, 0xb5,
-	0x13, 0x8c, 0x22, 0xcc, 0xfc, 0x89, 0xb9, 0xe1, 0x3b, 0x6f, 0x9a, 0xf5, 0x13, 0xa5, 0x9f, 0x75,
-	0x57, 0xd7, 0xc3, 0x1d, 0x6d, 0x4f, 0xbc, 0x1d, 0x0d, 0x99, 0xd4, 0x78, 0x53, 0x33, 0xe6, 0xff,
-	0x81, 0x37, 0xad, 0x78, 0x53, 0x73, 0xaf, 0x5f, 0x9a, 0x60, 0x5b, 0xbb, 0xe0, 0x29, 0xe8, 0x73,
-	0x12, 0xe7, 0x38, 0xf2, 0xb5, 0xc4, 0x94, 0x75, 0x50, 0x87, 0xea, 0x97, 0xfb, 0x42, 0xc9, 0x0c,
-	0xbd, 0x75, 0x75, 0x3d, 0xb4, 0xbc, 0x1e, 0xaf, 0xed, 0xc1, 0x63, 0xd0, 0x5f, 0x97, 0xc5, 0xe7,
-	0xb8, 0x2a, 0xf1, 0x6b, 0x50, 0xeb, 0x64, 0x5f, 0x60, 0xe1, 0xf5, 0x16, 0xb5, 0x15, 0xfc, 0x0c,
-	0xe8, 0x27, 0x4a, 0x1d, 0x48, 0x4d, 0xeb, 0xd6, 0x1d, 0xa7, 0xb5, 0x6f, 0xe2, 0xcc, 0xb8, 0x9e,
-	0x01, 0x58, 0x81, 0x36, 0xcd, 0x62, 0xde, 0xb6, 0x37, 0x1d, 0xe9, 0xff, 0x26, 0x72, 0xd3, 0x14,
-	0x87, 0xcf, 0x40, 0xbb, 0x7a, 0x94, 0xe1, 0x01, 0xe8, 0xe4, 0x65, 0x86, 0x99, 0xf4, 0xa8, 0x7c,
-	0xb5, 0xbc, 0xcd, 0x06, 0x1c, 0x81, 0x6e, 0x84, 0x73, 0x9a, 0x91, 0x5c, 0xf9, 0x9b, 0xca, 0x5f,
-	0xdf, 0x9a, 0x45, 0xaf, 0x56, 0x03, 0xeb, 0x6a, 0x35, 0xb0, 0xfe, 0x58, 0x0d, 0xac, 0x97, 0x37,
-	0x83, 0xc6, 0xd5, 0xcd, 0xa0, 0xf1, 0xdb, 0xcd, 0xa0, 0xf1, 0xed, 0xb3, 0xbf, 0x35, 0xaf, 0xfe,
-	0x44, 0x06, 0xe1, 0x51, 0x4c, 0xdd, 0xc5, 0x07, 0x6e, 0x46, 0xa3, 0x32, 0xc5, 0x5c, 0x7f, 0xc8,
-	0x8f, 0xaa, 0x2f, 0xf9, 0xc3, 0xf7, 0x8f, 0x36, 0x97, 0x79, 0xbc, 0x31, 0x83, 0x6d, 0x35, 0x91,
-	0x8f, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xd6, 0xd5, 0xaa, 0xfd, 0x07, 0x00, 0x00,
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xeb, 0x24, 0xbb, 0x4d, 0x26, 0xc9, 0x16, 0x46, 0x2b, 0xe4, 0x56, 0x55, 0x12, 0x8a,
+	0x04, 0xb9, 0xd4, 0xde, 0x74, 0x91, 0x40, 0x2c, 0x48, 0x90, 0xee, 0x42, 0xbb, 0x6c, 0xa1, 0x72,
+	0x81, 0x03, 0x17, 0x6b, 0x6c, 0x4f, 0xec, 0xd1, 0xda, 0x1e, 0x6b, 0x66, 0x1c, 0x5a, 0x4e, 0x1c,
+	0x39, 0xee, 0x91, 0x23, 0x1f, 0x81, 0x8f, 0xb1, 0xc7, 0x5e, 0x90, 0x38, 0x15, 0xd4, 0x7e, 0x0b,
+	0x4e, 0x68, 0x5e, 0xec, 0x98, 0xb2, 0x82, 0x88, 0x4b, 0xf5, 0xcc, 0x33, 0xff, 0xe7, 0xd7, 0x99,
+	0xe7, 0x65, 0x62, 0xe0, 0x92, 0x20, 0x74, 0x53, 0x12, 0x27, 0x22, 0x4c, 0x09, 0xce, 0x05, 0x77,
+	0x05, 0xce, 0x23, 0xcc, 0x32, 0x92, 0x0b, 0x77, 0x39, 0x6b, 0xac, 0x9c, 0x82, 0x51, 0x41, 0xe1,
+	0x88, 0x04, 0xa1, 0xd3, 0x0c, 0x70, 0x1a, 0x92, 0xe5, 0x6c, 0x67, 0xd2, 0x88, 0x17, 0x17, 0x05,
+	0xe6, 0xee, 0x12, 0xa5, 0x24, 0x42, 0x82, 0x32, 0x4d, 0xd8, 0xd9, 0xfd, 0x87, 0x42, 0xfd, 0xad,
+	0x76, 0x43, 0xca, 0x33, 0xca, 0x5d, 0x12, 0xf2, 0x83, 0x87, 0xf2, 0x04, 0x05, 0xa3, 0x74, 0x51,
+	0xed, 0x8e, 0x62, 0x4a, 0xe3, 0x14, 0xbb, 0x6a, 0x15, 0x94, 0x0b, 0x37, 0x2a, 0x19, 0x12, 0x84,
+	0xe6, 0x66, 0x7f, 0x7c, 0x7b, 0x5f, 0x90, 0x0c, 0x73, 0x81, 0xb2, 0xa2, 0x12, 0xc8, 0xfb, 0x86,
+	0x94, 0x61, 0x57, 0x1f, 0x5f, 0xfe, 0x07, 0x6d, 0x19, 0xc1, 0x3b, 0x2b, 0x01, 0xcd, 0x32, 0x22,
+	0xb2, 0x4a, 0x54, 0xaf, 0x8c, 0xf0, 0x7e, 0x4c, 0x63, 0xaa, 0x4c, 0x57, 0x5a, 0xda, 0xbb, 0x77,
+	0x7d, 0x07, 0xf4, 0x0f, 0x15, 0xef, 0x4c, 0x20, 0x81, 0xe1, 0x36, 0xe8, 0x86, 0x09, 0x22, 0xb9,
+	0x4f, 0x22, 0xdb, 0x9a, 0x58, 0xd3, 0x9e, 0xb7, 0xa9, 0xd6, 0xc7, 0x11, 0xfc, 0x12, 0xf4, 0x05,
+	0x2b, 0xb9, 0xf0, 0x53, 0xbc, 0xc4, 0xa9, 0xdd, 0x9a, 0x58, 0xd3, 0xfe, 0xc1, 0xd4, 0xf9, 0xf7,
+	0xfc, 0x3a, 0x9f, 0x32, 0x14, 0xca, 0x0b, 0xcf, 0x3b, 0x2f, 0xaf, 0xc6, 0x1b, 0x1e, 0x50, 0x88,
+	0x67, 0x92, 0x00, 0x9f, 0x81, 0x2d, 0xb5, 0x22, 0x79, 0xec, 0x17, 0x98, 0x11, 0x1a, 0xd9, 0x6d,
+	0x05, 0xdd, 0x76, 0x74, 0x5a, 0x9c, 0x2a, 0x2d, 0xce, 0x63, 0x93, 0xb6, 0x79, 0x57, 0x52, 0x7e,
+	0xfa, 0x7d, 0x6c, 0x79, 0xf7, 0xaa, 0xd8, 0x53, 0x15, 0x0a, 0xbf, 0x00, 0xaf, 0x95, 0x79, 0x40,
+	0xf3, 0xa8, 0x81, 0xeb, 0xac, 0x8f, 0xdb, 0xaa, 0x83, 0x0d, 0xef, 0x73, 0xb0, 0x95, 0xa1, 0x73,
+	0x3f, 0x4c, 0x69, 0xf8, 0xdc, 0x8f, 0x18, 0x59, 0x08, 0xfb, 0xce, 0xfa, 0xb8, 0x61, 0x86, 0xce,
+	0x0f, 0x65, 0xe8, 0x63, 0x19, 0x09, 0x9f, 0x80, 0xe1, 0x82, 0xd1, 0xef, 0x71, 0xee, 0x27, 0x58,
+	0xe6, 0xca, 0xbe, 0xab, 0x50, 0x3b, 0x2a, 0x7b, 0xb2, 0x7a, 0x8e, 0x29, 0xea, 0x72, 0xe6, 0x1c,
+	0x29, 0x85, 0xc9, 0xd7, 0x40, 0x87, 0x69, 0x9f, 0xc4, 0xa4, 0x48, 0x60, 0x2e, 0x2a, 0xcc, 0xe6,
+	0xba, 0x18, 0x1d, 0x66, 0x30, 0x8f, 0x40, 0x5f, 0x75, 0xa9, 0xcf, 0x0b, 0x1c, 0x72, 0xbb, 0x3b,
+	0x69, 0x2b, 0x88, 0xee, 0x64, 0x47, 0x75, 0xb2, 0x24, 0x9c, 0x4a, 0xcd, 0x59, 0x81, 0x43, 0x0f,
+	0x14, 0x95, 0xc9, 0xe1, 0x9b, 0x60, 0x50, 0x16, 0x31, 0x43, 0x11, 0xf6, 0x0b, 0x24, 0x12, 0xbb,
+	0x37, 0x69, 0x4f, 0x7b, 0x5e, 0xdf, 0xf8, 0x4e, 0x91, 0x48, 0xe0, 0x47, 0x60, 0x1b, 0xa5, 0x29,
+	0xfd, 0xce, 0x2f, 0x8b, 0x08, 0x09, 0xec, 0xa3, 0x85, 0xc0, 0xcc, 0xc7, 0xe7, 0x05, 0x61, 0x17,
+	0x36, 0x98, 0x58, 0xd3, 0xee, 0xbc, 0x65, 0x5b, 0xde, 0x1b, 0x4a, 0xf4, 0xb5, 0xd2, 0x7c, 0x22,
+	0x25, 0x4f, 0x94, 0x02, 0x1e, 0x83, 0xf1, 0x2b, 0xc2, 0x33, 0xc2, 0x03, 0x9c, 0xa0, 0x25, 0xa1,
+	0x25, 0xb3, 0xfb, 0x35, 0x64, 0xf7, 0x36, 0xe4, 0xa4, 0xa1, 0xfb, 0xa0, 0xf3, 0xe3, 0xcf, 0xe3,
+	0x8d, 0xbd, 0x1f, 0x5a, 0xe0, 0xde, 0x21, 0xcd, 0x39, 0xce, 0x79, 0xc9, 0x75, 0x9f, 0xcf, 0x41,
+	0xaf, 0x1e, 0x35, 0xd5, 0xe8, 0x32, 0x01, 0xb7, 0xeb, 0xfa, 0x55, 0xa5, 0xd0, 0x85, 0x7d, 0x21,
+	0x0b, 0xbb, 0x0a, 0x83, 0x1f, 0x82, 0x0e, 0xa3, 0x54, 0x98, 0x49, 0xd8, 0x6b, 0x14, 0x61, 0x35,
+	0x7b, 0xcb, 0x99, 0x73, 0x82, 0xd9, 0xf3, 0x14, 0x7b, 0x94, 0x56, 0xc5, 0x50, 0x51, 0x70, 0x01,
+	0xee, 0xe7, 0xf8, 0x5c, 0xf8, 0xf5, 0x73, 0xc3, 0xfd, 0x04, 0xf1, 0x44, 0x8d, 0xc0, 0x60, 0xfe,
+	0xee, 0x9f, 0x57, 0xe3, 0x07, 0x31, 0x11, 0x49, 0x19, 0x48, 0x9c, 0x1c, 0x67, 0x2c, 0x82, 0x85,
+	0x58, 0x19, 0x29, 0x09, 0xb8, 0x1b, 0x5c, 0x08, 0xcc, 0x9d, 0x23, 0x7c, 0x3e, 0x97, 0x86, 0x07,
+	0x25, 0xf1, 0x9b, 0x1a, 0x78, 0x84, 0x78, 0x62, 0x52, 0xf0, 0xab, 0x05, 0x06, 0xcd, 0xcc, 0xc0,
+	0x31, 0xe8, 0xe9, 0x5e, 0xa9, 0x27, 0x5d, 0xa5, 0xb3, 0xab, 0x9d, 0xc7, 0x72, 0x9e, 0xba, 0x09,
+	0x46, 0x11, 0x66, 0xfe, 0xcc, 0xdc, 0xf0, 0xed, 0xff, 0x9a, 0xf5, 0x23, 0xa5, 0x9f, 0xf7, 0xaf,
+	0xaf, 0xc6, 0x9b, 0xda, 0x9e, 0x79, 0x9b, 0x1a, 0x32, 0x6b, 0xf0, 0x0e, 0xec, 0xf6, 0xff, 0xe5,
+	0x1d, 0x54, 0xbc, 0x03, 0x73, 0xaf, 0x5f, 0x5a, 0xe0, 0xae, 0xde, 0x82, 0xc7, 0x60, 0xc8, 0x49,
+	0x9c, 0xe3, 0xc8, 0xd7, 0x12, 0x53, 0xd6, 0x51, 0x13, 0xaa, 0x5f, 0xee, 0x33, 0x25, 0x33, 0xf4,
+	0xce, 0xe5, 0xd5, 0xd8, 0xf2, 0x06, 0xbc, 0xe1, 0x83, 0x87, 0x60, 0x58, 0x97, 0xc5, 0xe7, 0xb8,
+	0x2a, 0xf1, 0x2b, 0x50, 0x75, 0xb2, 0xcf, 0xb0, 0xf0, 0x06, 0xcb, 0xc6, 0x0a, 0x7e, 0x06, 0xf4,
+	0x13, 0xa5, 0x0e, 0xa4, 0xa6, 0xb5, 0xbd, 0xe6, 0xb4, 0x0e, 0x4d, 0x9c, 0x19, 0xd7, 0x13, 0x00,
+	0x2b, 0xd0, 0xaa, 0x59, 0xec, 0xce, 0x5a, 0x47, 0x7a, 0xdd, 0x44, 0xd6, 0x4e, 0xbe, 0x27, 0x40,
+	0xdf, 0x5c, 0x1d, 0x89, 0x30, 0x81, 0x1f, 0x03, 0x93, 0x52, 0x6e, 0x5b, 0x93, 0xf6, 0xfa, 0x65,
+	0xa9, 0x2a, 0xc1, 0xe1, 0x5b, 0x60, 0xc8, 0x05, 0x65, 0xd8, 0x27, 0x79, 0x44, 0x42, 0xcc, 0xed,
+	0xd6, 0xa4, 0x3d, 0x1d, 0x7a, 0x03, 0xe5, 0x3c, 0xd6, 0xbe, 0xbd, 0xa7, 0xa0, 0x5b, 0xfd, 0x14,
+	0xc0, 0x5d, 0xd0, 0xcb, 0xcb, 0x0c, 0x33, 0x79, 0x1e, 0x55, 0xa5, 0x8e, 0xb7, 0x72, 0xc0, 0x09,
+	0xe8, 0x47, 0x38, 0xa7, 0x19, 0xc9, 0xd5, 0x7e, 0x4b, 0xed, 0x37, 0x5d, 0xf3, 0xe8, 0xe5, 0xf5,
+	0xc8, 0xba, 0xbc, 0x1e, 0x59, 0x7f, 0x5c, 0x8f, 0xac, 0x17, 0x37, 0xa3, 0x8d, 0xcb, 0x9b, 0xd1,
+	0xc6, 0x6f, 0x37, 0xa3, 0x8d, 0x6f, 0x9f, 0xfe, 0x6d, 0x64, 0xf4, 0x0f, 0x73, 0x10, 0xee, 0xc7,
+	0xd4, 0x5d, 0xbe, 0xef, 0x66, 0x34, 0x2a, 0x53, 0xcc, 0xf5, 0xe7, 0xc3, 0x7e, 0xf5, 0xfd, 0xf0,
+	0xe0, 0xbd, 0xfd, 0xd5, 0xf5, 0x1e, 0xad, 0xcc, 0xe0, 0xae, 0x7a, 0x07, 0x1e, 0xfe, 0x35, 0x00,
+	0xec, 0x4c, 0x78, 0x2e, 0x73, 0x08, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -683,6 +747,61 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeaderBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreIndices) > 0 {
+		dAtA16 := make([]byte, len(m.StoreIndices)*10)
+		var j15 int
+		for _, num := range m.StoreIndices {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTendermint(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Fraction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -831,6 +950,28 @@ func (m *Header) Size() (n int) {
 	return n
 }
 
+func (m *HeaderBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
+	}
+	if len(m.StoreIndices) > 0 {
+		l = 0
+		for _, e := range m.StoreIndices {
+			l += sovTendermint(uint64(e))
+		}
+		n += 1 + sovTendermint(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *Fraction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1733,6 +1874,166 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HeaderBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTendermint
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StoreIndices = append(m.StoreIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTendermint
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTendermint
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTendermint
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.StoreIndices) == 0 {
+					m.StoreIndices = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTendermint
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StoreIndices = append(m.StoreIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreIndices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Fraction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -110,6 +110,27 @@ func getBothSigners(suite *TendermintTestSuite, altVal *cmttypes.Validator, altP
 	return bothValSet, bothSigners
 }
 
+// getHeaderBatch commits numHeaders blocks on the counterparty chain of the provided endpoint and returns
+// a HeaderBatch containing a header for each committed block, each trusting the header which precedes it.
+func getHeaderBatch(suite *TendermintTestSuite, endpoint *ibctesting.Endpoint, numHeaders int, storeIndices []uint32) *ibctm.HeaderBatch {
+	trustedHeight, ok := endpoint.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	headers := make([]*ibctm.Header, 0, numHeaders)
+	for i := 0; i < numHeaders; i++ {
+		suite.coordinator.CommitBlock(endpoint.Counterparty.Chain)
+
+		header, err := endpoint.Counterparty.Chain.IBCClientHeader(endpoint.Counterparty.Chain.LatestCommittedHeader, trustedHeight)
+		suite.Require().NoError(err)
+
+		headers = append(headers, header)
+		trustedHeight, ok = header.GetHeight().(clienttypes.Height)
+		suite.Require().True(ok)
+	}
+
+	return ibctm.NewHeaderBatch(headers, storeIndices)
+}
+
 func TestTendermintTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(TendermintTestSuite))
 }
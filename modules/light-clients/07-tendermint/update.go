@@ -19,7 +19,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+// VerifyClientMessage checks if the clientMessage is of type Header, HeaderBatch or Misbehaviour and verifies the message
 func (cs *ClientState) VerifyClientMessage(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
 	clientMsg exported.ClientMessage,
@@ -27,6 +27,8 @@ func (cs *ClientState) VerifyClientMessage(
 	switch msg := clientMsg.(type) {
 	case *Header:
 		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	case *HeaderBatch:
+		return cs.verifyHeaderBatch(ctx, clientStore, cdc, msg)
 	case *Misbehaviour:
 		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
 	default:
@@ -46,14 +48,48 @@ func (cs *ClientState) verifyHeader(
 	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
 	header *Header,
 ) error {
-	currentTimestamp := ctx.BlockTime()
-
 	// Retrieve trusted consensus states for each Header in misbehaviour
 	consState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight)
 	}
 
+	return cs.verifyHeaderWithConsensusState(ctx, header, consState)
+}
+
+// verifyHeaderBatch verifies each header of the batch in order. The first header is verified against
+// the stored consensus state at its trusted height, each following header is verified against the
+// consensus state of the preceding header, which is not required to be stored.
+func (cs *ClientState) verifyHeaderBatch(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	headerBatch *HeaderBatch,
+) error {
+	for i, header := range headerBatch.Headers {
+		if i == 0 {
+			if err := cs.verifyHeader(ctx, clientStore, cdc, header); err != nil {
+				return errorsmod.Wrapf(err, "failed to verify header %d", i)
+			}
+
+			continue
+		}
+
+		previous := headerBatch.Headers[i-1]
+		if !header.TrustedHeight.EQ(previous.GetHeight()) {
+			return errorsmod.Wrapf(ErrInvalidHeaderHeight, "header %d trusted height %s must equal preceding header height %s", i, header.TrustedHeight, previous.GetHeight())
+		}
+
+		if err := cs.verifyHeaderWithConsensusState(ctx, header, previous.ConsensusState()); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify header %d", i)
+		}
+	}
+
+	return nil
+}
+
+// verifyHeaderWithConsensusState verifies the header against the provided trusted consensus state.
+func (cs *ClientState) verifyHeaderWithConsensusState(ctx sdk.Context, header *Header, consState *ConsensusState) error {
+	currentTimestamp := ctx.BlockTime()
+
 	if err := checkTrustedHeader(header, consState); err != nil {
 		return err
 	}
@@ -128,43 +164,63 @@ func (cs *ClientState) verifyHeader(
 // If we are updating to a future height, the consensus state is created and the client state is updated to reflect
 // the new latest height
 // A list containing the updated consensus height is returned.
+// If a HeaderBatch is provided, a consensus state is created for each of its stored headers and a list
+// containing all of the stored consensus heights is returned.
 // UpdateState must only be used to update within a single revision, thus header revision number and trusted height's revision
 // number must be the same. To update to a new revision, use a separate upgrade path
 // UpdateState will prune the oldest consensus state if it is expired.
 func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
-	header, ok := clientMsg.(*Header)
-	if !ok {
-		panic(fmt.Errorf("expected type %T, got %T", &Header{}, clientMsg))
+	var headers []*Header
+	switch msg := clientMsg.(type) {
+	case *Header:
+		headers = []*Header{msg}
+	case *HeaderBatch:
+		headers = msg.GetStoredHeaders()
+	default:
+		panic(fmt.Errorf("expected type %T or %T, got %T", &Header{}, &HeaderBatch{}, clientMsg))
 	}
 
 	cs.pruneOldestConsensusState(ctx, cdc, clientStore)
 
-	// check for duplicate update
-	if _, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found {
-		// perform no-op
-		return []exported.Height{header.GetHeight()}
-	}
+	var (
+		heights []exported.Height
+		updated bool
+	)
+	for _, header := range headers {
+		height, ok := header.GetHeight().(clienttypes.Height)
+		if !ok {
+			panic(fmt.Errorf("cannot convert %T to %T", header.GetHeight(), &clienttypes.Height{}))
+		}
 
-	height, ok := header.GetHeight().(clienttypes.Height)
-	if !ok {
-		panic(fmt.Errorf("cannot convert %T to %T", header.GetHeight(), &clienttypes.Height{}))
-	}
-	if height.GT(cs.LatestHeight) {
-		cs.LatestHeight = height
-	}
+		heights = append(heights, height)
+
+		// check for duplicate update
+		if _, found := GetConsensusState(clientStore, cdc, height); found {
+			// perform no-op
+			continue
+		}
+
+		if height.GT(cs.LatestHeight) {
+			cs.LatestHeight = height
+		}
+
+		consensusState := &ConsensusState{
+			Timestamp:          header.GetTime(),
+			Root:               commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()),
+			NextValidatorsHash: header.Header.NextValidatorsHash,
+		}
 
-	consensusState := &ConsensusState{
-		Timestamp:          header.GetTime(),
-		Root:               commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()),
-		NextValidatorsHash: header.Header.NextValidatorsHash,
+		// set consensus state and associated metadata
+		setConsensusState(clientStore, cdc, consensusState, height)
+		setConsensusMetadata(ctx, clientStore, height)
+		updated = true
 	}
 
-	// set client state, consensus state and associated metadata
-	setClientState(clientStore, cdc, &cs)
-	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
-	setConsensusMetadata(ctx, clientStore, header.GetHeight())
+	if updated {
+		setClientState(clientStore, cdc, &cs)
+	}
 
-	return []exported.Height{height}
+	return heights
 }
 
 // pruneOldestConsensusState will retrieve the earliest consensus state for this clientID and check if it is expired. If it is,
@@ -1,6 +1,7 @@
 package tendermint_test
 
 import (
+	"slices"
 	"time"
 
 	storetypes "cosmossdk.io/store/types"
@@ -884,3 +885,238 @@ func (suite *TendermintTestSuite) TestUpdateStateOnMisbehaviour() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestVerifyHeaderBatch() {
+	var (
+		path        *ibctesting.Path
+		headerBatch *ibctm.HeaderBatch
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with a single header",
+			func() {
+				headerBatch.Headers = headerBatch.Headers[:1]
+			},
+			true,
+		},
+		{
+			"failure: first header trusted consensus state not found",
+			func() {
+				headerBatch.Headers[0].TrustedHeight = headerBatch.Headers[0].TrustedHeight.Increment().(clienttypes.Height)
+			},
+			false,
+		},
+		{
+			"failure: trusted height does not match preceding header height",
+			func() {
+				headerBatch.Headers[2].TrustedHeight = headerBatch.Headers[0].GetHeight().(clienttypes.Height)
+			},
+			false,
+		},
+		{
+			"failure: header trusted validators do not match preceding header",
+			func() {
+				headerBatch.Headers[2].TrustedValidators = suite.chainA.LatestCommittedHeader.ValidatorSet
+			},
+			false,
+		},
+		{
+			"failure: header signed by untrusted validators",
+			func() {
+				altPrivVal := cmttypes.NewMockPV()
+				altPubKey, err := altPrivVal.GetPubKey()
+				suite.Require().NoError(err)
+
+				altVal := cmttypes.NewValidator(altPubKey, 100)
+				altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+
+				header := headerBatch.Headers[2]
+				headerBatch.Headers[2] = suite.chainB.CreateTMClientHeader(
+					suite.chainB.ChainID, header.Header.Height, header.TrustedHeight, header.GetTime(),
+					altValSet, altValSet, suite.chainB.Vals, getAltSigners(altVal, altPrivVal),
+				)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			headerBatch = getHeaderBatch(suite, path.EndpointA, 3, nil)
+
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			err := lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), path.EndpointA.ClientID, headerBatch)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestUpdateStateWithHeaderBatch() {
+	var (
+		path        *ibctesting.Path
+		headerBatch *ibctm.HeaderBatch
+	)
+
+	testCases := []struct {
+		name         string
+		storeIndices []uint32
+		malleate     func()
+		expStored    []int
+	}{
+		{
+			"success: only the last header is stored",
+			nil,
+			func() {},
+			[]int{3},
+		},
+		{
+			"success: selected headers and the last header are stored",
+			[]uint32{0, 2},
+			func() {},
+			[]int{0, 2, 3},
+		},
+		{
+			"success: stored header already exists",
+			[]uint32{1},
+			func() {
+				header := headerBatch.Headers[1]
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight(), header.ConsensusState())
+			},
+			[]int{1, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			headerBatch = getHeaderBatch(suite, path.EndpointA, 4, tc.storeIndices)
+
+			tc.malleate()
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			consensusHeights := clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, headerBatch)
+
+			var expHeights []exported.Height
+			for _, index := range tc.expStored {
+				expHeights = append(expHeights, headerBatch.Headers[index].GetHeight())
+			}
+			suite.Require().Equal(expHeights, consensusHeights)
+
+			for i, header := range headerBatch.Headers {
+				consensusState, found := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, header.GetHeight())
+				if slices.Contains(tc.expStored, i) {
+					suite.Require().True(found)
+					suite.Require().Equal(header.ConsensusState(), consensusState)
+				} else {
+					suite.Require().False(found)
+				}
+			}
+
+			lastHeader := headerBatch.Headers[len(headerBatch.Headers)-1]
+			suite.Require().Equal(lastHeader.GetHeight(), path.EndpointA.GetClientLatestHeight())
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestCheckForMisbehaviourWithHeaderBatch() {
+	var (
+		path        *ibctesting.Path
+		headerBatch *ibctm.HeaderBatch
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid update no misbehaviour",
+			func() {},
+			false,
+		},
+		{
+			"consensus state already exists for a header which is not stored",
+			func() {
+				header := headerBatch.Headers[1]
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight(), header.ConsensusState())
+			},
+			false,
+		},
+		{
+			"conflicting consensus state exists for a header which is not stored",
+			func() {
+				consensusState := headerBatch.Headers[1].ConsensusState()
+				consensusState.NextValidatorsHash = suite.chainA.Vals.Hash()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, headerBatch.Headers[1].GetHeight(), consensusState)
+			},
+			true,
+		},
+		{
+			"header breaks monotonic time of stored consensus states",
+			func() {
+				// store a consensus state after the batch with a timestamp before the last header
+				lastHeader := headerBatch.Headers[2]
+				header := suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, lastHeader.Header.Height+1, lastHeader.GetHeight().(clienttypes.Height), headerBatch.Headers[0].GetTime(), suite.chainB.Vals, suite.chainB.NextVals, suite.chainB.Vals, suite.chainB.Signers)
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, header)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			headerBatch = getHeaderBatch(suite, path.EndpointA, 3, nil)
+
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			foundMisbehaviour := lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), path.EndpointA.ClientID, headerBatch)
+			suite.Require().Equal(tc.expPass, foundMisbehaviour)
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -19,7 +21,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule           = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruningModule = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -460,6 +465,60 @@ func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteCl
 	return err
 }
 
+// DeleteClient removes the client state, all consensus states and any contract data from the client store.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 08-wasm-{n}.
+func (l LightClientModule) DeleteClient(ctx sdk.Context, clientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	if _, found := types.GetClientState(clientStore, l.keeper.Codec()); !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	clienttypes.DeleteClientStore(clientStore)
+
+	return nil
+}
+
+// PruneExpiredConsensusStates obtains the client state associated with the client identifier and calls into the appropriate
+// contract endpoint. The contract is expected to prune at most limit expired consensus states, never pruning the consensus
+// state at the latest height, and to return the number of consensus states pruned. Contracts which predate the
+// prune_expired_consensus_states sudo message reject it as an unknown variant, in which case no consensus states are
+// pruned and no error is returned.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 08-wasm-{n}.
+func (l LightClientModule) PruneExpiredConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := types.GetClientState(clientStore, cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	payload := types.SudoMsg{
+		PruneExpiredConsensusStates: &types.PruneExpiredConsensusStatesMsg{Limit: limit},
+	}
+
+	res, err := l.keeper.WasmSudo(ctx, clientID, clientStore, clientState, payload)
+	if err != nil {
+		if isUnknownVariantError(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var result types.PruneExpiredConsensusStatesResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return 0, errorsmod.Wrap(types.ErrWasmInvalidResponseData, err.Error())
+	}
+
+	if result.PrunedCount > limit {
+		return 0, errorsmod.Wrapf(types.ErrWasmInvalidResponseData, "contract pruned %d consensus states, exceeding limit %d", result.PrunedCount, limit)
+	}
+
+	return result.PrunedCount, nil
+}
+
 // VerifyUpgradeAndUpdateState obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
 // The new client and consensus states will be unmarshaled and an error is returned if the new client state is not at a height greater
 // than the existing client. On a successful verification, it expects the contract to update the new client state, consensus state, and any other client metadata.
@@ -509,3 +568,9 @@ func (l LightClientModule) VerifyUpgradeAndUpdateState(
 	_, err := l.keeper.WasmSudo(ctx, clientID, clientStore, clientState, payload)
 	return err
 }
+
+// isUnknownVariantError returns true if the contract call failed because the contract does not support
+// the sudo message sent to it, i.e. the contract failed to deserialize the message into a known variant.
+func isUnknownVariantError(err error) bool {
+	return errors.Is(err, types.ErrWasmContractCallFailed) && strings.Contains(err.Error(), "unknown variant")
+}
@@ -1396,6 +1396,108 @@ func (suite *WasmTestSuite) TestUpdateStateOnMisbehaviour() {
 	}
 }
 
+func (suite *WasmTestSuite) TestPruneExpiredConsensusStates() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned uint64
+		expErr    error
+	}{
+		{
+			"success",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneExpiredConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					var msg types.SudoMsg
+					err := json.Unmarshal(sudoMsg, &msg)
+					suite.Require().NoError(err)
+
+					suite.Require().NotNil(msg.PruneExpiredConsensusStates)
+					suite.Require().Equal(uint64(10), msg.PruneExpiredConsensusStates.Limit)
+
+					resp, err := json.Marshal(types.PruneExpiredConsensusStatesResult{PrunedCount: 3})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			3,
+			nil,
+		},
+		{
+			"success: contract does not support the sudo message",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneExpiredConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: "Error parsing into type SudoMsg: unknown variant `prune_expired_consensus_states`"}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			0,
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedWasmClientID
+			},
+			0,
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: contract pruned more consensus states than the limit",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneExpiredConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					resp, err := json.Marshal(types.PruneExpiredConsensusStatesResult{PrunedCount: 11})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			0,
+			types.ErrWasmInvalidResponseData,
+		},
+		{
+			"failure: err return from contract",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneExpiredConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, 0, nil
+				})
+			},
+			0,
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(clientID)
+			suite.Require().True(found)
+
+			pruningModule, ok := lightClientModule.(exported.ConsensusStatePruningModule)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			pruned, err := pruningModule.PruneExpiredConsensusStates(suite.chainA.GetContext(), clientID, 10)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *WasmTestSuite) TestRecoverClient() {
 	var (
 		expectedClientStateBz               []byte
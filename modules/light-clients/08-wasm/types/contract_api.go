@@ -52,6 +52,7 @@ type SudoMsg struct {
 	VerifyMembership            *VerifyMembershipMsg            `json:"verify_membership,omitempty"`
 	VerifyNonMembership         *VerifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
 	MigrateClientStore          *MigrateClientStoreMsg          `json:"migrate_client_store,omitempty"`
+	PruneExpiredConsensusStates *PruneExpiredConsensusStatesMsg `json:"prune_expired_consensus_states,omitempty"`
 }
 
 // UpdateStateMsg is a sudoMsg sent to the contract to update the client state.
@@ -94,9 +95,14 @@ type VerifyUpgradeAndUpdateStateMsg struct {
 // MigrateClientStoreMsg is a sudoMsg sent to the contract to verify a given substitute client and update to its state.
 type MigrateClientStoreMsg struct{}
 
+// PruneExpiredConsensusStatesMsg is a sudoMsg sent to the contract to prune at most limit expired consensus states.
+type PruneExpiredConsensusStatesMsg struct {
+	Limit uint64 `json:"limit"`
+}
+
 // ContractResult is a type constraint that defines the expected results that can be returned by a contract call/query.
 type ContractResult interface {
-	EmptyResult | StatusResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult
+	EmptyResult | StatusResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult | PruneExpiredConsensusStatesResult
 }
 
 // EmptyResult is the default return type of any contract call that does not require a custom return type.
@@ -123,3 +129,9 @@ type CheckForMisbehaviourResult struct {
 type UpdateStateResult struct {
 	Heights []clienttypes.Height `json:"heights"`
 }
+
+// PruneExpiredConsensusStatesResult is the expected return type of the pruneExpiredConsensusStatesMsg sudo call. It returns
+// the number of consensus states pruned.
+type PruneExpiredConsensusStatesResult struct {
+	PrunedCount uint64 `json:"pruned_count"`
+}
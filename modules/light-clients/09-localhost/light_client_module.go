@@ -165,6 +165,11 @@ func (LightClientModule) RecoverClient(_ sdk.Context, _, _ string) error {
 	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "cannot update localhost client with a proposal")
 }
 
+// DeleteClient returns an error since the localhost client cannot be deleted.
+func (LightClientModule) DeleteClient(_ sdk.Context, _ string) error {
+	return errorsmod.Wrap(clienttypes.ErrInvalidClientType, "cannot delete localhost client")
+}
+
 // VerifyUpgradeAndUpdateState returns an error since localhost cannot be upgraded.
 func (LightClientModule) VerifyUpgradeAndUpdateState(ctx sdk.Context, clientID string, newClient, newConsState, upgradeClientProof, upgradeConsensusStateProof []byte) error {
 	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
@@ -311,6 +311,14 @@ func (suite *LocalhostTestSuite) TestRecoverClient() {
 	suite.Require().Error(err)
 }
 
+func (suite *LocalhostTestSuite) TestDeleteClient() {
+	lightClientModule, found := suite.chain.GetSimApp().IBCKeeper.ClientKeeper.Route(exported.LocalhostClientID)
+	suite.Require().True(found)
+
+	err := lightClientModule.DeleteClient(suite.chain.GetContext(), exported.LocalhostClientID)
+	suite.Require().Error(err)
+}
+
 func (suite *LocalhostTestSuite) TestVerifyUpgradeAndUpdateState() {
 	lightClientModule, found := suite.chain.GetSimApp().IBCKeeper.ClientKeeper.Route(exported.LocalhostClientID)
 	suite.Require().True(found)
@@ -35,8 +35,9 @@ func (*TendermintConfig) GetClientType() string {
 }
 
 type ConnectionConfig struct {
-	DelayPeriod uint64
-	Version     *connectiontypes.Version
+	DelayPeriod     uint64
+	Version         *connectiontypes.Version
+	ProposedUpgrade connectiontypes.UpgradeFields
 }
 
 func NewConnectionConfig() *ConnectionConfig {
@@ -119,6 +119,9 @@ func (endpoint *Endpoint) CreateClient() (err error) {
 	)
 	require.NoError(endpoint.Chain.TB, err)
 
+	// store the sender as the client creator so that the counterparty may be provided using ProvideCounterparty
+	msg.AllowCounterparty = true
+
 	res, err := endpoint.Chain.SendMsgs(msg)
 	if err != nil {
 		return err
@@ -130,6 +133,17 @@ func (endpoint *Endpoint) CreateClient() (err error) {
 	return nil
 }
 
+// ProvideCounterparty provides the counterparty client identifier and merkle path prefix of the
+// client associated with the endpoint, enabling packets to be routed by client identifier.
+func (endpoint *Endpoint) ProvideCounterparty() error {
+	merklePathPrefix := commitmenttypes.NewMerklePath(string(endpoint.Counterparty.Chain.GetPrefix().Bytes()))
+	counterparty := clienttypes.NewCounterparty(endpoint.Counterparty.ClientID, merklePathPrefix)
+
+	msg := clienttypes.NewMsgProvideCounterparty(endpoint.ClientID, counterparty, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
 // UpdateClient updates the IBC client associated with the endpoint.
 func (endpoint *Endpoint) UpdateClient() (err error) {
 	// ensure counterparty has committed state
@@ -293,6 +307,227 @@ func (endpoint *Endpoint) ConnOpenConfirm() error {
 	return endpoint.Chain.sendMsgs(msg)
 }
 
+// ConnCloseInit will construct a MsgConnectionCloseInit on the associated endpoint
+// and submit it via governance proposal.
+func (endpoint *Endpoint) ConnCloseInit() error {
+	msg := connectiontypes.NewMsgConnectionCloseInit(
+		endpoint.ConnectionID,
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+
+	proposal, err := govtypesv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, govtypesv1.DefaultMinDepositTokens)),
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.ConnectionID,
+		"close-init",
+		fmt.Sprintf("gov proposal for closing connection: %s", endpoint.ConnectionID),
+		false,
+	)
+	require.NoError(endpoint.Chain.TB, err)
+
+	res, err := endpoint.Chain.SendMsgs(proposal)
+	if err != nil {
+		return err
+	}
+
+	proposalID, err := ParseProposalIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return VoteAndCheckProposalStatus(endpoint, proposalID)
+}
+
+// ConnCloseConfirm will construct and execute a MsgConnectionCloseConfirm on the associated endpoint.
+func (endpoint *Endpoint) ConnCloseConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionKey := host.ConnectionKey(endpoint.Counterparty.ConnectionID)
+	proof, height := endpoint.Counterparty.Chain.QueryProof(connectionKey)
+
+	msg := connectiontypes.NewMsgConnectionCloseConfirm(
+		endpoint.ConnectionID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.GetConnection().UpgradeSequence,
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// QueryConnectionUpgradeProof returns all the proofs necessary to execute ConnUpgradeTry/ConnUpgradeAck.
+// It returns the proof for the connection on the endpoint's chain, the proof for the upgrade attempt on the
+// endpoint's chain, and the height at which the proof was queried.
+func (endpoint *Endpoint) QueryConnectionUpgradeProof() ([]byte, []byte, clienttypes.Height) {
+	connectionKey := host.ConnectionKey(endpoint.ConnectionID)
+	connectionProof, height := endpoint.QueryProof(connectionKey)
+
+	upgradeKey := host.ConnectionUpgradeKey(endpoint.ConnectionID)
+	upgradeProof, _ := endpoint.QueryProof(upgradeKey)
+
+	return connectionProof, upgradeProof, height
+}
+
+// ConnUpgradeInit sends a MsgConnectionUpgradeInit on the associated endpoint.
+// The upgrade fields returned by GetProposedConnectionUpgrade are used and the
+// message is submitted via governance proposal.
+func (endpoint *Endpoint) ConnUpgradeInit() error {
+	msg := connectiontypes.NewMsgConnectionUpgradeInit(
+		endpoint.ConnectionID,
+		endpoint.GetProposedConnectionUpgrade(),
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+
+	proposal, err := govtypesv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, govtypesv1.DefaultMinDepositTokens)),
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.ConnectionID,
+		"upgrade-init",
+		fmt.Sprintf("gov proposal for initialising connection upgrade: %s", endpoint.ConnectionID),
+		false,
+	)
+	require.NoError(endpoint.Chain.TB, err)
+
+	res, err := endpoint.Chain.SendMsgs(proposal)
+	if err != nil {
+		return err
+	}
+
+	proposalID, err := ParseProposalIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return VoteAndCheckProposalStatus(endpoint, proposalID)
+}
+
+// ConnUpgradeTry sends a MsgConnectionUpgradeTry on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeTry() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionProof, upgradeProof, height := endpoint.Counterparty.QueryConnectionUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTry(
+		endpoint.ConnectionID,
+		counterpartyUpgrade.Fields,
+		endpoint.Counterparty.GetConnection().UpgradeSequence,
+		connectionProof,
+		upgradeProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeAck sends a MsgConnectionUpgradeAck on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeAck() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionProof, upgradeProof, height := endpoint.Counterparty.QueryConnectionUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeAck(
+		endpoint.ConnectionID,
+		counterpartyUpgrade,
+		connectionProof,
+		upgradeProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeConfirm sends a MsgConnectionUpgradeConfirm on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionKey := host.ConnectionKey(endpoint.Counterparty.ConnectionID)
+	connectionProof, height := endpoint.Counterparty.QueryProof(connectionKey)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeConfirm(
+		endpoint.ConnectionID,
+		connectionProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeTimeout sends a MsgConnectionUpgradeTimeout on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeTimeout() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionKey := host.ConnectionKey(endpoint.Counterparty.ConnectionID)
+	connectionProof, height := endpoint.Counterparty.QueryProof(connectionKey)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTimeout(
+		endpoint.ConnectionID,
+		endpoint.Counterparty.GetConnection(),
+		connectionProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeCancel sends a MsgConnectionUpgradeCancel on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeCancel() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	errorReceiptKey := host.ConnectionUpgradeErrorKey(endpoint.Counterparty.ConnectionID)
+	proofErrorReceipt, height := endpoint.Counterparty.QueryProof(errorReceiptKey)
+
+	errorReceipt, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeCancel(
+		endpoint.ConnectionID,
+		errorReceipt,
+		proofErrorReceipt,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// GetProposedConnectionUpgrade returns the upgrade fields of a connection upgrade proposed by the endpoint.
+// The current connection version, delay period and counterparty prefix are used by default.
+// If any non-empty values are specified in the ConnectionConfig's ProposedUpgrade,
+// those values will be used in the returned upgrade fields.
+func (endpoint *Endpoint) GetProposedConnectionUpgrade() connectiontypes.UpgradeFields {
+	connection := endpoint.GetConnection()
+
+	upgradeFields := connectiontypes.NewUpgradeFields(connection.Versions[0], connection.DelayPeriod, connection.Counterparty.Prefix)
+
+	override := endpoint.ConnectionConfig.ProposedUpgrade
+	if override.Version != nil {
+		upgradeFields.Version = override.Version
+	}
+
+	if override.DelayPeriod != 0 {
+		upgradeFields.DelayPeriod = override.DelayPeriod
+	}
+
+	if !override.CounterpartyPrefix.Empty() {
+		upgradeFields.CounterpartyPrefix = override.CounterpartyPrefix
+	}
+
+	return upgradeFields
+}
+
 // QueryConnectionHandshakeProof returns all the proofs necessary to execute OpenTry or Open Ack of
 // the connection handshakes. It returns the counterparty client state, proof of the counterparty
 // client state, proof of the counterparty consensus state, the consensus state height, proof of
@@ -459,6 +694,33 @@ func (endpoint *Endpoint) SendPacket(
 	return sequence, nil
 }
 
+// SendPacketWithClient sends a packet routed by the client identifier of the endpoint from the port
+// of the endpoint to the port of the counterparty endpoint. The packet sequence generated for the packet
+// to be sent is returned. An error is returned if one occurs.
+func (endpoint *Endpoint) SendPacketWithClient(
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	portCap := endpoint.Chain.GetPortCapability(endpoint.ChannelConfig.PortID)
+
+	// no need to send message, acting as a module
+	sequence, err := endpoint.Chain.App.GetIBCKeeper().PacketServerKeeper.SendPacket(endpoint.Chain.GetContext(), portCap, endpoint.ClientID, endpoint.ChannelConfig.PortID, endpoint.Counterparty.ChannelConfig.PortID, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// commit changes since no message was sent
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	err = endpoint.Counterparty.UpdateClient()
+	if err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
 // RecvPacket receives a packet on the associated endpoint.
 // The counterparty client is updated.
 func (endpoint *Endpoint) RecvPacket(packet channeltypes.Packet) error {
@@ -522,23 +784,28 @@ func (endpoint *Endpoint) AcknowledgePacket(packet channeltypes.Packet, ack []by
 
 // TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
-	// get proof for timeout based on channel order
-	var packetKey []byte
+	// packets routed by client identifier are timed out using the absence of a packet receipt
+	if packet.ProtocolVersion == channeltypes.IBC_VERSION_2 {
+		packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
 
-	switch endpoint.ChannelConfig.Order {
-	case channeltypes.ORDERED:
-		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
-		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-	default:
-		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+		timeoutMsg := channeltypes.NewMsgTimeout(packet, 0, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+		return endpoint.Chain.sendMsgs(timeoutMsg)
 	}
 
 	counterparty := endpoint.Counterparty
-	proof, proofHeight := counterparty.QueryProof(packetKey)
 	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
 	require.True(endpoint.Chain.TB, found)
 
+	// get proof for timeout based on channel order
+	packetKey, err := endpoint.timeoutProofKey(packet, nextSeqRecv)
+	if err != nil {
+		return err
+	}
+
+	proof, proofHeight := counterparty.QueryProof(packetKey)
+
 	timeoutMsg := channeltypes.NewMsgTimeout(
 		packet, nextSeqRecv,
 		proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
@@ -549,16 +816,13 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 
 // TimeoutOnClose sends a MsgTimeoutOnClose to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
-	// get proof for timeout based on channel order
-	var packetKey []byte
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.TB, found)
 
-	switch endpoint.ChannelConfig.Order {
-	case channeltypes.ORDERED:
-		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
-		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-	default:
-		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+	// get proof for timeout based on channel order
+	packetKey, err := endpoint.timeoutProofKey(packet, nextSeqRecv)
+	if err != nil {
+		return err
 	}
 
 	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
@@ -566,9 +830,6 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
 	closedProof, _ := endpoint.Counterparty.QueryProof(channelKey)
 
-	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
-	require.True(endpoint.Chain.TB, found)
-
 	timeoutOnCloseMsg := channeltypes.NewMsgTimeoutOnClose(
 		packet, nextSeqRecv,
 		proof, closedProof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
@@ -578,6 +839,26 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	return endpoint.Chain.sendMsgs(timeoutOnCloseMsg)
 }
 
+// timeoutProofKey returns the counterparty store key which must be proven to time out the packet
+// based on the channel order. On ORDERED_ALLOW_TIMEOUT channels the timeout receipt is proven
+// once the counterparty has moved past the packet sequence.
+func (endpoint *Endpoint) timeoutProofKey(packet channeltypes.Packet, nextSeqRecv uint64) ([]byte, error) {
+	switch endpoint.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		return host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()), nil
+	case channeltypes.UNORDERED:
+		return host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), nil
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		if nextSeqRecv > packet.GetSequence() {
+			return host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), nil
+		}
+
+		return host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()), nil
+	default:
+		return nil, fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+	}
+}
+
 // QueryChannelUpgradeProof returns all the proofs necessary to execute UpgradeTry/UpgradeAck/UpgradeOpen.
 // It returns the proof for the channel on the endpoint's chain, the proof for the upgrade attempt on the
 // endpoint's chain, and the height at which the proof was queried.
@@ -849,9 +1130,10 @@ func (endpoint *Endpoint) GetChannel() channeltypes.Channel {
 	return channel
 }
 
-// SetChannel sets the channel for this endpoint.
+// SetChannel sets the channel for this endpoint and indexes it under the connection of its first connection hop.
 func (endpoint *Endpoint) SetChannel(channel channeltypes.Channel) {
 	endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, channel)
+	endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SetConnectionChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, channel.ConnectionHops)
 }
 
 // GetChannelUpgrade retrieves an IBC Channel Upgrade for the endpoint. The upgrade
@@ -117,6 +117,20 @@ type IBCApp struct {
 		connectionHops []string,
 		version string,
 	)
+
+	OnChanUpgradeCancel func(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	)
+
+	AuthorizeClientRoute func(
+		ctx sdk.Context,
+		portID,
+		clientID,
+		counterpartyPortID,
+		counterpartyClientID string,
+	) error
 }
 
 // NewIBCApp returns a IBCApp. An empty PortID indicates the mock app doesn't bind/claim ports.
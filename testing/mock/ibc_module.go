@@ -17,9 +17,10 @@ import (
 )
 
 var (
-	_ porttypes.IBCModule             = (*IBCModule)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCModule)(nil)
-	_ porttypes.UpgradableModule      = (*IBCModule)(nil)
+	_ porttypes.IBCModule                = (*IBCModule)(nil)
+	_ porttypes.PacketDataUnmarshaler    = (*IBCModule)(nil)
+	_ porttypes.UpgradableModule         = (*IBCModule)(nil)
+	_ porttypes.UpgradeCancellableModule = (*IBCModule)(nil)
 )
 
 // applicationCallbackError is a custom error type that will be unique for testing purposes.
@@ -217,6 +218,23 @@ func (im IBCModule) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string,
 	}
 }
 
+// OnChanUpgradeCancel implements the UpgradeCancellableModule interface
+func (im IBCModule) OnChanUpgradeCancel(ctx sdk.Context, portID, channelID string) {
+	if im.IBCApp.OnChanUpgradeCancel != nil {
+		im.IBCApp.OnChanUpgradeCancel(ctx, portID, channelID)
+	}
+}
+
+// AuthorizeClientRoute implements the ClientRoutedModule interface. Packets routed by client identifier
+// are accepted unless the mock IBCApp overrides the callback.
+func (im IBCModule) AuthorizeClientRoute(ctx sdk.Context, portID, clientID, counterpartyPortID, counterpartyClientID string) error {
+	if im.IBCApp.AuthorizeClientRoute != nil {
+		return im.IBCApp.AuthorizeClientRoute(ctx, portID, clientID, counterpartyPortID, counterpartyClientID)
+	}
+
+	return nil
+}
+
 // UnmarshalPacketData returns the MockPacketData. This function implements the optional
 // PacketDataUnmarshaler interface required for ADR 008 support.
 func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
@@ -0,0 +1,384 @@
+package ibctesting
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MultihopPath contains the two endpoints of a multi-hop channel (ICS-33) together with the paths
+// connecting each pair of neighbouring chains. The channel is opened between the first and the last
+// chain and is routed over the connections of the intermediate chains.
+type MultihopPath struct {
+	EndpointA *MultihopEndpoint
+	EndpointB *MultihopEndpoint
+
+	// Paths contains the path of every connection hop, ordered from the chain of EndpointA to the chain of EndpointB.
+	Paths []*Path
+}
+
+// MultihopEndpoint represents a channel endpoint of a multi-hop channel. The connection hops of the channel
+// are the connections of the hop endpoints, ordered from the chain of the endpoint towards the counterparty chain.
+type MultihopEndpoint struct {
+	Chain        *TestChain
+	Counterparty *MultihopEndpoint
+	ChannelID    string
+
+	ChannelConfig *ChannelConfig
+
+	// Hops contains the endpoint of every connection hop, the first one being located on the chain of the endpoint.
+	Hops []*Endpoint
+}
+
+// NewMultihopPath constructs a path for every pair of neighbouring chains and the multi-hop endpoints on the
+// first and the last chain using default values. At least three chains must be provided.
+func NewMultihopPath(chains ...*TestChain) *MultihopPath {
+	if len(chains) < 3 {
+		panic(fmt.Errorf("multi-hop path requires at least 3 chains, got %d", len(chains)))
+	}
+
+	paths := make([]*Path, len(chains)-1)
+	for i := range paths {
+		paths[i] = NewPath(chains[i], chains[i+1])
+	}
+
+	hopsA := make([]*Endpoint, len(paths))
+	hopsB := make([]*Endpoint, len(paths))
+	for i, path := range paths {
+		hopsA[i] = path.EndpointA
+		hopsB[len(paths)-1-i] = path.EndpointB
+	}
+
+	endpointA := &MultihopEndpoint{
+		Chain:         chains[0],
+		ChannelConfig: NewChannelConfig(),
+		Hops:          hopsA,
+	}
+	endpointB := &MultihopEndpoint{
+		Chain:         chains[len(chains)-1],
+		ChannelConfig: NewChannelConfig(),
+		Hops:          hopsB,
+	}
+
+	endpointA.Counterparty = endpointB
+	endpointB.Counterparty = endpointA
+
+	return &MultihopPath{
+		EndpointA: endpointA,
+		EndpointB: endpointB,
+		Paths:     paths,
+	}
+}
+
+// Setup constructs TM clients and connections between every pair of neighbouring chains and
+// a multi-hop channel between the first and the last chain. It will fail if any error occurs.
+func (path *MultihopPath) Setup() {
+	path.SetupConnections()
+
+	path.CreateChannels()
+}
+
+// SetupConnections is a helper function to create clients and connections between every pair of
+// neighbouring chains. It assumes the caller does not anticipate any errors.
+func (path *MultihopPath) SetupConnections() {
+	for _, p := range path.Paths {
+		p.SetupConnections()
+	}
+}
+
+// CreateChannels constructs and executes channel handshake messages in order to create
+// an OPEN multi-hop channel on the first and the last chain. The function expects the
+// channels to be successfully opened otherwise testing will fail.
+func (path *MultihopPath) CreateChannels() {
+	if err := path.EndpointA.ChanOpenInit(); err != nil {
+		panic(err)
+	}
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		panic(err)
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		panic(err)
+	}
+
+	if err := path.EndpointB.ChanOpenConfirm(); err != nil {
+		panic(err)
+	}
+}
+
+// RelayPacket attempts to relay the packet first on EndpointA and then on EndpointB
+// if EndpointA does not contain a packet commitment for that packet. An error is returned
+// if a relay step fails or the packet commitment does not exist on either endpoint.
+func (path *MultihopPath) RelayPacket(packet channeltypes.Packet) error {
+	for _, endpoint := range []*MultihopEndpoint{path.EndpointA, path.EndpointB} {
+		pc := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(endpoint.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		if !bytes.Equal(pc, channeltypes.CommitPacket(endpoint.Chain.App.AppCodec(), packet)) {
+			continue
+		}
+
+		res, err := endpoint.Counterparty.RecvPacketWithResult(packet)
+		if err != nil {
+			return err
+		}
+
+		ack, err := ParseAckFromEvents(res.Events)
+		if err != nil {
+			return err
+		}
+
+		return endpoint.AcknowledgePacket(packet, ack)
+	}
+
+	return fmt.Errorf("packet commitment does not exist on either endpoint for provided packet")
+}
+
+// ConnectionHops returns the connection hops of the channel end of the endpoint.
+func (endpoint *MultihopEndpoint) ConnectionHops() []string {
+	connectionHops := make([]string, len(endpoint.Hops))
+	for i, hop := range endpoint.Hops {
+		connectionHops[i] = hop.ConnectionID
+	}
+
+	return connectionHops
+}
+
+// QueryProof queries a multi-hop proof of the key stored on the chain of the endpoint, which is verified
+// by the counterparty over its connection hops. The clients of the connection hops are updated starting
+// from the chain of the endpoint, so that every intermediate chain is proven at the latest height of the
+// client tracking it. The proof height is the latest height of the client of the first counterparty hop.
+func (endpoint *MultihopEndpoint) QueryProof(key []byte) ([]byte, clienttypes.Height) {
+	hops := endpoint.Counterparty.Hops
+	for i := len(hops) - 1; i >= 0; i-- {
+		err := hops[i].UpdateClient()
+		require.NoError(endpoint.Chain.TB, err)
+	}
+
+	// the key is proven at the height of the consensus state stored by the last counterparty hop
+	lastHop := hops[len(hops)-1]
+	keyProof, _ := lastHop.Counterparty.QueryProofAtHeight(key, lastHop.GetClientLatestHeight().GetRevisionHeight())
+
+	multihopProof := connectiontypes.MultihopProof{
+		HopProofs: make([]connectiontypes.HopProof, len(hops)-1),
+		KeyProof:  keyProof,
+	}
+
+	for i := range multihopProof.HopProofs {
+		hop := hops[i+1]
+		proofHeight := hops[i].GetClientLatestHeight().GetRevisionHeight()
+
+		consensusHeight, ok := hop.GetClientLatestHeight().(clienttypes.Height)
+		require.True(endpoint.Chain.TB, ok)
+
+		consensusState, err := clienttypes.PackConsensusState(hop.GetConsensusState(consensusHeight))
+		require.NoError(endpoint.Chain.TB, err)
+
+		clientState, err := clienttypes.PackClientState(hop.GetClientState())
+		require.NoError(endpoint.Chain.TB, err)
+
+		proofConnection, _ := hop.QueryProofAtHeight(host.ConnectionKey(hop.ConnectionID), proofHeight)
+		proofConsensus, _ := hop.QueryProofAtHeight(host.FullConsensusStateKey(hop.ClientID, consensusHeight), proofHeight)
+		proofClientState, _ := hop.QueryProofAtHeight(host.FullClientStateKey(hop.ClientID), proofHeight)
+
+		multihopProof.HopProofs[i] = connectiontypes.HopProof{
+			Connection:       hop.GetConnection(),
+			ProofConnection:  proofConnection,
+			ConsensusState:   consensusState,
+			ConsensusHeight:  consensusHeight,
+			ProofConsensus:   proofConsensus,
+			ClientState:      clientState,
+			ProofClientState: proofClientState,
+		}
+	}
+
+	proof, err := endpoint.Chain.App.AppCodec().Marshal(&multihopProof)
+	require.NoError(endpoint.Chain.TB, err)
+
+	proofHeight, ok := hops[0].GetClientLatestHeight().(clienttypes.Height)
+	require.True(endpoint.Chain.TB, ok)
+
+	return proof, proofHeight
+}
+
+// ChanOpenInit will construct and execute a MsgChannelOpenInit on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenInit() error {
+	msg := channeltypes.NewMsgChannelOpenInit(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID, err = ParseChannelIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	// update version to selected app version
+	// NOTE: this update must be performed after SendMsgs()
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+	endpoint.Counterparty.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenTry will construct and execute a MsgChannelOpenTry on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenTry() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.Counterparty.QueryProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenTry(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	if endpoint.ChannelID == "" {
+		endpoint.ChannelID, err = ParseChannelIDFromEvents(res.Events)
+		require.NoError(endpoint.Chain.TB, err)
+	}
+
+	// update version to selected app version
+	// NOTE: this update must be performed after the endpoint channelID is set
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+	endpoint.Counterparty.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenAck will construct and execute a MsgChannelOpenAck on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenAck() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.Counterparty.QueryProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenAck(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version, // testing doesn't use flexible selection
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenConfirm will construct and execute a MsgChannelOpenConfirm on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenConfirm() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.Counterparty.QueryProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// SendPacket sends a packet through the channel keeper using the associated endpoint.
+// The packet sequence generated for the packet to be sent is returned. An error
+// is returned if one occurs.
+func (endpoint *MultihopEndpoint) SendPacket(
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	channelCap := endpoint.Chain.GetChannelCapability(endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+
+	// no need to send message, acting as a module
+	sequence, err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), channelCap, endpoint.ChannelConfig.PortID, endpoint.ChannelID, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// commit changes since no message was sent
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return sequence, nil
+}
+
+// RecvPacket receives a packet on the associated endpoint.
+func (endpoint *MultihopEndpoint) RecvPacket(packet channeltypes.Packet) error {
+	_, err := endpoint.RecvPacketWithResult(packet)
+	return err
+}
+
+// RecvPacketWithResult receives a packet on the associated endpoint and the result
+// of the transaction is returned.
+func (endpoint *MultihopEndpoint) RecvPacketWithResult(packet channeltypes.Packet) (*abci.ExecTxResult, error) {
+	// get proof of packet commitment on source
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.SendMsgs(recvMsg)
+}
+
+// AcknowledgePacket sends a MsgAcknowledgement to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) AcknowledgePacket(packet channeltypes.Packet, ack []byte) error {
+	// get proof of acknowledgement on counterparty
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+
+	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
+// TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) TimeoutPacket(packet channeltypes.Packet) error {
+	counterparty := endpoint.Counterparty
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	// get proof for timeout based on channel order
+	var packetKey []byte
+	switch endpoint.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.UNORDERED:
+		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	default:
+		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+	}
+
+	proof, proofHeight := counterparty.QueryProof(packetKey)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(
+		packet, nextSeqRecv,
+		proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(timeoutMsg)
+}
+
+// GetChannel retrieves an IBC Channel for the endpoint. The channel
+// is expected to exist otherwise testing will fail.
+func (endpoint *MultihopEndpoint) GetChannel() channeltypes.Channel {
+	channel, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	return channel
+}
@@ -66,6 +66,12 @@ func (path *Path) SetChannelOrdered() {
 	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
 }
 
+// SetChannelOrderedAllowTimeout sets the channel order for both endpoints to ORDERED_ALLOW_TIMEOUT.
+func (path *Path) SetChannelOrderedAllowTimeout() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+}
+
 // RelayPacket attempts to relay the packet first on EndpointA and then on EndpointB
 // if EndpointA does not contain a packet commitment for that packet. An error is returned
 // if a relay step fails or the packet commitment does not exist on either endpoint.
@@ -155,6 +161,19 @@ func (path *Path) SetupClients() {
 	}
 }
 
+// SetupCounterparties is a helper function to provide the counterparty of the clients on both chains,
+// enabling packets to be routed by client identifier. It assumes the clients have been created and the
+// caller does not anticipate any errors.
+func (path *Path) SetupCounterparties() {
+	if err := path.EndpointA.ProvideCounterparty(); err != nil {
+		panic(err)
+	}
+
+	if err := path.EndpointB.ProvideCounterparty(); err != nil {
+		panic(err)
+	}
+}
+
 // SetupConnections is a helper function to create clients and the appropriate
 // connections on both the source and counterparty chain. It assumes the caller does not
 // anticipate any errors.